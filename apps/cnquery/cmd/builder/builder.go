@@ -151,6 +151,9 @@ func buildCmd(baseCmd *cobra.Command, commonCmdFlags commonFlagsFn, preRun commo
 	baseCmd.AddCommand(ms365ProviderCmd(commonCmdFlags, preRun, runFn, docs))
 	baseCmd.AddCommand(hostProviderCmd(commonCmdFlags, preRun, runFn, docs))
 	baseCmd.AddCommand(aristaProviderCmd(commonCmdFlags, preRun, runFn, docs))
+	baseCmd.AddCommand(diskImageProviderCmd(commonCmdFlags, preRun, runFn, docs))
+	baseCmd.AddCommand(dockerfileProviderCmd(commonCmdFlags, preRun, runFn, docs))
+	baseCmd.AddCommand(cloudformationProviderCmd(commonCmdFlags, preRun, runFn, docs))
 }
 
 type CommandsDocs struct {
@@ -237,6 +240,7 @@ func terraformProviderCmd(commonCmdFlags commonFlagsFn, preRun commonPreRunFn, r
 		},
 	}
 	commonCmdFlags(cmd)
+	cmd.Flags().StringArray("var", nil, "set a Terraform input variable like terraform does, e.g. --var region=eu-west-1")
 	return cmd
 }
 
@@ -423,6 +427,8 @@ func kubernetesProviderCmd(commonCmdFlags commonFlagsFn, preRun commonPreRunFn,
 	cmd.Flags().String("context", "", "target a kubernetes context")
 	cmd.Flags().String("namespaces-exclude", "", "filter out kubernetes objects in the matching namespaces")
 	cmd.Flags().String("namespaces", "", "only include kubernetes object in the matching namespaces")
+	cmd.Flags().StringSlice("helm-values", nil, "values files used to render a helm chart directory")
+	cmd.Flags().String("helm-release-name", "", "release name used to render a helm chart directory")
 	return cmd
 }
 
@@ -687,6 +693,8 @@ func gitlabProviderCmd(commonCmdFlags commonFlagsFn, preRun commonPreRunFn, runF
 		PreRun: func(cmd *cobra.Command, args []string) {
 			viper.BindPFlag("token", cmd.Flags().Lookup("token"))
 			viper.BindPFlag("group", cmd.Flags().Lookup("group"))
+			viper.BindPFlag("project", cmd.Flags().Lookup("project"))
+			viper.BindPFlag("url", cmd.Flags().Lookup("url"))
 			preRun(cmd, args)
 		},
 		Run: func(cmd *cobra.Command, args []string) {
@@ -696,6 +704,8 @@ func gitlabProviderCmd(commonCmdFlags commonFlagsFn, preRun commonPreRunFn, runF
 	commonCmdFlags(cmd)
 	cmd.Flags().String("group", "", "a GitLab group to scan")
 	cmd.MarkFlagRequired("group")
+	cmd.Flags().String("project", "", "a GitLab project to scan, either the path within the group or the full path")
+	cmd.Flags().String("url", "", "GitLab API url of self-managed instances, e.g. https://gitlab.example.com/api/v4")
 	cmd.Flags().String("token", "", "GitLab personal access token")
 	return cmd
 }
@@ -751,3 +761,53 @@ func aristaProviderCmd(commonCmdFlags commonFlagsFn, preRun commonPreRunFn, runF
 	commonCmdFlags(cmd)
 	return cmd
 }
+
+func diskImageProviderCmd(commonCmdFlags commonFlagsFn, preRun commonPreRunFn, runFn runFn, docs CommandsDocs) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:    "disk PATH",
+		Short:  docs.GetShort("disk"),
+		Long:   docs.GetLong("disk"),
+		Args:   cobra.ExactArgs(1),
+		PreRun: preRun,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Flags().Set("path", args[0])
+			runFn(cmd, args, providers.ProviderType_DISK_IMAGE, DefaultAssetType)
+		},
+	}
+	commonCmdFlags(cmd)
+	cmd.Flags().String("partition", "", "number of the partition with the root filesystem, detected by default")
+	return cmd
+}
+
+func dockerfileProviderCmd(commonCmdFlags commonFlagsFn, preRun commonPreRunFn, runFn runFn, docs CommandsDocs) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:    "docker-file PATH",
+		Short:  docs.GetShort("docker-file"),
+		Long:   docs.GetLong("docker-file"),
+		Args:   cobra.ExactArgs(1),
+		PreRun: preRun,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Flags().Set("path", args[0])
+			runFn(cmd, args, providers.ProviderType_DOCKERFILE, DefaultAssetType)
+		},
+	}
+	commonCmdFlags(cmd)
+	return cmd
+}
+
+func cloudformationProviderCmd(commonCmdFlags commonFlagsFn, preRun commonPreRunFn, runFn runFn, docs CommandsDocs) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cloudformation PATH",
+		Aliases: []string{"cfn"},
+		Short:   docs.GetShort("cloudformation"),
+		Long:    docs.GetLong("cloudformation"),
+		Args:    cobra.ExactArgs(1),
+		PreRun:  preRun,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Flags().Set("path", args[0])
+			runFn(cmd, args, providers.ProviderType_CLOUDFORMATION, DefaultAssetType)
+		},
+	}
+	commonCmdFlags(cmd)
+	return cmd
+}
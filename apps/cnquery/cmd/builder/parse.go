@@ -12,6 +12,8 @@ import (
 	"go.mondoo.com/cnquery/motor/motorid/awsec2"
 	"go.mondoo.com/cnquery/motor/providers"
 	"go.mondoo.com/cnquery/motor/providers/awsec2ebs"
+	"go.mondoo.com/cnquery/motor/providers/k8s"
+	"go.mondoo.com/cnquery/motor/providers/terraform"
 	"go.mondoo.com/cnquery/motor/vault"
 )
 
@@ -118,9 +120,23 @@ func ParseTargetAsset(cmd *cobra.Command, args []string, providerType providers.
 	case providers.ProviderType_MOCK:
 		connection.Backend = providerType
 		connection.Options["path"] = filepath
+	case providers.ProviderType_DISK_IMAGE:
+		connection.Backend = providerType
+		connection.Options["path"] = filepath
+		if partition, err := cmd.Flags().GetString("partition"); err != nil {
+			log.Fatal().Err(err).Msg("cannot parse --partition value")
+		} else if partition != "" {
+			connection.Options["partition"] = partition
+		}
 	case providers.ProviderType_VAGRANT:
 		connection.Backend = providerType
 		connection.Host = args[0]
+	case providers.ProviderType_DOCKERFILE:
+		connection.Backend = providerType
+		connection.Options["path"] = filepath
+	case providers.ProviderType_CLOUDFORMATION:
+		connection.Backend = providerType
+		connection.Options["path"] = filepath
 	case providers.ProviderType_TERRAFORM:
 		connection.Backend = providerType
 		connection.Options["path"] = filepath
@@ -128,6 +144,17 @@ func ParseTargetAsset(cmd *cobra.Command, args []string, providerType providers.
 		switch assetType {
 		case TerraformHclAssetType:
 			connection.Options["asset-type"] = "hcl"
+			vars, err := cmd.Flags().GetStringArray("var")
+			if err != nil {
+				log.Fatal().Err(err).Msg("cannot parse --var value")
+			}
+			for _, v := range vars {
+				name, value, ok := strings.Cut(v, "=")
+				if !ok {
+					log.Fatal().Str("var", v).Msg("--var must be set as name=value")
+				}
+				connection.Options[terraform.VarOptionPrefix+name] = value
+			}
 		case TerraformPlanAssetType:
 			connection.Options["asset-type"] = "plan"
 		case TerraformStateAssetType:
@@ -249,6 +276,18 @@ func ParseTargetAsset(cmd *cobra.Command, args []string, providerType providers.
 		} else if includeNamespaces != "" {
 			connection.Options["namespaces"] = includeNamespaces
 		}
+
+		if helmValues, err := cmd.Flags().GetStringSlice("helm-values"); err != nil {
+			log.Fatal().Err(err).Msg("cannot parse --helm-values values")
+		} else if len(helmValues) > 0 {
+			connection.Options[k8s.OPTION_HELM_VALUES] = strings.Join(helmValues, ",")
+		}
+
+		if releaseName, err := cmd.Flags().GetString("helm-release-name"); err != nil {
+			log.Fatal().Err(err).Msg("cannot parse --helm-release-name value")
+		} else if releaseName != "" {
+			connection.Options[k8s.OPTION_HELM_RELEASE_NAME] = releaseName
+		}
 	case providers.ProviderType_AWS:
 		connection.Backend = providerType
 		if profile, err := cmd.Flags().GetString("profile"); err != nil {
@@ -427,6 +466,18 @@ func ParseTargetAsset(cmd *cobra.Command, args []string, providerType providers.
 			connection.Options["group"] = x
 		}
 
+		if x, err := cmd.Flags().GetString("project"); err != nil {
+			log.Fatal().Err(err).Msg("cannot parse --project value")
+		} else if x != "" {
+			connection.Options["project"] = x
+		}
+
+		if x, err := cmd.Flags().GetString("url"); err != nil {
+			log.Fatal().Err(err).Msg("cannot parse --url value")
+		} else if x != "" {
+			connection.Options["url"] = x
+		}
+
 		if x, err := cmd.Flags().GetString("token"); err != nil {
 			log.Fatal().Err(err).Msg("cannot parse --token value")
 		} else if x != "" {
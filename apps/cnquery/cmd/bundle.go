@@ -25,6 +25,12 @@ func init() {
 	// bundle validate
 	packBundlesCmd.AddCommand(queryPackValidateCmd)
 
+	// bundle lint
+	queryPackLintCmd.Flags().StringP("output", "o", "cli", "Set output format: cli, json")
+	queryPackLintCmd.Flags().String("min-version", "", "Report queries that need a newer cnquery version than this one")
+	queryPackLintCmd.Flags().StringSlice("known-tags", nil, "Tag keys that are used by your tooling, all other tags are reported as unused")
+	packBundlesCmd.AddCommand(queryPackLintCmd)
+
 	// bundle add
 	queryPackUploadCmd.Flags().String("pack-version", "", "Override the version of each pack in the bundle")
 	packBundlesCmd.AddCommand(queryPackUploadCmd)
@@ -130,6 +136,54 @@ var queryPackValidateCmd = &cobra.Command{
 	},
 }
 
+var queryPackLintCmd = &cobra.Command{
+	Use:   "lint [path...]",
+	Short: "Lint query pack bundles and report all issues with their position",
+	Long: `Lint query pack bundles and report all issues with their position.
+
+The command exits with a non-zero exit code if any errors are found,
+which makes it possible to use it as a gate in CI pipelines.`,
+	Args: cobra.MinimumNArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		viper.BindPFlag("output", cmd.Flags().Lookup("output"))
+		viper.BindPFlag("min-version", cmd.Flags().Lookup("min-version"))
+		viper.BindPFlag("known-tags", cmd.Flags().Lookup("known-tags"))
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		output := viper.GetString("output")
+		if output != "cli" && output != "json" {
+			log.Fatal().Str("output", output).Msg("unknown output format, available: cli, json")
+		}
+
+		results, err := explorer.LintBundleFiles(explorer.LintOptions{
+			MinMondooVersion: viper.GetString("min-version"),
+			KnownTags:        viper.GetStringSlice("known-tags"),
+		}, args...)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not lint query packs")
+		}
+
+		if output == "json" {
+			data, err := results.ToJSON()
+			if err != nil {
+				log.Fatal().Err(err).Msg("could not render lint results")
+			}
+			fmt.Println(string(data))
+		} else {
+			for i := range results.Entries {
+				fmt.Println(results.Entries[i].String())
+			}
+			if len(results.Entries) == 0 {
+				log.Info().Msg("no issues found")
+			}
+		}
+
+		if results.HasError() {
+			os.Exit(1)
+		}
+	},
+}
+
 var queryPackUploadCmd = &cobra.Command{
 	Use:   "upload [path]",
 	Short: "Add a user-owned pack to Mondoo Platform's Query Hub",
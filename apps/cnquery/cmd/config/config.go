@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/cockroachdb/errors"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/viper"
@@ -28,6 +30,13 @@ type CliConfig struct {
 	// Asset Category
 	Category               string `json:"category,omitempty" mapstructure:"category"`
 	AutoDetectCICDCategory bool   `json:"detect-cicd,omitempty" mapstructure:"detect-cicd"`
+
+	// path to a local datalake, which persists results across scans
+	Datalake string `json:"datalake,omitempty" mapstructure:"datalake"`
+
+	// path to a local field cache, which reuses resource fields across runs
+	FieldCache    string        `json:"field-cache,omitempty" mapstructure:"field-cache"`
+	FieldCacheTTL time.Duration `json:"field-cache-ttl,omitempty" mapstructure:"field-cache-ttl"`
 }
 
 type CommonCliConfig struct {
@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.mondoo.com/cnquery/cli/reporter"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/internal/datalakes/boltdb"
+)
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+	diffCmd.Flags().BoolP("json", "j", false, "Set output to JSON")
+	diffCmd.Flags().Bool("exit-code", false, "Exit with 1 if there are differences and 0 otherwise")
+	diffCmd.Flags().String("datalake", "", "Compare the two most recent scans of an asset in a local datalake file")
+}
+
+var diffCmd = &cobra.Command{
+	Use:   "diff OLD NEW | --datalake PATH [ASSET-MRN]",
+	Short: "Compare two scan reports",
+	Long: `Compare two scan reports and show which data values were added, removed
+or changed for every asset and query.
+
+Reports are created via:
+
+    cnquery scan ... --output report > scan.json
+
+Only reports are supported, the json output of scans does not contain the
+types and code of the queries that are needed to compare data values.
+
+Assets are aligned by their platform IDs and queries by their code ID.
+Assets that failed to scan are compared by their error, not their data.
+
+Scans that were run with --datalake keep the history of every asset. The
+two most recent scans of an asset are compared via:
+
+    cnquery diff --datalake datalake.db ASSET-MRN
+
+The asset MRN can be omitted if the datalake only contains one asset.`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if datalake, _ := cmd.Flags().GetString("datalake"); datalake != "" {
+			return cobra.MaximumNArgs(1)(cmd, args)
+		}
+		return cobra.ExactArgs(2)(cmd, args)
+	},
+	PreRun: func(cmd *cobra.Command, args []string) {
+		viper.BindPFlag("json", cmd.Flags().Lookup("json"))
+		viper.BindPFlag("exit-code", cmd.Flags().Lookup("exit-code"))
+		viper.BindPFlag("datalake", cmd.Flags().Lookup("datalake"))
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		var old, cur *explorer.ReportCollection
+		if datalake := viper.GetString("datalake"); datalake != "" {
+			assetMrn := ""
+			if len(args) == 1 {
+				assetMrn = args[0]
+			}
+			var err error
+			old, cur, err = loadDatalakeHistory(datalake, assetMrn)
+			if err != nil {
+				log.Fatal().Err(err).Str("datalake", datalake).Msg("could not load reports")
+			}
+		} else {
+			var err error
+			old, err = loadReportCollection(args[0])
+			if err != nil {
+				log.Fatal().Err(err).Str("file", args[0]).Msg("could not load report")
+			}
+
+			cur, err = loadReportCollection(args[1])
+			if err != nil {
+				log.Fatal().Err(err).Str("file", args[1]).Msg("could not load report")
+			}
+		}
+
+		diff := explorer.DiffReports(old, cur)
+
+		format := "compact"
+		if viper.GetBool("json") {
+			format = "json"
+		}
+		r, err := reporter.New(format)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not initialize reporter")
+		}
+
+		if err = r.PrintDiff(diff, os.Stdout); err != nil {
+			log.Fatal().Err(err).Msg("failed to print")
+		}
+
+		if viper.GetBool("exit-code") && diff.HasChanges() {
+			os.Exit(1)
+		}
+	},
+}
+
+func loadReportCollection(path string) (*explorer.ReportCollection, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return explorer.ReportCollectionFromJSON(data)
+}
+
+// loadDatalakeHistory returns the two most recent scans of an asset. Without
+// an asset MRN, the datalake must contain exactly one asset.
+func loadDatalakeHistory(path string, assetMrn string) (*explorer.ReportCollection, *explorer.ReportCollection, error) {
+	// opening the datalake would create a new and empty one
+	if _, err := os.Stat(path); err != nil {
+		return nil, nil, err
+	}
+
+	var history []*explorer.ReportCollection
+	err := boltdb.WithDb(path, func(db *boltdb.Db, _ *explorer.LocalServices) error {
+		ctx := context.Background()
+		if assetMrn == "" {
+			assets, err := db.ListReportAssets(ctx)
+			if err != nil {
+				return err
+			}
+			if len(assets) == 0 {
+				return errors.New("datalake does not contain any reports")
+			}
+			if len(assets) > 1 {
+				return errors.New("datalake contains " + strconv.Itoa(len(assets)) + " assets, select one of: " + strings.Join(assets, ", "))
+			}
+			assetMrn = assets[0]
+		}
+
+		var err error
+		history, err = db.ReportHistory(ctx, assetMrn)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(history) < 2 {
+		return nil, nil, errors.New("asset '" + assetMrn + "' needs at least two scans to compare")
+	}
+	return history[1], history[0], nil
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/hashicorp/go-plugin"
@@ -10,6 +11,7 @@ import (
 	"go.mondoo.com/cnquery/cli/printer"
 	"go.mondoo.com/cnquery/cli/reporter"
 	"go.mondoo.com/cnquery/cli/shell"
+	"go.mondoo.com/cnquery/internal/fieldcache"
 	"go.mondoo.com/cnquery/logger"
 	"go.mondoo.com/cnquery/motor/asset"
 	"go.mondoo.com/cnquery/motor/discovery"
@@ -109,6 +111,19 @@ func (c *cnqueryPlugin) RunQuery(conf *proto.RunQueryConfig, out shared.OutputHe
 		out.WriteString("[")
 	}
 
+	var cache *fieldcache.Cache
+	if conf.FieldCache != "" {
+		cache, err = fieldcache.Open(conf.FieldCache, time.Duration(conf.FieldCacheTtl)*time.Second)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := cache.Close(); err != nil {
+				log.Error().Err(err).Msg("failed to store field cache")
+			}
+		}()
+	}
+
 	for i := range filteredAssets {
 		connectAsset := filteredAssets[i]
 		m, err := provider_resolver.OpenAssetConnection(ctx, connectAsset, im.GetCredential, conf.DoRecord)
@@ -125,6 +140,9 @@ func (c *cnqueryPlugin) RunQuery(conf *proto.RunQueryConfig, out shared.OutputHe
 		shellOptions = append(shellOptions, shell.WithOnCloseListener(onCloseHandler))
 		shellOptions = append(shellOptions, shell.WithFeatures(conf.Features))
 		shellOptions = append(shellOptions, shell.WithOutput(out))
+		if cache != nil {
+			shellOptions = append(shellOptions, shell.WithFieldCache(cache))
+		}
 
 		sh, err := shell.New(m, shellOptions...)
 		if err != nil {
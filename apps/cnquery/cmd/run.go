@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"os"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/rs/zerolog/log"
@@ -52,6 +53,9 @@ var execCmd = builder.NewProviderCommand(builder.CommandOpts{
 		cmd.Flags().StringToString("option", nil, "Additional connection options, multiple options can be passed in via --option key=value")
 		cmd.Flags().String("discover", common.DiscoveryAuto, "Enable the discovery of nested assets. Supported are 'all|auto|instances|host-instances|host-machines|container|container-images|pods|cronjobs|statefulsets|deployments|jobs|replicasets|daemonsets'")
 		cmd.Flags().StringToString("discover-filter", nil, "Additional filter for asset discovery")
+
+		cmd.Flags().String("field-cache", "", "Path to a local cache file, which reuses resource fields of previous runs")
+		cmd.Flags().Duration("field-cache-ttl", time.Hour, "Duration that cached resource fields stay valid")
 	},
 	CommonPreRun: func(cmd *cobra.Command, args []string) {
 		// for all assets
@@ -67,6 +71,8 @@ var execCmd = builder.NewProviderCommand(builder.CommandOpts{
 
 		viper.BindPFlag("record", cmd.Flags().Lookup("record"))
 		viper.BindPFlag("record-file", cmd.Flags().Lookup("record-file"))
+		viper.BindPFlag("field-cache", cmd.Flags().Lookup("field-cache"))
+		viper.BindPFlag("field-cache-ttl", cmd.Flags().Lookup("field-cache-ttl"))
 	},
 	Run: func(cmd *cobra.Command, args []string, provider providers.ProviderType, assetType builder.AssetType) {
 		conf, err := GetCobraRunConfig(cmd, args, provider, assetType)
@@ -87,7 +93,9 @@ var execCmd = builder.NewProviderCommand(builder.CommandOpts{
 // and translates them into a config for the runner.
 func GetCobraRunConfig(cmd *cobra.Command, args []string, provider providers.ProviderType, assetType builder.AssetType) (*proto.RunQueryConfig, error) {
 	conf := proto.RunQueryConfig{
-		Features: config.Features,
+		Features:      config.Features,
+		FieldCache:    viper.GetString("field-cache"),
+		FieldCacheTtl: int64(viper.GetDuration("field-cache-ttl").Seconds()),
 	}
 
 	// check if the user used --password without a value
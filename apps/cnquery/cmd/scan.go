@@ -6,6 +6,7 @@ import (
 	"os"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
@@ -90,6 +91,12 @@ You can also explicitly request the scan of an image or a container registry:
 
     cnquery scan container image ubuntu:20.04
     cnquery scan container registry harbor.lunalectric.com/project/repository
+
+Images stored in an OCI image layout or a docker archive are scanned without
+docker, each image in the layout or archive is scanned as a separate asset:
+
+    cnquery scan container oci-dir:/path/to/layout
+    cnquery scan container docker-archive:/path/to/images.tar
 `,
 			},
 			"container-image": {
@@ -127,6 +134,11 @@ or the image name (e.g. ubuntu:latest).`,
 			},
 			"kubernetes": {
 				Short: "Scan a Kubernetes cluster",
+				Long: `Scan a Kubernetes cluster, a manifest or a local Helm chart. A directory with
+a Chart.yaml is rendered with its values files before the scan:
+
+    cnquery scan k8s ./mychart --helm-values values-prod.yaml
+`,
 			},
 			"aws": {
 				Short: "Scan an AWS account or instance",
@@ -185,7 +197,7 @@ configure your Azure credentials and have SSH access to your instances.`,
 				Short: "Scan a GitHub repository",
 			},
 			"gitlab": {
-				Short: "Scan a GitLab group",
+				Short: "Scan a GitLab group or project",
 			},
 			"ms365": {
 				Short: "Scan a Microsoft 365 endpoint",
@@ -206,6 +218,32 @@ This example connects to Microsoft 365 using the PKCS #12 formatted certificate:
 			"arista": {
 				Short: "Scan an Arista endpoint",
 			},
+			"disk": {
+				Short: "Scan a virtual machine disk image",
+				Long: `Scan a virtual machine disk image without mounting it. Supported formats are
+raw, qcow2, VMDK and VHD images with MBR or GPT partitions and ext4, XFS or FAT filesystems:
+
+    cnquery scan disk ubuntu.qcow2
+    cnquery scan disk disk.vmdk --partition 2
+
+The partition with /etc/os-release is used as root filesystem by default.
+`,
+			},
+			"docker-file": {
+				Short: "Scan a Dockerfile",
+				Long: `Scan a Dockerfile for static analysis, the path is either the Dockerfile or
+a directory with a Dockerfile:
+
+    cnquery scan docker-file ./Dockerfile
+`,
+			},
+			"cloudformation": {
+				Short: "Scan an AWS CloudFormation template",
+				Long: `Scan an AWS CloudFormation template in JSON or YAML format for static analysis:
+
+    cnquery scan cloudformation ./template.yaml
+`,
+			},
 		},
 	},
 	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -224,6 +262,11 @@ This example connects to Microsoft 365 using the PKCS #12 formatted certificate:
 		cmd.Flags().Bool("incognito", false, "Run in incognito mode. Do not report scan results to the Mondoo platform.")
 		cmd.Flags().StringSlice("querypack", nil, "Set the query packs to be executed (requires querypack-bundle). Multiple UIDs can be specified")
 		cmd.Flags().StringSliceP("querypack-bundle", "f", nil, "Path to local query pack file")
+		cmd.Flags().StringArray("props", nil, "Override query pack properties, multiple props can be passed in via --props name=value")
+		cmd.Flags().String("props-file", "", "Path to a YAML or JSON file with query pack property values")
+		cmd.Flags().String("datalake", "", "Path to a local datalake file, which keeps the results of all scans")
+		cmd.Flags().String("field-cache", "", "Path to a local cache file, which reuses resource fields of previous scans")
+		cmd.Flags().Duration("field-cache-ttl", time.Hour, "Duration that cached resource fields stay valid")
 		// flag completion command
 		cmd.RegisterFlagCompletionFunc("querypack", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 			return getQueryPacksForCompletion(), cobra.ShellCompDirectiveDefault
@@ -270,6 +313,9 @@ This example connects to Microsoft 365 using the PKCS #12 formatted certificate:
 
 		// for all assets
 		viper.BindPFlag("incognito", cmd.Flags().Lookup("incognito"))
+		viper.BindPFlag("datalake", cmd.Flags().Lookup("datalake"))
+		viper.BindPFlag("field-cache", cmd.Flags().Lookup("field-cache"))
+		viper.BindPFlag("field-cache-ttl", cmd.Flags().Lookup("field-cache-ttl"))
 		viper.BindPFlag("insecure", cmd.Flags().Lookup("insecure"))
 		viper.BindPFlag("querypacks", cmd.Flags().Lookup("querypack"))
 		viper.BindPFlag("sudo.active", cmd.Flags().Lookup("sudo"))
@@ -326,7 +372,11 @@ type scanConfig struct {
 	Output         string
 	QueryPackPaths []string
 	QueryPackNames []string
+	Props          map[string]string
 	Bundle         *explorer.Bundle
+	DataLake       string
+	FieldCache     string
+	FieldCacheTTL  time.Duration
 
 	IsIncognito bool
 	DoRecord    bool
@@ -352,6 +402,9 @@ func getCobraScanConfig(cmd *cobra.Command, args []string, provider providers.Pr
 		DoRecord:       viper.GetBool("record"),
 		QueryPackPaths: viper.GetStringSlice("querypack-bundle"),
 		QueryPackNames: viper.GetStringSlice("querypacks"),
+		DataLake:       opts.Datalake,
+		FieldCache:     opts.FieldCache,
+		FieldCacheTTL:  opts.FieldCacheTTL,
 	}
 
 	// if users want to get more information on available output options,
@@ -368,6 +421,12 @@ func getCobraScanConfig(cmd *cobra.Command, args []string, provider providers.Pr
 	}
 	conf.Output = output
 
+	props, err := getCobraProps(cmd)
+	if err != nil {
+		return nil, err
+	}
+	conf.Props = props
+
 	// check if the user used --password without a value
 	askPass, err := cmd.Flags().GetBool("ask-pass")
 	if err == nil && askPass {
@@ -434,6 +493,33 @@ func getCobraScanConfig(cmd *cobra.Command, args []string, provider providers.Pr
 	return &conf, nil
 }
 
+// getCobraProps reads property values from --props-file and --props,
+// where --props takes precedence
+func getCobraProps(cmd *cobra.Command) (map[string]string, error) {
+	res := map[string]string{}
+
+	if path, _ := cmd.Flags().GetString("props-file"); path != "" {
+		props, err := explorer.PropsFromFile(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load props file")
+		}
+		for k, v := range props {
+			res[k] = v
+		}
+	}
+
+	entries, _ := cmd.Flags().GetStringArray("props")
+	props, err := explorer.ParseProps(entries)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range props {
+		res[k] = v
+	}
+
+	return res, nil
+}
+
 func (c *scanConfig) loadBundles() error {
 	if c.IsIncognito {
 		if len(c.QueryPackPaths) == 0 {
@@ -457,6 +543,12 @@ func RunScan(config *scanConfig) (*explorer.ReportCollection, error) {
 	if config.UpstreamConfig != nil {
 		opts = append(opts, scan.WithUpstream(config.UpstreamConfig.ApiEndpoint, config.UpstreamConfig.SpaceMrn, config.UpstreamConfig.Plugins))
 	}
+	if config.DataLake != "" {
+		opts = append(opts, scan.WithDataLake(config.DataLake))
+	}
+	if config.FieldCache != "" {
+		opts = append(opts, scan.WithFieldCache(config.FieldCache, config.FieldCacheTTL))
+	}
 
 	scanner := scan.NewLocalScanner(opts...)
 	ctx := cnquery.SetFeatures(context.Background(), config.Features)
@@ -469,6 +561,7 @@ func RunScan(config *scanConfig) (*explorer.ReportCollection, error) {
 				Inventory:        config.Inventory,
 				Bundle:           config.Bundle,
 				QueryPackFilters: config.QueryPackNames,
+				Props:            config.Props,
 			})
 	}
 	return scanner.Run(
@@ -478,6 +571,7 @@ func RunScan(config *scanConfig) (*explorer.ReportCollection, error) {
 			Inventory:        config.Inventory,
 			Bundle:           config.Bundle,
 			QueryPackFilters: config.QueryPackNames,
+			Props:            config.Props,
 		})
 }
 
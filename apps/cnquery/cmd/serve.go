@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.mondoo.com/cnquery/apps/cnquery/cmd/builder"
+	"go.mondoo.com/cnquery/cli/server"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/internal/datalakes/boltdb"
+	"go.mondoo.com/cnquery/internal/datalakes/inmemory"
+	"go.mondoo.com/cnquery/motor/discovery"
+	"go.mondoo.com/cnquery/motor/discovery/common"
+	"go.mondoo.com/cnquery/motor/providers"
+	"go.mondoo.com/cnquery/resources"
+	"go.mondoo.com/cnquery/resources/packs/all"
+)
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+}
+
+var serveCmd = builder.NewProviderCommand(builder.CommandOpts{
+	Use:   "serve",
+	Short: "Serve the resources of an asset to other tools",
+	Long: `
+Connects to an asset and serves the MQL, QueryHub and QueryConductor services
+via ranger RPC (protobuf or JSON over HTTP). Queries are run via the Run method
+of the MQL service:
+
+    $ cnquery serve local --listen unix:///tmp/cnquery.sock
+    $ curl --unix-socket /tmp/cnquery.sock -H "Authorization: Bearer $TOKEN" \
+        -H "Content-Type: application/json" -d '{"query": "asset.name"}' http://localhost/MQL/Run
+
+All requests need the token as bearer token. It is set via --auth-token or the
+CNQUERY_SERVE_TOKEN environment variable, otherwise a random token is printed.
+`,
+	CommonFlags: func(cmd *cobra.Command) {
+		cmd.Flags().String("listen", "127.0.0.1:8989", "Address to listen on, either host:port or unix:///path/to/socket")
+		cmd.Flags().String("auth-token", "", "Token that clients need to authenticate with")
+		cmd.Flags().String("datalake", "", "Path to a persistent datalake for the query services, defaults to an in-memory datalake")
+
+		cmd.Flags().StringP("password", "p", "", "Connection password e.g. for ssh/winrm")
+		cmd.Flags().Bool("ask-pass", false, "Prompt for connection password")
+		cmd.Flags().StringP("identity-file", "i", "", "Select a file from which the identity (private key) for public key authentication is read.")
+		cmd.Flags().Bool("insecure", false, "Disable TLS/SSL checks or SSH hostkey config")
+		cmd.Flags().Bool("sudo", false, "Elevate privileges with sudo")
+		cmd.Flags().String("platform-id", "", "Select an specific asset by providing the platform id for the target")
+		cmd.Flags().Bool("instances", false, "Also scan instances (only applies to api targets like aws, azure or gcp)")
+		cmd.Flags().Bool("host-machines", false, "Also scan host machines like ESXi server")
+
+		cmd.Flags().String("path", "", "Path to a local file or directory that the connection should use")
+		cmd.Flags().StringToString("option", nil, "Additional connection options, multiple options can be passed in via --option key=value")
+		cmd.Flags().String("discover", common.DiscoveryAuto, "Enable the discovery of nested assets. Supported are 'all|auto|instances|host-instances|host-machines|container|container-images|pods|cronjobs|statefulsets|deployments|jobs|replicasets|daemonsets'")
+		cmd.Flags().StringToString("discover-filter", nil, "Additional filter for asset discovery")
+	},
+	CommonPreRun: func(cmd *cobra.Command, args []string) {
+		// for all assets
+		viper.BindPFlag("insecure", cmd.Flags().Lookup("insecure"))
+		viper.BindPFlag("sudo.active", cmd.Flags().Lookup("sudo"))
+		viper.BindPFlag("platform-id", cmd.Flags().Lookup("platform-id"))
+
+		viper.BindPFlag("serve.listen", cmd.Flags().Lookup("listen"))
+		viper.BindPFlag("serve.token", cmd.Flags().Lookup("auth-token"))
+		viper.BindEnv("serve.token", "CNQUERY_SERVE_TOKEN")
+		viper.BindPFlag("datalake", cmd.Flags().Lookup("datalake"))
+	},
+	Run: func(cmd *cobra.Command, args []string, provider providers.ProviderType, assetType builder.AssetType) {
+		conf, err := GetCobraShellConfig(cmd, args, provider, assetType)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to prepare config")
+		}
+
+		token := viper.GetString("serve.token")
+		if token == "" {
+			token, err = server.NewToken()
+			if err != nil {
+				log.Fatal().Err(err).Msg("failed to generate token")
+			}
+			fmt.Println("token: " + token)
+		}
+
+		if err := StartServer(conf, viper.GetString("serve.listen"), token, viper.GetString("datalake")); err != nil {
+			log.Fatal().Err(err).Msg("failed to serve")
+		}
+	},
+})
+
+// StartServer connects to the asset and serves its resources until
+// the process is interrupted
+func StartServer(conf *ShellConfig, listen string, token string, datalake string) error {
+	ctx, cancel := signal.NotifyContext(discovery.InitCtx(context.Background()), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	m := connectShellAsset(ctx, conf)
+	defer m.Close()
+
+	var services *explorer.LocalServices
+	if datalake != "" {
+		db, ls, err := boltdb.NewServices(datalake)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+		services = ls
+	} else {
+		_, ls, err := inmemory.NewServices()
+		if err != nil {
+			return err
+		}
+		services = ls
+	}
+
+	runtime := resources.NewRuntime(all.Registry, m)
+	runtime.UpstreamConfig = conf.UpstreamConfig
+
+	srv, err := server.New(runtime, services, conf.Features, token)
+	if err != nil {
+		return err
+	}
+
+	listener, err := server.Listen(listen)
+	if err != nil {
+		return err
+	}
+
+	log.Info().Str("address", listen).Msg("listening for requests")
+	return srv.Serve(ctx, listener)
+}
@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"time"
+
 	"github.com/cockroachdb/errors"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
@@ -49,6 +51,9 @@ var shellCmd = builder.NewProviderCommand(builder.CommandOpts{
 		cmd.Flags().StringToString("option", nil, "Additional connection options, multiple options can be passed in via --option key=value")
 		cmd.Flags().String("discover", common.DiscoveryAuto, "Enable the discovery of nested assets. Supported are 'all|auto|instances|host-instances|host-machines|container|container-images|pods|cronjobs|statefulsets|deployments|jobs|replicasets|daemonsets'")
 		cmd.Flags().StringToString("discover-filter", nil, "Additional filter for asset discovery")
+
+		cmd.Flags().String("field-cache", "", "Path to a local cache file, which reuses resource fields of previous runs")
+		cmd.Flags().Duration("field-cache-ttl", time.Hour, "Duration that cached resource fields stay valid")
 	},
 	CommonPreRun: func(cmd *cobra.Command, args []string) {
 		// for all assets
@@ -64,6 +69,8 @@ var shellCmd = builder.NewProviderCommand(builder.CommandOpts{
 
 		viper.BindPFlag("record", cmd.Flags().Lookup("record"))
 		viper.BindPFlag("record-file", cmd.Flags().Lookup("record-file"))
+		viper.BindPFlag("field-cache", cmd.Flags().Lookup("field-cache"))
+		viper.BindPFlag("field-cache-ttl", cmd.Flags().Lookup("field-cache-ttl"))
 	},
 	Docs: builder.CommandsDocs{
 		Entries: map[string]builder.CommandDocsEntry{
@@ -142,6 +149,11 @@ or the image name (e.g. ubuntu:latest).`,
 			},
 			"kubernetes": {
 				Short: "Connect to a Kubernetes cluster or manifest",
+				Long: `Connect to a Kubernetes cluster, a manifest or a local Helm chart. A directory
+with a Chart.yaml is rendered with its values files:
+
+    cnquery shell k8s ./mychart --helm-values values-prod.yaml
+`,
 			},
 			"aws": {
 				Short: "Connect to an AWS account or instance",
@@ -200,7 +212,7 @@ configure your Azure credentials and have SSH access to your instances.`,
 				Short: "Connect to a GitHub repository",
 			},
 			"gitlab": {
-				Short: "Connect to a GitLab group",
+				Short: "Connect to a GitLab group or project",
 			},
 			"ms365": {
 				Short: "Connect to a Microsoft 365 tenant",
@@ -221,6 +233,32 @@ This example connects to Microsoft 365 using the PKCS #12 formatted certificate:
 			"arista": {
 				Short: "Connect to an Arista endpoint",
 			},
+			"disk": {
+				Short: "Connect to a virtual machine disk image",
+				Long: `Connect to a virtual machine disk image without mounting it. Supported formats are
+raw, qcow2, VMDK and VHD images with MBR or GPT partitions and ext4, XFS or FAT filesystems:
+
+    cnquery shell disk ubuntu.qcow2
+    cnquery shell disk disk.vmdk --partition 2
+
+The partition with /etc/os-release is used as root filesystem by default.
+`,
+			},
+			"docker-file": {
+				Short: "Connect to a Dockerfile",
+				Long: `Connect to a Dockerfile for static analysis, the path is either the Dockerfile or
+a directory with a Dockerfile:
+
+    cnquery shell docker-file ./Dockerfile
+`,
+			},
+			"cloudformation": {
+				Short: "Connect to an AWS CloudFormation template",
+				Long: `Connect to an AWS CloudFormation template in JSON or YAML format for static analysis:
+
+    cnquery shell cloudformation ./template.yaml
+`,
+			},
 		},
 	},
 	Run: func(cmd *cobra.Command, args []string, provider providers.ProviderType, assetType builder.AssetType) {
@@ -245,7 +283,9 @@ func GetCobraShellConfig(cmd *cobra.Command, args []string, provider providers.P
 	config.DisplayUsedConfig()
 
 	conf := ShellConfig{
-		Features: config.Features,
+		Features:      config.Features,
+		FieldCache:    opts.FieldCache,
+		FieldCacheTTL: opts.FieldCacheTTL,
 	}
 
 	// check if the user used --password without a value
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/mattn/go-isatty"
 
@@ -12,6 +13,8 @@ import (
 	"go.mondoo.com/cnquery/cli/components"
 	"go.mondoo.com/cnquery/cli/shell"
 	"go.mondoo.com/cnquery/cli/theme"
+	"go.mondoo.com/cnquery/internal/fieldcache"
+	"go.mondoo.com/cnquery/motor"
 	"go.mondoo.com/cnquery/motor/asset"
 	"go.mondoo.com/cnquery/motor/discovery"
 	"go.mondoo.com/cnquery/motor/inventory"
@@ -32,13 +35,64 @@ type ShellConfig struct {
 	DoRecord       bool
 	WelcomeMessage string
 
+	// path to a local field cache, disabled if empty
+	FieldCache    string
+	FieldCacheTTL time.Duration
+
 	UpstreamConfig *resources.UpstreamConfig
 }
 
 // StartShell will start an interactive CLI shell
 func StartShell(conf *ShellConfig) error {
 	ctx := discovery.InitCtx(context.Background())
+	m := connectShellAsset(ctx, conf)
+
+	var cache *fieldcache.Cache
+	if conf.FieldCache != "" {
+		var err error
+		cache, err = fieldcache.Open(conf.FieldCache, conf.FieldCacheTTL)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to open field cache")
+		}
+	}
+
+	// when we close the shell, we need to close the backend and store the recording
+	onCloseHandler := func() {
+		// store tracked commands and files
+		storeRecording(m)
+		if cache != nil {
+			if err := cache.Close(); err != nil {
+				log.Error().Err(err).Msg("failed to store field cache")
+			}
+		}
+	}
+
+	shellOptions := []shell.ShellOption{}
+	if cache != nil {
+		shellOptions = append(shellOptions, shell.WithFieldCache(cache))
+	}
+	shellOptions = append(shellOptions, shell.WithOnCloseListener(onCloseHandler))
+	shellOptions = append(shellOptions, shell.WithFeatures(conf.Features))
+
+	if conf.UpstreamConfig != nil {
+		shellOptions = append(shellOptions, shell.WithUpstreamConfig(conf.UpstreamConfig))
+	}
+
+	sh, err := shell.New(m, shellOptions...)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to initialize interactive shell")
+	}
+	if conf.WelcomeMessage != "" {
+		sh.Theme.Welcome = conf.WelcomeMessage
+	}
+	sh.RunInteractive(conf.Command)
+
+	return nil
+}
 
+// connectShellAsset resolves the inventory and connects to the asset that is
+// selected via platform id or interactively
+func connectShellAsset(ctx context.Context, conf *ShellConfig) *motor.Motor {
 	log.Info().Msgf("discover related assets for %d asset(s)", len(conf.Inventory.Spec.Assets))
 	im, err := inventory.New(inventory.WithInventory(conf.Inventory))
 	if err != nil {
@@ -85,29 +139,5 @@ func StartShell(conf *ShellConfig) error {
 	if err != nil {
 		log.Fatal().Err(err).Msg("could not connect to asset")
 	}
-
-	// when we close the shell, we need to close the backend and store the recording
-	onCloseHandler := func() {
-		// store tracked commands and files
-		storeRecording(m)
-	}
-
-	shellOptions := []shell.ShellOption{}
-	shellOptions = append(shellOptions, shell.WithOnCloseListener(onCloseHandler))
-	shellOptions = append(shellOptions, shell.WithFeatures(conf.Features))
-
-	if conf.UpstreamConfig != nil {
-		shellOptions = append(shellOptions, shell.WithUpstreamConfig(conf.UpstreamConfig))
-	}
-
-	sh, err := shell.New(m, shellOptions...)
-	if err != nil {
-		log.Error().Err(err).Msg("failed to initialize interactive shell")
-	}
-	if conf.WelcomeMessage != "" {
-		sh.Theme.Welcome = conf.WelcomeMessage
-	}
-	sh.RunInteractive(conf.Command)
-
-	return nil
+	return m
 }
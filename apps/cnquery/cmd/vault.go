@@ -0,0 +1,306 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mattn/go-isatty"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	v1 "go.mondoo.com/cnquery/motor/inventory/v1"
+	"go.mondoo.com/cnquery/motor/vault"
+	"go.mondoo.com/cnquery/motor/vault/config"
+	"golang.org/x/term"
+)
+
+func init() {
+	vaultCmd.PersistentFlags().String("vault", "", "Name of the vault, configured vaults are looked up by name if no type is set")
+	vaultCmd.PersistentFlags().String("type", "", "Type of the vault, e.g. encrypted-file, linux-kernel-keyring, keyring or hashicorp-vault")
+	vaultCmd.PersistentFlags().StringToString("option", nil, "Options for the vault, e.g. --option path=./vault --option name=cnquery")
+
+	// vault add-secret
+	vaultAddSecretCmd.Flags().String("cred-type", "password", "Type of the credential, e.g. password, private_key, ssh_agent or bearer")
+	vaultAddSecretCmd.Flags().String("user", "", "User for the credential")
+	vaultAddSecretCmd.Flags().String("password", "", "Password for the credential, prompted for if it is not set for password credentials")
+	vaultAddSecretCmd.Flags().String("private-key-path", "", "Path to a private key, which is stored in the vault")
+	vaultCmd.AddCommand(vaultAddSecretCmd)
+
+	// vault list
+	vaultCmd.AddCommand(vaultListCmd)
+
+	// vault delete
+	vaultCmd.AddCommand(vaultDeleteCmd)
+
+	// vault rotate-password
+	vaultCmd.AddCommand(vaultRotatePasswordCmd)
+
+	// vault import
+	vaultImportCmd.Flags().StringP("output", "o", "", "Write the updated inventory to this file instead of stdout")
+	vaultCmd.AddCommand(vaultImportCmd)
+
+	rootCmd.AddCommand(vaultCmd)
+}
+
+var vaultCmd = &cobra.Command{
+	Use:   "vault",
+	Short: "Manage secrets in vaults",
+	Long: `
+Manage the secrets that are used to connect to assets. A vault is either
+looked up by name from the configured vaults or set up via --type and --option:
+
+    $ cnquery vault list --type encrypted-file --option path=./vault --option name=cnquery
+
+Encrypted files prompt for their password, unless it is set via --option password=...
+`,
+}
+
+var vaultAddSecretCmd = &cobra.Command{
+	Use:   "add-secret [secret-id]",
+	Short: "Add a credential to the vault or replace an existing one",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		v, _ := getCobraVault(cmd)
+
+		credType, _ := cmd.Flags().GetString("cred-type")
+		typ, ok := vault.CredentialType_value[credType]
+		if !ok {
+			log.Fatal().Msgf("unknown credential type '%s'", credType)
+		}
+
+		cred := &vault.Credential{
+			SecretId: args[0],
+			Type:     vault.CredentialType(typ),
+		}
+		cred.User, _ = cmd.Flags().GetString("user")
+		cred.Password, _ = cmd.Flags().GetString("password")
+
+		if path, _ := cmd.Flags().GetString("private-key-path"); path != "" {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				log.Fatal().Err(err).Msg("could not read private key")
+			}
+			cred.PrivateKey = string(data)
+		}
+
+		if cred.Type == vault.CredentialType_password && cred.Password == "" {
+			cred.Password = readPassword("Password for " + args[0] + ": ")
+		}
+		cred.PreProcess()
+
+		secret, err := vault.NewSecret(cred, vault.SecretEncoding_encoding_json)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not encode credential")
+		}
+		secret.Label = "cnquery: " + args[0]
+
+		if _, err := v.Set(context.Background(), secret); err != nil {
+			log.Fatal().Err(err).Msg("could not store secret")
+		}
+		log.Info().Msgf("stored secret %s", args[0])
+	},
+}
+
+var vaultListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the ids of all secrets in the vault",
+	Long: `
+List the ids of all secrets in the vault. Listing is supported by the keyring,
+linux-kernel-keyring and encrypted-file vaults. The secrets of
+hashicorp-vault, gcp-secret-manager, aws-secrets-manager and
+aws-parameter-store vaults need to be listed with their own tooling.
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		v, _ := getCobraVault(cmd)
+
+		lister, ok := v.(vault.SecretLister)
+		if !ok {
+			log.Fatal().Msg("this vault does not support listing secrets, use the tooling of the vault instead")
+		}
+
+		ids, err := lister.ListSecrets(context.Background())
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not list secrets")
+		}
+
+		for i := range ids {
+			fmt.Println(ids[i].Key)
+		}
+	},
+}
+
+var vaultDeleteCmd = &cobra.Command{
+	Use:   "delete [secret-id]",
+	Short: "Delete a secret from the vault",
+	Long: `
+Delete a secret from the vault. Deleting is supported by the keyring,
+linux-kernel-keyring and encrypted-file vaults. The secrets of
+hashicorp-vault, gcp-secret-manager, aws-secrets-manager and
+aws-parameter-store vaults need to be deleted with their own tooling.
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		v, _ := getCobraVault(cmd)
+
+		deleter, ok := v.(vault.SecretDeleter)
+		if !ok {
+			log.Fatal().Msg("this vault does not support deleting secrets, use the tooling of the vault instead")
+		}
+
+		if err := deleter.DeleteSecret(context.Background(), &vault.SecretID{Key: args[0]}); err != nil {
+			log.Fatal().Err(err).Msgf("could not delete secret %s", args[0])
+		}
+		log.Info().Msgf("deleted secret %s", args[0])
+	},
+}
+
+var vaultRotatePasswordCmd = &cobra.Command{
+	Use:   "rotate-password",
+	Short: "Re-encrypt all secrets of a password-protected vault with a new password",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		v, _ := getCobraVault(cmd)
+
+		rotator, ok := v.(vault.PasswordRotator)
+		if !ok {
+			log.Fatal().Msg("this vault does not support password rotation")
+		}
+
+		password := readPassword("New vault password: ")
+		if password == "" {
+			log.Fatal().Msg("the new password cannot be empty")
+		}
+		if isatty.IsTerminal(os.Stdin.Fd()) && readPassword("Repeat new vault password: ") != password {
+			log.Fatal().Msg("passwords do not match")
+		}
+
+		if err := rotator.RotatePassword(context.Background(), password); err != nil {
+			log.Fatal().Err(err).Msg("could not rotate vault password")
+		}
+		log.Info().Msg("rotated vault password")
+	},
+}
+
+var vaultImportCmd = &cobra.Command{
+	Use:   "import [inventory]",
+	Short: "Move all credentials of an inventory into the vault",
+	Long: `
+Stores all credentials of an inventory in the vault and writes the inventory
+with references to the stored secrets instead of the credentials themselves.
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		v, vaultRef := getCobraVault(cmd)
+
+		inventory, err := v1.InventoryFromFile(args[0])
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not load inventory")
+		}
+
+		if err := inventory.PreProcess(); err != nil {
+			log.Fatal().Err(err).Msg("could not load inventory")
+		}
+
+		ids, err := inventory.MoveCredentialsToVault(context.Background(), v)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not import credentials")
+		}
+		inventory.Spec.Vault = vaultRef
+		if vaultRef.Type == "encrypted-file" {
+			log.Warn().Msg("the inventory does not contain the vault password, use a configured vault via --vault to scan without it")
+		}
+
+		data, err := inventory.ToYAML()
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not generate inventory")
+		}
+
+		output, _ := cmd.Flags().GetString("output")
+		if output == "" {
+			fmt.Println(string(data))
+		} else if err := os.WriteFile(output, data, 0o600); err != nil {
+			log.Fatal().Err(err).Msgf("could not write '%s'", output)
+		}
+		log.Info().Msgf("imported %d secret(s) into the vault", len(ids))
+	},
+}
+
+// getCobraVault connects to the vault that is configured via flags and returns
+// a reference to it, which can be stored in inventories
+func getCobraVault(cmd *cobra.Command) (vault.Vault, *v1.VaultConfiguration) {
+	name, _ := cmd.Flags().GetString("vault")
+	typeName, _ := cmd.Flags().GetString("type")
+	options, _ := cmd.Flags().GetStringToString("option")
+
+	if typeName == "" {
+		if name == "" {
+			log.Fatal().Msg("please specify a vault via --vault or --type")
+		}
+
+		v, err := config.GetConfiguredVault(name)
+		if err != nil {
+			log.Fatal().Err(err).Msgf("could not find configured vault '%s'", name)
+		}
+		return v, &v1.VaultConfiguration{Name: name}
+	}
+
+	typ, err := vault.NewVaultType(typeName)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid vault type")
+	}
+
+	if options == nil {
+		options = map[string]string{}
+	}
+	ref := &v1.VaultConfiguration{
+		Name:    name,
+		Type:    typeName,
+		Options: map[string]string{},
+	}
+	for k := range options {
+		// passwords never end up in generated inventories
+		if k != "password" {
+			ref.Options[k] = options[k]
+		}
+	}
+
+	if typ == vault.VaultType_EncryptedFile && options["password"] == "" {
+		options["password"] = readPassword("Vault password: ")
+	}
+
+	v, err := config.New(&vault.VaultConfiguration{
+		Name:    name,
+		Type:    typ,
+		Options: options,
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not connect to vault")
+	}
+	return v, ref
+}
+
+// stdin is shared, so that multiple passwords can be piped in line by line
+var stdinReader = bufio.NewReader(os.Stdin)
+
+// readPassword prompts for a password on terminals and reads
+// a single line from stdin otherwise
+func readPassword(prompt string) string {
+	if isatty.IsTerminal(os.Stdin.Fd()) {
+		fmt.Fprint(os.Stderr, prompt)
+		data, err := term.ReadPassword(int(os.Stdin.Fd()))
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not read password")
+		}
+		return string(data)
+	}
+
+	line, err := stdinReader.ReadString('\n')
+	if err != nil && line == "" {
+		log.Fatal().Err(err).Msg("could not read password from stdin")
+	}
+	return strings.TrimRight(line, "\r\n")
+}
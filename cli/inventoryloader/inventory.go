@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io"
 	"os"
+	"path/filepath"
 	"runtime"
 
 	"github.com/cockroachdb/errors"
@@ -81,7 +82,7 @@ func Parse() (*v1.Inventory, error) {
 	// force detection
 	if viper.GetBool("inventory-ansible") {
 		log.Debug().Msg("parse ansible inventory")
-		inventory, err := parseAnsibleInventory(data)
+		inventory, err := parseAnsibleInventory(data, inventoryFilePath)
 		if err != nil {
 			return nil, err
 		}
@@ -116,8 +117,15 @@ func Parse() (*v1.Inventory, error) {
 	return inventory, nil
 }
 
-func parseAnsibleInventory(data []byte) (*v1.Inventory, error) {
-	inventory, err := ansibleinventory.Parse(data)
+// parseAnsibleInventory parses the ansible inventory, group_vars and host_vars are loaded
+// from the directory of the inventory file
+func parseAnsibleInventory(data []byte, inventoryFilePath string) (*v1.Inventory, error) {
+	varsDir := ""
+	if inventoryFilePath != "-" {
+		varsDir = filepath.Dir(inventoryFilePath)
+	}
+
+	inventory, err := ansibleinventory.ParseWithVars(data, varsDir)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,86 @@
+package reporter
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"go.mondoo.com/cnquery/explorer"
+)
+
+var csvHeader = []string{
+	"Asset Name",
+	"Asset MRN",
+	"Query Pack",
+	"Query Pack MRN",
+	"Query Title",
+	"Query MRN",
+	"Impact",
+	"Data",
+	"Error",
+}
+
+// ReportCollectionToCSV writes one row per asset and query into the output.
+// Data values are rendered as JSON. Assets that failed to scan are written
+// with their error and no query information.
+func ReportCollectionToCSV(data *explorer.ReportCollection, out io.Writer) error {
+	w := csv.NewWriter(out)
+
+	if err := w.Write(csvHeader); err != nil {
+		return err
+	}
+
+	if data == nil {
+		w.Flush()
+		return w.Error()
+	}
+
+	for _, assetMrn := range sortedAssetMrns(data) {
+		asset := data.Assets[assetMrn]
+		name := assetName(assetMrn, asset)
+
+		if errMsg, ok := data.Errors[assetMrn]; ok {
+			if err := w.Write([]string{name, assetMrn, "", "", "", "", "", "", errMsg}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		results, err := assetQueryResults(data, assetMrn)
+		if err != nil {
+			return err
+		}
+
+		for i := range results {
+			res := results[i]
+
+			impact := ""
+			if v := queryImpact(res.Query); v >= 0 {
+				impact = strconv.Itoa(int(v))
+			}
+
+			errMsg := ""
+			if res.Error != nil {
+				errMsg = res.Error.Error()
+			}
+
+			err := w.Write([]string{
+				name,
+				assetMrn,
+				queryPackName(res.Pack),
+				res.Pack.Mrn,
+				queryTitle(res.Query),
+				queryID(res.Query),
+				impact,
+				res.Data,
+				errMsg,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
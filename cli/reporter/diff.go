@@ -0,0 +1,171 @@
+package reporter
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/muesli/termenv"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/llx"
+)
+
+var diffSymbols = map[explorer.DiffStatus]string{
+	explorer.DiffAdded:     "+",
+	explorer.DiffRemoved:   "-",
+	explorer.DiffChanged:   "~",
+	explorer.DiffUnchanged: " ",
+}
+
+type diffChangeJSON struct {
+	Path   string              `json:"path"`
+	Status explorer.DiffStatus `json:"status"`
+	Old    json.RawMessage     `json:"old,omitempty"`
+	New    json.RawMessage     `json:"new,omitempty"`
+}
+
+type diffQueryJSON struct {
+	CodeId  string              `json:"code_id"`
+	Mrn     string              `json:"mrn,omitempty"`
+	Title   string              `json:"title,omitempty"`
+	Status  explorer.DiffStatus `json:"status"`
+	Changes []diffChangeJSON    `json:"changes,omitempty"`
+}
+
+type diffAssetJSON struct {
+	Name        string              `json:"name"`
+	Mrn         string              `json:"mrn,omitempty"`
+	OldMrn      string              `json:"old_mrn,omitempty"`
+	PlatformIds []string            `json:"platform_ids,omitempty"`
+	Status      explorer.DiffStatus `json:"status"`
+	Error       string              `json:"error,omitempty"`
+	OldError    string              `json:"old_error,omitempty"`
+	Queries     []diffQueryJSON     `json:"queries,omitempty"`
+}
+
+func diffDataJSON(data *llx.RawData, checksum string, code *llx.CodeBundle) json.RawMessage {
+	if data == nil {
+		return nil
+	}
+	return json.RawMessage(data.JSON(checksum, code))
+}
+
+// DiffToJSON renders the diff with all data values as JSON
+func DiffToJSON(diff *explorer.ReportDiff, out io.Writer) error {
+	assets := make([]diffAssetJSON, len(diff.Assets))
+	for i := range diff.Assets {
+		asset := diff.Assets[i]
+		assets[i] = diffAssetJSON{
+			Name:        asset.Name,
+			Mrn:         asset.Mrn,
+			OldMrn:      asset.OldMrn,
+			PlatformIds: asset.PlatformIds,
+			Status:      asset.Status,
+			Error:       asset.Error,
+			OldError:    asset.OldError,
+		}
+
+		for j := range asset.Queries {
+			query := asset.Queries[j]
+			q := diffQueryJSON{
+				CodeId: query.CodeId,
+				Mrn:    query.Mrn,
+				Title:  query.Title,
+				Status: query.Status,
+			}
+			for k := range query.Changes {
+				change := query.Changes[k]
+				q.Changes = append(q.Changes, diffChangeJSON{
+					Path:   change.Path,
+					Status: change.Status,
+					Old:    diffDataJSON(change.Old, change.Checksum, query.Code),
+					New:    diffDataJSON(change.New, change.Checksum, query.Code),
+				})
+			}
+			assets[i].Queries = append(assets[i].Queries, q)
+		}
+	}
+
+	res, err := json.Marshal(map[string]interface{}{"assets": assets})
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(res)
+	return err
+}
+
+// PrintDiff renders the difference between two reports in a human-readable form
+func (r *Reporter) PrintDiff(diff *explorer.ReportDiff, out io.Writer) error {
+	if r.Format == JSON {
+		return DiffToJSON(diff, out)
+	}
+
+	if !diff.HasChanges() {
+		_, err := out.Write([]byte("No differences found.\n"))
+		return err
+	}
+
+	for i := range diff.Assets {
+		asset := diff.Assets[i]
+		if asset.Status == explorer.DiffUnchanged {
+			continue
+		}
+
+		r.printDiffLine(out, asset.Status, "", "Asset: "+asset.Name+" ("+string(asset.Status)+")")
+		switch {
+		case asset.Error == asset.OldError:
+		case asset.OldError == "":
+			r.printDiffLine(out, explorer.DiffAdded, "  ", "error: "+asset.Error)
+		case asset.Error == "":
+			r.printDiffLine(out, explorer.DiffRemoved, "  ", "error: "+asset.OldError)
+		default:
+			r.printDiffLine(out, explorer.DiffChanged, "  ", "error: "+asset.OldError+" => "+asset.Error)
+		}
+		for j := range asset.Queries {
+			query := asset.Queries[j]
+			r.printDiffLine(out, query.Status, "  ", query.Title)
+
+			for k := range query.Changes {
+				change := query.Changes[k]
+				var value string
+				switch change.Status {
+				case explorer.DiffAdded:
+					value = r.diffValue(change.New, change.Checksum, query.Code)
+				case explorer.DiffRemoved:
+					value = r.diffValue(change.Old, change.Checksum, query.Code)
+				default:
+					value = r.diffValue(change.Old, change.Checksum, query.Code) +
+						" => " + r.diffValue(change.New, change.Checksum, query.Code)
+				}
+				r.printDiffLine(out, change.Status, "    ", change.Path+": "+value)
+			}
+		}
+		out.Write([]byte{'\n'})
+	}
+
+	return nil
+}
+
+func (r *Reporter) diffValue(data *llx.RawData, checksum string, code *llx.CodeBundle) string {
+	if data == nil {
+		return ""
+	}
+	if data.Error != nil {
+		return "error: " + data.Error.Error()
+	}
+	res := r.Printer.Data(data.Type, data.Value, checksum, code, "")
+	return strings.ReplaceAll(res, "\n", " ")
+}
+
+func (r *Reporter) printDiffLine(out io.Writer, status explorer.DiffStatus, indent string, text string) {
+	line := indent + diffSymbols[status] + " " + text
+	switch status {
+	case explorer.DiffAdded:
+		line = termenv.String(line).Foreground(r.Colors.Success).String()
+	case explorer.DiffRemoved:
+		line = termenv.String(line).Foreground(r.Colors.Error).String()
+	case explorer.DiffChanged:
+		line = termenv.String(line).Foreground(r.Colors.Medium).String()
+	}
+	out.Write([]byte(line + "\n"))
+}
@@ -0,0 +1,134 @@
+package reporter
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/llx"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files of the reporter tests")
+
+const (
+	testAssetMrn       = "//assets.api.mondoo.app/spaces/test/assets/arch"
+	testBrokenAssetMrn = "//assets.api.mondoo.app/spaces/test/assets/broken"
+	testPackMrn        = "//local.cnquery.io/run/local-execution/querypacks/test-pack"
+)
+
+// testReportCollection runs a small query pack against the arch mock and
+// returns its report collection. It contains one successful asset, with
+// one query that succeeds and one that fails, and one asset that could
+// not be scanned.
+func testReportCollection(t *testing.T) *explorer.ReportCollection {
+	queries := []*explorer.Mquery{
+		{
+			Mrn:    "//local.cnquery.io/run/local-execution/queries/root-users",
+			Title:  "Users with UID 0",
+			Query:  "users.where(uid==0)",
+			Impact: &explorer.ImpactValue{Value: 80},
+			Docs: &explorer.MqueryDocs{
+				Desc:        "Lists all users with root privileges.",
+				Remediation: "Remove all users except root from UID 0.",
+			},
+			Tags: map[string]string{"category": "users"},
+		},
+		{
+			Mrn:   "//local.cnquery.io/run/local-execution/queries/missing-file",
+			Title: "Content of a missing file",
+			Query: "file(\"/does/not/exist\").content",
+		},
+	}
+
+	resolved := &explorer.ResolvedPack{
+		ExecutionJob: &explorer.ExecutionJob{
+			Queries: map[string]*explorer.ExecutionQuery{},
+		},
+	}
+	report := &explorer.Report{
+		PackMrn:   testPackMrn,
+		EntityMrn: testAssetMrn,
+		Data:      map[string]*llx.Result{},
+	}
+
+	for i := range queries {
+		query := queries[i]
+		code, results := testQuery(t, query.Query)
+		query.CodeId = code.CodeV2.Id
+		resolved.ExecutionJob.Queries[query.CodeId] = &explorer.ExecutionQuery{
+			Query:    query.Query,
+			Checksum: code.CodeV2.Id,
+			Code:     code,
+		}
+		for k, v := range results {
+			report.Data[k] = v.Result()
+		}
+	}
+
+	return &explorer.ReportCollection{
+		Assets: map[string]*explorer.Asset{
+			testAssetMrn:       {Mrn: testAssetMrn, Name: "arch-host"},
+			testBrokenAssetMrn: {Mrn: testBrokenAssetMrn, Name: "broken-host"},
+		},
+		Bundle: &explorer.Bundle{
+			Packs: []*explorer.QueryPack{{
+				Mrn:     testPackMrn,
+				Name:    "Test Pack",
+				Queries: queries,
+			}},
+		},
+		Reports: map[string]*explorer.Report{
+			testAssetMrn: report,
+		},
+		Resolved: map[string]*explorer.ResolvedPack{
+			testAssetMrn: resolved,
+		},
+		Errors: map[string]string{
+			testBrokenAssetMrn: "failed to connect to asset",
+		},
+	}
+}
+
+// assertGolden compares the output to the golden file in testdata.
+// Run the tests with -update to regenerate all golden files.
+func assertGolden(t *testing.T, name string, actual []byte) {
+	path := filepath.Join("testdata", name+".golden")
+	if *updateGolden {
+		require.NoError(t, os.MkdirAll("testdata", 0o755))
+		require.NoError(t, os.WriteFile(path, actual, 0o644))
+	}
+
+	expected, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(actual))
+}
+
+func TestReporterGoldenFiles(t *testing.T) {
+	tests := []struct {
+		format string
+		file   string
+	}{
+		{"csv", "report.csv"},
+		{"junit", "report.junit.xml"},
+		{"sarif", "report.sarif.json"},
+	}
+
+	data := testReportCollection(t)
+
+	for i := range tests {
+		cur := tests[i]
+		t.Run(cur.format, func(t *testing.T) {
+			r, err := New(cur.format)
+			require.NoError(t, err)
+
+			var out bytes.Buffer
+			require.NoError(t, r.Print(data, &out))
+			assertGolden(t, cur.file, out.Bytes())
+		})
+	}
+}
@@ -25,7 +25,8 @@ func BundleResultsToJSON(code *llx.CodeBundle, results map[string]*llx.RawResult
 	for j, checksum := range checksums {
 		result := results[checksum]
 		if result == nil {
-			llx.JSONerror(errors.New("cannot find result for this query"))
+			out.WriteString(llx.PrettyPrintString(checksum) + ":")
+			out.Write(llx.JSONerror(errors.New("cannot find result for this query")))
 		} else {
 			jsonData := result.Data.JSONfield(checksum, code)
 			out.Write(jsonData)
@@ -0,0 +1,146 @@
+package reporter
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+
+	"go.mondoo.com/cnquery/explorer"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string           `xml:"name,attr"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	Errors     int              `xml:"errors,attr"`
+	Properties *junitProperties `xml:"properties,omitempty"`
+	TestCases  []junitTestCase  `xml:"testcase"`
+}
+
+type junitProperties struct {
+	Properties []junitProperty `xml:"property"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Name       string           `xml:"name,attr"`
+	Classname  string           `xml:"classname,attr"`
+	Properties *junitProperties `xml:"properties,omitempty"`
+	Error      *junitError      `xml:"error,omitempty"`
+	SystemOut  *junitOutput     `xml:"system-out,omitempty"`
+}
+
+type junitOutput struct {
+	Text string `xml:",cdata"`
+}
+
+type junitError struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+// ReportCollectionToJUnit renders the report collection as JUnit XML.
+// Every asset and query pack combination becomes a test suite and every
+// query a test case. Data values are attached as system-out and failed
+// queries are reported as errors.
+func ReportCollectionToJUnit(data *explorer.ReportCollection, out io.Writer) error {
+	res := junitTestSuites{Name: "cnquery"}
+
+	if data != nil {
+		for _, assetMrn := range sortedAssetMrns(data) {
+			asset := data.Assets[assetMrn]
+			name := assetName(assetMrn, asset)
+
+			if errMsg, ok := data.Errors[assetMrn]; ok {
+				res.Suites = append(res.Suites, junitTestSuite{
+					Name:       name,
+					Tests:      1,
+					Errors:     1,
+					Properties: &junitProperties{[]junitProperty{{Name: "asset.mrn", Value: assetMrn}}},
+					TestCases: []junitTestCase{{
+						Name:      "scan",
+						Classname: name,
+						Error:     &junitError{Message: errMsg, Type: "scan error"},
+					}},
+				})
+				continue
+			}
+
+			results, err := assetQueryResults(data, assetMrn)
+			if err != nil {
+				return err
+			}
+
+			suites := map[*explorer.QueryPack]*junitTestSuite{}
+			var order []*explorer.QueryPack
+			for i := range results {
+				cur := results[i]
+
+				suite, ok := suites[cur.Pack]
+				if !ok {
+					packName := queryPackName(cur.Pack)
+					suite = &junitTestSuite{
+						Name: name + " / " + packName,
+						Properties: &junitProperties{[]junitProperty{
+							{Name: "asset.mrn", Value: assetMrn},
+							{Name: "querypack.mrn", Value: cur.Pack.Mrn},
+						}},
+					}
+					suites[cur.Pack] = suite
+					order = append(order, cur.Pack)
+				}
+
+				tc := junitTestCase{
+					Name:       queryTitle(cur.Query),
+					Classname:  queryPackName(cur.Pack),
+					Properties: &junitProperties{[]junitProperty{{Name: "query.mrn", Value: queryID(cur.Query)}}},
+					SystemOut:  &junitOutput{Text: cur.Data},
+				}
+				if impact := queryImpact(cur.Query); impact >= 0 {
+					tc.Properties.Properties = append(tc.Properties.Properties, junitProperty{Name: "impact", Value: strconv.Itoa(int(impact))})
+				}
+				if cur.Error != nil {
+					tc.Error = &junitError{Message: cur.Error.Error(), Type: "query error"}
+					suite.Errors++
+				}
+				suite.Tests++
+				suite.TestCases = append(suite.TestCases, tc)
+			}
+
+			for i := range order {
+				res.Suites = append(res.Suites, *suites[order[i]])
+			}
+		}
+	}
+
+	for i := range res.Suites {
+		res.Tests += res.Suites[i].Tests
+		res.Failures += res.Suites[i].Failures
+		res.Errors += res.Suites[i].Errors
+	}
+
+	if _, err := io.WriteString(out, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(out)
+	enc.Indent("", "  ")
+	if err := enc.Encode(res); err != nil {
+		return err
+	}
+	_, err := io.WriteString(out, "\n")
+	return err
+}
@@ -15,6 +15,8 @@ const (
 	JSON
 	JUnit
 	CSV
+	SARIF
+	Report
 )
 
 // Formats that are supported by the reporter
@@ -26,7 +28,10 @@ var Formats = map[string]Format{
 	"yaml":    YAML,
 	"yml":     YAML,
 	"json":    JSON,
+	"junit":   JUnit,
 	"csv":     CSV,
+	"sarif":   SARIF,
+	"report":  Report,
 }
 
 func AllFormats() string {
@@ -13,7 +13,7 @@ import (
 
 type Reporter struct {
 	// Pager set to true will use a pager for the output. Only relevant for all
-	// non-json/yaml/junit/csv reports (for now)
+	// non-json/yaml/junit/csv/sarif/report reports (for now)
 	UsePager    bool
 	Pager       string
 	Format      Format
@@ -66,8 +66,20 @@ func (r *Reporter) Print(data *explorer.ReportCollection, out io.Writer) error {
 	case JSON:
 		w := shared.IOWriter{Writer: out}
 		return ReportCollectionToJSON(data, &w)
-	// case CSV:
-	// 	res, err = data.ToCsv()
+	case JUnit:
+		return ReportCollectionToJUnit(data, out)
+	case CSV:
+		return ReportCollectionToCSV(data, out)
+	case SARIF:
+		return ReportCollectionToSARIF(data, out)
+	case Report:
+		// the full report collection, which can be compared via `cnquery diff`
+		res, err := data.ToJSON()
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(res)
+		return err
 	default:
 		return errors.New("unknown reporter type, don't recognize this Format")
 	}
@@ -0,0 +1,158 @@
+package reporter
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/mrn"
+	"go.mondoo.com/cnquery/shared"
+)
+
+// queryResult is the flattened result of one query in one query pack
+// that was executed against an asset. It is used by all reporters that
+// need a tabular view of a report collection (csv, junit, sarif).
+type queryResult struct {
+	AssetMrn string
+	Asset    *explorer.Asset
+	Pack     *explorer.QueryPack
+	Query    *explorer.Mquery
+	// Data is the JSON representation of all entrypoints of the query
+	Data string
+	// Error is set if any of the entrypoints of the query failed
+	Error error
+}
+
+// sortedAssetMrns returns all asset MRNs of the collection in a stable order
+func sortedAssetMrns(data *explorer.ReportCollection) []string {
+	res := make([]string, 0, len(data.Assets))
+	for k := range data.Assets {
+		res = append(res, k)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// assetName returns a printable name for the asset
+func assetName(assetMrn string, asset *explorer.Asset) string {
+	if asset != nil && asset.Name != "" {
+		return asset.Name
+	}
+	return assetMrn
+}
+
+// queryTitle returns a printable title for the query
+func queryTitle(query *explorer.Mquery) string {
+	if query.Title != "" {
+		return query.Title
+	}
+	if query.Mrn != "" {
+		if uid, err := mrn.GetResource(query.Mrn, explorer.MRN_RESOURCE_QUERY); err == nil {
+			return uid
+		}
+	}
+	if query.Uid != "" {
+		return query.Uid
+	}
+	return strings.TrimSpace(query.Query)
+}
+
+// queryPackName returns a printable name for the query pack
+func queryPackName(pack *explorer.QueryPack) string {
+	if pack.Name != "" {
+		return pack.Name
+	}
+	if pack.Mrn != "" {
+		if uid, err := mrn.GetResource(pack.Mrn, explorer.MRN_RESOURCE_QUERYPACK); err == nil {
+			return uid
+		}
+		return pack.Mrn
+	}
+	return pack.Uid
+}
+
+// queryID returns a stable identifier for the query
+func queryID(query *explorer.Mquery) string {
+	if query.Mrn != "" {
+		return query.Mrn
+	}
+	if query.Uid != "" {
+		return query.Uid
+	}
+	return query.CodeId
+}
+
+// queryImpact returns the impact of a query or -1 if none was set
+func queryImpact(query *explorer.Mquery) int32 {
+	if query.Impact == nil {
+		return -1
+	}
+	return query.Impact.Value
+}
+
+// assetQueryResults collects all query results of one asset in the order
+// in which packs and queries are defined in the bundle. It returns nil if
+// the asset has no report.
+func assetQueryResults(data *explorer.ReportCollection, assetMrn string) ([]queryResult, error) {
+	report, ok := data.Reports[assetMrn]
+	if !ok {
+		return nil, nil
+	}
+
+	resolved, ok := data.Resolved[assetMrn]
+	if !ok || resolved.ExecutionJob == nil {
+		return nil, errors.New("cannot find resolved pack for " + assetMrn + " in report")
+	}
+
+	if data.Bundle == nil {
+		return nil, nil
+	}
+
+	results := report.RawResults()
+	asset := data.Assets[assetMrn]
+
+	var res []queryResult
+	for i := range data.Bundle.Packs {
+		pack := data.Bundle.Packs[i]
+		for j := range pack.Queries {
+			query := pack.Queries[j]
+			equery, ok := resolved.ExecutionJob.Queries[query.CodeId]
+			if !ok || equery.Code == nil {
+				continue
+			}
+
+			subRes := map[string]*llx.RawResult{}
+			var queryErr error
+			sums := equery.Code.EntrypointChecksums()
+			for k := range sums {
+				sum := sums[k]
+				r := results[sum]
+				subRes[sum] = r
+				if r == nil {
+					continue
+				}
+				if r.Data != nil && r.Data.Error != nil && queryErr == nil {
+					queryErr = r.Data.Error
+				}
+			}
+
+			var out strings.Builder
+			if err := BundleResultsToJSON(equery.Code, subRes, &shared.IOWriter{Writer: &out}); err != nil {
+				return nil, err
+			}
+
+			res = append(res, queryResult{
+				AssetMrn: assetMrn,
+				Asset:    asset,
+				Pack:     pack,
+				Query:    query,
+				Data:     out.String(),
+				Error:    queryErr,
+			})
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,249 @@
+package reporter
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"go.mondoo.com/cnquery"
+	"go.mondoo.com/cnquery/explorer"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool        sarifTool         `json:"tool"`
+	Invocations []sarifInvocation `json:"invocations,omitempty"`
+	Results     []sarifResult     `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri,omitempty"`
+	Version        string      `json:"version,omitempty"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string                 `json:"id"`
+	Name                 string                 `json:"name,omitempty"`
+	ShortDescription     *sarifMessage          `json:"shortDescription,omitempty"`
+	FullDescription      *sarifMessage          `json:"fullDescription,omitempty"`
+	Help                 *sarifMessage          `json:"help,omitempty"`
+	DefaultConfiguration *sarifConfiguration    `json:"defaultConfiguration,omitempty"`
+	Properties           map[string]interface{} `json:"properties,omitempty"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifInvocation struct {
+	ExecutionSuccessful        bool                `json:"executionSuccessful"`
+	ToolExecutionNotifications []sarifNotification `json:"toolExecutionNotifications,omitempty"`
+}
+
+type sarifNotification struct {
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifResult struct {
+	RuleID     string                 `json:"ruleId"`
+	RuleIndex  int                    `json:"ruleIndex"`
+	Level      string                 `json:"level"`
+	Message    sarifMessage           `json:"message"`
+	Locations  []sarifLocation        `json:"locations"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+type sarifLocation struct {
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifLogicalLocation struct {
+	Name               string `json:"name"`
+	FullyQualifiedName string `json:"fullyQualifiedName,omitempty"`
+	Kind               string `json:"kind,omitempty"`
+}
+
+// sarifLevel maps a query impact (0-100) onto a SARIF level
+func sarifLevel(impact int32) string {
+	switch {
+	case impact >= 70:
+		return "error"
+	case impact >= 40:
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+func sarifAssetLocation(assetMrn string, asset *explorer.Asset) sarifLocation {
+	return sarifLocation{
+		LogicalLocations: []sarifLogicalLocation{{
+			Name:               assetName(assetMrn, asset),
+			FullyQualifiedName: assetMrn,
+			Kind:               "asset",
+		}},
+	}
+}
+
+func sarifQueryRule(query *explorer.Mquery) sarifRule {
+	title := queryTitle(query)
+	rule := sarifRule{
+		ID:               queryID(query),
+		Name:             title,
+		ShortDescription: &sarifMessage{Text: title},
+		Properties: map[string]interface{}{
+			"query": query.Query,
+		},
+	}
+
+	desc := query.Desc
+	if query.Docs != nil && query.Docs.Desc != "" {
+		desc = query.Docs.Desc
+	}
+	if desc != "" {
+		rule.FullDescription = &sarifMessage{Text: desc}
+	}
+	if query.Docs != nil && query.Docs.Remediation != "" {
+		rule.Help = &sarifMessage{Text: query.Docs.Remediation}
+	}
+
+	if impact := queryImpact(query); impact >= 0 {
+		rule.DefaultConfiguration = &sarifConfiguration{Level: sarifLevel(impact)}
+		rule.Properties["impact"] = impact
+		// used by code scanning dashboards to rank findings (0.0-10.0)
+		rule.Properties["security-severity"] = strconv.FormatFloat(float64(impact)/10, 'f', 1, 64)
+	}
+
+	if len(query.Tags) != 0 {
+		rule.Properties["tags"] = query.Tags
+	}
+
+	return rule
+}
+
+// ReportCollectionToSARIF renders the report collection as a SARIF 2.1.0 log.
+// Queries become rules, every query result on an asset becomes a result
+// with the asset as its logical location, and assets that could not be
+// scanned are reported as tool execution notifications. Queries that are
+// part of multiple packs share one rule, which lists all of these packs,
+// while every result names the pack it was executed for.
+func ReportCollectionToSARIF(data *explorer.ReportCollection, out io.Writer) error {
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           "cnquery",
+			InformationURI: "https://mondoo.com",
+			Version:        cnquery.GetVersion(),
+			Rules:          []sarifRule{},
+		}},
+		Results: []sarifResult{},
+	}
+	invocation := sarifInvocation{ExecutionSuccessful: true}
+
+	if data != nil {
+		ruleIdx := map[string]int{}
+		rulePacks := [][]string{}
+		if data.Bundle != nil {
+			for i := range data.Bundle.Packs {
+				pack := data.Bundle.Packs[i]
+				for j := range pack.Queries {
+					query := pack.Queries[j]
+					id := queryID(query)
+					idx, ok := ruleIdx[id]
+					if !ok {
+						idx = len(run.Tool.Driver.Rules)
+						ruleIdx[id] = idx
+						run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifQueryRule(query))
+						rulePacks = append(rulePacks, nil)
+					}
+					rulePacks[idx] = append(rulePacks[idx], pack.Mrn)
+				}
+			}
+		}
+		for i := range run.Tool.Driver.Rules {
+			run.Tool.Driver.Rules[i].Properties["querypacks"] = rulePacks[i]
+		}
+
+		for _, assetMrn := range sortedAssetMrns(data) {
+			asset := data.Assets[assetMrn]
+
+			if errMsg, ok := data.Errors[assetMrn]; ok {
+				invocation.ExecutionSuccessful = false
+				invocation.ToolExecutionNotifications = append(invocation.ToolExecutionNotifications, sarifNotification{
+					Level:     "error",
+					Message:   sarifMessage{Text: errMsg},
+					Locations: []sarifLocation{sarifAssetLocation(assetMrn, asset)},
+				})
+				continue
+			}
+
+			results, err := assetQueryResults(data, assetMrn)
+			if err != nil {
+				return err
+			}
+
+			for i := range results {
+				cur := results[i]
+				id := queryID(cur.Query)
+
+				level := "note"
+				if impact := queryImpact(cur.Query); impact >= 0 {
+					level = sarifLevel(impact)
+				}
+
+				msg := queryTitle(cur.Query) + ": " + cur.Data
+				if cur.Error != nil {
+					level = "error"
+					msg = queryTitle(cur.Query) + ": " + cur.Error.Error()
+				}
+
+				props := map[string]interface{}{
+					"querypack": cur.Pack.Mrn,
+				}
+				if cur.Data != "" {
+					props["data"] = json.RawMessage(cur.Data)
+				}
+
+				run.Results = append(run.Results, sarifResult{
+					RuleID:     id,
+					RuleIndex:  ruleIdx[id],
+					Level:      level,
+					Message:    sarifMessage{Text: msg},
+					Locations:  []sarifLocation{sarifAssetLocation(assetMrn, asset)},
+					Properties: props,
+				})
+			}
+		}
+	}
+	run.Invocations = []sarifInvocation{invocation}
+
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []sarifRun{run},
+	})
+}
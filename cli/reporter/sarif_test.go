@@ -0,0 +1,46 @@
+package reporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/explorer"
+)
+
+func TestReportCollectionToSARIF_SharedQuery(t *testing.T) {
+	data := testReportCollection(t)
+	otherPackMrn := "//local.cnquery.io/run/local-execution/querypacks/other-pack"
+	sharedQuery := data.Bundle.Packs[0].Queries[0]
+	data.Bundle.Packs = append(data.Bundle.Packs, &explorer.QueryPack{
+		Mrn:     otherPackMrn,
+		Name:    "Other Pack",
+		Queries: []*explorer.Mquery{sharedQuery},
+	})
+
+	var out bytes.Buffer
+	require.NoError(t, ReportCollectionToSARIF(data, &out))
+
+	var log sarifLog
+	require.NoError(t, json.Unmarshal(out.Bytes(), &log))
+	require.Len(t, log.Runs, 1)
+	run := log.Runs[0]
+
+	// the shared query is only one rule, which knows about both packs
+	require.Len(t, run.Tool.Driver.Rules, 2)
+	rule := run.Tool.Driver.Rules[0]
+	assert.Equal(t, sharedQuery.Mrn, rule.ID)
+	assert.Equal(t, []interface{}{testPackMrn, otherPackMrn}, rule.Properties["querypacks"])
+
+	// every pack reports its own result for the shared query
+	packs := []interface{}{}
+	for i := range run.Results {
+		if run.Results[i].RuleID == sharedQuery.Mrn {
+			assert.Equal(t, 0, run.Results[i].RuleIndex)
+			packs = append(packs, run.Results[i].Properties["querypack"])
+		}
+	}
+	assert.Equal(t, []interface{}{testPackMrn, otherPackMrn}, packs)
+}
@@ -0,0 +1,27 @@
+//go:build !windows
+// +build !windows
+
+package server
+
+import (
+	"net"
+	"os"
+	"syscall"
+)
+
+// listenUnix creates the socket with a restrictive umask, so that it is
+// never accessible by other users, not even between creation and chmod
+func listenUnix(path string) (net.Listener, error) {
+	mask := syscall.Umask(0o177)
+	listener, err := net.Listen("unix", path)
+	syscall.Umask(mask)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Chmod(path, 0o600); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	return listener, nil
+}
@@ -0,0 +1,22 @@
+//go:build windows
+// +build windows
+
+package server
+
+import (
+	"net"
+	"os"
+)
+
+func listenUnix(path string) (net.Listener, error) {
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Chmod(path, 0o600); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	return listener, nil
+}
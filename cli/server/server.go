@@ -0,0 +1,128 @@
+// Package server exposes the services of a connected asset via ranger RPC,
+// so that tools can query it without spawning a new process per query.
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/resources"
+	"go.mondoo.com/cnquery/resources/service"
+)
+
+const unixPrefix = "unix://"
+
+// Server serves the MQL, QueryHub and QueryConductor services
+type Server struct {
+	token    string
+	mql      *service.Server
+	services *explorer.LocalServices
+}
+
+// New creates a server for the runtime. All requests need to provide the
+// token as bearer token in their Authorization header.
+func New(runtime *resources.Runtime, services *explorer.LocalServices, features cnquery.Features, token string) (*Server, error) {
+	if token == "" {
+		return nil, errors.New("cannot serve without an authentication token")
+	}
+
+	return &Server{
+		token: token,
+		mql: &service.Server{
+			Registry: runtime.Registry,
+			Runtime:  runtime,
+			Features: features,
+		},
+		services: services,
+	}, nil
+}
+
+// Handler returns the http handler for all services
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/MQL/", service.NewMQLServer(s.mql))
+	if s.services != nil {
+		mux.Handle("/QueryHub/", explorer.NewQueryHubServer(s.services))
+		mux.Handle("/QueryConductor/", explorer.NewQueryConductorServer(s.services))
+	}
+	return s.authenticate(mux)
+}
+
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		token := strings.TrimPrefix(auth, "Bearer ")
+		if token == auth || subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+			log.Debug().Str("path", r.URL.Path).Msg("server> rejected unauthenticated request")
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Serve handles requests on the listener until the context is done
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	srv := &http.Server{
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		srv.Shutdown(shutdownCtx)
+	}()
+
+	err := srv.Serve(listener)
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// Listen opens a listener for the address. Addresses starting with unix://
+// create a socket that is only accessible by the current user, all other
+// addresses are treated as tcp host:port.
+func Listen(addr string) (net.Listener, error) {
+	if !strings.HasPrefix(addr, unixPrefix) {
+		return net.Listen("tcp", addr)
+	}
+
+	path := strings.TrimPrefix(addr, unixPrefix)
+	// remove leftover sockets of previous runs, but never other files
+	stat, err := os.Lstat(path)
+	if err == nil {
+		if stat.Mode()&os.ModeSocket == 0 {
+			return nil, errors.New("cannot listen on " + path + ": file exists and is not a socket")
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	return listenUnix(path)
+}
+
+// NewToken generates a random token for authenticating requests
+func NewToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
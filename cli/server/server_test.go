@@ -0,0 +1,125 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery"
+	"go.mondoo.com/cnquery/internal/datalakes/inmemory"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/resources"
+	"go.mondoo.com/cnquery/resources/packs/all"
+	"go.mondoo.com/cnquery/resources/packs/testutils"
+	"go.mondoo.com/cnquery/resources/service"
+)
+
+func testServer(t *testing.T) *Server {
+	m := testutils.Mock("../../resources/packs/testdata/arch.toml")
+	_, services, err := inmemory.NewServices()
+	require.NoError(t, err)
+
+	srv, err := New(resources.NewRuntime(all.Registry, m), services, cnquery.Features{}, "secret")
+	require.NoError(t, err)
+	return srv
+}
+
+func TestNew_RequiresToken(t *testing.T) {
+	m := testutils.Mock("../../resources/packs/testdata/arch.toml")
+	_, err := New(resources.NewRuntime(all.Registry, m), nil, cnquery.Features{}, "")
+	assert.Error(t, err)
+}
+
+func TestServer_Authentication(t *testing.T) {
+	handler := testServer(t).Handler()
+
+	for header, authorized := range map[string]bool{
+		"":              false,
+		"Bearer wrong":  false,
+		"secret":        false,
+		"Bearer secret": true,
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/MQL/ListResources", nil)
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if authorized {
+			assert.NotEqual(t, http.StatusUnauthorized, rec.Code, header)
+		} else {
+			assert.Equal(t, http.StatusUnauthorized, rec.Code, header)
+		}
+	}
+}
+
+func TestServer_Run(t *testing.T) {
+	srv := testServer(t)
+
+	res, err := srv.mql.Run(context.Background(), &service.RunRequest{
+		Query: "users.where(uid == 1000).length\nprops.name",
+		Props: map[string]*llx.Primitive{"name": llx.StringPrimitive("chris")},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Results, 2)
+
+	assert.Empty(t, res.Results[0].Error)
+	assert.Equal(t, int64(2), res.Results[0].RawResultV2().Data.Value)
+	assert.Equal(t, "chris", res.Results[1].RawResultV2().Data.Value)
+
+	_, err = srv.mql.Run(context.Background(), &service.RunRequest{Query: "users.unknownField"})
+	assert.Error(t, err)
+}
+
+func TestListen_Unix(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cnquery.sock")
+	listener, err := Listen("unix://" + path)
+	require.NoError(t, err)
+	defer listener.Close()
+
+	assert.Equal(t, "unix", listener.Addr().Network())
+	assert.Equal(t, path, listener.Addr().String())
+}
+
+func TestListen_UnixPermissions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cnquery.sock")
+	listener, err := Listen("unix://" + path)
+	require.NoError(t, err)
+	defer listener.Close()
+
+	stat, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), stat.Mode().Perm())
+}
+
+func TestListen_UnixLeftoverSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cnquery.sock")
+	listener, err := Listen("unix://" + path)
+	require.NoError(t, err)
+	// simulate a crashed run that left the socket behind
+	listener.(*net.UnixListener).SetUnlinkOnClose(false)
+	listener.Close()
+
+	listener, err = Listen("unix://" + path)
+	require.NoError(t, err)
+	listener.Close()
+}
+
+func TestListen_UnixRefusesFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cnquery.sock")
+	require.NoError(t, os.WriteFile(path, []byte("data"), 0o600))
+
+	_, err := Listen("unix://" + path)
+	assert.Error(t, err)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(data))
+}
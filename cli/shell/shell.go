@@ -41,6 +41,13 @@ func WithUpstreamConfig(c *resources.UpstreamConfig) ShellOption {
 	}
 }
 
+// WithFieldCache reuses resource fields of previous runs from the cache
+func WithFieldCache(cache resources.FieldCache) ShellOption {
+	return func(t *Shell) {
+		t.Runtime.FieldCache = cache
+	}
+}
+
 func WithFeatures(features cnquery.Features) ShellOption {
 	return func(t *Shell) {
 		t.features = features
@@ -234,6 +234,12 @@ func (p *Bundle) Compile(ctx context.Context) (*BundleMap, error) {
 			return nil, errors.New("failed to refresh query pack " + querypack.Mrn + ": " + err.Error())
 		}
 
+		// type-check all properties, queries are compiled against them
+		props, err := querypack.CompileProps()
+		if err != nil {
+			return nil, errors.New("failed to compile properties of query pack " + querypack.Mrn + ": " + err.Error())
+		}
+
 		for i := range querypack.Queries {
 			query := querypack.Queries[i]
 
@@ -246,7 +252,7 @@ func (p *Bundle) Compile(ctx context.Context) (*BundleMap, error) {
 			}
 
 			// recalculate the checksums
-			codeBundle, err := query.RefreshChecksumAndType(nil)
+			codeBundle, err := query.RefreshChecksumAndType(props)
 			if err != nil {
 				log.Error().Err(err).Msg("could not compile the query")
 				warnings = append(warnings, errors.Wrap(err, "failed to validate query '"+query.Mrn+"'"))
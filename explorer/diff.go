@@ -0,0 +1,463 @@
+package explorer
+
+import (
+	"sort"
+
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/types"
+)
+
+type DiffStatus string
+
+const (
+	DiffAdded     DiffStatus = "added"
+	DiffRemoved   DiffStatus = "removed"
+	DiffChanged   DiffStatus = "changed"
+	DiffUnchanged DiffStatus = "unchanged"
+)
+
+// ReportDiff is the difference between two report collections
+type ReportDiff struct {
+	Assets []*AssetDiff `json:"assets"`
+}
+
+// AssetDiff is the difference of one asset between two report collections.
+// Assets are aligned by their platform IDs and fall back to their MRN.
+// Assets that could not be scanned have an error instead of query data,
+// their queries are only compared if both scans succeeded.
+type AssetDiff struct {
+	Name        string       `json:"name"`
+	Mrn         string       `json:"mrn,omitempty"`
+	OldMrn      string       `json:"old_mrn,omitempty"`
+	PlatformIds []string     `json:"platform_ids,omitempty"`
+	Status      DiffStatus   `json:"status"`
+	Error       string       `json:"error,omitempty"`
+	OldError    string       `json:"old_error,omitempty"`
+	Queries     []*QueryDiff `json:"queries,omitempty"`
+}
+
+// QueryDiff is the difference of one query's data on an asset.
+// Queries are aligned by their code ID.
+type QueryDiff struct {
+	CodeId  string        `json:"code_id"`
+	Mrn     string        `json:"mrn,omitempty"`
+	Title   string        `json:"title,omitempty"`
+	Status  DiffStatus    `json:"status"`
+	Changes []*DataChange `json:"changes,omitempty"`
+	// Code of the query, which is needed to render the data values
+	Code *llx.CodeBundle `json:"-"`
+}
+
+// DataChange is a single change in the data of a query. For arrays, every
+// added or removed element is its own change. For maps and dicts, every key
+// is compared individually.
+type DataChange struct {
+	// Checksum of the entrypoint that this change belongs to
+	Checksum string       `json:"checksum"`
+	Path     string       `json:"path"`
+	Status   DiffStatus   `json:"status"`
+	Old      *llx.RawData `json:"-"`
+	New      *llx.RawData `json:"-"`
+}
+
+// HasChanges returns true if any asset was added, removed or changed
+func (d *ReportDiff) HasChanges() bool {
+	for i := range d.Assets {
+		if d.Assets[i].Status != DiffUnchanged {
+			return true
+		}
+	}
+	return false
+}
+
+type assetQueries struct {
+	asset   *Asset
+	mrn     string
+	err     string
+	queries map[string]*assetQuery
+}
+
+type assetQuery struct {
+	code    *llx.CodeBundle
+	query   *Mquery
+	results map[string]*llx.RawResult
+}
+
+// collectAssetQueries indexes all query results of an asset by code ID
+func collectAssetQueries(collection *ReportCollection, assetMrn string) *assetQueries {
+	res := &assetQueries{
+		asset:   collection.Assets[assetMrn],
+		mrn:     assetMrn,
+		err:     collection.Errors[assetMrn],
+		queries: map[string]*assetQuery{},
+	}
+
+	report, ok := collection.Reports[assetMrn]
+	if !ok {
+		return res
+	}
+	resolved, ok := collection.Resolved[assetMrn]
+	if !ok || resolved.ExecutionJob == nil {
+		return res
+	}
+
+	mqueries := map[string]*Mquery{}
+	if collection.Bundle != nil {
+		for i := range collection.Bundle.Packs {
+			pack := collection.Bundle.Packs[i]
+			for j := range pack.Queries {
+				mqueries[pack.Queries[j].CodeId] = pack.Queries[j]
+			}
+		}
+	}
+
+	results := report.RawResults()
+	for codeID, equery := range resolved.ExecutionJob.Queries {
+		if equery.Code == nil {
+			continue
+		}
+		res.queries[codeID] = &assetQuery{
+			code:    equery.Code,
+			query:   mqueries[codeID],
+			results: results,
+		}
+	}
+
+	return res
+}
+
+func (a *assetQueries) name() string {
+	if a.asset != nil && a.asset.Name != "" {
+		return a.asset.Name
+	}
+	return a.mrn
+}
+
+func (a *assetQueries) platformIds() []string {
+	if a.asset == nil {
+		return nil
+	}
+	return a.asset.PlatformIds
+}
+
+// DiffReports compares two report collections. Assets are aligned by their
+// platform IDs or MRNs, queries by their code ID. Data values are compared
+// using their MQL types, so arrays and maps report individual elements
+// that were added, removed or changed.
+func DiffReports(old *ReportCollection, cur *ReportCollection) *ReportDiff {
+	res := &ReportDiff{}
+
+	oldAssets := map[string]*assetQueries{}
+	oldByID := map[string]string{}
+	if old != nil {
+		for mrn := range old.Assets {
+			oldAssets[mrn] = collectAssetQueries(old, mrn)
+			oldByID[mrn] = mrn
+			for _, id := range old.Assets[mrn].GetPlatformIds() {
+				oldByID[id] = mrn
+			}
+		}
+	}
+
+	matched := map[string]struct{}{}
+	if cur != nil {
+		mrns := make([]string, 0, len(cur.Assets))
+		for mrn := range cur.Assets {
+			mrns = append(mrns, mrn)
+		}
+		sort.Strings(mrns)
+
+		for _, mrn := range mrns {
+			next := collectAssetQueries(cur, mrn)
+
+			ids := make([]string, 0, len(next.platformIds())+1)
+			ids = append(ids, next.platformIds()...)
+			ids = append(ids, mrn)
+
+			var prev *assetQueries
+			for _, id := range ids {
+				if oldMrn, ok := oldByID[id]; ok {
+					if _, used := matched[oldMrn]; !used {
+						prev = oldAssets[oldMrn]
+						break
+					}
+				}
+			}
+
+			if prev == nil {
+				res.Assets = append(res.Assets, &AssetDiff{
+					Name:        next.name(),
+					Mrn:         mrn,
+					PlatformIds: next.platformIds(),
+					Status:      DiffAdded,
+					Error:       next.err,
+				})
+				continue
+			}
+
+			matched[prev.mrn] = struct{}{}
+			res.Assets = append(res.Assets, diffAsset(prev, next))
+		}
+	}
+
+	oldMrns := make([]string, 0, len(oldAssets))
+	for mrn := range oldAssets {
+		if _, ok := matched[mrn]; !ok {
+			oldMrns = append(oldMrns, mrn)
+		}
+	}
+	sort.Strings(oldMrns)
+	for _, mrn := range oldMrns {
+		prev := oldAssets[mrn]
+		res.Assets = append(res.Assets, &AssetDiff{
+			Name:        prev.name(),
+			OldMrn:      mrn,
+			PlatformIds: prev.platformIds(),
+			Status:      DiffRemoved,
+			OldError:    prev.err,
+		})
+	}
+
+	return res
+}
+
+func diffAsset(prev *assetQueries, next *assetQueries) *AssetDiff {
+	res := &AssetDiff{
+		Name:        next.name(),
+		Mrn:         next.mrn,
+		OldMrn:      prev.mrn,
+		PlatformIds: next.platformIds(),
+		Status:      DiffUnchanged,
+		Error:       next.err,
+		OldError:    prev.err,
+	}
+
+	// without data of both scans, only the error state can be compared,
+	// otherwise all data of a failed scan would show up as removed
+	if prev.err != "" || next.err != "" {
+		if prev.err != next.err {
+			res.Status = DiffChanged
+		}
+		return res
+	}
+
+	codeIDs := map[string]struct{}{}
+	for id := range prev.queries {
+		codeIDs[id] = struct{}{}
+	}
+	for id := range next.queries {
+		codeIDs[id] = struct{}{}
+	}
+	sortedIDs := make([]string, 0, len(codeIDs))
+	for id := range codeIDs {
+		sortedIDs = append(sortedIDs, id)
+	}
+	sort.Strings(sortedIDs)
+
+	for _, codeID := range sortedIDs {
+		p := prev.queries[codeID]
+		n := next.queries[codeID]
+
+		var qd *QueryDiff
+		switch {
+		case p == nil:
+			qd = newQueryDiff(codeID, n, DiffAdded)
+		case n == nil:
+			qd = newQueryDiff(codeID, p, DiffRemoved)
+		default:
+			qd = newQueryDiff(codeID, n, DiffUnchanged)
+			for _, checksum := range n.code.EntrypointChecksums() {
+				qd.Changes = append(qd.Changes, diffResults(checksum, n.code, p.results[checksum], n.results[checksum])...)
+			}
+			if len(qd.Changes) != 0 {
+				qd.Status = DiffChanged
+			}
+		}
+
+		if qd.Status == DiffUnchanged {
+			continue
+		}
+		res.Queries = append(res.Queries, qd)
+		res.Status = DiffChanged
+	}
+
+	return res
+}
+
+func newQueryDiff(codeID string, q *assetQuery, status DiffStatus) *QueryDiff {
+	res := &QueryDiff{
+		CodeId: codeID,
+		Status: status,
+		Code:   q.code,
+	}
+	if q.query != nil {
+		res.Mrn = q.query.Mrn
+		res.Title = q.query.Title
+	}
+	if res.Title == "" {
+		res.Title = q.code.Source
+	}
+	return res
+}
+
+func diffResults(checksum string, bundle *llx.CodeBundle, prev *llx.RawResult, next *llx.RawResult) []*DataChange {
+	label := bundle.GetLabels().GetLabels()[checksum]
+	if label == "" {
+		label = checksum
+	}
+
+	var p, n *llx.RawData
+	if prev != nil {
+		p = prev.Data
+	}
+	if next != nil {
+		n = next.Data
+	}
+
+	d := &differ{checksum: checksum, bundle: bundle}
+	d.diff(label, p, n)
+	return d.changes
+}
+
+type differ struct {
+	checksum string
+	bundle   *llx.CodeBundle
+	changes  []*DataChange
+}
+
+func (d *differ) add(path string, status DiffStatus, prev *llx.RawData, next *llx.RawData) {
+	d.changes = append(d.changes, &DataChange{
+		Checksum: d.checksum,
+		Path:     path,
+		Status:   status,
+		Old:      prev,
+		New:      next,
+	})
+}
+
+// key returns a canonical representation of a value, which is used to
+// check two values of the same type for equality
+func (d *differ) key(data *llx.RawData) string {
+	if data.Error != nil {
+		return "error:" + data.Error.Error()
+	}
+	return string(data.JSON(d.checksum, d.bundle))
+}
+
+func (d *differ) diff(path string, prev *llx.RawData, next *llx.RawData) {
+	switch {
+	case prev == nil && next == nil:
+		return
+	case prev == nil:
+		d.add(path, DiffAdded, nil, next)
+		return
+	case next == nil:
+		d.add(path, DiffRemoved, prev, nil)
+		return
+	}
+
+	if prev.Type != next.Type || prev.Error != nil || next.Error != nil ||
+		prev.Value == nil || next.Value == nil {
+		if d.key(prev) != d.key(next) {
+			d.add(path, DiffChanged, prev, next)
+		}
+		return
+	}
+
+	typ := next.Type
+	switch {
+	case typ.IsArray():
+		d.diffArray(path, typ.Child(), prev.Value, next.Value)
+
+	case typ.IsMap() && typ.Key() == types.String:
+		d.diffMap(path, typ.Child(), prev.Value, next.Value)
+
+	case typ == types.Dict:
+		_, pIsMap := prev.Value.(map[string]interface{})
+		_, nIsMap := next.Value.(map[string]interface{})
+		_, pIsArr := prev.Value.([]interface{})
+		_, nIsArr := next.Value.([]interface{})
+		if pIsMap && nIsMap {
+			d.diffMap(path, types.Dict, prev.Value, next.Value)
+		} else if pIsArr && nIsArr {
+			d.diffArray(path, types.Dict, prev.Value, next.Value)
+		} else if d.key(prev) != d.key(next) {
+			d.add(path, DiffChanged, prev, next)
+		}
+
+	default:
+		if d.key(prev) != d.key(next) {
+			d.add(path, DiffChanged, prev, next)
+		}
+	}
+}
+
+// diffArray treats both arrays as multisets and reports every element that
+// was added or removed. The order of elements is ignored.
+func (d *differ) diffArray(path string, child types.Type, prev interface{}, next interface{}) {
+	pArr, ok1 := prev.([]interface{})
+	nArr, ok2 := next.([]interface{})
+	if !ok1 || !ok2 {
+		return
+	}
+
+	counts := map[string]int{}
+	for i := range pArr {
+		counts[d.key(&llx.RawData{Type: child, Value: pArr[i]})]++
+	}
+
+	var added []*llx.RawData
+	for i := range nArr {
+		entry := &llx.RawData{Type: child, Value: nArr[i]}
+		k := d.key(entry)
+		if counts[k] > 0 {
+			counts[k]--
+			continue
+		}
+		added = append(added, entry)
+	}
+
+	for i := range pArr {
+		entry := &llx.RawData{Type: child, Value: pArr[i]}
+		k := d.key(entry)
+		if counts[k] > 0 {
+			counts[k]--
+			d.add(path+"[]", DiffRemoved, entry, nil)
+		}
+	}
+	for i := range added {
+		d.add(path+"[]", DiffAdded, nil, added[i])
+	}
+}
+
+func (d *differ) diffMap(path string, child types.Type, prev interface{}, next interface{}) {
+	pMap, ok1 := prev.(map[string]interface{})
+	nMap, ok2 := next.(map[string]interface{})
+	if !ok1 || !ok2 {
+		return
+	}
+
+	keys := map[string]struct{}{}
+	for k := range pMap {
+		keys[k] = struct{}{}
+	}
+	for k := range nMap {
+		keys[k] = struct{}{}
+	}
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	for _, k := range sorted {
+		var p, n *llx.RawData
+		if v, ok := pMap[k]; ok {
+			p = &llx.RawData{Type: child, Value: v}
+		}
+		if v, ok := nMap[k]; ok {
+			n = &llx.RawData{Type: child, Value: v}
+		}
+		d.diff(path+"."+k, p, n)
+	}
+}
@@ -0,0 +1,177 @@
+package explorer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/types"
+)
+
+type testAssetData struct {
+	mrn         string
+	platformIds []string
+	data        map[string]*llx.RawData
+}
+
+// testCollection builds a report collection where every asset ran all queries
+// and the given data is the result of the first entrypoint of each query
+func testCollection(t *testing.T, queries map[string]string, assets ...testAssetData) *ReportCollection {
+	code := map[string]*llx.CodeBundle{}
+	pack := &QueryPack{Mrn: "//local.cnquery.io/run/local-execution/querypacks/test"}
+	for uid, mql := range queries {
+		query := &Mquery{Mrn: "//local.cnquery.io/run/local-execution/queries/" + uid, Title: uid, Query: mql}
+		bundle, err := query.RefreshChecksumAndType(nil)
+		require.NoError(t, err)
+		code[uid] = bundle
+		pack.Queries = append(pack.Queries, query)
+	}
+
+	res := &ReportCollection{
+		Assets:   map[string]*Asset{},
+		Bundle:   &Bundle{Packs: []*QueryPack{pack}},
+		Reports:  map[string]*Report{},
+		Resolved: map[string]*ResolvedPack{},
+	}
+
+	for i := range assets {
+		asset := assets[i]
+		res.Assets[asset.mrn] = &Asset{Mrn: asset.mrn, Name: "asset", PlatformIds: asset.platformIds}
+
+		job := &ExecutionJob{Queries: map[string]*ExecutionQuery{}}
+		report := &Report{EntityMrn: asset.mrn, Data: map[string]*llx.Result{}}
+		for uid, bundle := range code {
+			job.Queries[bundle.CodeV2.Id] = &ExecutionQuery{Code: bundle}
+			if data, ok := asset.data[uid]; ok {
+				checksum := bundle.EntrypointChecksums()[0]
+				result := data.Result()
+				result.CodeId = checksum
+				report.Data[checksum] = result
+			}
+		}
+		res.Resolved[asset.mrn] = &ResolvedPack{ExecutionJob: job}
+		res.Reports[asset.mrn] = report
+	}
+
+	return res
+}
+
+func TestDiffReports(t *testing.T) {
+	queries := map[string]string{
+		"names":  "packages.map(name)",
+		"labels": "asset.labels",
+	}
+
+	old := testCollection(t, queries,
+		testAssetData{
+			mrn:         "//assets/old-mrn",
+			platformIds: []string{"//platformid/host1"},
+			data: map[string]*llx.RawData{
+				"names":  llx.ArrayData([]interface{}{"bash", "openssl"}, types.String),
+				"labels": llx.MapData(map[string]interface{}{"env": "prod", "team": "a"}, types.String),
+			},
+		},
+		testAssetData{mrn: "//assets/removed"},
+	)
+
+	cur := testCollection(t, queries,
+		testAssetData{
+			mrn:         "//assets/new-mrn",
+			platformIds: []string{"//platformid/host1"},
+			data: map[string]*llx.RawData{
+				"names":  llx.ArrayData([]interface{}{"curl", "bash"}, types.String),
+				"labels": llx.MapData(map[string]interface{}{"env": "dev", "team": "a"}, types.String),
+			},
+		},
+		testAssetData{mrn: "//assets/added"},
+	)
+
+	diff := DiffReports(old, cur)
+	require.True(t, diff.HasChanges())
+	require.Len(t, diff.Assets, 3)
+
+	assert.Equal(t, "//assets/added", diff.Assets[0].Mrn)
+	assert.Equal(t, DiffAdded, diff.Assets[0].Status)
+
+	changed := diff.Assets[1]
+	assert.Equal(t, "//assets/new-mrn", changed.Mrn)
+	assert.Equal(t, "//assets/old-mrn", changed.OldMrn)
+	assert.Equal(t, DiffChanged, changed.Status)
+
+	type change struct {
+		path   string
+		status DiffStatus
+		old    interface{}
+		new    interface{}
+	}
+	var changes []change
+	for _, q := range changed.Queries {
+		assert.Equal(t, DiffChanged, q.Status)
+		for _, c := range q.Changes {
+			cur := change{path: c.Path, status: c.Status}
+			if c.Old != nil {
+				cur.old = c.Old.Value
+			}
+			if c.New != nil {
+				cur.new = c.New.Value
+			}
+			changes = append(changes, cur)
+		}
+	}
+	assert.ElementsMatch(t, []change{
+		{"packages.map[]", DiffRemoved, "openssl", nil},
+		{"packages.map[]", DiffAdded, nil, "curl"},
+		{"asset.labels.env", DiffChanged, "prod", "dev"},
+	}, changes)
+
+	assert.Equal(t, "//assets/removed", diff.Assets[2].OldMrn)
+	assert.Equal(t, DiffRemoved, diff.Assets[2].Status)
+
+	t.Run("identical reports have no changes", func(t *testing.T) {
+		diff := DiffReports(old, old)
+		assert.False(t, diff.HasChanges())
+	})
+
+	t.Run("failed scans are compared by their error", func(t *testing.T) {
+		failed := testCollection(t, queries, testAssetData{
+			mrn:         "//assets/new-mrn",
+			platformIds: []string{"//platformid/host1"},
+		})
+		delete(failed.Reports, "//assets/new-mrn")
+		delete(failed.Resolved, "//assets/new-mrn")
+		failed.Errors = map[string]string{"//assets/new-mrn": "connection refused"}
+
+		diff := DiffReports(old, failed)
+		require.Len(t, diff.Assets, 2)
+		asset := diff.Assets[0]
+		assert.Equal(t, DiffChanged, asset.Status)
+		assert.Equal(t, "connection refused", asset.Error)
+		assert.Empty(t, asset.OldError)
+		assert.Empty(t, asset.Queries)
+
+		diff = DiffReports(failed, failed)
+		assert.False(t, diff.HasChanges())
+		assert.Equal(t, "connection refused", diff.Assets[0].Error)
+
+		diff = DiffReports(failed, cur)
+		assert.Equal(t, DiffChanged, diff.Assets[1].Status)
+		assert.Equal(t, "connection refused", diff.Assets[1].OldError)
+		assert.Empty(t, diff.Assets[1].Queries)
+	})
+
+	t.Run("json output is not a report", func(t *testing.T) {
+		_, err := ReportCollectionFromJSON([]byte(`{"assets":{},"data":{},"errors":{}}`))
+		assert.ErrorContains(t, err, "--output report")
+	})
+
+	t.Run("reports survive serialization", func(t *testing.T) {
+		data, err := cur.ToJSON()
+		require.NoError(t, err)
+		loaded, err := ReportCollectionFromJSON(data)
+		require.NoError(t, err)
+
+		diff := DiffReports(cur, loaded)
+		assert.False(t, diff.HasChanges())
+	})
+}
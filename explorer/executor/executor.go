@@ -23,17 +23,31 @@ func RunExecutionJob(
 	timeout := 30 * time.Minute
 
 	bundles := make([]*llx.CodeBundle, len(job.Queries))
+	props := make(map[string]map[string]*llx.Primitive, len(job.Queries))
 	i := 0
 	for _, query := range job.Queries {
 		bundles[i] = query.Code
 		i++
+
+		if len(query.Properties) == 0 {
+			continue
+		}
+		queryProps := make(map[string]*llx.Primitive, len(query.Properties))
+		for name, checksum := range query.Properties {
+			prop, ok := job.Props[checksum]
+			if !ok {
+				return nil, errors.New("cannot find property '" + name + "' for query " + query.Code.CodeV2.Id)
+			}
+			queryProps[name] = prop
+		}
+		props[query.Code.CodeV2.Id] = queryProps
 	}
 
 	res := newInstance(schema, runtime, progressFn)
 	res.assetMrn = assetMrn
 	res.collector = collectorSvc
 
-	return res, res.runCode(bundles, timeout)
+	return res, res.runCode(bundles, props, timeout)
 }
 
 func RunFilterQueries(
@@ -59,7 +73,7 @@ func RunFilterQueries(
 	}
 
 	instance := newInstance(schema, runtime, nil)
-	err := instance.runCode(bundles, timeout)
+	err := instance.runCode(bundles, nil, timeout)
 	if err != nil {
 		return nil, []error{err}
 	}
@@ -93,7 +107,9 @@ func RunFilterQueries(
 	return res, errs
 }
 
-func (e *instance) runCode(bundles []*llx.CodeBundle, timeout time.Duration) error {
+// runCode executes all bundles. Props are the property values
+// of every bundle, which are looked up by its code ID.
+func (e *instance) runCode(bundles []*llx.CodeBundle, props map[string]map[string]*llx.Primitive, timeout time.Duration) error {
 	e.execs = make(map[string]*llx.MQLExecutorV2, len(bundles))
 
 	for i := range bundles {
@@ -113,7 +129,7 @@ func (e *instance) runCode(bundles []*llx.CodeBundle, timeout time.Duration) err
 	for i := range bundles {
 		bundle := bundles[i]
 
-		exec, err := llx.NewExecutorV2(bundle.CodeV2, e.runtime, nil, e.collect)
+		exec, err := llx.NewExecutorV2(bundle.CodeV2, e.runtime, props[bundle.CodeV2.Id], e.collect)
 		if err != nil {
 			multierror.Append(errs, err)
 			continue
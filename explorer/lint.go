@@ -0,0 +1,492 @@
+package explorer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	vrs "github.com/hashicorp/go-version"
+	"github.com/pkg/errors"
+	llx "go.mondoo.com/cnquery/llx"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	LintError   = "error"
+	LintWarning = "warning"
+)
+
+// Lint rule IDs reported by the bundle linter
+const (
+	LintRuleInvalidYAML     = "bundle-invalid-yaml"
+	LintRulePackUid         = "pack-uid"
+	LintRulePackName        = "pack-name"
+	LintRuleDuplicateUid    = "duplicate-uid"
+	LintRuleQueryUid        = "query-uid"
+	LintRuleQueryTitle      = "query-title"
+	LintRuleQueryDocs       = "query-docs"
+	LintRuleQueryCompile    = "query-compile"
+	LintRuleQueryMinVersion = "query-min-version"
+	LintRuleFilterCompile   = "filter-unknown-resource"
+	LintRuleImpactInvalid   = "impact-invalid"
+	LintRuleEmptyTag        = "empty-tag"
+	LintRuleUnusedTag       = "unused-tag"
+	LintRuleUnknownVersion  = "query-unknown-version"
+	LintRulePropInvalid     = "prop-invalid"
+)
+
+// LintEntry is a single diagnostic of the bundle linter
+type LintEntry struct {
+	RuleID  string `json:"rule"`
+	Level   string `json:"level"`
+	Message string `json:"message"`
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+}
+
+func (e *LintEntry) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s: %s (%s)", e.File, e.Line, e.Column, e.Level, e.Message, e.RuleID)
+}
+
+// LintResults collects all diagnostics of a bundle lint run
+type LintResults struct {
+	Entries []*LintEntry `json:"entries"`
+}
+
+// HasError returns true if at least one entry is an error
+func (r *LintResults) HasError() bool {
+	for i := range r.Entries {
+		if r.Entries[i].Level == LintError {
+			return true
+		}
+	}
+	return false
+}
+
+// ToJSON returns the results as JSON
+func (r *LintResults) ToJSON() ([]byte, error) {
+	if r.Entries == nil {
+		r.Entries = []*LintEntry{}
+	}
+	return json.Marshal(r)
+}
+
+// LintOptions configure the bundle linter
+type LintOptions struct {
+	// MinMondooVersion is the declared minimum version that the bundle
+	// must run on. Queries that need a newer version are reported.
+	// If it is empty, the check is skipped.
+	MinMondooVersion string
+	// KnownTags are the tag keys that are referenced by tooling, e.g. to
+	// group or filter queries. Other tags are reported as unused.
+	// If it is empty, the check is skipped.
+	KnownTags []string
+}
+
+type linter struct {
+	opts       LintOptions
+	minVersion *vrs.Version
+	knownTags  map[string]struct{}
+	results    *LintResults
+	// uid => first location it was defined at
+	packUids  map[string]string
+	queryUids map[string]string
+}
+
+func newLinter(opts LintOptions) (*linter, error) {
+	l := &linter{
+		opts:      opts,
+		results:   &LintResults{},
+		packUids:  map[string]string{},
+		queryUids: map[string]string{},
+	}
+
+	if len(opts.KnownTags) != 0 {
+		l.knownTags = make(map[string]struct{}, len(opts.KnownTags))
+		for i := range opts.KnownTags {
+			l.knownTags[strings.TrimSpace(opts.KnownTags[i])] = struct{}{}
+		}
+	}
+
+	if opts.MinMondooVersion != "" {
+		var err error
+		l.minVersion, err = vrs.NewVersion(opts.MinMondooVersion)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid minimum version '"+opts.MinMondooVersion+"'")
+		}
+	}
+
+	return l, nil
+}
+
+// LintBundleFiles checks all bundle files in the given paths and reports
+// structured diagnostics including their position in the source files.
+// Directories are walked the same way as BundleFromPaths does.
+func LintBundleFiles(opts LintOptions, paths ...string) (*LintResults, error) {
+	files, err := walkBundleFiles(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := newLinter(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range files {
+		data, err := os.ReadFile(files[i])
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load file: "+files[i])
+		}
+		l.lintFile(files[i], data)
+	}
+
+	return l.sortedResults(), nil
+}
+
+// LintBundleYAML checks the contents of a single bundle file.
+// The filename is only used for reporting.
+func LintBundleYAML(opts LintOptions, filename string, data []byte) (*LintResults, error) {
+	l, err := newLinter(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	l.lintFile(filename, data)
+	return l.sortedResults(), nil
+}
+
+func (l *linter) sortedResults() *LintResults {
+	sort.SliceStable(l.results.Entries, func(i, j int) bool {
+		a := l.results.Entries[i]
+		b := l.results.Entries[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+	return l.results
+}
+
+func (l *linter) add(rule string, level string, file string, node *yaml.Node, msg string) {
+	entry := &LintEntry{
+		RuleID:  rule,
+		Level:   level,
+		Message: msg,
+		File:    file,
+	}
+	if node != nil {
+		entry.Line = node.Line
+		entry.Column = node.Column
+	}
+	l.results.Entries = append(l.results.Entries, entry)
+}
+
+// mappingValue returns the key and value nodes for a key in a mapping node
+func mappingValue(node *yaml.Node, key string) (*yaml.Node, *yaml.Node) {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i], node.Content[i+1]
+		}
+	}
+	return nil, nil
+}
+
+func scalarValue(node *yaml.Node, key string) (string, *yaml.Node) {
+	_, v := mappingValue(node, key)
+	if v == nil || v.Kind != yaml.ScalarNode {
+		return "", v
+	}
+	return strings.TrimSpace(v.Value), v
+}
+
+var yamlErrLine = regexp.MustCompile(`line (\d+)`)
+
+func location(file string, node *yaml.Node) string {
+	return file + ":" + strconv.Itoa(node.Line)
+}
+
+func (l *linter) lintFile(file string, data []byte) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		var pos *yaml.Node
+		if m := yamlErrLine.FindStringSubmatch(err.Error()); m != nil {
+			line, _ := strconv.Atoi(m[1])
+			pos = &yaml.Node{Line: line, Column: 1}
+		}
+		l.add(LintRuleInvalidYAML, LintError, file, pos, err.Error())
+		return
+	}
+
+	if len(root.Content) == 0 {
+		return
+	}
+	doc := root.Content[0]
+
+	_, packs := mappingValue(doc, "packs")
+	if packs == nil {
+		return
+	}
+	if packs.Kind != yaml.SequenceNode {
+		l.add(LintRuleInvalidYAML, LintError, file, packs, "packs must be a list")
+		return
+	}
+
+	for i := range packs.Content {
+		l.lintPack(file, i, packs.Content[i])
+	}
+}
+
+func (l *linter) lintPack(file string, idx int, pack *yaml.Node) {
+	packID := strconv.Itoa(idx)
+
+	uid, uidNode := scalarValue(pack, "uid")
+	mrn, _ := scalarValue(pack, "mrn")
+	if uid == "" && mrn == "" {
+		l.add(LintRulePackUid, LintError, file, pack, "pack "+packID+" does not define a uid")
+	} else if uid != "" {
+		packID = uid
+		if prev, ok := l.packUids[uid]; ok {
+			l.add(LintRuleDuplicateUid, LintError, file, uidNode, "pack uid '"+uid+"' is already used in "+prev)
+		} else {
+			l.packUids[uid] = location(file, uidNode)
+		}
+	} else {
+		packID = mrn
+	}
+
+	if name, _ := scalarValue(pack, "name"); name == "" {
+		l.add(LintRulePackName, LintError, file, pack, "pack "+packID+" does not define a name")
+	}
+
+	if _, filters := mappingValue(pack, "filters"); filters != nil {
+		l.lintFilters(file, packID, filters)
+	}
+
+	if _, tags := mappingValue(pack, "tags"); tags != nil {
+		l.lintTags(file, "pack "+packID, tags)
+	}
+
+	var props map[string]*llx.Primitive
+	if _, propsNode := mappingValue(pack, "props"); propsNode != nil {
+		props = l.lintProps(file, packID, propsNode)
+	}
+
+	_, queries := mappingValue(pack, "queries")
+	if queries == nil {
+		return
+	}
+	if queries.Kind != yaml.SequenceNode {
+		l.add(LintRuleInvalidYAML, LintError, file, queries, "queries of pack "+packID+" must be a list")
+		return
+	}
+	for i := range queries.Content {
+		l.lintQuery(file, packID, i, queries.Content[i], props)
+	}
+}
+
+// lintProps checks all property declarations of a pack and returns the
+// properties that queries can be compiled against
+func (l *linter) lintProps(file string, packID string, props *yaml.Node) map[string]*llx.Primitive {
+	res := map[string]*llx.Primitive{}
+	if props.Kind != yaml.SequenceNode {
+		l.add(LintRuleInvalidYAML, LintError, file, props, "props of pack "+packID+" must be a list")
+		return res
+	}
+
+	for i := range props.Content {
+		node := props.Content[i]
+
+		var raw interface{}
+		if err := node.Decode(&raw); err != nil {
+			l.add(LintRuleInvalidYAML, LintError, file, node, "invalid property in pack "+packID+": "+err.Error())
+			continue
+		}
+		data, err := json.Marshal(raw)
+		if err != nil {
+			l.add(LintRuleInvalidYAML, LintError, file, node, "invalid property in pack "+packID+": "+err.Error())
+			continue
+		}
+		var prop Property
+		if err := json.Unmarshal(data, &prop); err != nil {
+			l.add(LintRuleInvalidYAML, LintError, file, node, "invalid property in pack "+packID+": "+err.Error())
+			continue
+		}
+
+		if prop.Name == "" {
+			l.add(LintRulePropInvalid, LintError, file, node, "property in pack "+packID+" does not define a name")
+			continue
+		}
+		if _, ok := res[prop.Name]; ok {
+			l.add(LintRulePropInvalid, LintError, file, node, "property '"+prop.Name+"' is defined multiple times in pack "+packID)
+			continue
+		}
+
+		prim, err := prop.Primitive(prop.Default)
+		if err != nil {
+			l.add(LintRulePropInvalid, LintError, file, node, err.Error())
+			// still register its type, so queries don't report it as missing
+			if typ, err := ParsePropertyType(prop.Type); err == nil {
+				prim = &llx.Primitive{Type: string(typ)}
+			} else {
+				continue
+			}
+		}
+		res[prop.Name] = prim
+	}
+
+	return res
+}
+
+func (l *linter) lintFilters(file string, packID string, filters *yaml.Node) {
+	var nodes []*yaml.Node
+	switch filters.Kind {
+	case yaml.SequenceNode:
+		nodes = filters.Content
+	case yaml.ScalarNode:
+		nodes = []*yaml.Node{filters}
+	default:
+		l.add(LintRuleInvalidYAML, LintError, file, filters, "filters of pack "+packID+" must be a list of queries")
+		return
+	}
+
+	for i := range nodes {
+		node := nodes[i]
+		if node.Kind != yaml.ScalarNode {
+			l.add(LintRuleInvalidYAML, LintError, file, node, "filter in pack "+packID+" must be a query")
+			continue
+		}
+
+		filter := &Mquery{Query: node.Value}
+		if _, err := filter.Compile(nil); err != nil {
+			l.add(LintRuleFilterCompile, LintError, file, node,
+				"filter '"+strings.TrimSpace(node.Value)+"' in pack "+packID+" cannot be compiled: "+err.Error())
+		}
+	}
+}
+
+func (l *linter) lintTags(file string, owner string, tags *yaml.Node) {
+	if tags.Kind != yaml.MappingNode {
+		l.add(LintRuleInvalidYAML, LintError, file, tags, "tags of "+owner+" must be a map")
+		return
+	}
+
+	for i := 0; i+1 < len(tags.Content); i += 2 {
+		key := tags.Content[i]
+		value := tags.Content[i+1]
+		if strings.TrimSpace(key.Value) == "" {
+			l.add(LintRuleEmptyTag, LintWarning, file, key, owner+" has a tag without a key")
+			continue
+		}
+		if value.Kind != yaml.ScalarNode || strings.TrimSpace(value.Value) == "" {
+			l.add(LintRuleEmptyTag, LintWarning, file, key, owner+" has tag '"+key.Value+"' without a value")
+		}
+		if l.knownTags == nil {
+			continue
+		}
+		if _, ok := l.knownTags[strings.TrimSpace(key.Value)]; !ok {
+			l.add(LintRuleUnusedTag, LintWarning, file, key, owner+" has tag '"+key.Value+"', which is not used by any tooling")
+		}
+	}
+}
+
+func (l *linter) lintImpact(file string, queryID string, impact *yaml.Node) {
+	value := impact
+	if impact.Kind == yaml.MappingNode {
+		_, value = mappingValue(impact, "value")
+		if value == nil {
+			l.add(LintRuleImpactInvalid, LintError, file, impact, "impact of query "+queryID+" does not define a value")
+			return
+		}
+	}
+
+	if value.Kind != yaml.ScalarNode {
+		l.add(LintRuleImpactInvalid, LintError, file, value, "impact of query "+queryID+" must be a number between 0 and 100")
+		return
+	}
+
+	v, err := strconv.Atoi(strings.TrimSpace(value.Value))
+	if err != nil || v < 0 || v > 100 {
+		l.add(LintRuleImpactInvalid, LintError, file, value,
+			"impact of query "+queryID+" must be a number between 0 and 100, got '"+value.Value+"'")
+	}
+}
+
+func (l *linter) lintQuery(file string, packID string, idx int, query *yaml.Node, props map[string]*llx.Primitive) {
+	queryID := packID + "/" + strconv.Itoa(idx)
+
+	uid, uidNode := scalarValue(query, "uid")
+	mrn, _ := scalarValue(query, "mrn")
+	if uid == "" && mrn == "" {
+		l.add(LintRuleQueryUid, LintError, file, query, "query "+queryID+" does not define a uid")
+	} else if uid != "" {
+		queryID = packID + "/" + uid
+		if prev, ok := l.queryUids[uid]; ok {
+			l.add(LintRuleDuplicateUid, LintError, file, uidNode, "query uid '"+uid+"' is already used in "+prev)
+		} else {
+			l.queryUids[uid] = location(file, uidNode)
+		}
+	} else {
+		queryID = mrn
+	}
+
+	if title, _ := scalarValue(query, "title"); title == "" {
+		l.add(LintRuleQueryTitle, LintError, file, query, "query "+queryID+" does not define a title")
+	}
+
+	desc, _ := scalarValue(query, "desc")
+	if _, docs := mappingValue(query, "docs"); docs != nil {
+		if d, _ := scalarValue(docs, "desc"); d != "" {
+			desc = d
+		}
+	}
+	if desc == "" {
+		l.add(LintRuleQueryDocs, LintWarning, file, query, "query "+queryID+" does not define any docs")
+	}
+
+	if _, impact := mappingValue(query, "impact"); impact != nil {
+		l.lintImpact(file, queryID, impact)
+	}
+
+	if _, tags := mappingValue(query, "tags"); tags != nil {
+		l.lintTags(file, "query "+queryID, tags)
+	}
+
+	mql, queryNode := scalarValue(query, "query")
+	if mql == "" {
+		l.add(LintRuleQueryCompile, LintError, file, query, "query "+queryID+" does not define any mql")
+		return
+	}
+
+	code, err := (&Mquery{Query: mql}).Compile(props)
+	if err != nil {
+		l.add(LintRuleQueryCompile, LintError, file, queryNode, "query "+queryID+" cannot be compiled: "+err.Error())
+		return
+	}
+
+	if l.minVersion == nil || code.MinMondooVersion == "" {
+		return
+	}
+
+	required, err := vrs.NewVersion(code.MinMondooVersion)
+	if err != nil {
+		l.add(LintRuleUnknownVersion, LintWarning, file, queryNode,
+			"query "+queryID+" requires an unknown version '"+code.MinMondooVersion+"'")
+		return
+	}
+	if required.GreaterThan(l.minVersion) {
+		l.add(LintRuleQueryMinVersion, LintError, file, queryNode,
+			"query "+queryID+" requires version "+code.MinMondooVersion+", but the bundle targets "+l.opts.MinMondooVersion)
+	}
+}
@@ -0,0 +1,122 @@
+package explorer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintBundleFiles(t *testing.T) {
+	results, err := LintBundleFiles(LintOptions{}, "testdata/lint.mql.yaml")
+	require.NoError(t, err)
+	assert.True(t, results.HasError())
+
+	type entry struct {
+		rule string
+		line int
+	}
+	var actual []entry
+	for i := range results.Entries {
+		actual = append(actual, entry{results.Entries[i].RuleID, results.Entries[i].Line})
+	}
+
+	assert.Equal(t, []entry{
+		{LintRuleFilterCompile, 6},
+		{LintRuleEmptyTag, 8},
+		{LintRuleDuplicateUid, 15},
+		{LintRuleQueryTitle, 19},
+		{LintRuleQueryDocs, 19},
+		{LintRuleImpactInvalid, 21},
+		{LintRuleQueryCompile, 26},
+		{LintRulePackUid, 27},
+	}, actual)
+}
+
+func TestLintBundleYAML(t *testing.T) {
+	t.Run("valid bundle", func(t *testing.T) {
+		results, err := LintBundleYAML(LintOptions{}, "valid.mql.yaml", []byte(`
+packs:
+  - uid: pack
+    name: Pack
+    queries:
+      - uid: query
+        title: Query
+        desc: Returns the hostname
+        query: asset.name
+`))
+		require.NoError(t, err)
+		assert.Empty(t, results.Entries)
+		assert.False(t, results.HasError())
+	})
+
+	t.Run("invalid yaml", func(t *testing.T) {
+		results, err := LintBundleYAML(LintOptions{}, "invalid.mql.yaml", []byte("packs:\n  - uid: [\n"))
+		require.NoError(t, err)
+		require.Len(t, results.Entries, 1)
+		assert.Equal(t, LintRuleInvalidYAML, results.Entries[0].RuleID)
+	})
+
+	t.Run("min version", func(t *testing.T) {
+		bundle := []byte(`
+packs:
+  - uid: pack
+    name: Pack
+    queries:
+      - uid: query
+        title: Query
+        desc: Checks for empty files
+        query: file("/etc/passwd").empty
+`)
+		results, err := LintBundleYAML(LintOptions{MinMondooVersion: "5.15.0"}, "x.mql.yaml", bundle)
+		require.NoError(t, err)
+		require.Len(t, results.Entries, 1)
+		assert.Equal(t, LintRuleQueryMinVersion, results.Entries[0].RuleID)
+		assert.Equal(t, 9, results.Entries[0].Line)
+
+		results, err = LintBundleYAML(LintOptions{MinMondooVersion: "5.18.0"}, "x.mql.yaml", bundle)
+		require.NoError(t, err)
+		assert.Empty(t, results.Entries)
+
+		_, err = LintBundleYAML(LintOptions{MinMondooVersion: "not a version"}, "x.mql.yaml", bundle)
+		assert.Error(t, err)
+	})
+	t.Run("unused tags", func(t *testing.T) {
+		bundle := []byte(`
+packs:
+  - uid: pack
+    name: Pack
+    tags:
+      category: base
+      team: ops
+    queries:
+      - uid: query
+        title: Query
+        desc: Returns the hostname
+        query: asset.name
+        tags:
+          category: ""
+          legacy: "true"
+`)
+		results, err := LintBundleYAML(LintOptions{}, "x.mql.yaml", bundle)
+		require.NoError(t, err)
+		require.Len(t, results.Entries, 1)
+		assert.Equal(t, LintRuleEmptyTag, results.Entries[0].RuleID)
+
+		results, err = LintBundleYAML(LintOptions{KnownTags: []string{"category"}}, "x.mql.yaml", bundle)
+		require.NoError(t, err)
+		type entry struct {
+			rule string
+			line int
+		}
+		var actual []entry
+		for i := range results.Entries {
+			actual = append(actual, entry{results.Entries[i].RuleID, results.Entries[i].Line})
+		}
+		assert.Equal(t, []entry{
+			{LintRuleUnusedTag, 7},
+			{LintRuleEmptyTag, 14},
+			{LintRuleUnusedTag, 15},
+		}, actual)
+	})
+}
@@ -30,6 +30,10 @@ func (m *Mquery) Compile(props map[string]*llx.Primitive) (*llx.CodeBundle, erro
 		return nil, err
 	}
 
+	if err := addPropsChecksum(v2Code, props); err != nil {
+		return nil, err
+	}
+
 	return v2Code, nil
 }
 
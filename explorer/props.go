@@ -0,0 +1,351 @@
+package explorer
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.mondoo.com/cnquery/checksums"
+	llx "go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/types"
+	"sigs.k8s.io/yaml"
+)
+
+var propertyTypes = map[string]types.Type{
+	"bool":   types.Bool,
+	"int":    types.Int,
+	"float":  types.Float,
+	"string": types.String,
+	"regex":  types.Regex,
+}
+
+// ParsePropertyType turns a type label like int, []string or
+// map[string]int into its MQL type
+func ParsePropertyType(label string) (types.Type, error) {
+	label = strings.TrimSpace(label)
+
+	if typ, ok := propertyTypes[label]; ok {
+		return typ, nil
+	}
+
+	if strings.HasPrefix(label, "[]") {
+		child, err := ParsePropertyType(label[2:])
+		if err != nil {
+			return types.Nil, err
+		}
+		return types.Array(child), nil
+	}
+
+	if strings.HasPrefix(label, "map[string]") {
+		child, err := ParsePropertyType(label[11:])
+		if err != nil {
+			return types.Nil, err
+		}
+		return types.Map(types.String, child), nil
+	}
+
+	return types.Nil, errors.New("unsupported property type '" + label + "'")
+}
+
+// UnmarshalJSON accepts any JSON value as the default of a property,
+// so that users can write `default: 30` or `default: [a, b]` in YAML
+func (p *Property) UnmarshalJSON(data []byte) error {
+	type property Property
+	raw := struct {
+		*property
+		Default json.RawMessage `json:"default,omitempty"`
+	}{property: (*property)(p)}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	p.Default = ""
+	if len(raw.Default) == 0 || string(raw.Default) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(raw.Default, &s); err == nil {
+		p.Default = s
+		return nil
+	}
+
+	p.Default = string(raw.Default)
+	return nil
+}
+
+// Primitive type-checks the given value against this property
+// and returns it as a primitive. If the property doesn't declare
+// a type, it is derived from the value.
+func (p *Property) Primitive(value string) (*llx.Primitive, error) {
+	if p.Type == "" {
+		return inferPrimitive(value), nil
+	}
+
+	typ, err := ParsePropertyType(p.Type)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid type for property '"+p.Name+"'")
+	}
+
+	res, err := parsePrimitive(typ, value)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid value for property '"+p.Name+"' of type "+typ.Label())
+	}
+	return res, nil
+}
+
+// Checksum of this property with the given value
+func (p *Property) Checksum(value *llx.Primitive) (string, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return checksums.New.
+		Add(p.Name).
+		Add(value.Type).
+		Add(string(raw)).
+		String(), nil
+}
+
+// addPropsChecksum adds the values of all properties that the code uses to
+// its code ID and checksums. Values are not part of the compiled code, but
+// the same query with different values must not share its datapoints.
+func addPropsChecksum(bundle *llx.CodeBundle, props map[string]*llx.Primitive) error {
+	if len(bundle.Props) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(bundle.Props))
+	for name := range bundle.Props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	c := checksums.New
+	for _, name := range names {
+		value, ok := props[name]
+		if !ok {
+			return errors.New("cannot find value for property '" + name + "'")
+		}
+		prop := &Property{Name: name}
+		checksum, err := prop.Checksum(value)
+		if err != nil {
+			return err
+		}
+		c = c.Add(checksum)
+	}
+	propsChecksum := c.String()
+
+	code := bundle.CodeV2
+	code.Id = checksums.New.Add(code.Id).Add(propsChecksum).String()
+	for ref, checksum := range code.Checksums {
+		code.Checksums[ref] = checksums.New.Add(checksum).Add(propsChecksum).String()
+	}
+	for _, assertion := range code.Assertions {
+		for i := range assertion.Checksums {
+			assertion.Checksums[i] = checksums.New.Add(assertion.Checksums[i]).Add(propsChecksum).String()
+		}
+	}
+	return nil
+}
+
+func inferPrimitive(value string) *llx.Primitive {
+	value = strings.TrimSpace(value)
+	if b, err := strconv.ParseBool(value); err == nil {
+		return llx.BoolPrimitive(b)
+	}
+	if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return llx.IntPrimitive(i)
+	}
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return llx.FloatPrimitive(f)
+	}
+	return llx.StringPrimitive(value)
+}
+
+func parsePrimitive(typ types.Type, value string) (*llx.Primitive, error) {
+	switch typ {
+	case types.String:
+		return llx.StringPrimitive(value), nil
+	case types.Regex:
+		return llx.RegexPrimitive(value), nil
+	}
+
+	if typ.IsArray() || typ.IsMap() {
+		var raw interface{}
+		if err := json.Unmarshal([]byte(value), &raw); err != nil {
+			return nil, errors.New("expected a JSON value, got '" + value + "'")
+		}
+		return jsonPrimitive(typ, raw)
+	}
+
+	value = strings.TrimSpace(value)
+	switch typ {
+	case types.Bool:
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, errors.New("expected a bool, got '" + value + "'")
+		}
+		return llx.BoolPrimitive(v), nil
+	case types.Int:
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, errors.New("expected an int, got '" + value + "'")
+		}
+		return llx.IntPrimitive(v), nil
+	case types.Float:
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, errors.New("expected a float, got '" + value + "'")
+		}
+		return llx.FloatPrimitive(v), nil
+	}
+
+	return nil, errors.New("unsupported property type " + typ.Label())
+}
+
+func jsonPrimitive(typ types.Type, raw interface{}) (*llx.Primitive, error) {
+	switch typ.Underlying() {
+	case types.ArrayLike:
+		arr, ok := raw.([]interface{})
+		if !ok {
+			return nil, errors.New("expected a list")
+		}
+		res := make([]*llx.Primitive, len(arr))
+		for i := range arr {
+			cur, err := jsonPrimitive(typ.Child(), arr[i])
+			if err != nil {
+				return nil, err
+			}
+			res[i] = cur
+		}
+		return llx.ArrayPrimitive(res, typ.Child()), nil
+
+	case types.MapLike:
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("expected a map")
+		}
+		res := make(map[string]*llx.Primitive, len(m))
+		for k, v := range m {
+			cur, err := jsonPrimitive(typ.Child(), v)
+			if err != nil {
+				return nil, err
+			}
+			res[k] = cur
+		}
+		return llx.MapPrimitive(res, typ.Child()), nil
+	}
+
+	switch v := raw.(type) {
+	case string:
+		return parsePrimitive(typ, v)
+	case bool, float64:
+		data, _ := json.Marshal(v)
+		return parsePrimitive(typ, string(data))
+	}
+	return nil, errors.New("expected a " + typ.Label())
+}
+
+// CompileProps type-checks all properties of this query pack and returns
+// their current values, which are the defaults unless they were overridden
+func (p *QueryPack) CompileProps() (map[string]*llx.Primitive, error) {
+	res := make(map[string]*llx.Primitive, len(p.Props))
+	for i := range p.Props {
+		prop := p.Props[i]
+		if prop.Name == "" {
+			return nil, errors.New("property in query pack " + p.Mrn + " has no name")
+		}
+		if _, ok := res[prop.Name]; ok {
+			return nil, errors.New("property '" + prop.Name + "' is defined multiple times in query pack " + p.Mrn)
+		}
+
+		prim, err := prop.Primitive(prop.Default)
+		if err != nil {
+			return nil, err
+		}
+		res[prop.Name] = prim
+	}
+	return res, nil
+}
+
+// ApplyProps overrides the defaults of all properties with the given values.
+// Every override must be declared by at least one query pack in the bundle.
+func (p *Bundle) ApplyProps(props map[string]string) error {
+	if len(props) == 0 {
+		return nil
+	}
+
+	used := make(map[string]struct{}, len(props))
+	for i := range p.Packs {
+		pack := p.Packs[i]
+		for j := range pack.Props {
+			prop := pack.Props[j]
+			value, ok := props[prop.Name]
+			if !ok {
+				continue
+			}
+			if _, err := prop.Primitive(value); err != nil {
+				return err
+			}
+			prop.Default = value
+			used[prop.Name] = struct{}{}
+		}
+	}
+
+	var unknown []string
+	for name := range props {
+		if _, ok := used[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) != 0 {
+		sort.Strings(unknown)
+		return errors.New("properties are not defined by any query pack: " + strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
+
+// ParseProps parses a list of name=value pairs, as they are provided on the CLI
+func ParseProps(entries []string) (map[string]string, error) {
+	res := make(map[string]string, len(entries))
+	for i := range entries {
+		name, value, ok := strings.Cut(entries[i], "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, errors.New("invalid property '" + entries[i] + "', please use name=value")
+		}
+		res[name] = value
+	}
+	return res, nil
+}
+
+// PropsFromFile loads property values from a YAML or JSON file,
+// which contains a map of property names to their values
+func PropsFromFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, errors.Wrap(err, "failed to parse props file "+path)
+	}
+
+	res := make(map[string]string, len(raw))
+	for name, value := range raw {
+		var s string
+		if err := json.Unmarshal(value, &s); err == nil {
+			res[name] = s
+		} else {
+			res[name] = string(value)
+		}
+	}
+	return res, nil
+}
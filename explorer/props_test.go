@@ -0,0 +1,248 @@
+package explorer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/types"
+)
+
+const propsBundle = `
+packs:
+  - uid: props-pack
+    name: Props Pack
+    props:
+      - name: maxUid
+        type: int
+        default: 1000
+        docs:
+          desc: Highest UID for system users
+      - name: admins
+        type: "[]string"
+        default: [root, admin]
+      - name: shell
+        default: /bin/bash
+    queries:
+      - uid: system-users
+        title: System users
+        query: users.where(uid < props.maxUid && shell == props.shell)
+      - uid: admins
+        title: Admins
+        query: users.where(uid == 0).list.map(name).containsOnly(props.admins)
+`
+
+func TestParsePropertyType(t *testing.T) {
+	tests := []struct {
+		label string
+		typ   types.Type
+	}{
+		{"int", types.Int},
+		{"string", types.String},
+		{"[]string", types.Array(types.String)},
+		{"map[string]int", types.Map(types.String, types.Int)},
+		{"[][]bool", types.Array(types.Array(types.Bool))},
+	}
+
+	for i := range tests {
+		typ, err := ParsePropertyType(tests[i].label)
+		require.NoError(t, err, tests[i].label)
+		assert.Equal(t, tests[i].typ, typ, tests[i].label)
+	}
+
+	_, err := ParsePropertyType("user")
+	assert.Error(t, err)
+}
+
+func TestBundleProps(t *testing.T) {
+	t.Run("compile with defaults", func(t *testing.T) {
+		bundle, err := BundleFromYAML([]byte(propsBundle))
+		require.NoError(t, err)
+
+		pack := bundle.Packs[0]
+		require.Len(t, pack.Props, 3)
+		assert.Equal(t, "1000", pack.Props[0].Default)
+		assert.Equal(t, `["root","admin"]`, pack.Props[1].Default)
+		assert.Equal(t, "Highest UID for system users", pack.Props[0].Docs.Desc)
+
+		props, err := pack.CompileProps()
+		require.NoError(t, err)
+		assert.Equal(t, llx.IntPrimitive(1000), props["maxUid"])
+		assert.Equal(t, llx.StringPrimitive("/bin/bash"), props["shell"])
+		assert.Equal(t, llx.ArrayPrimitive([]*llx.Primitive{
+			llx.StringPrimitive("root"),
+			llx.StringPrimitive("admin"),
+		}, types.String), props["admins"])
+
+		bundlemap, err := bundle.Compile(context.Background())
+		require.NoError(t, err)
+		code := bundlemap.Code[pack.Queries[0].Mrn]
+		assert.Equal(t, map[string]string{
+			"maxUid": string(types.Int),
+			"shell":  string(types.String),
+		}, code.Props)
+	})
+
+	t.Run("overrides are type-checked", func(t *testing.T) {
+		bundle, err := BundleFromYAML([]byte(propsBundle))
+		require.NoError(t, err)
+
+		assert.Error(t, bundle.ApplyProps(map[string]string{"maxUid": "many"}))
+		assert.Error(t, bundle.ApplyProps(map[string]string{"unknown": "1"}))
+		assert.Error(t, bundle.ApplyProps(map[string]string{"admins": "root"}))
+
+		require.NoError(t, bundle.ApplyProps(map[string]string{"maxUid": "500", "admins": `["ops"]`}))
+		props, err := bundle.Packs[0].CompileProps()
+		require.NoError(t, err)
+		assert.Equal(t, llx.IntPrimitive(500), props["maxUid"])
+	})
+
+	t.Run("invalid defaults fail to compile", func(t *testing.T) {
+		bundle, err := BundleFromYAML([]byte(propsBundle))
+		require.NoError(t, err)
+		bundle.Packs[0].Props[0].Default = "1.5"
+
+		_, err = bundle.Compile(context.Background())
+		assert.ErrorContains(t, err, "maxUid")
+	})
+
+	t.Run("undeclared props fail to compile", func(t *testing.T) {
+		bundle, err := BundleFromYAML([]byte(propsBundle))
+		require.NoError(t, err)
+		bundle.Packs[0].Props = bundle.Packs[0].Props[1:]
+
+		_, err = bundle.Compile(context.Background())
+		assert.ErrorContains(t, err, "cannot find property 'maxUid'")
+	})
+
+	t.Run("props are part of the execution checksum", func(t *testing.T) {
+		checksum := func(props map[string]string) string {
+			bundle, err := BundleFromYAML([]byte(propsBundle))
+			require.NoError(t, err)
+			require.NoError(t, bundle.ApplyProps(props))
+			_, err = bundle.Compile(context.Background())
+			require.NoError(t, err)
+			require.NoError(t, bundle.Packs[0].UpdateChecksums())
+			return bundle.Packs[0].LocalExecutionChecksum
+		}
+
+		defaults := checksum(nil)
+		assert.NotEmpty(t, defaults)
+		assert.Equal(t, defaults, checksum(map[string]string{"maxUid": "1000"}))
+		assert.NotEqual(t, defaults, checksum(map[string]string{"maxUid": "500"}))
+	})
+}
+
+const sharedQueryBundle = `
+packs:
+  - uid: strict-pack
+    name: Strict Pack
+    props:
+      - name: maxUid
+        type: int
+        default: 100
+    queries:
+      - uid: strict-system-users
+        title: System users
+        query: users.where(uid < props.maxUid)
+  - uid: relaxed-pack
+    name: Relaxed Pack
+    props:
+      - name: maxUid
+        type: int
+        default: 1000
+    queries:
+      - uid: relaxed-system-users
+        title: System users
+        query: users.where(uid < props.maxUid)
+`
+
+func TestSharedQueryProps(t *testing.T) {
+	bundle, err := BundleFromYAML([]byte(sharedQueryBundle))
+	require.NoError(t, err)
+	_, err = bundle.Compile(context.Background())
+	require.NoError(t, err)
+
+	strict, relaxed := bundle.Packs[0].Queries[0], bundle.Packs[1].Queries[0]
+	assert.NotEqual(t, strict.CodeId, relaxed.CodeId)
+
+	job := ExecutionJob{
+		Queries:    map[string]*ExecutionQuery{},
+		Datapoints: map[string]*DataQueryInfo{},
+		Props:      map[string]*llx.Primitive{},
+	}
+	for _, pack := range bundle.Packs {
+		props, err := pack.CompileProps()
+		require.NoError(t, err)
+		equery, err := query2executionQuery(pack.Queries[0], props, job.Props)
+		require.NoError(t, err)
+		assert.Equal(t, pack.Queries[0].CodeId, equery.Code.CodeV2.Id)
+		job.Queries[pack.Queries[0].CodeId] = equery
+		for _, checksum := range equery.Code.DatapointChecksums() {
+			job.Datapoints[checksum] = &DataQueryInfo{}
+		}
+		for _, checksum := range equery.Code.EntrypointChecksums() {
+			job.Datapoints[checksum] = &DataQueryInfo{}
+		}
+	}
+
+	require.Len(t, job.Queries, 2)
+	require.Len(t, job.Props, 2)
+	strictCode := job.Queries[strict.CodeId].Code
+	relaxedCode := job.Queries[relaxed.CodeId].Code
+	assert.Equal(t, llx.IntPrimitive(100), job.Props[job.Queries[strict.CodeId].Properties["maxUid"]])
+	assert.Equal(t, llx.IntPrimitive(1000), job.Props[job.Queries[relaxed.CodeId].Properties["maxUid"]])
+	assert.Len(t, job.Datapoints, len(strictCode.EntrypointChecksums())+len(strictCode.DatapointChecksums())+
+		len(relaxedCode.EntrypointChecksums())+len(relaxedCode.DatapointChecksums()))
+
+	// the same values must result in the same code, e.g. for stored reports
+	code, err := strict.Compile(map[string]*llx.Primitive{"maxUid": llx.IntPrimitive(100)})
+	require.NoError(t, err)
+	assert.Equal(t, strictCode.CodeV2.Id, code.CodeV2.Id)
+	assert.Equal(t, strictCode.EntrypointChecksums(), code.EntrypointChecksums())
+}
+
+func TestPropsFromFile(t *testing.T) {
+	props, err := PropsFromFile("testdata/props.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"maxUid": "500",
+		"admins": `["ops","root"]`,
+		"shell":  "/bin/zsh",
+	}, props)
+
+	props, err = ParseProps([]string{"maxUid=10", "shell=/bin/sh=x"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"maxUid": "10", "shell": "/bin/sh=x"}, props)
+
+	_, err = ParseProps([]string{"maxUid"})
+	assert.Error(t, err)
+}
+
+func TestLintProps(t *testing.T) {
+	results, err := LintBundleYAML(LintOptions{}, "props.mql.yaml", []byte(propsBundle))
+	require.NoError(t, err)
+	assert.False(t, results.HasError(), results.Entries)
+
+	results, err = LintBundleYAML(LintOptions{}, "props.mql.yaml", []byte(`
+packs:
+  - uid: pack
+    name: Pack
+    props:
+      - name: maxUid
+        type: int
+        default: many
+    queries:
+      - uid: query
+        title: Query
+        query: users.where(uid < props.maxUid)
+        docs:
+          desc: All users below the max UID
+`))
+	require.NoError(t, err)
+	require.Len(t, results.Entries, 1)
+	assert.Equal(t, LintRulePropInvalid, results.Entries[0].RuleID)
+	assert.Equal(t, 6, results.Entries[0].Line)
+}
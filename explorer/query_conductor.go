@@ -6,6 +6,8 @@ import (
 	"strings"
 
 	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/checksums"
+	"go.mondoo.com/cnquery/llx"
 	"go.mondoo.com/ranger-rpc/codes"
 	"go.mondoo.com/ranger-rpc/status"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
@@ -127,12 +129,19 @@ func (s *LocalServices) Resolve(ctx context.Context, req *ResolveReq) (*Resolved
 	job := ExecutionJob{
 		Queries:    make(map[string]*ExecutionQuery),
 		Datapoints: make(map[string]*DataQueryInfo),
+		Props:      make(map[string]*llx.Primitive),
 	}
 	for i := range applicablePacks {
 		pack := applicablePacks[i]
+
+		props, err := pack.CompileProps()
+		if err != nil {
+			return nil, err
+		}
+
 		for i := range pack.Queries {
 			query := pack.Queries[i]
-			equery, err := query2executionQuery(query)
+			equery, err := query2executionQuery(query, props, job.Props)
 			if err != nil {
 				return nil, err
 			}
@@ -153,8 +162,11 @@ func (s *LocalServices) Resolve(ctx context.Context, req *ResolveReq) (*Resolved
 		}
 	}
 
+	job.Checksum = job.computeChecksum()
+
 	res := &ResolvedPack{
-		ExecutionJob: &job,
+		ExecutionJob:           &job,
+		GraphExecutionChecksum: job.Checksum,
 	}
 
 	err = s.DataLake.SetResolvedPack(req.EntityMrn, filtersChecksum, res)
@@ -166,17 +178,58 @@ func (s *LocalServices) Resolve(ctx context.Context, req *ResolveReq) (*Resolved
 	return res, err
 }
 
-func query2executionQuery(query *Mquery) (*ExecutionQuery, error) {
-	bundle, err := query.Compile(nil)
+// query2executionQuery compiles the query with the given properties. All
+// properties that the query uses are added to propsValues by their checksum.
+func query2executionQuery(query *Mquery, props map[string]*llx.Primitive, propsValues map[string]*llx.Primitive) (*ExecutionQuery, error) {
+	bundle, err := query.Compile(props)
 	if err != nil {
 		return nil, err
 	}
 
-	return &ExecutionQuery{
+	res := &ExecutionQuery{
 		Query:    query.Query,
 		Checksum: query.Checksum,
 		Code:     bundle,
-	}, nil
+	}
+
+	if len(bundle.Props) != 0 {
+		res.Properties = make(map[string]string, len(bundle.Props))
+		for name := range bundle.Props {
+			prop := &Property{Name: name}
+			checksum, err := prop.Checksum(props[name])
+			if err != nil {
+				return nil, err
+			}
+			res.Properties[name] = checksum
+			propsValues[checksum] = props[name]
+		}
+	}
+
+	return res, nil
+}
+
+// computeChecksum of all queries and property values in this job
+func (job *ExecutionJob) computeChecksum() string {
+	queryIDs := make([]string, 0, len(job.Queries))
+	for id := range job.Queries {
+		queryIDs = append(queryIDs, id)
+	}
+	sort.Strings(queryIDs)
+
+	propIDs := make([]string, 0, len(job.Props))
+	for id := range job.Props {
+		propIDs = append(propIDs, id)
+	}
+	sort.Strings(propIDs)
+
+	c := checksums.New
+	for i := range queryIDs {
+		c = c.Add(queryIDs[i])
+	}
+	for i := range propIDs {
+		c = c.Add(propIDs[i])
+	}
+	return c.String()
 }
 
 // MatchAssetFilters will take the list of filters and only return the ones
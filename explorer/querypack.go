@@ -88,6 +88,36 @@ func (p *QueryPack) UpdateChecksums() error {
 		executionChecksum = executionChecksum.Add(q.CodeId)
 	}
 
+	// PROPERTIES
+	// values are part of the execution, since they change the results (must be sorted)
+	props, err := p.CompileProps()
+	if err != nil {
+		return err
+	}
+	propNames := make([]string, 0, len(props))
+	for name := range props {
+		propNames = append(propNames, name)
+	}
+	sort.Strings(propNames)
+	for i := range p.Props {
+		prop := p.Props[i]
+		contentChecksum = contentChecksum.Add(prop.Name).Add(prop.Type).Add(prop.Default).Add(prop.Title)
+		if prop.Docs != nil {
+			contentChecksum = contentChecksum.Add(prop.Docs.Desc)
+		}
+	}
+	for _, name := range propNames {
+		prop := &Property{Name: name}
+		checksum, err := prop.Checksum(props[name])
+		if err != nil {
+			return err
+		}
+		executionChecksum = executionChecksum.Add(checksum)
+	}
+
+	p.LocalContentChecksum = contentChecksum.String()
+	p.LocalExecutionChecksum = executionChecksum.String()
+
 	return nil
 }
 
@@ -1,6 +1,12 @@
 package explorer
 
-import llx "go.mondoo.com/cnquery/llx"
+import (
+	"encoding/json"
+	"errors"
+
+	llx "go.mondoo.com/cnquery/llx"
+	"google.golang.org/protobuf/encoding/protojson"
+)
 
 func (r *Report) RawResults() map[string]*llx.RawResult {
 	results := map[string]*llx.RawResult{}
@@ -13,3 +19,29 @@ func (r *Report) RawResults() map[string]*llx.RawResult {
 
 	return results
 }
+
+// ToJSON serializes the full report collection including all data types and
+// code bundles, so that it can be loaded again with ReportCollectionFromJSON
+func (r *ReportCollection) ToJSON() ([]byte, error) {
+	return protojson.Marshal(r)
+}
+
+// ReportCollectionFromJSON loads a report collection that was serialized via ToJSON
+func ReportCollectionFromJSON(data []byte) (*ReportCollection, error) {
+	// the json output of scans has the same assets, but only the rendered
+	// data without its types and code, which is lost otherwise
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err == nil {
+		_, hasData := fields["data"]
+		_, hasReports := fields["reports"]
+		if hasData && !hasReports {
+			return nil, errors.New("this is not a full report, but the json output of a scan, create reports with --output report")
+		}
+	}
+
+	var res ReportCollection
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
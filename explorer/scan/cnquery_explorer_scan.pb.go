@@ -27,10 +27,11 @@ type Job struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Inventory        *v1.Inventory    `protobuf:"bytes,1,opt,name=inventory,proto3" json:"inventory,omitempty"`
-	Bundle           *explorer.Bundle `protobuf:"bytes,2,opt,name=bundle,proto3" json:"bundle,omitempty"`
-	DoRecord         bool             `protobuf:"varint,20,opt,name=do_record,json=doRecord,proto3" json:"do_record,omitempty"`
-	QueryPackFilters []string         `protobuf:"bytes,21,rep,name=query_pack_filters,json=queryPackFilters,proto3" json:"query_pack_filters,omitempty"`
+	Inventory        *v1.Inventory     `protobuf:"bytes,1,opt,name=inventory,proto3" json:"inventory,omitempty"`
+	Bundle           *explorer.Bundle  `protobuf:"bytes,2,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	DoRecord         bool              `protobuf:"varint,20,opt,name=do_record,json=doRecord,proto3" json:"do_record,omitempty"`
+	QueryPackFilters []string          `protobuf:"bytes,21,rep,name=query_pack_filters,json=queryPackFilters,proto3" json:"query_pack_filters,omitempty"`
+	Props            map[string]string `protobuf:"bytes,22,rep,name=props,proto3" json:"props,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *Job) Reset() {
@@ -93,6 +94,13 @@ func (x *Job) GetQueryPackFilters() []string {
 	return nil
 }
 
+func (x *Job) GetProps() map[string]string {
+	if x != nil {
+		return x.Props
+	}
+	return nil
+}
+
 var File_cnquery_explorer_scan_proto protoreflect.FileDescriptor
 
 var file_cnquery_explorer_scan_proto_rawDesc = []byte{
@@ -103,7 +111,7 @@ var file_cnquery_explorer_scan_proto_rawDesc = []byte{
 	0x6e, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
 	0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x65, 0x78, 0x70, 0x6c, 0x6f, 0x72,
 	0x65, 0x72, 0x2f, 0x63, 0x6e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x6f,
-	0x72, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc7, 0x01, 0x0a, 0x03, 0x4a, 0x6f,
+	0x72, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbe, 0x02, 0x0a, 0x03, 0x4a, 0x6f,
 	0x62, 0x12, 0x43, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x6d,
 	0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x76,
@@ -116,10 +124,17 @@ var file_cnquery_explorer_scan_proto_rawDesc = []byte{
 	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70,
 	0x61, 0x63, 0x6b, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28,
 	0x09, 0x52, 0x10, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x6f, 0x2e, 0x6d, 0x6f, 0x6e, 0x64, 0x6f, 0x6f,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x65, 0x78, 0x70,
-	0x6c, 0x6f, 0x72, 0x65, 0x72, 0x2f, 0x73, 0x63, 0x61, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x65, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x73, 0x18, 0x16, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x65, 0x78, 0x70,
+	0x6c, 0x6f, 0x72, 0x65, 0x72, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x2e, 0x4a, 0x6f, 0x62, 0x2e, 0x50,
+	0x72, 0x6f, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x73,
+	0x1a, 0x38, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x6f,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x64, 0x6f, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6e, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x2f, 0x65, 0x78, 0x70, 0x6c, 0x6f, 0x72, 0x65, 0x72, 0x2f, 0x73, 0x63, 0x61,
+	0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -134,20 +149,22 @@ func file_cnquery_explorer_scan_proto_rawDescGZIP() []byte {
 	return file_cnquery_explorer_scan_proto_rawDescData
 }
 
-var file_cnquery_explorer_scan_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cnquery_explorer_scan_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_cnquery_explorer_scan_proto_goTypes = []interface{}{
 	(*Job)(nil),             // 0: cnquery.explorer.scan.Job
-	(*v1.Inventory)(nil),    // 1: cnquery.motor.inventory.v1.Inventory
-	(*explorer.Bundle)(nil), // 2: cnquery.explorer.Bundle
+	nil,                     // 1: cnquery.explorer.scan.Job.PropsEntry
+	(*v1.Inventory)(nil),    // 2: cnquery.motor.inventory.v1.Inventory
+	(*explorer.Bundle)(nil), // 3: cnquery.explorer.Bundle
 }
 var file_cnquery_explorer_scan_proto_depIdxs = []int32{
-	1, // 0: cnquery.explorer.scan.Job.inventory:type_name -> cnquery.motor.inventory.v1.Inventory
-	2, // 1: cnquery.explorer.scan.Job.bundle:type_name -> cnquery.explorer.Bundle
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 0: cnquery.explorer.scan.Job.inventory:type_name -> cnquery.motor.inventory.v1.Inventory
+	3, // 1: cnquery.explorer.scan.Job.bundle:type_name -> cnquery.explorer.Bundle
+	1, // 2: cnquery.explorer.scan.Job.props:type_name -> cnquery.explorer.scan.Job.PropsEntry
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_cnquery_explorer_scan_proto_init() }
@@ -175,7 +192,7 @@ func file_cnquery_explorer_scan_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cnquery_explorer_scan_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
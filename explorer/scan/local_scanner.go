@@ -2,6 +2,8 @@ package scan
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"os"
 	"strings"
 	"time"
@@ -14,7 +16,9 @@ import (
 	"go.mondoo.com/cnquery/cli/progress"
 	"go.mondoo.com/cnquery/explorer"
 	"go.mondoo.com/cnquery/explorer/executor"
+	"go.mondoo.com/cnquery/internal/datalakes/boltdb"
 	"go.mondoo.com/cnquery/internal/datalakes/inmemory"
+	"go.mondoo.com/cnquery/internal/fieldcache"
 	"go.mondoo.com/cnquery/llx"
 	"go.mondoo.com/cnquery/logger"
 	"go.mondoo.com/cnquery/motor"
@@ -38,6 +42,15 @@ type LocalScanner struct {
 	apiEndpoint string
 	spaceMrn    string
 	plugins     []ranger.ClientPlugin
+
+	// path to a persistent datalake, in-memory if empty
+	dataLakePath string
+	dataLake     *boltdb.Db
+
+	// path to a persistent field cache, disabled if empty
+	fieldCachePath string
+	fieldCacheTTL  time.Duration
+	fieldCache     *fieldcache.Cache
 }
 
 type ScannerOption func(*LocalScanner)
@@ -50,6 +63,23 @@ func WithUpstream(apiEndpoint string, spaceMrn string, plugins []ranger.ClientPl
 	}
 }
 
+// WithDataLake persists all queries, assets and results in a local datalake
+// at the given path instead of keeping them in memory
+func WithDataLake(path string) func(s *LocalScanner) {
+	return func(s *LocalScanner) {
+		s.dataLakePath = path
+	}
+}
+
+// WithFieldCache reuses resource fields, which were computed in previous
+// scans of the same asset, until they are older than the ttl
+func WithFieldCache(path string, ttl time.Duration) func(s *LocalScanner) {
+	return func(s *LocalScanner) {
+		s.fieldCachePath = path
+		s.fieldCacheTTL = ttl
+	}
+}
+
 func NewLocalScanner(opts ...ScannerOption) *LocalScanner {
 	ls := &LocalScanner{
 		fetcher: newFetcher(),
@@ -139,6 +169,32 @@ func (s *LocalScanner) distributeJob(job *Job, ctx context.Context, upstreamConf
 		return nil, false, errors.New("could not find an asset that we can connect to")
 	}
 
+	if s.dataLakePath != "" {
+		db, _, err := boltdb.NewServices(s.dataLakePath)
+		if err != nil {
+			return nil, false, err
+		}
+		s.dataLake = db
+		defer func() {
+			db.Close()
+			s.dataLake = nil
+		}()
+	}
+
+	if s.fieldCachePath != "" {
+		cache, err := fieldcache.Open(s.fieldCachePath, s.fieldCacheTTL)
+		if err != nil {
+			return nil, false, err
+		}
+		s.fieldCache = cache
+		defer func() {
+			if err := cache.Close(); err != nil {
+				log.Warn().Err(err).Msg("failed to store field cache")
+			}
+			s.fieldCache = nil
+		}()
+	}
+
 	// sync assets
 	if upstreamConfig.ApiEndpoint != "" && !upstreamConfig.Incognito {
 		log.Info().Msg("synchronize assets")
@@ -172,7 +228,13 @@ func (s *LocalScanner) distributeJob(job *Job, ctx context.Context, upstreamConf
 		for i := range assetList {
 			cur := assetList[i]
 			if cur.Mrn == "" && cur.Id == "" {
-				randID := "//" + explorer.SERVICE_NAME + "/" + explorer.MRN_RESOURCE_ASSET + "/" + ksuid.New().String()
+				id := ksuid.New().String()
+				// persisted results need to be found again on the next scan of the same asset
+				if s.dataLake != nil && len(cur.PlatformIds) != 0 {
+					sum := sha256.Sum256([]byte(cur.PlatformIds[0]))
+					id = hex.EncodeToString(sum[:])
+				}
+				randID := "//" + explorer.SERVICE_NAME + "/" + explorer.MRN_RESOURCE_ASSET + "/" + id
 				x, err := mrn.NewMRN(randID)
 				if err != nil {
 					return nil, false, errors.Wrap(err, "failed to generate a random asset MRN")
@@ -189,6 +251,11 @@ func (s *LocalScanner) distributeJob(job *Job, ctx context.Context, upstreamConf
 	if job.Bundle != nil && job.Bundle.FilterQueryPacks(job.QueryPackFilters) {
 		return nil, false, errors.New("all available packs filtered out. nothing to do.")
 	}
+	if job.Bundle != nil {
+		if err := job.Bundle.ApplyProps(job.Props); err != nil {
+			return nil, false, err
+		}
+	}
 
 	for i := range assetList {
 		asset := assetList[i]
@@ -208,6 +275,7 @@ func (s *LocalScanner) distributeJob(job *Job, ctx context.Context, upstreamConf
 			Asset:            asset,
 			Bundle:           job.Bundle,
 			QueryPackFilters: job.QueryPackFilters,
+			Props:            job.Props,
 			Ctx:              ctx,
 			GetCredential:    im.GetCredential,
 			Reporter:         reporter,
@@ -270,7 +338,7 @@ func (s *LocalScanner) runMotorizedAsset(job *AssetJob) (*AssetReport, error) {
 	var res *AssetReport
 	var scanErr error
 
-	runtimeErr := inmemory.WithDb(func(db *inmemory.Db, services *explorer.LocalServices) error {
+	runtimeErr := s.withDb(func(db explorer.DataLake, services *explorer.LocalServices) error {
 		if job.UpstreamConfig.ApiEndpoint != "" && !job.UpstreamConfig.Incognito {
 			log.Debug().Msg("using API endpoint " + s.apiEndpoint)
 			upstream, err := explorer.NewRemoteServices(s.apiEndpoint, s.plugins)
@@ -284,6 +352,9 @@ func (s *LocalScanner) runMotorizedAsset(job *AssetJob) (*AssetReport, error) {
 		schema := registry.Schema()
 		runtime := resources.NewRuntime(registry, job.connection)
 		runtime.UpstreamConfig = &job.UpstreamConfig
+		if s.fieldCache != nil {
+			runtime.FieldCache = s.fieldCache
+		}
 
 		var progressListener progress.Progress
 		if isatty.IsTerminal(os.Stdout.Fd()) {
@@ -312,8 +383,20 @@ func (s *LocalScanner) runMotorizedAsset(job *AssetJob) (*AssetReport, error) {
 	return res, scanErr
 }
 
+// withDb runs the given function with the persistent datalake if one is
+// configured and with a fresh in-memory datalake otherwise
+func (s *LocalScanner) withDb(f func(explorer.DataLake, *explorer.LocalServices) error) error {
+	if s.dataLake != nil {
+		return f(s.dataLake, explorer.NewLocalServices(s.dataLake, ksuid.New().String()))
+	}
+
+	return inmemory.WithDb(func(db *inmemory.Db, services *explorer.LocalServices) error {
+		return f(db, services)
+	})
+}
+
 type localAssetScanner struct {
-	db       *inmemory.Db
+	db       explorer.DataLake
 	services *explorer.LocalServices
 	job      *AssetJob
 	fetcher  *fetcher
@@ -371,6 +454,11 @@ func (s *localAssetScanner) prepareAsset() error {
 		querypackMrns[i] = s.job.Bundle.Packs[i].Mrn
 	}
 
+	// assets in a persistent datalake may still have packs from earlier scans
+	if err = s.unassignStalePacks(querypackMrns); err != nil {
+		return err
+	}
+
 	_, err = conductor.Assign(s.job.Ctx, &explorer.Assignment{
 		AssetMrn: s.job.Asset.Mrn,
 		PackMrns: querypackMrns,
@@ -379,6 +467,36 @@ func (s *localAssetScanner) prepareAsset() error {
 	return err
 }
 
+func (s *localAssetScanner) unassignStalePacks(querypackMrns []string) error {
+	bundle, err := s.db.GetBundle(s.job.Ctx, s.job.Asset.Mrn)
+	if err != nil {
+		// the asset doesn't exist yet
+		return nil
+	}
+
+	current := make(map[string]struct{}, len(querypackMrns))
+	for i := range querypackMrns {
+		current[querypackMrns[i]] = struct{}{}
+	}
+
+	var stale []string
+	for i := range bundle.Packs {
+		if _, ok := current[bundle.Packs[i].Mrn]; !ok {
+			stale = append(stale, bundle.Packs[i].Mrn)
+		}
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+
+	var conductor explorer.QueryConductor = s.services
+	_, err = conductor.Unassign(s.job.Ctx, &explorer.Assignment{
+		AssetMrn: s.job.Asset.Mrn,
+		PackMrns: stale,
+	})
+	return err
+}
+
 var assetDetectBundle = executor.MustCompile("asset { kind platform runtime version family }")
 
 func (s *localAssetScanner) ensureBundle() error {
@@ -434,7 +552,7 @@ func (s *localAssetScanner) ensureBundle() error {
 		return errors.New("all available packs filtered out. nothing to do.")
 	}
 
-	return err
+	return s.job.Bundle.ApplyProps(s.job.Props)
 }
 
 func (s *localAssetScanner) runQueryPack() (*AssetReport, error) {
@@ -31,8 +31,9 @@ func NewAggregateReporter(assetList []*asset.Asset) *AggregateReporter {
 	for i := range assetList {
 		cur := assetList[i]
 		assets[cur.Mrn] = &explorer.Asset{
-			Mrn:  cur.Mrn,
-			Name: cur.Name,
+			Mrn:         cur.Mrn,
+			Name:        cur.Name,
+			PlatformIds: cur.PlatformIds,
 		}
 	}
 
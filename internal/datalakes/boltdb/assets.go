@@ -0,0 +1,75 @@
+package boltdb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog/log"
+	"go.etcd.io/bbolt"
+	"go.mondoo.com/cnquery/explorer"
+)
+
+// EnsureAsset makes sure an asset exists
+func (db *Db) EnsureAsset(ctx context.Context, mrn string) error {
+	return db.db.Update(func(tx *bbolt.Tx) error {
+		_, err := ensureAssetBucket(tx, mrn)
+		return err
+	})
+}
+
+// ensureAssetBucket returns the bucket of an asset and creates it
+// with an empty bundle if it doesn't exist yet
+func ensureAssetBucket(tx *bbolt.Tx, mrn string) (*bbolt.Bucket, error) {
+	assets := tx.Bucket(bucketAsset)
+	if b := assets.Bucket([]byte(mrn)); b != nil {
+		return b, nil
+	}
+
+	log.Debug().Str("mrn", mrn).Msg("assets> create asset")
+	b, err := assets.CreateBucket([]byte(mrn))
+	if err != nil {
+		return nil, errors.New("failed to create asset '" + mrn + "': " + err.Error())
+	}
+
+	err = putProto(b, keyAssetBundle, &explorer.Bundle{OwnerMrn: mrn})
+	if err != nil {
+		return nil, errors.New("failed to create asset '" + mrn + "': " + err.Error())
+	}
+
+	return b, nil
+}
+
+func assetBucket(tx *bbolt.Tx, mrn string) *bbolt.Bucket {
+	return tx.Bucket(bucketAsset).Bucket([]byte(mrn))
+}
+
+func getAssetBundle(tx *bbolt.Tx, mrn string) (*explorer.Bundle, error) {
+	b := assetBucket(tx, mrn)
+	if b == nil {
+		return nil, errors.New("failed to find asset " + mrn)
+	}
+
+	var bundle explorer.Bundle
+	ok, err := getProto(b, keyAssetBundle, &bundle)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("found an asset without a bundle configured in the DB")
+	}
+	return &bundle, nil
+}
+
+func getAssetResolvedPack(tx *bbolt.Tx, mrn string) (*explorer.ResolvedPack, explorer.ResolvedVersion, error) {
+	b := assetBucket(tx, mrn)
+	if b == nil {
+		return nil, "", errors.New("cannot find asset '" + mrn + "'")
+	}
+
+	var resolved explorer.ResolvedPack
+	ok, err := getProto(b, keyAssetResolvedPack, &resolved)
+	if err != nil || !ok {
+		return nil, "", err
+	}
+	return &resolved, explorer.ResolvedVersion(b.Get(keyAssetResolvedVersion)), nil
+}
@@ -0,0 +1,116 @@
+package boltdb
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+	"go.mondoo.com/cnquery/explorer"
+	"google.golang.org/protobuf/proto"
+)
+
+// Db is a file-backed datalake. It persists queries, packs, assets and
+// all of their results across runs in a single embedded key-value file.
+type Db struct {
+	db          *bbolt.DB
+	services    *explorer.LocalServices // bidirectional connection between db + services
+	uuid        string                  // used for all object identifiers to prevent clashes (eg in-memory pubsub)
+	nowProvider func() time.Time
+}
+
+// NewServices opens (or creates) the datalake at the given path and creates
+// a new set of backend services. The caller must close the Db once done.
+func NewServices(path string) (*Db, *explorer.LocalServices, error) {
+	// the file lock ensures that only one process uses the datalake
+	bdb, err := bbolt.Open(path, 0o600, &bbolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to open datalake "+path)
+	}
+
+	err = bdb.Update(func(tx *bbolt.Tx) error {
+		for i := range buckets {
+			if _, err := tx.CreateBucketIfNotExists(buckets[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		bdb.Close()
+		return nil, nil, errors.Wrap(err, "failed to initialize datalake "+path)
+	}
+
+	db := &Db{
+		db:          bdb,
+		uuid:        uuid.New().String(),
+		nowProvider: time.Now,
+	}
+
+	services := explorer.NewLocalServices(db, db.uuid)
+	db.services = services // close the connection between db and services
+
+	return db, services, nil
+}
+
+// WithDb opens the datalake at the given path, creates a new set of backend
+// services and closes everything out once the function is done
+func WithDb(path string, f func(*Db, *explorer.LocalServices) error) error {
+	db, ls, err := NewServices(path)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return f(db, ls)
+}
+
+// Close the underlying datalake file
+func (db *Db) Close() error {
+	return db.db.Close()
+}
+
+func (db *Db) SetNowProvider(f func() time.Time) {
+	db.nowProvider = f
+}
+
+// Buckets for all data that is stored in the datalake.
+// Assets, data and reports use one nested bucket per asset MRN.
+var (
+	bucketQuery        = []byte("queries")
+	bucketQueryPack    = []byte("querypacks")
+	bucketAsset        = []byte("assets")
+	bucketResolvedPack = []byte("resolvedpacks")
+	// filters checksum of every resolved pack
+	bucketResolvedFilters = []byte("resolvedfilters")
+	bucketData            = []byte("data")
+	bucketReports         = []byte("reports")
+
+	buckets = [][]byte{bucketQuery, bucketQueryPack, bucketAsset, bucketResolvedPack, bucketResolvedFilters, bucketData, bucketReports}
+)
+
+// Keys in the nested bucket of every asset
+var (
+	keyAssetBundle          = []byte("bundle")
+	keyAssetResolvedPack    = []byte("resolvedpack")
+	keyAssetResolvedVersion = []byte("resolvedversion")
+)
+
+func getProto(b *bbolt.Bucket, key []byte, msg proto.Message) (bool, error) {
+	if b == nil {
+		return false, nil
+	}
+	raw := b.Get(key)
+	if raw == nil {
+		return false, nil
+	}
+	return true, proto.Unmarshal(raw, msg)
+}
+
+func putProto(b *bbolt.Bucket, key []byte, msg proto.Message) error {
+	raw, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return b.Put(key, raw)
+}
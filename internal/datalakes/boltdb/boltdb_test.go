@@ -0,0 +1,144 @@
+package boltdb
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/llx"
+)
+
+const testBundle = `
+packs:
+  - uid: test-pack
+    name: Test Pack
+    filters:
+      - asset.family.contains("unix")
+    queries:
+      - uid: hostname
+        title: Hostname
+        query: asset.name
+`
+
+const testAsset = "//explorer.api.mondoo.app/assets/test-asset"
+
+func resolveTestAsset(t *testing.T, ctx context.Context, services *explorer.LocalServices) *explorer.ResolvedPack {
+	bundle, err := explorer.BundleFromYAML([]byte(testBundle))
+	require.NoError(t, err)
+
+	_, err = services.SetBundle(ctx, bundle)
+	require.NoError(t, err)
+
+	_, err = services.Assign(ctx, &explorer.Assignment{
+		AssetMrn: testAsset,
+		PackMrns: []string{bundle.Packs[0].Mrn},
+	})
+	require.NoError(t, err)
+
+	filters, err := services.GetFilters(ctx, &explorer.Mrn{Mrn: testAsset})
+	require.NoError(t, err)
+	require.Len(t, filters.Items, 1)
+
+	resolved, err := services.Resolve(ctx, &explorer.ResolveReq{
+		EntityMrn:    testAsset,
+		AssetFilters: filters.Items,
+	})
+	require.NoError(t, err)
+	require.Len(t, resolved.ExecutionJob.Datapoints, 1)
+	return resolved
+}
+
+func TestDb_PersistsReports(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "datalake.db")
+
+	var checksum string
+	for i, name := range []string{"first", "second"} {
+		err := WithDb(path, func(db *Db, services *explorer.LocalServices) error {
+			db.SetNowProvider(func() time.Time { return time.Unix(int64(1000+i), 0) })
+
+			resolved := resolveTestAsset(t, ctx, services)
+			for checksum = range resolved.ExecutionJob.Datapoints {
+			}
+
+			_, err := services.StoreResults(ctx, &explorer.StoreResultsReq{
+				AssetMrn: testAsset,
+				Data: map[string]*llx.Result{
+					checksum: (&llx.RawResult{CodeID: checksum, Data: llx.StringData(name)}).Result(),
+				},
+			})
+			return err
+		})
+		require.NoError(t, err)
+	}
+
+	err := WithDb(path, func(db *Db, services *explorer.LocalServices) error {
+		report, err := services.GetReport(ctx, &explorer.EntityDataRequest{
+			EntityMrn: testAsset,
+			DataMrn:   testAsset,
+		})
+		require.NoError(t, err)
+		require.Contains(t, report.Data, checksum)
+		assert.Equal(t, "second", string(report.Data[checksum].Data.Value))
+
+		history, err := db.ListReports(ctx, testAsset)
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+		assert.Equal(t, int64(1001), history[0].Created)
+		assert.Equal(t, "second", string(history[0].Data[checksum].Data.Value))
+		assert.Equal(t, int64(1000), history[1].Created)
+		assert.Equal(t, "first", string(history[1].Data[checksum].Data.Value))
+
+		assets, err := db.ListReportAssets(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{testAsset}, assets)
+
+		filters, err := db.GetResolvedPackFilters(testAsset)
+		require.NoError(t, err)
+		assert.NotEmpty(t, filters)
+
+		_, err = db.GetResolvedPackFilters("//explorer.api.mondoo.app/assets/unknown")
+		assert.Error(t, err)
+
+		collections, err := db.ReportHistory(ctx, testAsset)
+		require.NoError(t, err)
+		require.Len(t, collections, 2)
+		diff := explorer.DiffReports(collections[1], collections[0])
+		require.Len(t, diff.Assets, 1)
+		assert.Equal(t, explorer.DiffChanged, diff.Assets[0].Status)
+		require.Len(t, diff.Assets[0].Queries, 1)
+		assert.Equal(t, explorer.DiffChanged, diff.Assets[0].Queries[0].Status)
+
+		_, err = db.ReportHistory(ctx, "//explorer.api.mondoo.app/assets/unknown")
+		assert.Error(t, err)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestDb_TypeMismatch(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "datalake.db")
+
+	err := WithDb(path, func(db *Db, services *explorer.LocalServices) error {
+		resolved := resolveTestAsset(t, ctx, services)
+		var checksum string
+		for checksum = range resolved.ExecutionJob.Datapoints {
+		}
+
+		_, err := db.UpdateData(ctx, testAsset, map[string]*llx.Result{
+			checksum: (&llx.RawResult{CodeID: checksum, Data: llx.IntData(1)}).Result(),
+		})
+		assert.ErrorIs(t, err, errTypesDontMatch)
+
+		history, err := db.ListReports(ctx, testAsset)
+		require.NoError(t, err)
+		assert.Empty(t, history)
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,301 @@
+package boltdb
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/rs/zerolog/log"
+	"go.etcd.io/bbolt"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/types"
+	"google.golang.org/protobuf/proto"
+)
+
+func (db *Db) SetResolvedPack(mrn string, filtersChecksum string, resolved *explorer.ResolvedPack) error {
+	return db.db.Update(func(tx *bbolt.Tx) error {
+		if err := putProto(tx.Bucket(bucketResolvedPack), []byte(mrn), resolved); err != nil {
+			return errors.New("failed to save resolved pack '" + mrn + "': " + err.Error())
+		}
+		if err := tx.Bucket(bucketResolvedFilters).Put([]byte(mrn), []byte(filtersChecksum)); err != nil {
+			return errors.New("failed to save filters of resolved pack '" + mrn + "': " + err.Error())
+		}
+		return nil
+	})
+}
+
+// GetResolvedPackFilters returns the checksum of the asset filters
+// that the resolved pack was created for
+func (db *Db) GetResolvedPackFilters(mrn string) (string, error) {
+	var res string
+	err := db.db.View(func(tx *bbolt.Tx) error {
+		raw := tx.Bucket(bucketResolvedFilters).Get([]byte(mrn))
+		if raw == nil {
+			return errors.New("resolved pack '" + mrn + "' not found")
+		}
+		res = string(raw)
+		return nil
+	})
+	return res, err
+}
+
+func (db *Db) SetAssetResolvedPack(ctx context.Context, assetMrn string, resolved *explorer.ResolvedPack, version explorer.ResolvedVersion) error {
+	return db.db.Update(func(tx *bbolt.Tx) error {
+		b := assetBucket(tx, assetMrn)
+		if b == nil {
+			return errors.New("cannot find asset '" + assetMrn + "'")
+		}
+
+		existing, existingVersion, err := getAssetResolvedPack(tx, assetMrn)
+		if err != nil {
+			return err
+		}
+		if existing != nil && existing.GraphExecutionChecksum == resolved.GraphExecutionChecksum && existingVersion == version {
+			log.Debug().
+				Str("asset", assetMrn).
+				Msg("resolverj.db> asset resolved query pack is already cached (and unchanged)")
+			return nil
+		}
+
+		if _, err := tx.Bucket(bucketData).CreateBucketIfNotExists([]byte(assetMrn)); err != nil {
+			log.Error().
+				Err(err).
+				Str("asset", assetMrn).
+				Msg("resolver.db> failed to set asset resolved pack, failed to initialize data")
+			return errors.New("failed to create asset scoring job (failed to init data)")
+		}
+
+		if err := putProto(b, keyAssetResolvedPack, resolved); err != nil {
+			return errors.New("failed to save resolved pack for asset '" + assetMrn + "': " + err.Error())
+		}
+		return b.Put(keyAssetResolvedVersion, []byte(version))
+	})
+}
+
+func (db *Db) GetResolvedPack(mrn string) (*explorer.ResolvedPack, error) {
+	var res explorer.ResolvedPack
+	err := db.db.View(func(tx *bbolt.Tx) error {
+		ok, err := getProto(tx.Bucket(bucketResolvedPack), []byte(mrn), &res)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.New("resolved pack '" + mrn + "' not found")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+var errTypesDontMatch = errors.New("types don't match")
+
+// UpdateData sets the list of data value for a given asset and returns a list of updated IDs.
+// Every update is also recorded as a new report in the history of the asset.
+func (db *Db) UpdateData(ctx context.Context, assetMrn string, data map[string]*llx.Result) (map[string]types.Type, error) {
+	resolved, err := db.GetResolvedPack(assetMrn)
+	if err != nil {
+		return nil, errors.New("cannot find collectorJob to store data: " + err.Error())
+	}
+	executionJob := resolved.ExecutionJob
+
+	res := make(map[string]types.Type, len(data))
+	var errList error
+	err = db.db.Update(func(tx *bbolt.Tx) error {
+		bucket, err := tx.Bucket(bucketData).CreateBucketIfNotExists([]byte(assetMrn))
+		if err != nil {
+			return err
+		}
+
+		for dpChecksum, val := range data {
+			info, ok := executionJob.Datapoints[dpChecksum]
+			if !ok {
+				return errors.New("cannot find this datapoint to store values: " + dpChecksum)
+			}
+
+			if val.Data != nil && !val.Data.IsNil() && val.Data.Type != "" &&
+				val.Data.Type != info.Type && types.Type(info.Type) != types.Unset {
+				log.Warn().
+					Str("checksum", dpChecksum).
+					Str("asset", assetMrn).
+					Interface("data", val.Data).
+					Str("expected", types.Type(info.Type).Label()).
+					Str("received", types.Type(val.Data.Type).Label()).
+					Msg("resolver.db> failed to store data, types don't match")
+
+				errList = multierror.Append(errList, fmt.Errorf("failed to store data for %q, %w: expected %s, got %s",
+					dpChecksum, errTypesDontMatch, types.Type(info.Type).Label(), types.Type(val.Data.Type).Label()))
+
+				continue
+			}
+
+			if err := putProto(bucket, []byte(dpChecksum), val); err != nil {
+				errList = multierror.Append(errList, errors.New("failed to save asset data for asset '"+assetMrn+"' and checksum '"+dpChecksum+"'"))
+				continue
+			}
+
+			// TODO: we don't know which data was updated and which wasn't yet, so
+			// we currently always notify...
+			res[dpChecksum] = types.Type(info.Type)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if errList != nil {
+		return nil, errList
+	}
+
+	if err := db.recordReport(ctx, assetMrn); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// recordReport stores the current report of the asset in its history
+func (db *Db) recordReport(ctx context.Context, assetMrn string) error {
+	report, err := db.GetReport(ctx, assetMrn, assetMrn)
+	if err != nil {
+		return err
+	}
+
+	now := db.nowProvider()
+	report.Created = now.Unix()
+	report.Modified = now.Unix()
+
+	// keys are sorted by time, so the history can be read in order
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(now.UnixNano()))
+
+	return db.db.Update(func(tx *bbolt.Tx) error {
+		bucket, err := tx.Bucket(bucketReports).CreateBucketIfNotExists([]byte(assetMrn))
+		if err != nil {
+			return err
+		}
+		return putProto(bucket, key, report)
+	})
+}
+
+// GetReport retrieves all scores and data for a given asset
+func (db *Db) GetReport(ctx context.Context, assetMrn string, packMrn string) (*explorer.Report, error) {
+	data := map[string]*llx.Result{}
+	err := db.db.View(func(tx *bbolt.Tx) error {
+		resolvedPack, _, err := getAssetResolvedPack(tx, assetMrn)
+		if err != nil {
+			return err
+		}
+		if resolvedPack == nil {
+			return errors.New("cannot find a resolved pack for asset '" + assetMrn + "'")
+		}
+
+		bucket := tx.Bucket(bucketData).Bucket([]byte(assetMrn))
+		for id := range resolvedPack.ExecutionJob.Datapoints {
+			var datum llx.Result
+			ok, err := getProto(bucket, []byte(id), &datum)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+			data[id] = &datum
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &explorer.Report{
+		PackMrn:   packMrn,
+		EntityMrn: assetMrn,
+		Data:      data,
+	}, nil
+}
+
+// ListReports returns all reports that were recorded for the given asset,
+// starting with the most recent one
+func (db *Db) ListReports(ctx context.Context, assetMrn string) ([]*explorer.Report, error) {
+	res := []*explorer.Report{}
+	err := db.db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(bucketReports).Bucket([]byte(assetMrn))
+		if bucket == nil {
+			return nil
+		}
+
+		c := bucket.Cursor()
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			var report explorer.Report
+			if err := proto.Unmarshal(v, &report); err != nil {
+				return err
+			}
+			res = append(res, &report)
+		}
+		return nil
+	})
+	return res, err
+}
+
+// ListReportAssets returns the MRNs of all assets that have reports
+// in their history
+func (db *Db) ListReportAssets(ctx context.Context) ([]string, error) {
+	res := []string{}
+	err := db.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(bucketReports).ForEach(func(k, v []byte) error {
+			// nested buckets have no value
+			if v == nil {
+				res = append(res, string(k))
+			}
+			return nil
+		})
+	})
+	return res, err
+}
+
+// ReportHistory returns one report collection for every report that was
+// recorded for the given asset, starting with the most recent one. All
+// collections use the current bundle and resolved pack of the asset, so
+// they can be compared via explorer.DiffReports.
+func (db *Db) ReportHistory(ctx context.Context, assetMrn string) ([]*explorer.ReportCollection, error) {
+	reports, err := db.ListReports(ctx, assetMrn)
+	if err != nil {
+		return nil, err
+	}
+	if len(reports) == 0 {
+		return nil, errors.New("cannot find any reports for asset '" + assetMrn + "'")
+	}
+
+	var bundle *explorer.Bundle
+	var resolved *explorer.ResolvedPack
+	err = db.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		if bundle, err = getAssetBundle(tx, assetMrn); err != nil {
+			return err
+		}
+		resolved, _, err = getAssetResolvedPack(tx, assetMrn)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	asset := &explorer.Asset{Mrn: assetMrn, Name: assetMrn}
+	res := make([]*explorer.ReportCollection, len(reports))
+	for i := range reports {
+		res[i] = &explorer.ReportCollection{
+			Assets:   map[string]*explorer.Asset{assetMrn: asset},
+			Bundle:   bundle,
+			Reports:  map[string]*explorer.Report{assetMrn: reports[i]},
+			Resolved: map[string]*explorer.ResolvedPack{assetMrn: resolved},
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,217 @@
+package boltdb
+
+import (
+	"context"
+	"errors"
+
+	"go.etcd.io/bbolt"
+	"go.mondoo.com/cnquery/explorer"
+	"go.mondoo.com/cnquery/mrn"
+	"google.golang.org/protobuf/proto"
+)
+
+// QueryExists checks if the given MRN exists
+func (db *Db) QueryExists(ctx context.Context, mrn string) (bool, error) {
+	var res bool
+	err := db.db.View(func(tx *bbolt.Tx) error {
+		res = tx.Bucket(bucketQuery).Get([]byte(mrn)) != nil
+		return nil
+	})
+	return res, err
+}
+
+// GetQuery retrieves a given query
+func (db *Db) GetQuery(ctx context.Context, mrn string) (*explorer.Mquery, error) {
+	var res explorer.Mquery
+	err := db.db.View(func(tx *bbolt.Tx) error {
+		ok, err := getProto(tx.Bucket(bucketQuery), []byte(mrn), &res)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.New("query '" + mrn + "' not found")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// SetQuery stores a given query
+// Note: the query must be defined, it cannot be nil
+func (db *Db) SetQuery(ctx context.Context, mrn string, mquery *explorer.Mquery) error {
+	return db.db.Update(func(tx *bbolt.Tx) error {
+		return setQuery(tx, mrn, mquery)
+	})
+}
+
+func setQuery(tx *bbolt.Tx, mrn string, mquery *explorer.Mquery) error {
+	if err := putProto(tx.Bucket(bucketQuery), []byte(mrn), mquery); err != nil {
+		return errors.New("failed to save query '" + mrn + "': " + err.Error())
+	}
+	return nil
+}
+
+// SetQueryPack stores a given pack in the datalake
+func (db *Db) SetQueryPack(ctx context.Context, obj *explorer.QueryPack, filters []*explorer.Mquery) error {
+	return db.db.Update(func(tx *bbolt.Tx) error {
+		for i := range filters {
+			filter := filters[i]
+			if err := setQuery(tx, filter.Mrn, filter); err != nil {
+				return err
+			}
+		}
+
+		if err := putProto(tx.Bucket(bucketQueryPack), []byte(obj.Mrn), obj); err != nil {
+			return errors.New("failed to save query pack '" + obj.Mrn + "': " + err.Error())
+		}
+		return nil
+	})
+}
+
+// GetQueryPack retrieves the pack
+func (db *Db) GetQueryPack(ctx context.Context, mrn string) (*explorer.QueryPack, error) {
+	var res *explorer.QueryPack
+	err := db.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		res, err = getQueryPack(tx, mrn)
+		return err
+	})
+	return res, err
+}
+
+func getQueryPack(tx *bbolt.Tx, mrn string) (*explorer.QueryPack, error) {
+	var res explorer.QueryPack
+	ok, err := getProto(tx.Bucket(bucketQueryPack), []byte(mrn), &res)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("query pack '" + mrn + "' not found")
+	}
+	return &res, nil
+}
+
+// GetQueryPackFilters retrieves the query pack filters
+func (db *Db) GetQueryPackFilters(ctx context.Context, in string) ([]*explorer.Mquery, error) {
+	// if it's an asset
+	if _, err := mrn.GetResource(in, explorer.MRN_RESOURCE_ASSET); err != nil {
+		return nil, errors.New("can only retrieve query pack filters for assets")
+	}
+
+	bundle, err := db.GetBundle(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return bundle.AssetFilters(), nil
+}
+
+// DeleteQueryPack removes a given mrn
+// Note: the MRN has to be valid
+func (db *Db) DeleteQueryPack(ctx context.Context, mrn string) error {
+	return db.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(bucketQueryPack).Delete([]byte(mrn))
+	})
+}
+
+// ListQueryPacks for a given owner
+// Note: Owner MRN is required
+func (db *Db) ListQueryPacks(ctx context.Context, ownerMrn string, name string) ([]*explorer.QueryPack, error) {
+	res := []*explorer.QueryPack{}
+	err := db.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(bucketQueryPack).ForEach(func(k, v []byte) error {
+			var obj explorer.QueryPack
+			if err := proto.Unmarshal(v, &obj); err != nil {
+				return err
+			}
+
+			if obj.OwnerMrn != ownerMrn {
+				return nil
+			}
+
+			res = append(res, &obj)
+			return nil
+		})
+	})
+	return res, err
+}
+
+// GetBundle retrieves and if necessary updates the pack. Used for assets,
+// which have multiple query packs associated with them.
+func (db *Db) GetBundle(ctx context.Context, mrn string) (*explorer.Bundle, error) {
+	var res *explorer.Bundle
+	err := db.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		res, err = getAssetBundle(tx, mrn)
+		return err
+	})
+	return res, err
+}
+
+// MutateBundle runs the given mutation on a bundle, typically an asset.
+// If it cannot find the owner, it will create it.
+func (db *Db) MutateBundle(ctx context.Context, mutation *explorer.BundleMutationDelta, createIfMissing bool) (*explorer.Bundle, error) {
+	var res *explorer.Bundle
+	err := db.db.Update(func(tx *bbolt.Tx) error {
+		b := assetBucket(tx, mutation.OwnerMrn)
+		if b == nil {
+			if !createIfMissing {
+				return errors.New("failed to find asset " + mutation.OwnerMrn)
+			}
+
+			var err error
+			b, err = ensureAssetBucket(tx, mutation.OwnerMrn)
+			if err != nil {
+				return err
+			}
+		}
+
+		bundle, err := getAssetBundle(tx, mutation.OwnerMrn)
+		if err != nil {
+			return err
+		}
+
+		existing := map[string]*explorer.QueryPack{}
+		for i := range bundle.Packs {
+			cur := bundle.Packs[i]
+			existing[cur.Mrn] = cur
+		}
+
+		for _, delta := range mutation.Deltas {
+			switch delta.Action {
+			case explorer.AssignmentDelta_ADD:
+				pack, err := getQueryPack(tx, delta.Mrn)
+				if err != nil {
+					return errors.New("failed to find query pack for assignment: " + delta.Mrn)
+				}
+
+				existing[delta.Mrn] = pack
+
+			case explorer.AssignmentDelta_DELETE:
+				delete(existing, delta.Mrn)
+
+			default:
+				return errors.New("cannot mutate bundle, the action is unknown")
+			}
+		}
+
+		packs := make([]*explorer.QueryPack, len(existing))
+		i := 0
+		for _, qp := range existing {
+			packs[i] = qp
+			i++
+		}
+
+		bundle.Packs = packs
+		res = bundle
+		return putProto(b, keyAssetBundle, bundle)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
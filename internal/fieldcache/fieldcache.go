@@ -0,0 +1,189 @@
+// Package fieldcache persists computed resource fields in a local file,
+// so that repeated runs against the same asset don't have to call the
+// provider again until the cached values expire.
+package fieldcache
+
+import (
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.etcd.io/bbolt"
+)
+
+var bucketFields = []byte("fields")
+
+// flushBatchSize is the number of new entries that are written at once
+const flushBatchSize = 100
+
+// flushInterval is the longest time that new entries stay in memory only
+var flushInterval = 5 * time.Second
+
+// Cache is a file-backed field cache with a fixed TTL for all entries
+type Cache struct {
+	db          *bbolt.DB
+	ttl         time.Duration
+	lock        sync.Mutex
+	pending     map[string][]byte
+	flushTimer  *time.Timer
+	nowProvider func() time.Time
+}
+
+// Open opens (or creates) the field cache at the given path. Entries
+// expire once they are older than the ttl. New entries are written in
+// batches, the caller must close the cache to write the remaining ones.
+func Open(path string, ttl time.Duration) (*Cache, error) {
+	if ttl <= 0 {
+		return nil, errors.New("field cache ttl must be positive")
+	}
+
+	db, err := bbolt.Open(path, 0o600, &bbolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open field cache "+path)
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(bucketFields)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, errors.Wrap(err, "failed to initialize field cache "+path)
+	}
+
+	return &Cache{
+		db:          db,
+		ttl:         ttl,
+		pending:     map[string][]byte{},
+		nowProvider: time.Now,
+	}, nil
+}
+
+// Load returns the cached field for the key, if it hasn't expired yet
+func (c *Cache) Load(key string) ([]byte, bool) {
+	c.lock.Lock()
+	data, ok := c.pending[key]
+	c.lock.Unlock()
+	if ok {
+		return data, true
+	}
+
+	now := c.nowProvider()
+	err := c.db.View(func(tx *bbolt.Tx) error {
+		raw := tx.Bucket(bucketFields).Get([]byte(key))
+		if raw == nil || isExpired(raw, now) {
+			return nil
+		}
+		// bbolt values are only valid during the transaction
+		data = append([]byte{}, raw[8:]...)
+		ok = true
+		return nil
+	})
+	if err != nil {
+		return nil, false
+	}
+	return data, ok
+}
+
+// Store adds the field to the cache. Entries are written in batches, so
+// that fields don't each need their own transaction. A batch is written
+// once it is full or at the latest after the flush interval.
+func (c *Cache) Store(key string, data []byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.pending[key] = data
+	if len(c.pending) >= flushBatchSize {
+		if err := c.flush(false); err != nil {
+			log.Debug().Err(err).Msg("could not write field cache")
+		}
+		return
+	}
+
+	if c.flushTimer == nil {
+		c.flushTimer = time.AfterFunc(flushInterval, func() {
+			c.lock.Lock()
+			defer c.lock.Unlock()
+			if err := c.flush(false); err != nil {
+				log.Debug().Err(err).Msg("could not write field cache")
+			}
+		})
+	}
+}
+
+// flush writes all pending entries and optionally removes expired ones.
+// The caller must hold the lock, so that entries are available via Load
+// until they are written.
+func (c *Cache) flush(removeExpired bool) error {
+	if c.flushTimer != nil {
+		c.flushTimer.Stop()
+		c.flushTimer = nil
+	}
+	if len(c.pending) == 0 && !removeExpired {
+		return nil
+	}
+
+	now := c.nowProvider()
+	expires := make([]byte, 8)
+	binary.BigEndian.PutUint64(expires, uint64(now.Add(c.ttl).UnixNano()))
+
+	err := c.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(bucketFields)
+
+		if removeExpired {
+			var expired [][]byte
+			err := b.ForEach(func(k, v []byte) error {
+				if isExpired(v, now) {
+					expired = append(expired, append([]byte{}, k...))
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			for i := range expired {
+				if err := b.Delete(expired[i]); err != nil {
+					return err
+				}
+			}
+		}
+
+		for key, data := range c.pending {
+			value := make([]byte, 0, 8+len(data))
+			value = append(value, expires...)
+			value = append(value, data...)
+			if err := b.Put([]byte(key), value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	// entries that could not be written are dropped, they are only a cache
+	c.pending = map[string][]byte{}
+	if err != nil {
+		return errors.Wrap(err, "failed to write field cache")
+	}
+	return nil
+}
+
+// Close writes all new entries, removes expired ones and closes the file
+func (c *Cache) Close() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err := c.flush(true); err != nil {
+		c.db.Close()
+		return err
+	}
+	return c.db.Close()
+}
+
+// isExpired checks the expiry timestamp that prefixes every value
+func isExpired(value []byte, now time.Time) bool {
+	if len(value) < 8 {
+		return true
+	}
+	return int64(binary.BigEndian.Uint64(value[:8])) <= now.UnixNano()
+}
@@ -0,0 +1,120 @@
+package fieldcache
+
+import (
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache_PersistsEntries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fields.db")
+
+	cache, err := Open(path, time.Hour)
+	require.NoError(t, err)
+	cache.Store("key", []byte("value"))
+
+	// pending entries are available before they are written
+	data, ok := cache.Load("key")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("value"), data)
+	require.NoError(t, cache.Close())
+
+	cache, err = Open(path, time.Hour)
+	require.NoError(t, err)
+	defer cache.Close()
+
+	data, ok = cache.Load("key")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("value"), data)
+
+	_, ok = cache.Load("missing")
+	assert.False(t, ok)
+}
+
+func TestCache_Expires(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fields.db")
+
+	cache, err := Open(path, time.Minute)
+	require.NoError(t, err)
+	cache.Store("key", []byte("value"))
+	require.NoError(t, cache.Close())
+
+	cache, err = Open(path, time.Minute)
+	require.NoError(t, err)
+	cache.nowProvider = func() time.Time { return time.Now().Add(2 * time.Minute) }
+
+	_, ok := cache.Load("key")
+	assert.False(t, ok)
+
+	// closing removes expired entries
+	require.NoError(t, cache.Close())
+	cache, err = Open(path, time.Minute)
+	require.NoError(t, err)
+	defer cache.Close()
+	_, ok = cache.Load("key")
+	assert.False(t, ok)
+}
+
+func TestOpen_InvalidTTL(t *testing.T) {
+	_, err := Open(filepath.Join(t.TempDir(), "fields.db"), 0)
+	assert.Error(t, err)
+}
+
+// abandon closes the file without writing pending entries, like a process
+// that is killed before it closes the cache
+func abandon(t *testing.T, cache *Cache) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	require.NoError(t, cache.db.Close())
+}
+
+func TestCache_FlushesBatches(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fields.db")
+
+	cache, err := Open(path, time.Hour)
+	require.NoError(t, err)
+	for i := 0; i < flushBatchSize+1; i++ {
+		cache.Store("key"+strconv.Itoa(i), []byte("value"))
+	}
+	abandon(t, cache)
+
+	cache, err = Open(path, time.Hour)
+	require.NoError(t, err)
+	defer cache.Close()
+
+	for i := 0; i < flushBatchSize; i++ {
+		_, ok := cache.Load("key" + strconv.Itoa(i))
+		assert.True(t, ok)
+	}
+	// the last entry didn't fill a batch
+	_, ok := cache.Load("key" + strconv.Itoa(flushBatchSize))
+	assert.False(t, ok)
+}
+
+func TestCache_FlushesAfterInterval(t *testing.T) {
+	defer func(d time.Duration) { flushInterval = d }(flushInterval)
+	flushInterval = 10 * time.Millisecond
+	path := filepath.Join(t.TempDir(), "fields.db")
+
+	cache, err := Open(path, time.Hour)
+	require.NoError(t, err)
+	cache.Store("key", []byte("value"))
+	require.Eventually(t, func() bool {
+		cache.lock.Lock()
+		defer cache.lock.Unlock()
+		return len(cache.pending) == 0
+	}, time.Second, 5*time.Millisecond)
+	abandon(t, cache)
+
+	cache, err = Open(path, time.Hour)
+	require.NoError(t, err)
+	defer cache.Close()
+
+	data, ok := cache.Load("key")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("value"), data)
+}
@@ -303,14 +303,25 @@ func init() {
 			string("contains" + types.Array(types.String)): {f: stringContainsArrayStringV2, Label: "contains"},
 			string("contains" + types.Int):                 {f: stringContainsIntV2, Label: "contains"},
 			string("contains" + types.Array(types.Int)):    {f: stringContainsArrayIntV2, Label: "contains"},
-			string("find"):      {f: stringFindV2, Label: "find"},
-			string("camelcase"): {f: stringCamelcaseV2, Label: "camelcase"},
-			string("downcase"):  {f: stringDowncaseV2, Label: "downcase"},
-			string("upcase"):    {f: stringUpcaseV2, Label: "upcase"},
-			string("length"):    {f: stringLengthV2, Label: "length"},
-			string("lines"):     {f: stringLinesV2, Label: "lines"},
-			string("split"):     {f: stringSplitV2, Label: "split"},
-			string("trim"):      {f: stringTrimV2, Label: "trim"},
+			string("find"):         {f: stringFindV2, Label: "find"},
+			string("camelcase"):    {f: stringCamelcaseV2, Label: "camelcase"},
+			string("downcase"):     {f: stringDowncaseV2, Label: "downcase"},
+			string("upcase"):       {f: stringUpcaseV2, Label: "upcase"},
+			string("length"):       {f: stringLengthV2, Label: "length"},
+			string("lines"):        {f: stringLinesV2, Label: "lines"},
+			string("split"):        {f: stringSplitV2, Label: "split"},
+			string("trim"):         {f: stringTrimV2, Label: "trim"},
+			string("replace"):      {f: stringReplaceV2, Label: "replace"},
+			string("startsWith"):   {f: stringStartsWithV2, Label: "startsWith"},
+			string("endsWith"):     {f: stringEndsWithV2, Label: "endsWith"},
+			string("trimPrefix"):   {f: stringTrimPrefixV2, Label: "trimPrefix"},
+			string("trimSuffix"):   {f: stringTrimSuffixV2, Label: "trimSuffix"},
+			string("substring"):    {f: stringSubstringV2, Label: "substring"},
+			string("capture"):      {f: stringCaptureV2, Label: "capture"},
+			string("format"):       {f: stringFormatV2, Label: "format"},
+			string("decodeBase64"): {f: stringDecodeBase64V2, Label: "decodeBase64"},
+			string("decodeHex"):    {f: stringDecodeHexV2, Label: "decodeHex"},
+			string("sha256"):       {f: stringSha256V2, Label: "sha256"},
 		},
 		types.Regex: {
 			// == / !=
@@ -475,6 +486,17 @@ func init() {
 			"lines":                           {f: dictLinesV2, Label: "lines"},
 			"split":                           {f: dictSplitV2, Label: "split"},
 			"trim":                            {f: dictTrimV2, Label: "trim"},
+			"replace":                         {f: dictReplaceV2, Label: "replace"},
+			"startsWith":                      {f: dictStartsWithV2, Label: "startsWith"},
+			"endsWith":                        {f: dictEndsWithV2, Label: "endsWith"},
+			"trimPrefix":                      {f: dictTrimPrefixV2, Label: "trimPrefix"},
+			"trimSuffix":                      {f: dictTrimSuffixV2, Label: "trimSuffix"},
+			"substring":                       {f: dictSubstringV2, Label: "substring"},
+			"capture":                         {f: dictCaptureV2, Label: "capture"},
+			"format":                          {f: dictFormatV2, Label: "format"},
+			"decodeBase64":                    {f: dictDecodeBase64V2, Label: "decodeBase64"},
+			"decodeHex":                       {f: dictDecodeHexV2, Label: "decodeHex"},
+			"sha256":                          {f: dictSha256V2, Label: "sha256"},
 			"keys":                            {f: dictKeysV2, Label: "keys"},
 			"values":                          {f: dictValuesV2, Label: "values"},
 			"where":                           {f: dictWhereV2, Label: "where"},
@@ -512,6 +534,16 @@ func init() {
 			"unique":                 {f: arrayUniqueV2},
 			"difference":             {f: arrayDifferenceV2},
 			"containsNone":           {f: arrayContainsNoneV2},
+			"sort":                   {f: arraySortV2},
+			"sortBy":                 {f: arraySortByV2},
+			"flat":                   {f: arrayFlatV2},
+			"groupBy":                {f: arrayGroupByV2},
+			"sum":                    {f: arraySumV2},
+			"min":                    {f: arrayMinV2},
+			"max":                    {f: arrayMaxV2},
+			"avg":                    {f: arrayAvgV2},
+			"take":                   {f: arrayTakeV2},
+			"skip":                   {f: arraySkipV2},
 			"==":                     {Compiler: compileArrayOpArray("=="), f: tarrayCmpTarrayV2, Label: "=="},
 			"!=":                     {Compiler: compileArrayOpArray("!="), f: tarrayNotTarrayV2, Label: "!="},
 			"==" + string(types.Nil): {f: arrayCmpNilV2},
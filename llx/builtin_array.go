@@ -2,6 +2,7 @@ package llx
 
 import (
 	"errors"
+	"sort"
 	"strconv"
 
 	"github.com/hashicorp/go-multierror"
@@ -755,6 +756,347 @@ func arrayContainsNoneV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint
 	return &RawData{Type: bind.Type, Value: res}, 0, nil
 }
 
+// arrayBlockKeys runs the function block of a chunk for every entry in an array
+// and calls onComplete with the entrypoint value of every entry. It is used
+// by all functions which need a value per entry, e.g. sortBy or groupBy.
+func arrayBlockKeys(e *blockExecutor, chunk *Chunk, ref uint64, name string,
+	onComplete func(items *RawData, keys []*RawData) *RawData,
+) (*RawData, uint64, error) {
+	itemsRef := chunk.Function.Args[0]
+	items, rref, err := e.resolveValue(itemsRef, ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	if items.Value == nil {
+		return &RawData{Type: types.Type(chunk.Function.Type), Error: items.Error}, 0, nil
+	}
+
+	list := items.Value.([]interface{})
+	if len(list) == 0 {
+		return onComplete(items, nil), 0, nil
+	}
+
+	arg1 := chunk.Function.Args[1]
+	fref, ok := arg1.RefV2()
+	if !ok {
+		return nil, 0, errors.New("Failed to retrieve function reference of '" + name + "' call")
+	}
+
+	dref, err := e.ensureArgsResolved(chunk.Function.Args[2:], ref)
+	if dref != 0 || err != nil {
+		return nil, dref, err
+	}
+
+	ct := items.Type.Child()
+
+	argsList := make([][]*RawData, len(list))
+	for i := range list {
+		argsList[i] = []*RawData{
+			{
+				Type:  ct,
+				Value: list[i],
+			},
+		}
+	}
+
+	err = e.runFunctionBlocks(argsList, fref, func(results []arrayBlockCallResult, errs []error) {
+		f := e.ctx.code.Block(fref)
+		epChecksum := e.ctx.code.Checksums[f.Entrypoints[0]]
+
+		var data *RawData
+		keys := make([]*RawData, len(results))
+		for i, res := range results {
+			epVal, ok := res.entrypoints[epChecksum].(*RawData)
+			if !ok {
+				data = &RawData{Type: types.Type(chunk.Function.Type), Error: errors.New("failed to get the value for '" + name + "' of array entry " + strconv.Itoa(i))}
+				break
+			}
+			if epVal.Error != nil {
+				data = &RawData{Type: types.Type(chunk.Function.Type), Error: epVal.Error}
+				break
+			}
+			keys[i] = epVal
+		}
+
+		if data == nil {
+			data = onComplete(items, keys)
+		}
+
+		e.cache.Store(ref, &stepCache{
+			Result:   data,
+			IsStatic: false,
+		})
+		e.triggerChain(ref, data)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return nil, 0, nil
+}
+
+func arraySortV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type, Error: bind.Error}, 0, nil
+	}
+
+	ct := bind.Type.Child()
+	lessFunc, ok := types.Less[ct]
+	if !ok {
+		return nil, 0, errors.New("cannot sort array entries of type " + ct.Label())
+	}
+
+	list := bind.Value.([]interface{})
+	res := make([]interface{}, len(list))
+	copy(res, list)
+	sort.SliceStable(res, func(i, j int) bool {
+		return lessNil(res[i], res[j], lessFunc)
+	})
+
+	return &RawData{Type: bind.Type, Value: res}, 0, nil
+}
+
+func arraySortByV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return arrayBlockKeys(e, chunk, ref, "sortBy", func(items *RawData, keys []*RawData) *RawData {
+		list := items.Value.([]interface{})
+		if len(list) == 0 {
+			return items
+		}
+
+		kt := keys[0].Type
+		lessFunc, ok := types.Less[kt]
+		if !ok {
+			return &RawData{Type: items.Type, Error: errors.New("cannot sort array by values of type " + kt.Label())}
+		}
+
+		idx := make([]int, len(list))
+		for i := range idx {
+			idx[i] = i
+		}
+		sort.SliceStable(idx, func(i, j int) bool {
+			return lessNil(keys[idx[i]].Value, keys[idx[j]].Value, lessFunc)
+		})
+
+		res := make([]interface{}, len(list))
+		for i := range idx {
+			res[i] = list[idx[i]]
+		}
+		return &RawData{Type: items.Type, Value: res}
+	})
+}
+
+// lessNil sorts nil values before all others
+func lessNil(left interface{}, right interface{}, lessFunc func(interface{}, interface{}) bool) bool {
+	if left == nil || right == nil {
+		return left == nil && right != nil
+	}
+	return lessFunc(left, right)
+}
+
+func arrayFlatV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	typ := types.Type(chunk.Function.Type)
+	if bind.Value == nil {
+		return &RawData{Type: typ, Error: bind.Error}, 0, nil
+	}
+
+	list := bind.Value.([]interface{})
+	res := []interface{}{}
+	for i := range list {
+		// dicts may contain lists and other values
+		if child, ok := list[i].([]interface{}); ok {
+			res = append(res, child...)
+		} else if list[i] != nil {
+			res = append(res, list[i])
+		}
+	}
+
+	return &RawData{Type: typ, Value: res}, 0, nil
+}
+
+func arrayGroupByV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return arrayBlockKeys(e, chunk, ref, "groupBy", func(items *RawData, keys []*RawData) *RawData {
+		typ := types.Map(types.String, items.Type)
+		list := items.Value.([]interface{})
+
+		res := map[string]interface{}{}
+		for i := range list {
+			key, err := groupKey(keys[i])
+			if err != nil {
+				return &RawData{Type: typ, Error: err}
+			}
+
+			group, _ := res[key].([]interface{})
+			res[key] = append(group, list[i])
+		}
+
+		return &RawData{Type: typ, Value: res}
+	})
+}
+
+func groupKey(key *RawData) (string, error) {
+	switch x := key.Value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return x, nil
+	case int64:
+		return strconv.FormatInt(x, 10), nil
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(x), nil
+	default:
+		return "", errors.New("cannot group array by values of type " + key.Type.Label())
+	}
+}
+
+// arrayNumbers converts all entries of an int, float or dict array into
+// floats. Entries that are nil are skipped.
+func arrayNumbers(bind *RawData) ([]float64, error) {
+	list := bind.Value.([]interface{})
+	res := make([]float64, 0, len(list))
+	for i := range list {
+		switch x := list[i].(type) {
+		case nil:
+		case int64:
+			res = append(res, float64(x))
+		case float64:
+			res = append(res, x)
+		default:
+			return nil, errors.New("cannot calculate with non-numeric array entry " + strconv.Itoa(i))
+		}
+	}
+	return res, nil
+}
+
+func arraySumV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	typ := types.Type(chunk.Function.Type)
+	if bind.Value == nil {
+		return &RawData{Type: typ, Error: bind.Error}, 0, nil
+	}
+
+	// ints stay ints, so that large values don't lose precision
+	if typ == types.Int {
+		var sum int64
+		list := bind.Value.([]interface{})
+		for i := range list {
+			if v, ok := list[i].(int64); ok {
+				sum += v
+			}
+		}
+		return IntData(sum), 0, nil
+	}
+
+	nums, err := arrayNumbers(bind)
+	if err != nil {
+		return nil, 0, err
+	}
+	var sum float64
+	for i := range nums {
+		sum += nums[i]
+	}
+	return FloatData(sum), 0, nil
+}
+
+func arrayAvgV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.Float, Error: bind.Error}, 0, nil
+	}
+
+	nums, err := arrayNumbers(bind)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(nums) == 0 {
+		return &RawData{Type: types.Float}, 0, nil
+	}
+
+	var sum float64
+	for i := range nums {
+		sum += nums[i]
+	}
+	return FloatData(sum / float64(len(nums))), 0, nil
+}
+
+func _arrayMinMaxV2(bind *RawData, chunk *Chunk, isMax bool) (*RawData, uint64, error) {
+	typ := types.Type(chunk.Function.Type)
+	if bind.Value == nil {
+		return &RawData{Type: typ, Error: bind.Error}, 0, nil
+	}
+
+	ct := bind.Type.Child()
+	lessFunc, ok := types.Less[ct]
+	if !ok {
+		return nil, 0, errors.New("cannot compare array entries of type " + ct.Label())
+	}
+
+	var res interface{}
+	list := bind.Value.([]interface{})
+	for i := range list {
+		if list[i] == nil {
+			continue
+		}
+		if res == nil || (isMax && lessFunc(res, list[i])) || (!isMax && lessFunc(list[i], res)) {
+			res = list[i]
+		}
+	}
+
+	return &RawData{Type: typ, Value: res}, 0, nil
+}
+
+func arrayMinV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return _arrayMinMaxV2(bind, chunk, false)
+}
+
+func arrayMaxV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return _arrayMinMaxV2(bind, chunk, true)
+}
+
+func _arrayTakeSkipV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64, name string, take bool) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type, Error: bind.Error}, 0, nil
+	}
+
+	args := chunk.Function.Args
+	if len(args) != 1 {
+		return nil, 0, errors.New("called `" + name + "` with " + strconv.Itoa(len(args)) + " arguments, only 1 supported")
+	}
+
+	arg, rref, err := e.resolveValue(args[0], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+	if arg.Type != types.Int {
+		return nil, 0, errors.New("called `" + name + "` with wrong type (got: " + arg.Type.Label() + ", expected: int)")
+	}
+	n, _ := arg.Value.(int64)
+	if n < 0 {
+		return nil, 0, errors.New("called `" + name + "` with a negative number")
+	}
+
+	list := bind.Value.([]interface{})
+	if n > int64(len(list)) {
+		n = int64(len(list))
+	}
+
+	var res []interface{}
+	if take {
+		res = list[:n]
+	} else {
+		res = list[n:]
+	}
+	return &RawData{Type: bind.Type, Value: res}, 0, nil
+}
+
+func arrayTakeV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return _arrayTakeSkipV2(e, bind, chunk, ref, "take", true)
+}
+
+func arraySkipV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	return _arrayTakeSkipV2(e, bind, chunk, ref, "skip", false)
+}
+
 func compileArrayOpArray(op string) func(types.Type, types.Type) (string, error) {
 	return func(left types.Type, right types.Type) (string, error) {
 		name := string(left.Child()) + op + string(right)
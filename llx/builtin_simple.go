@@ -1,7 +1,11 @@
 package llx
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"io"
 	"regexp"
 	"strconv"
@@ -2221,6 +2225,259 @@ func stringTrimV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*R
 	return StringData(res), 0, nil
 }
 
+// resolveStringArgV2 resolves the string argument at idx of a function call.
+// ok is false if the argument is null.
+func resolveStringArgV2(e *blockExecutor, chunk *Chunk, ref uint64, idx int) (string, bool, uint64, error) {
+	arg, rref, err := e.resolveValue(chunk.Function.Args[idx], ref)
+	if err != nil || rref > 0 {
+		return "", false, rref, err
+	}
+	if arg.Value == nil {
+		return "", false, 0, nil
+	}
+	s, ok := arg.Value.(string)
+	if !ok {
+		return "", false, 0, errors.New("expected a string argument, got " + arg.Type.Label())
+	}
+	return s, true, 0, nil
+}
+
+func stringReplaceV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type}, 0, nil
+	}
+
+	arg, rref, err := e.resolveValue(chunk.Function.Args[0], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	replacement, ok, rref, err := resolveStringArgV2(e, chunk, ref, 1)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	if arg.Value == nil || !ok {
+		return &RawData{
+			Type:  bind.Type,
+			Error: errors.New("failed to replace in string, arguments cannot be null"),
+		}, 0, nil
+	}
+
+	s := bind.Value.(string)
+	search, ok := arg.Value.(string)
+	if !ok {
+		return nil, 0, errors.New("cannot replace " + arg.Type.Label() + " in string, only strings and regular expressions are supported")
+	}
+
+	if arg.Type == types.Regex {
+		re, err := regexp.Compile(search)
+		if err != nil {
+			return nil, 0, errors.New("Failed to compile regular expression: " + search)
+		}
+		return StringData(re.ReplaceAllString(s, replacement)), 0, nil
+	}
+	return StringData(strings.ReplaceAll(s, search, replacement)), 0, nil
+}
+
+func stringStartsWithV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return BoolFalse, 0, nil
+	}
+
+	prefix, ok, rref, err := resolveStringArgV2(e, chunk, ref, 0)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+	if !ok {
+		return BoolFalse, 0, nil
+	}
+
+	return BoolData(strings.HasPrefix(bind.Value.(string), prefix)), 0, nil
+}
+
+func stringEndsWithV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return BoolFalse, 0, nil
+	}
+
+	suffix, ok, rref, err := resolveStringArgV2(e, chunk, ref, 0)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+	if !ok {
+		return BoolFalse, 0, nil
+	}
+
+	return BoolData(strings.HasSuffix(bind.Value.(string), suffix)), 0, nil
+}
+
+func stringTrimPrefixV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type}, 0, nil
+	}
+
+	prefix, ok, rref, err := resolveStringArgV2(e, chunk, ref, 0)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+	if !ok {
+		return &RawData{
+			Type:  bind.Type,
+			Error: errors.New("failed to trim string, prefix was null"),
+		}, 0, nil
+	}
+
+	return StringData(strings.TrimPrefix(bind.Value.(string), prefix)), 0, nil
+}
+
+func stringTrimSuffixV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type}, 0, nil
+	}
+
+	suffix, ok, rref, err := resolveStringArgV2(e, chunk, ref, 0)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+	if !ok {
+		return &RawData{
+			Type:  bind.Type,
+			Error: errors.New("failed to trim string, suffix was null"),
+		}, 0, nil
+	}
+
+	return StringData(strings.TrimSuffix(bind.Value.(string), suffix)), 0, nil
+}
+
+// stringSubstringV2 returns the characters from start up to (excluding) end.
+// The end defaults to the end of the string and is capped to its length.
+func stringSubstringV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type}, 0, nil
+	}
+
+	runes := []rune(bind.Value.(string))
+	bounds := []int64{0, int64(len(runes))}
+	for i := range chunk.Function.Args {
+		arg, rref, err := e.resolveValue(chunk.Function.Args[i], ref)
+		if err != nil || rref > 0 {
+			return nil, rref, err
+		}
+		if arg.Value == nil {
+			return &RawData{
+				Type:  bind.Type,
+				Error: errors.New("failed to get substring, index was null"),
+			}, 0, nil
+		}
+		bounds[i] = arg.Value.(int64)
+	}
+
+	start, end := bounds[0], bounds[1]
+	if end > int64(len(runes)) {
+		end = int64(len(runes))
+	}
+	if start < 0 || start > end {
+		return &RawData{
+			Type:  bind.Type,
+			Error: errors.New("failed to get substring, index " + strconv.FormatInt(start, 10) + " is out of range"),
+		}, 0, nil
+	}
+
+	return StringData(string(runes[start:end])), 0, nil
+}
+
+// stringCaptureV2 matches a regular expression against the string and
+// returns all named capture groups of the first match
+func stringCaptureV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: types.Map(types.String, types.String)}, 0, nil
+	}
+
+	reContent, ok, rref, err := resolveStringArgV2(e, chunk, ref, 0)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+	if !ok {
+		return &RawData{Type: types.Map(types.String, types.String)}, 0, nil
+	}
+
+	re, err := regexp.Compile(reContent)
+	if err != nil {
+		return nil, 0, errors.New("Failed to compile regular expression: " + reContent)
+	}
+
+	res := map[string]interface{}{}
+	match := re.FindStringSubmatch(bind.Value.(string))
+	if match != nil {
+		for i, name := range re.SubexpNames() {
+			if name != "" {
+				res[name] = match[i]
+			}
+		}
+	}
+
+	return MapData(res, types.String), 0, nil
+}
+
+// stringFormatV2 uses the string as a format for all arguments,
+// e.g. 'port %d'.format(22)
+func stringFormatV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type}, 0, nil
+	}
+
+	args := make([]interface{}, len(chunk.Function.Args))
+	for i := range chunk.Function.Args {
+		arg, rref, err := e.resolveValue(chunk.Function.Args[i], ref)
+		if err != nil || rref > 0 {
+			return nil, rref, err
+		}
+		args[i] = arg.Value
+	}
+
+	return StringData(fmt.Sprintf(bind.Value.(string), args...)), 0, nil
+}
+
+func stringDecodeBase64V2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type}, 0, nil
+	}
+
+	s := strings.TrimSpace(bind.Value.(string))
+	enc := base64.StdEncoding
+	if !strings.HasSuffix(s, "=") && len(s)%4 != 0 {
+		enc = base64.RawStdEncoding
+	}
+
+	res, err := enc.DecodeString(s)
+	if err != nil {
+		return &RawData{Type: bind.Type, Error: errors.New("failed to decode base64: " + err.Error())}, 0, nil
+	}
+	return StringData(string(res)), 0, nil
+}
+
+func stringDecodeHexV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type}, 0, nil
+	}
+
+	res, err := hex.DecodeString(strings.TrimSpace(bind.Value.(string)))
+	if err != nil {
+		return &RawData{Type: bind.Type, Error: errors.New("failed to decode hex: " + err.Error())}, 0, nil
+	}
+	return StringData(string(res)), 0, nil
+}
+
+func stringSha256V2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	if bind.Value == nil {
+		return &RawData{Type: bind.Type}, 0, nil
+	}
+
+	hash := sha256.Sum256([]byte(bind.Value.(string)))
+	return StringData(hex.EncodeToString(hash[:])), 0, nil
+}
+
 // time methods
 
 // zeroTimeOffset to help convert unix times into base times that start at the year 0
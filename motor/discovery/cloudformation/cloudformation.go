@@ -0,0 +1,62 @@
+package cloudformation
+
+import (
+	"context"
+	"path/filepath"
+
+	"go.mondoo.com/cnquery/motor/asset"
+	"go.mondoo.com/cnquery/motor/discovery/common"
+	"go.mondoo.com/cnquery/motor/motorid"
+	"go.mondoo.com/cnquery/motor/providers"
+	"go.mondoo.com/cnquery/motor/providers/resolver"
+)
+
+type Resolver struct{}
+
+func (r *Resolver) Name() string {
+	return "CloudFormation Static Analysis Resolver"
+}
+
+func (r *Resolver) AvailableDiscoveryTargets() []string {
+	return []string{common.DiscoveryAuto, common.DiscoveryAll}
+}
+
+func (r *Resolver) Resolve(ctx context.Context, root *asset.Asset, tc *providers.Config, cfn common.CredentialFn, sfn common.QuerySecretFn, userIdDetectors ...providers.PlatformIdDetector) ([]*asset.Asset, error) {
+	assetObj := &asset.Asset{
+		Name:        root.Name,
+		Connections: []*providers.Config{tc},
+		State:       asset.State_STATE_ONLINE,
+		Labels:      map[string]string{},
+	}
+
+	path := tc.Options["path"]
+	absPath, _ := filepath.Abs(path)
+	assetObj.Labels["path"] = absPath
+
+	if assetObj.Name == "" {
+		// a directory often has multiple templates, therefore the file names the asset
+		assetObj.Name = "CloudFormation Static Analysis " + filepath.Base(absPath)
+	}
+
+	m, err := resolver.NewMotorConnection(ctx, tc, cfn)
+	if err != nil {
+		return nil, err
+	}
+	defer m.Close()
+
+	p, err := m.Platform()
+	if err == nil {
+		assetObj.Platform = p
+	}
+
+	fingerprint, err := motorid.IdentifyPlatform(m.Provider, p, userIdDetectors)
+	if err != nil {
+		return nil, err
+	}
+	assetObj.PlatformIds = fingerprint.PlatformIDs
+	if fingerprint.Name != "" {
+		assetObj.Name = fingerprint.Name
+	}
+
+	return []*asset.Asset{assetObj}, nil
+}
@@ -0,0 +1,179 @@
+package container_archive
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/motor/asset"
+	"go.mondoo.com/cnquery/motor/discovery/common"
+	"go.mondoo.com/cnquery/motor/motorid/containerid"
+	"go.mondoo.com/cnquery/motor/platform"
+	"go.mondoo.com/cnquery/motor/providers"
+	"go.mondoo.com/cnquery/motor/providers/container/image"
+	"go.mondoo.com/cnquery/motor/providers/tar"
+)
+
+const (
+	// OCIDirPrefix references an OCI image layout directory, e.g. oci-dir:/path/to/layout
+	OCIDirPrefix = "oci-dir:"
+	// DockerArchivePrefix references a `docker save` archive, e.g. docker-archive:/path/to/image.tar
+	DockerArchivePrefix = "docker-archive:"
+)
+
+// IsArchiveReference checks if the reference points to an OCI image layout or a docker archive
+func IsArchiveReference(ref string) bool {
+	return strings.HasPrefix(ref, OCIDirPrefix) || strings.HasPrefix(ref, DockerArchivePrefix)
+}
+
+type Resolver struct{}
+
+func (r *Resolver) Name() string {
+	return "Container Archive Discover"
+}
+
+func (r *Resolver) AvailableDiscoveryTargets() []string {
+	return []string{common.DiscoveryAuto, common.DiscoveryAll}
+}
+
+func (r *Resolver) Resolve(ctx context.Context, root *asset.Asset, pCfg *providers.Config, cfn common.CredentialFn, sfn common.QuerySecretFn, userIdDetectors ...providers.PlatformIdDetector) ([]*asset.Asset, error) {
+	var resolved []*asset.Asset
+	var err error
+	switch {
+	case strings.HasPrefix(pCfg.Host, OCIDirPrefix):
+		resolved, err = r.ociLayout(strings.TrimPrefix(pCfg.Host, OCIDirPrefix))
+	case strings.HasPrefix(pCfg.Host, DockerArchivePrefix):
+		resolved, err = r.dockerArchive(strings.TrimPrefix(pCfg.Host, DockerArchivePrefix))
+	default:
+		return nil, errors.New("unsupported container archive reference: " + pCfg.Host)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resolved) == 0 {
+		return nil, errors.New("could not find any container image in " + pCfg.Host)
+	}
+	return resolved, nil
+}
+
+// ociLayout returns an asset for each image manifest of the OCI image layout
+func (r *Resolver) ociLayout(path string) ([]*asset.Asset, error) {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.New("could not find the oci layout: " + path)
+	}
+	if !stat.IsDir() {
+		return nil, errors.New("oci layout must be a directory: " + path)
+	}
+
+	images, err := image.ListOCILayoutImages(path)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := []*asset.Asset{}
+	for i := range images {
+		ai := images[i]
+		img, err := image.LoadImageFromOCILayout(path, ai.Digest.String())
+		if err != nil {
+			return nil, err
+		}
+
+		a := newImageAsset(path, ai, img, ai.Digest)
+		a.Connections[0].Options[tar.OPTION_DIGEST] = ai.Digest.String()
+		log.Debug().Str("name", a.Name).Str("digest", ai.Digest.String()).Msg("resolved image of oci layout")
+		resolved = append(resolved, a)
+	}
+	return resolved, nil
+}
+
+// dockerArchive returns an asset for each image of the docker archive
+func (r *Resolver) dockerArchive(path string) ([]*asset.Asset, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, errors.New("could not find the docker archive: " + path)
+	}
+
+	images, err := image.ListDockerArchiveImages(path)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := []*asset.Asset{}
+	for i := range images {
+		ai := images[i]
+
+		// images are selected by their tag, untagged images can only be loaded from single image archives
+		tag := ""
+		if len(ai.Tags) > 0 {
+			tag = ai.Tags[0]
+		} else if len(images) > 1 {
+			log.Warn().Str("archive", path).Msg("skip untagged image of docker archive with multiple images")
+			continue
+		}
+
+		img, err := image.LoadImageFromDockerArchive(path, tag)
+		if err != nil {
+			return nil, err
+		}
+
+		digest, err := img.Digest()
+		if err != nil {
+			return nil, err
+		}
+
+		a := newImageAsset(path, ai, img, digest)
+		if tag != "" {
+			a.Connections[0].Options[tar.OPTION_TAG] = tag
+		}
+		log.Debug().Str("name", a.Name).Str("digest", digest.String()).Msg("resolved image of docker archive")
+		resolved = append(resolved, a)
+	}
+	return resolved, nil
+}
+
+func newImageAsset(path string, ai image.ArchiveImage, img v1.Image, digest v1.Hash) *asset.Asset {
+	name := strings.Join(ai.Tags, ",")
+	if name == "" {
+		name = path + "@" + containerid.ShortContainerImageID(digest.String())
+	}
+	if ai.Platform != nil {
+		name += " (" + ai.Platform.String() + ")"
+	}
+
+	a := &asset.Asset{
+		Name:        name,
+		PlatformIds: []string{containerid.MondooContainerImageID(digest.String())},
+		Platform: &platform.Platform{
+			Kind:    providers.Kind_KIND_CONTAINER_IMAGE,
+			Runtime: providers.RUNTIME_DOCKER_IMAGE,
+		},
+		Connections: []*providers.Config{
+			{
+				Backend: providers.ProviderType_TAR,
+				Options: map[string]string{
+					tar.OPTION_FILE: path,
+				},
+			},
+		},
+		State:  asset.State_STATE_ONLINE,
+		Labels: map[string]string{},
+	}
+
+	cfg, err := img.ConfigFile()
+	if err == nil {
+		a.Platform.Arch = cfg.Architecture
+		for k, v := range cfg.Config.Labels {
+			a.Labels[k] = v
+		}
+	}
+
+	a.Labels["docker.io/digests"] = digest.String()
+	if len(ai.Tags) > 0 {
+		a.Labels["docker.io/tags"] = strings.Join(ai.Tags, ",")
+	}
+	return a
+}
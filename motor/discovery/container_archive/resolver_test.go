@@ -0,0 +1,175 @@
+package container_archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/motor/asset"
+	"go.mondoo.com/cnquery/motor/motorid/containerid"
+	"go.mondoo.com/cnquery/motor/providers"
+	"go.mondoo.com/cnquery/motor/providers/container/cache"
+	"go.mondoo.com/cnquery/motor/providers/container/image"
+	motortar "go.mondoo.com/cnquery/motor/providers/tar"
+)
+
+func testImage(t *testing.T, osRelease string, arch string) v1.Image {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "etc/os-release", Typeflag: tar.TypeReg, Size: int64(len(osRelease)), Mode: 0o644}))
+	_, err := tw.Write([]byte(osRelease))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	data := buf.Bytes()
+
+	layer, err := tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(data)), nil
+	})
+	require.NoError(t, err)
+	img, err := mutate.AppendLayers(empty.Image, layer)
+	require.NoError(t, err)
+
+	cfg, err := img.ConfigFile()
+	require.NoError(t, err)
+	cfg = cfg.DeepCopy()
+	cfg.OS = "linux"
+	cfg.Architecture = arch
+	cfg.Config.Labels = map[string]string{"org.opencontainers.image.title": "test"}
+	img, err = mutate.ConfigFile(img, cfg)
+	require.NoError(t, err)
+	return img
+}
+
+func assetNames(assets []*asset.Asset) []string {
+	names := []string{}
+	for i := range assets {
+		names = append(names, assets[i].Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestOCILayout(t *testing.T) {
+	t.Setenv(cache.LayerCacheEnv, t.TempDir())
+
+	app := testImage(t, "ID=alpine\n", "amd64")
+	amd64 := testImage(t, "ID=debian\n", "amd64")
+	arm64 := testImage(t, "ID=debian\n", "arm64")
+	multi := mutate.AppendManifests(empty.Index,
+		mutate.IndexAddendum{Add: amd64, Descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "linux", Architecture: "amd64"}}},
+		mutate.IndexAddendum{Add: arm64, Descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "linux", Architecture: "arm64"}}},
+	)
+
+	dir := filepath.Join(t.TempDir(), "layout")
+	p, err := layout.Write(dir, empty.Index)
+	require.NoError(t, err)
+	require.NoError(t, p.AppendImage(app, layout.WithAnnotations(map[string]string{image.AnnotationRefName: "app:1.0"})))
+	require.NoError(t, p.AppendIndex(multi, layout.WithAnnotations(map[string]string{image.AnnotationRefName: "base:1.0"})))
+
+	r := &Resolver{}
+	assets, err := r.Resolve(context.Background(), &asset.Asset{}, &providers.Config{
+		Backend: providers.ProviderType_DOCKER,
+		Host:    OCIDirPrefix + dir,
+	}, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, assets, 3)
+	assert.Equal(t, []string{"app:1.0", "base:1.0 (linux/amd64)", "base:1.0 (linux/arm64)"}, assetNames(assets))
+
+	// each manifest is identified by its digest
+	digest, err := arm64.Digest()
+	require.NoError(t, err)
+	var armAsset *asset.Asset
+	for i := range assets {
+		if assets[i].Name == "base:1.0 (linux/arm64)" {
+			armAsset = assets[i]
+		}
+	}
+	require.NotNil(t, armAsset)
+	assert.Equal(t, []string{containerid.MondooContainerImageID(digest.String())}, armAsset.PlatformIds)
+	assert.Equal(t, "arm64", armAsset.Platform.Arch)
+	assert.Equal(t, "test", armAsset.Labels["org.opencontainers.image.title"])
+
+	// the connection loads the selected image of the layout
+	conn := armAsset.Connections[0]
+	assert.Equal(t, providers.ProviderType_TAR, conn.Backend)
+	assert.Equal(t, digest.String(), conn.Options[motortar.OPTION_DIGEST])
+
+	provider, err := motortar.New(conn)
+	require.NoError(t, err)
+	defer provider.Close()
+	assert.Equal(t, containerid.MondooContainerImageID(digest.String()), provider.PlatformIdentifier)
+	data, err := afero.ReadFile(provider.FS(), "/etc/os-release")
+	require.NoError(t, err)
+	assert.Equal(t, "ID=debian\n", string(data))
+
+	// layouts with multiple images require a digest
+	_, err = motortar.New(&providers.Config{
+		Backend: providers.ProviderType_TAR,
+		Options: map[string]string{motortar.OPTION_FILE: dir},
+	})
+	assert.Error(t, err)
+}
+
+func TestDockerArchive(t *testing.T) {
+	t.Setenv(cache.LayerCacheEnv, t.TempDir())
+
+	alpine := testImage(t, "ID=alpine\n", "amd64")
+	debian := testImage(t, "ID=debian\n", "amd64")
+	alpineTag, err := name.NewTag("alpine:3.17")
+	require.NoError(t, err)
+	debianTag, err := name.NewTag("debian:11")
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "images.tar")
+	require.NoError(t, tarball.MultiWriteToFile(path, map[name.Tag]v1.Image{
+		alpineTag: alpine,
+		debianTag: debian,
+	}))
+
+	r := &Resolver{}
+	assets, err := r.Resolve(context.Background(), &asset.Asset{}, &providers.Config{
+		Backend: providers.ProviderType_DOCKER,
+		Host:    DockerArchivePrefix + path,
+	}, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, assets, 2)
+	assert.Equal(t, []string{"alpine:3.17", "debian:11"}, assetNames(assets))
+
+	for i := range assets {
+		a := assets[i]
+		conn := a.Connections[0]
+		assert.Equal(t, a.Name, conn.Options[motortar.OPTION_TAG])
+
+		provider, err := motortar.New(conn)
+		require.NoError(t, err)
+		assert.Equal(t, a.PlatformIds[0], provider.PlatformIdentifier)
+		data, err := afero.ReadFile(provider.FS(), "/etc/os-release")
+		require.NoError(t, err)
+		provider.Close()
+
+		if a.Name == "alpine:3.17" {
+			assert.Equal(t, "ID=alpine\n", string(data))
+		} else {
+			assert.Equal(t, "ID=debian\n", string(data))
+		}
+	}
+}
+
+func TestIsArchiveReference(t *testing.T) {
+	assert.True(t, IsArchiveReference("oci-dir:/tmp/layout"))
+	assert.True(t, IsArchiveReference("docker-archive:/tmp/images.tar"))
+	assert.False(t, IsArchiveReference("ubuntu:20.04"))
+}
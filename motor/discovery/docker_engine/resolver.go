@@ -9,6 +9,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"go.mondoo.com/cnquery/motor/asset"
 	"go.mondoo.com/cnquery/motor/discovery/common"
+	"go.mondoo.com/cnquery/motor/discovery/container_archive"
 	"go.mondoo.com/cnquery/motor/discovery/container_registry"
 	"go.mondoo.com/cnquery/motor/platform"
 	"go.mondoo.com/cnquery/motor/providers"
@@ -74,6 +75,12 @@ func (r *Resolver) Resolve(ctx context.Context, root *asset.Asset, pCfg *provide
 		return []*asset.Asset{resolvedAsset}, nil
 	}
 
+	// images stored in an OCI image layout or a docker archive do not need docker
+	if container_archive.IsArchiveReference(pCfg.Host) {
+		rr := container_archive.Resolver{}
+		return rr.Resolve(ctx, root, pCfg, cfn, sfn)
+	}
+
 	ded, dockerEngErr := NewDockerEngineDiscovery()
 	// we do not fail here, since we pull the image from upstream if its is an image without the need for docker
 
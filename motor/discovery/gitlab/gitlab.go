@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/xanzy/go-gitlab"
 	"go.mondoo.com/cnquery/motor/asset"
 	"go.mondoo.com/cnquery/motor/discovery/common"
 	"go.mondoo.com/cnquery/motor/providers"
@@ -11,7 +12,10 @@ import (
 	"go.mondoo.com/cnquery/motor/providers/resolver"
 )
 
-const DiscoveryGroup = "group"
+const (
+	DiscoveryGroup   = "group"
+	DiscoveryProject = "project"
+)
 
 type Resolver struct{}
 
@@ -20,7 +24,7 @@ func (r *Resolver) Name() string {
 }
 
 func (r *Resolver) AvailableDiscoveryTargets() []string {
-	return []string{common.DiscoveryAuto, common.DiscoveryAll, DiscoveryGroup}
+	return []string{common.DiscoveryAuto, common.DiscoveryAll, DiscoveryGroup, DiscoveryProject}
 }
 
 func (r *Resolver) Resolve(ctx context.Context, root *asset.Asset, tc *providers.Config, cfn common.CredentialFn, sfn common.QuerySecretFn, userIdDetectors ...providers.PlatformIdDetector) ([]*asset.Asset, error) {
@@ -47,6 +51,28 @@ func (r *Resolver) Resolve(ctx context.Context, root *asset.Asset, tc *providers
 	}
 
 	var assets []*asset.Asset
+	if pf.Name == gitlab_transport.GitLabProjectPlatform.Name {
+		if tc.IncludesOneOfDiscoveryTarget(common.DiscoveryAuto, common.DiscoveryAll, DiscoveryProject) {
+			name := root.Name
+			if name == "" {
+				prj, err := trans.Project()
+				if err != nil {
+					return nil, err
+				}
+				name = "GitLab Project " + prj.PathWithNamespace
+			}
+
+			assets = append(assets, &asset.Asset{
+				PlatformIds: []string{identifier},
+				Name:        name,
+				Platform:    pf,
+				Connections: []*providers.Config{tc}, // pass-in the current config
+				State:       asset.State_STATE_ONLINE,
+			})
+		}
+		return assets, nil
+	}
+
 	if tc.IncludesOneOfDiscoveryTarget(common.DiscoveryAuto, common.DiscoveryAll, DiscoveryGroup) {
 		name := root.Name
 		if name == "" {
@@ -68,5 +94,56 @@ func (r *Resolver) Resolve(ctx context.Context, root *asset.Asset, tc *providers
 		})
 	}
 
+	if tc.IncludesOneOfDiscoveryTarget(common.DiscoveryAll, DiscoveryProject) {
+		grp, err := trans.Group()
+		if err != nil {
+			return nil, err
+		}
+
+		projects, err := listGroupProjects(trans.Client(), grp.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, prj := range projects {
+			clonedConfig := tc.Clone()
+			if clonedConfig.Options == nil {
+				clonedConfig.Options = map[string]string{}
+			}
+			clonedConfig.Options["project"] = prj.PathWithNamespace
+
+			assets = append(assets, &asset.Asset{
+				PlatformIds: []string{gitlab_transport.NewGitLabProjectIdentifier(grp.ID, prj.ID)},
+				Name:        "GitLab Project " + prj.PathWithNamespace,
+				Platform:    gitlab_transport.GitLabProjectPlatform,
+				Connections: []*providers.Config{clonedConfig},
+				State:       asset.State_STATE_ONLINE,
+			})
+		}
+	}
+
 	return assets, nil
 }
+
+// listGroupProjects returns all projects of the group including those in subgroups
+func listGroupProjects(client *gitlab.Client, groupID int) ([]*gitlab.Project, error) {
+	opts := &gitlab.ListGroupProjectsOptions{
+		ListOptions:      gitlab.ListOptions{PerPage: 100},
+		IncludeSubGroups: gitlab.Bool(true),
+		Archived:         gitlab.Bool(false),
+	}
+
+	var res []*gitlab.Project
+	for {
+		projects, resp, err := client.Groups.ListGroupProjects(groupID, opts)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, projects...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return res, nil
+}
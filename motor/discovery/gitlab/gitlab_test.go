@@ -0,0 +1,90 @@
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/motor/asset"
+	"go.mondoo.com/cnquery/motor/discovery/common"
+	"go.mondoo.com/cnquery/motor/providers"
+)
+
+// recorded responses of the GitLab API, keyed by the request path
+var fixtures = map[string]string{
+	"/api/v4/groups/mondoo":        `{"id": 7, "name": "Mondoo", "path": "mondoo", "full_path": "mondoo"}`,
+	"/api/v4/projects/mondoo/docs": `{"id": 43, "name": "docs", "path": "docs", "path_with_namespace": "mondoo/docs"}`,
+	"/api/v4/groups/7/projects": `[
+		{"id": 42, "name": "webapp", "path": "webapp", "path_with_namespace": "mondoo/webapp"},
+		{"id": 43, "name": "docs", "path": "docs", "path_with_namespace": "mondoo/team/docs"}
+	]`,
+}
+
+func fixtureServer(t *testing.T) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, ok := fixtures[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(data))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestGitlabDiscovery(t *testing.T) {
+	srv := fixtureServer(t)
+
+	config := func(discover []string, options map[string]string) *providers.Config {
+		opts := map[string]string{
+			"token": "test",
+			"group": "mondoo",
+			"url":   srv.URL + "/api/v4",
+		}
+		for k, v := range options {
+			opts[k] = v
+		}
+		return &providers.Config{
+			Backend:  providers.ProviderType_GITLAB,
+			Options:  opts,
+			Discover: &providers.Discovery{Targets: discover},
+		}
+	}
+
+	r := &Resolver{}
+	root := func() *asset.Asset { return &asset.Asset{} }
+
+	t.Run("group", func(t *testing.T) {
+		assets, err := r.Resolve(context.Background(), root(), config([]string{common.DiscoveryAuto}, nil), nil, nil)
+		require.NoError(t, err)
+		require.Len(t, assets, 1)
+		assert.Equal(t, "GitLab Group Mondoo", assets[0].Name)
+		assert.Equal(t, []string{"//platformid.api.mondoo.app/runtime/gitlab/group/7"}, assets[0].PlatformIds)
+		assert.Equal(t, "gitlab", assets[0].Platform.Name)
+	})
+
+	t.Run("group projects", func(t *testing.T) {
+		assets, err := r.Resolve(context.Background(), root(), config([]string{common.DiscoveryAll}, nil), nil, nil)
+		require.NoError(t, err)
+		require.Len(t, assets, 3)
+
+		assert.Equal(t, "GitLab Project mondoo/team/docs", assets[2].Name)
+		assert.Equal(t, []string{"//platformid.api.mondoo.app/runtime/gitlab/group/7/project/43"}, assets[2].PlatformIds)
+		assert.Equal(t, "gitlab-project", assets[2].Platform.Name)
+		require.Len(t, assets[2].Connections, 1)
+		assert.Equal(t, "mondoo/team/docs", assets[2].Connections[0].Options["project"])
+	})
+
+	t.Run("project", func(t *testing.T) {
+		assets, err := r.Resolve(context.Background(), root(), config([]string{common.DiscoveryAuto}, map[string]string{"project": "docs"}), nil, nil)
+		require.NoError(t, err)
+		require.Len(t, assets, 1)
+		assert.Equal(t, "GitLab Project mondoo/docs", assets[0].Name)
+		assert.Equal(t, []string{"//platformid.api.mondoo.app/runtime/gitlab/group/7/project/43"}, assets[0].PlatformIds)
+	})
+}
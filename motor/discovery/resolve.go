@@ -20,9 +20,11 @@ import (
 	"go.mondoo.com/cnquery/motor/discovery/aws"
 	"go.mondoo.com/cnquery/motor/discovery/aws/ebs"
 	"go.mondoo.com/cnquery/motor/discovery/azure"
+	"go.mondoo.com/cnquery/motor/discovery/cloudformation"
 	"go.mondoo.com/cnquery/motor/discovery/common"
 	"go.mondoo.com/cnquery/motor/discovery/container_registry"
 	"go.mondoo.com/cnquery/motor/discovery/docker_engine"
+	"go.mondoo.com/cnquery/motor/discovery/dockerfile"
 	"go.mondoo.com/cnquery/motor/discovery/equinix"
 	"go.mondoo.com/cnquery/motor/discovery/gcp"
 	"go.mondoo.com/cnquery/motor/discovery/github"
@@ -76,11 +78,14 @@ func init() {
 		providers.ProviderID_MS365:              &ms365.Resolver{},
 		providers.ProviderID_IPMI:               &ipmi.Resolver{},
 		providers.ProviderID_FS:                 &os.Resolver{},
+		providers.ProviderID_DISK_IMAGE:         &os.Resolver{},
 		providers.ProviderID_EQUINIX:            &equinix.Resolver{},
 		providers.ProviderID_GITHUB:             &github.Resolver{},
 		providers.ProviderID_AWS_EC2_EBS:        &ebs.Resolver{},
 		providers.ProviderID_GITLAB:             &gitlab.Resolver{},
 		providers.ProviderID_TERRAFORM:          &terraform.Resolver{},
+		providers.ProviderID_DOCKERFILE:         &dockerfile.Resolver{},
+		providers.ProviderID_CLOUDFORMATION:     &cloudformation.Resolver{},
 		providers.ProviderID_HOST:               &network.Resolver{},
 		providers.ProviderID_TLS:                &network.Resolver{},
 	}
@@ -0,0 +1,157 @@
+package ansibleinventory
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kballard/go-shellquote"
+)
+
+var (
+	iniIntValue   = regexp.MustCompile(`^-?(0|[1-9][0-9]*)$`)
+	iniFloatValue = regexp.MustCompile(`^-?[0-9]+\.[0-9]+$`)
+)
+
+// parseINI parses an ansible inventory in the INI format
+// see https://docs.ansible.com/ansible/latest/inventory_guide/intro_inventory.html
+func parseINI(data []byte) (*sourceInventory, error) {
+	s := newSourceInventory()
+
+	group := groupUngrouped
+	kind := "hosts"
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			group = strings.TrimSpace(line[1 : len(line)-1])
+			kind = "hosts"
+			if i := strings.LastIndex(group, ":"); i >= 0 {
+				kind = group[i+1:]
+				group = group[:i]
+			}
+			if group == "" {
+				return nil, errors.New("missing group name in line " + strconv.Itoa(lineNo))
+			}
+			if kind != "hosts" && kind != "vars" && kind != "children" {
+				return nil, errors.New("invalid section " + line + " in line " + strconv.Itoa(lineNo))
+			}
+			s.group(group)
+			continue
+		}
+
+		switch kind {
+		case "hosts":
+			if err := s.parseINIHost(group, line); err != nil {
+				return nil, errors.New(err.Error() + " in line " + strconv.Itoa(lineNo))
+			}
+		case "vars":
+			key, value, ok := strings.Cut(line, "=")
+			if !ok {
+				return nil, errors.New("expected key=value in line " + strconv.Itoa(lineNo))
+			}
+			s.group(group).vars[strings.TrimSpace(key)] = parseINIValue(strings.TrimSpace(value))
+		case "children":
+			s.addChild(group, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// parseINIHost parses a host line, e.g. web[01:03].example.com:2222 ansible_user=admin
+func (s *sourceInventory) parseINIHost(group string, line string) error {
+	fields, err := shellquote.Split(stripINIComment(line))
+	if err != nil {
+		return err
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	vars := map[string]interface{}{}
+	for _, field := range fields[1:] {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok {
+			return errors.New("expected key=value for host variable " + field)
+		}
+		vars[key] = parseINIValue(value)
+	}
+
+	pattern := fields[0]
+	// the port may be appended to the host, e.g. badwolf.example.com:5309
+	rangeEnd := strings.LastIndex(pattern, "]") + 1
+	if i := strings.LastIndex(pattern, ":"); i >= rangeEnd && strings.Count(pattern[rangeEnd:], ":") == 1 {
+		port, err := strconv.Atoi(pattern[i+1:])
+		if err != nil {
+			return errors.New("invalid port in host " + pattern)
+		}
+		vars["ansible_port"] = float64(port)
+		pattern = pattern[:i]
+	}
+
+	hosts, err := expandHostPattern(pattern)
+	if err != nil {
+		return err
+	}
+	for _, host := range hosts {
+		s.addHost(group, host, vars)
+	}
+	return nil
+}
+
+// stripINIComment removes inline comments from host lines, ansible splits them with
+// shlex which ignores everything after a # that is not quoted or escaped
+func stripINIComment(line string) string {
+	var quote rune
+	escaped := false
+	for i, c := range line {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\' && quote != '\'':
+			escaped = true
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '#':
+			return line[:i]
+		}
+	}
+	return line
+}
+
+// parseINIValue converts the value like ansible does for python literals. Numbers are
+// returned as float64, the same type json and yaml values use.
+func parseINIValue(value string) interface{} {
+	switch {
+	case len(value) >= 2 && (value[0] == '\'' || value[0] == '"') && value[len(value)-1] == value[0]:
+		return value[1 : len(value)-1]
+	case value == "True":
+		return true
+	case value == "False":
+		return false
+	case iniIntValue.MatchString(value) || iniFloatValue.MatchString(value):
+		f, err := strconv.ParseFloat(value, 64)
+		if err == nil {
+			return f
+		}
+	}
+	return value
+}
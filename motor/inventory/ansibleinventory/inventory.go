@@ -2,9 +2,12 @@ package ansibleinventory
 
 import (
 	"errors"
+	"fmt"
+	"path/filepath"
 	"strconv"
 	"strings"
 
+	"go.mondoo.com/cnquery/motor/providers/os/cmd"
 	"go.mondoo.com/cnquery/motor/vault"
 
 	"github.com/rs/zerolog/log"
@@ -19,7 +22,8 @@ import (
 )
 
 type Group struct {
-	Hosts []string
+	Hosts    []string
+	Children []string
 }
 
 type Groups map[string]Group
@@ -32,13 +36,59 @@ type All struct {
 	Children []string
 }
 
+// Parse parses an ansible inventory in the INI or YAML format as well as the output of
+// `ansible-inventory --list`
 func Parse(data []byte) (*Inventory, error) {
-	inventory := Inventory{}
-	err := inventory.Decode(data)
-	if err != nil {
-		return nil, err
+	return ParseWithVars(data, "")
+}
+
+// ParseWithVars parses the ansible inventory like Parse. For INI and YAML inventories the
+// variables of the group_vars and host_vars directories in dir are applied to the hosts.
+func ParseWithVars(data []byte, dir string) (*Inventory, error) {
+	var raw map[string]interface{}
+	var source *sourceInventory
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		source, err = parseINI(data)
+		if err != nil {
+			return nil, errors.New("could not parse ansible inventory: " + err.Error())
+		}
+	} else if raw == nil || isListOutput(raw) {
+		inventory := Inventory{}
+		err := inventory.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+		return &inventory, nil
+	} else {
+		source, err = parseYAML(raw)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var groupVars, hostVars map[string]map[string]interface{}
+	if dir != "" {
+		groups := make([]string, 0, len(source.groups))
+		for name := range source.groups {
+			groups = append(groups, name)
+		}
+		hosts := make([]string, 0, len(source.hostVars))
+		for name := range source.hostVars {
+			hosts = append(hosts, name)
+		}
+
+		var err error
+		groupVars, err = loadVarsDir(filepath.Join(dir, "group_vars"), groups)
+		if err != nil {
+			return nil, err
+		}
+		hostVars, err = loadVarsDir(filepath.Join(dir, "host_vars"), hosts)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return &inventory, nil
+
+	return source.resolve(groupVars, hostVars), nil
 }
 
 type Inventory struct {
@@ -104,16 +154,22 @@ func (i *Inventory) Decode(data []byte) error {
 }
 
 type Host struct {
-	Alias      string
-	Host       string // ansible_host
-	Port       string // ansible_port
-	User       string // ansible_user
-	Password   string // ansible_password
-	Identity   string // ansible_ssh_private_key_file
-	Become     bool   // ansible_become
-	Connection string // ansible_connection: ssh, local, docker
-	Groups     []string
-	Labels     []string
+	Alias          string
+	Host           string // ansible_host
+	Port           string // ansible_port
+	User           string // ansible_user
+	Password       string // ansible_password
+	Identity       string // ansible_ssh_private_key_file
+	Become         bool   // ansible_become
+	BecomeMethod   string // ansible_become_method: sudo, su, doas, pbrun, dzdo
+	BecomeUser     string // ansible_become_user
+	BecomePassword string // ansible_become_password
+	SSHCommonArgs  string // ansible_ssh_common_args
+	WinrmScheme    string // ansible_winrm_scheme: http, https
+	Insecure       bool   // ansible_winrm_server_cert_validation: ignore
+	Connection     string // ansible_connection: ssh, local, docker
+	Groups         []string
+	Labels         []string
 }
 
 // https://docs.ansible.com/ansible/latest/user_guide/intro_inventory.html
@@ -122,21 +178,24 @@ func (inventory *Inventory) List(groups ...string) []*Host {
 		return nil
 	}
 
-	list := inventory.All.Children
+	list := append([]string{}, inventory.All.Children...)
 	if len(groups) > 0 {
-		list = Filter(list, func(x string) bool {
-			for i := range groups {
-				if groups[i] == x {
-					return true
-				}
-			}
-			return false
-		})
+		list = append([]string{}, groups...)
 	}
 
 	hostMap := map[string]*Host{}
-	for i := range list {
-		groupname := list[i]
+	visited := map[string]bool{}
+	for len(list) > 0 {
+		groupname := list[0]
+		list = list[1:]
+		if visited[groupname] {
+			continue
+		}
+		visited[groupname] = true
+
+		// hosts of child groups are also part of the group
+		list = append(list, inventory.Groups[groupname].Children...)
+
 		hosts := inventory.Groups[groupname].Hosts
 		for j := range hosts {
 			alias := hosts[j]
@@ -149,29 +208,56 @@ func (inventory *Inventory) List(groups ...string) []*Host {
 
 			meta := inventory.Meta.HostVars[alias]
 
-			if d, ok := meta["ansible_host"]; ok {
-				host.Host = d.(string)
+			if d, ok := hostVar(meta, "ansible_host", "ansible_ssh_host"); ok {
+				host.Host = d
 			}
 
-			if f, ok := meta["ansible_port"]; ok {
-				s := strconv.FormatFloat(f.(float64), 'f', 0, 64)
-				host.Port = s
+			if d, ok := hostVar(meta, "ansible_port", "ansible_ssh_port"); ok {
+				host.Port = d
 			}
 
-			if d, ok := meta["ansible_user"]; ok {
-				host.User = d.(string)
+			if d, ok := hostVar(meta, "ansible_user", "ansible_ssh_user"); ok {
+				host.User = d
 			}
 
-			if d, ok := meta["ansible_password"]; ok {
-				host.Password = d.(string)
+			if d, ok := hostVar(meta, "ansible_password", "ansible_ssh_pass"); ok {
+				host.Password = d
 			}
 
-			if d, ok := meta["ansible_ssh_private_key_file"]; ok {
-				host.Identity = d.(string)
+			if d, ok := hostVar(meta, "ansible_ssh_private_key_file", "ansible_private_key_file"); ok {
+				host.Identity = d
 			}
 
-			if d, ok := meta["ansible_connection"]; ok {
-				host.Connection = d.(string)
+			if d, ok := hostVar(meta, "ansible_connection"); ok {
+				host.Connection = d
+			}
+
+			if d, ok := hostVar(meta, "ansible_become"); ok {
+				host.Become = isTrue(d)
+			}
+
+			if d, ok := hostVar(meta, "ansible_become_method"); ok {
+				host.BecomeMethod = d
+			}
+
+			if d, ok := hostVar(meta, "ansible_become_user"); ok {
+				host.BecomeUser = d
+			}
+
+			if d, ok := hostVar(meta, "ansible_become_password", "ansible_become_pass"); ok {
+				host.BecomePassword = d
+			}
+
+			if d, ok := hostVar(meta, "ansible_ssh_common_args"); ok {
+				host.SSHCommonArgs = d
+			}
+
+			if d, ok := hostVar(meta, "ansible_winrm_scheme"); ok {
+				host.WinrmScheme = d
+			}
+
+			if d, ok := hostVar(meta, "ansible_winrm_server_cert_validation"); ok {
+				host.Insecure = d == "ignore"
 			}
 
 			if d, ok := meta["tags"]; ok {
@@ -199,6 +285,49 @@ func (inventory *Inventory) List(groups ...string) []*Host {
 	return res
 }
 
+// hostVar returns the first of the variables as string. Templates and vault encrypted
+// values cannot be evaluated without ansible, therefore they are ignored.
+func hostVar(vars map[string]interface{}, keys ...string) (string, bool) {
+	for _, key := range keys {
+		v, ok := vars[key]
+		if !ok || v == nil {
+			continue
+		}
+
+		s := toString(v)
+		if strings.Contains(s, "{{") || strings.HasPrefix(s, "$ANSIBLE_VAULT") {
+			log.Warn().Str("variable", key).Msg("ansible> templates and vault encrypted values are not supported, ignore variable")
+			continue
+		}
+		return s, true
+	}
+	return "", false
+}
+
+func toString(v interface{}) string {
+	switch x := v.(type) {
+	case string:
+		return x
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(x)
+	case bool:
+		return strconv.FormatBool(x)
+	default:
+		return fmt.Sprintf("%v", x)
+	}
+}
+
+// isTrue returns true for ansible's boolean true values, e.g. yes or true
+func isTrue(s string) bool {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "yes", "y", "true", "t", "on", "1":
+		return true
+	}
+	return false
+}
+
 func Filter(vs []string, f func(string) bool) []string {
 	vsf := make([]string, 0)
 	for _, v := range vs {
@@ -281,13 +410,30 @@ func ansibleConnections(host *Host) []*providers.Config {
 	// further down in the execution chain
 	port, _ := strconv.Atoi(host.Port)
 
+	// the winrm provider uses https on port 5986 by default and only switches to http on
+	// port 5985, therefore the http scheme is mapped to that port
+	if backend == providers.ProviderType_WINRM && strings.ToLower(host.WinrmScheme) == "http" {
+		if port == 0 {
+			port = 5985
+		} else if port != 5985 {
+			log.Warn().Str("host", host.Alias).Int("port", port).Msg("ansible> winrm uses http on port 5985 only, connect via https")
+		}
+	}
+
 	res := &providers.Config{
-		Backend: backend,
-		Host:    host.Host,
-		Port:    int32(port),
-		Sudo: &providers.Sudo{
-			Active: host.Become,
-		},
+		Backend:  backend,
+		Host:     host.Host,
+		Port:     int32(port),
+		Insecure: host.Insecure,
+		Sudo:     ansibleSudo(host, backend),
+	}
+
+	if backend == providers.ProviderType_SSH && host.SSHCommonArgs != "" {
+		bastion, err := parseSSHCommonArgs(host.SSHCommonArgs)
+		if err != nil {
+			log.Warn().Err(err).Str("host", host.Alias).Msg("ansible> could not parse ansible_ssh_common_args, ignore jump host")
+		}
+		res.Bastion = bastion
 	}
 
 	credentials := []*vault.Credential{}
@@ -319,3 +465,39 @@ func ansibleConnections(host *Host) []*providers.Config {
 	res.Credentials = credentials
 	return []*providers.Config{res}
 }
+
+// ansibleSudo maps ansible's privilege escalation to the sudo configuration
+// https://docs.ansible.com/ansible/latest/playbook_guide/playbooks_privilege_escalation.html
+func ansibleSudo(host *Host, backend providers.ProviderType) *providers.Sudo {
+	sudo := &providers.Sudo{
+		Active: host.Become,
+	}
+	if !host.Become {
+		return sudo
+	}
+
+	method := strings.ToLower(strings.TrimSpace(host.BecomeMethod))
+	switch method {
+	case "", cmd.EscalationSudo, cmd.EscalationSu, cmd.EscalationDoas, cmd.EscalationPbrun, cmd.EscalationDzdo:
+	default:
+		// e.g. runas for windows or machinectl
+		log.Warn().Str("host", host.Alias).Str("become-method", host.BecomeMethod).Msg("ansible> unsupported become method, privilege escalation is not used")
+		sudo.Active = false
+		return sudo
+	}
+	if backend == providers.ProviderType_WINRM {
+		sudo.Active = false
+		return sudo
+	}
+
+	sudo.Method = method
+	sudo.User = host.BecomeUser
+	if host.BecomePassword != "" {
+		sudo.Credential = &vault.Credential{
+			Type:     vault.CredentialType_password,
+			User:     host.BecomeUser,
+			Password: host.BecomePassword,
+		}
+	}
+	return sudo
+}
@@ -6,8 +6,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.mondoo.com/cnquery/motor/asset"
 	"go.mondoo.com/cnquery/motor/inventory/ansibleinventory"
+	"go.mondoo.com/cnquery/motor/providers"
 	"go.mondoo.com/cnquery/motor/vault"
 )
 
@@ -211,12 +213,14 @@ func TestHostConnectionWinrm(t *testing.T) {
 		Host:       "172.16.2.5",
 		User:       "vagrant",
 		Password:   "password",
+		Insecure:   true,
 		Connection: "winrm",
 	}, {
 		Alias:      "172.16.2.6",
 		Host:       "172.16.2.6",
 		User:       "vagrant",
 		Password:   "password",
+		Insecure:   true,
 		Connection: "winrm",
 	}}, hosts)
 }
@@ -320,6 +324,208 @@ func TestTagsAndGroups(t *testing.T) {
 	assert.Equal(t, "/home/custom-user/.ssh/id_rsa", cred.PrivateKeyPath)
 }
 
+func TestParseIniInventory(t *testing.T) {
+	// winrm.json is the converted winrm.ini, both need to return the same hosts
+	input, err := os.ReadFile("./testdata/winrm.ini")
+	require.NoError(t, err)
+	iniInventory, err := ansibleinventory.Parse(input)
+	require.NoError(t, err)
+
+	input, err = os.ReadFile("./testdata/winrm.json")
+	require.NoError(t, err)
+	jsonInventory, err := ansibleinventory.Parse(input)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"ungrouped", "win"}, iniInventory.All.Children)
+	iniHosts := iniInventory.List()
+	jsonHosts := jsonInventory.List()
+	sortHosts(iniHosts)
+	sortHosts(jsonHosts)
+	assert.Equal(t, jsonHosts, iniHosts)
+
+	input, err = os.ReadFile("./testdata/static.ini")
+	require.NoError(t, err)
+	inventory, err := ansibleinventory.Parse(input)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"api", "payment", "ungrouped", "web"}, inventory.All.Children)
+	assert.Equal(t, []string{"192.168.3.1"}, inventory.Groups["payment"].Hosts)
+	assert.Equal(t, 5, len(inventory.List()))
+}
+
+func TestHostRanges(t *testing.T) {
+	inventory, err := ansibleinventory.Parse([]byte(`
+[web]
+web[08:10].example.com
+[db]
+db-[a:e:2] ansible_port=5432
+[cache]
+cache[1:2]-[a:b]
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"web08.example.com", "web09.example.com", "web10.example.com"}, inventory.Groups["web"].Hosts)
+	assert.Equal(t, []string{"db-a", "db-c", "db-e"}, inventory.Groups["db"].Hosts)
+	assert.Equal(t, []string{"cache1-a", "cache1-b", "cache2-a", "cache2-b"}, inventory.Groups["cache"].Hosts)
+	assert.Equal(t, float64(5432), inventory.Meta.HostVars["db-c"]["ansible_port"])
+
+	_, err = ansibleinventory.Parse([]byte("[web]\nweb[01:100]\n"))
+	assert.Error(t, err)
+}
+
+func TestIniInlineComments(t *testing.T) {
+	inventory, err := ansibleinventory.Parse([]byte(`
+[web]
+web01 ansible_user=admin  # primary
+web02 ansible_password='p#ss' ansible_host=10.0.0.2#secondary
+web03 ansible_password=p\#ss
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"web01", "web02", "web03"}, inventory.Groups["web"].Hosts)
+	assert.Equal(t, "admin", inventory.Meta.HostVars["web01"]["ansible_user"])
+	assert.Equal(t, "p#ss", inventory.Meta.HostVars["web02"]["ansible_password"])
+	assert.Equal(t, "10.0.0.2", inventory.Meta.HostVars["web02"]["ansible_host"])
+	assert.Equal(t, "p#ss", inventory.Meta.HostVars["web03"]["ansible_password"])
+}
+
+func TestInventoryPlugin(t *testing.T) {
+	input, err := os.ReadFile("./testdata/demo.aws_ec2.yml")
+	require.NoError(t, err)
+	_, err = ansibleinventory.Parse(input)
+	assert.ErrorContains(t, err, "plugins are not supported")
+}
+
+func TestInventoryWithVars(t *testing.T) {
+	for _, file := range []string{"inventory.ini", "inventory.yml"} {
+		t.Run(file, func(t *testing.T) {
+			input, err := os.ReadFile("./testdata/playbook/" + file)
+			require.NoError(t, err)
+
+			inventory, err := ansibleinventory.ParseWithVars(input, "./testdata/playbook")
+			require.NoError(t, err)
+
+			assert.Equal(t, []string{"production", "ungrouped", "windows"}, inventory.All.Children)
+			assert.Equal(t, []string{"bastion.example.com"}, inventory.Groups["ungrouped"].Hosts)
+			assert.ElementsMatch(t, []string{"webservers", "dbservers"}, inventory.Groups["production"].Children)
+
+			// group_vars and host_vars
+			assert.Equal(t, "ntp.example.com", inventory.Meta.HostVars["win01.example.com"]["ntp_server"])
+			assert.Equal(t, float64(8080), inventory.Meta.HostVars["web01.example.com"]["http_port"])
+			assert.Equal(t, float64(80), inventory.Meta.HostVars["web02.example.com"]["http_port"])
+
+			hosts := inventory.List("production")
+			assert.Equal(t, 6, len(hosts))
+			hosts = inventory.List()
+			assert.Equal(t, 8, len(hosts))
+			sortHosts(hosts)
+
+			assert.Equal(t, &ansibleinventory.Host{
+				Alias:          "web01.example.com",
+				Host:           "10.0.1.1",
+				User:           "ubuntu",
+				Become:         true,
+				BecomeUser:     "root",
+				BecomePassword: "secret",
+				SSHCommonArgs:  "-o ProxyJump=admin@bastion.example.com",
+				Connection:     "ssh",
+			}, findHost(hosts, "web01.example.com"))
+
+			web04 := findHost(hosts, "web04.example.com")
+			assert.Equal(t, "2222", web04.Port)
+			assert.Equal(t, "admin", web04.User)
+
+			assert.Equal(t, &ansibleinventory.Host{
+				Alias:         "db-a.example.com",
+				Host:          "db-a.example.com",
+				User:          "ubuntu",
+				Become:        true,
+				BecomeMethod:  "su",
+				BecomeUser:    "postgres",
+				SSHCommonArgs: "-o ProxyCommand=\"ssh -W %h:%p -q -p 2222 jump@db-bastion.example.com\"",
+				Connection:    "ssh",
+			}, findHost(hosts, "db-a.example.com"))
+
+			// templates are not evaluated
+			assert.Equal(t, &ansibleinventory.Host{
+				Alias:       "win01.example.com",
+				Host:        "win01.example.com",
+				User:        "Administrator",
+				Become:      true,
+				WinrmScheme: "http",
+				Insecure:    true,
+				Connection:  "winrm",
+			}, findHost(hosts, "win01.example.com"))
+		})
+	}
+}
+
+func TestInventoryConnectionConversion(t *testing.T) {
+	input, err := os.ReadFile("./testdata/playbook/inventory.ini")
+	require.NoError(t, err)
+
+	ansibleInventory, err := ansibleinventory.ParseWithVars(input, "./testdata/playbook")
+	require.NoError(t, err)
+	v1Inventory := ansibleInventory.ToV1Inventory()
+	assert.Equal(t, 8, len(v1Inventory.Spec.Assets))
+
+	t.Run("become and proxy jump", func(t *testing.T) {
+		a := findAsset(v1Inventory.Spec.Assets, "web01.example.com")
+		require.NotNil(t, a)
+		conn := a.Connections[0]
+		assert.Equal(t, providers.ProviderType_SSH, conn.Backend)
+		assert.Equal(t, "10.0.1.1", conn.Host)
+
+		require.NotNil(t, conn.Sudo)
+		assert.True(t, conn.Sudo.Active)
+		assert.Equal(t, "root", conn.Sudo.User)
+		require.NotNil(t, conn.Sudo.Credential)
+		cred := v1Inventory.Spec.Credentials[conn.Sudo.Credential.SecretId]
+		require.NotNil(t, cred)
+		assert.Equal(t, vault.CredentialType_password, cred.Type)
+		assert.Equal(t, "secret", string(cred.Secret))
+
+		require.NotNil(t, conn.Bastion)
+		assert.Equal(t, "bastion.example.com", conn.Bastion.Host)
+		cred = v1Inventory.Spec.Credentials[conn.Bastion.Credentials[0].SecretId]
+		assert.Equal(t, "admin", cred.User)
+	})
+
+	t.Run("become method and proxy command", func(t *testing.T) {
+		a := findAsset(v1Inventory.Spec.Assets, "db-b.example.com")
+		require.NotNil(t, a)
+		conn := a.Connections[0]
+		assert.Equal(t, "su", conn.Sudo.Method)
+		assert.Equal(t, "postgres", conn.Sudo.User)
+		assert.Nil(t, conn.Sudo.Credential)
+
+		require.NotNil(t, conn.Bastion)
+		assert.Equal(t, "db-bastion.example.com", conn.Bastion.Host)
+		assert.Equal(t, int32(2222), conn.Bastion.Port)
+		cred := v1Inventory.Spec.Credentials[conn.Bastion.Credentials[0].SecretId]
+		assert.Equal(t, "jump", cred.User)
+	})
+
+	t.Run("winrm", func(t *testing.T) {
+		a := findAsset(v1Inventory.Spec.Assets, "win01.example.com")
+		require.NotNil(t, a)
+		conn := a.Connections[0]
+		assert.Equal(t, providers.ProviderType_WINRM, conn.Backend)
+		assert.Equal(t, int32(5985), conn.Port)
+		assert.True(t, conn.Insecure)
+		assert.False(t, conn.Sudo.Active)
+		assert.Nil(t, conn.Bastion)
+	})
+}
+
+func findHost(hosts []*ansibleinventory.Host, alias string) *ansibleinventory.Host {
+	for i := range hosts {
+		if hosts[i].Alias == alias {
+			return hosts[i]
+		}
+	}
+	return nil
+}
+
 func findAsset(assetList []*asset.Asset, name string) *asset.Asset {
 	for i := range assetList {
 		if assetList[i].Name == name {
@@ -0,0 +1,333 @@
+package ansibleinventory
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	groupAll       = "all"
+	groupUngrouped = "ungrouped"
+)
+
+// sourceGroup is a group of an inventory file before variables are resolved
+type sourceGroup struct {
+	hosts    []string
+	children []string
+	vars     map[string]interface{}
+}
+
+// sourceInventory is the content of an INI or YAML inventory file. It is resolved into
+// the same structure as the output of `ansible-inventory --list`.
+type sourceInventory struct {
+	groups   map[string]*sourceGroup
+	hostVars map[string]map[string]interface{}
+}
+
+func newSourceInventory() *sourceInventory {
+	s := &sourceInventory{
+		groups:   map[string]*sourceGroup{},
+		hostVars: map[string]map[string]interface{}{},
+	}
+	s.group(groupAll)
+	s.group(groupUngrouped)
+	return s
+}
+
+// group returns the group with the name, it is created if it does not exist yet
+func (s *sourceInventory) group(name string) *sourceGroup {
+	g, ok := s.groups[name]
+	if !ok {
+		g = &sourceGroup{vars: map[string]interface{}{}}
+		s.groups[name] = g
+	}
+	return g
+}
+
+// addHost adds the host to the group and merges the variables that were defined
+// alongside the host
+func (s *sourceInventory) addHost(group string, host string, vars map[string]interface{}) {
+	g := s.group(group)
+	if !contains(g.hosts, host) {
+		g.hosts = append(g.hosts, host)
+	}
+
+	hv, ok := s.hostVars[host]
+	if !ok {
+		hv = map[string]interface{}{}
+		s.hostVars[host] = hv
+	}
+	mergeVars(hv, vars)
+}
+
+func (s *sourceInventory) addChild(group string, child string) {
+	g := s.group(group)
+	s.group(child)
+	if !contains(g.children, child) {
+		g.children = append(g.children, child)
+	}
+}
+
+// resolve determines the variables of all hosts. Variables are applied in the order of
+// ansible's variable precedence:
+// - inventory vars of the all group
+// - group_vars/all
+// - inventory vars of the host's groups, ordered by depth, priority and name
+// - group_vars of the host's groups
+// - inventory vars of the host
+// - host_vars of the host
+func (s *sourceInventory) resolve(groupVars map[string]map[string]interface{}, hostVars map[string]map[string]interface{}) *Inventory {
+	parents := map[string][]string{}
+	for name, g := range s.groups {
+		for _, child := range g.children {
+			parents[child] = append(parents[child], name)
+		}
+	}
+
+	// hosts that are in no group besides all belong to ungrouped
+	memberships := map[string][]string{}
+	for name, g := range s.groups {
+		if name == groupAll {
+			continue
+		}
+		for _, host := range g.hosts {
+			memberships[host] = append(memberships[host], name)
+		}
+	}
+	for _, host := range s.groups[groupAll].hosts {
+		if len(memberships[host]) == 0 {
+			s.addHost(groupUngrouped, host, nil)
+			memberships[host] = []string{groupUngrouped}
+		}
+	}
+	ungrouped := s.groups[groupUngrouped]
+	hosts := ungrouped.hosts[:0]
+	for _, host := range ungrouped.hosts {
+		if len(memberships[host]) == 1 {
+			hosts = append(hosts, host)
+		}
+	}
+	ungrouped.hosts = hosts
+
+	depths := map[string]int{}
+	for name := range s.groups {
+		groupDepth(name, parents, depths, map[string]bool{})
+	}
+
+	priority := func(name string) int {
+		p := 1
+		for _, vars := range []map[string]interface{}{s.groups[name].vars, groupVars[name]} {
+			if v, ok := vars["ansible_group_priority"]; ok {
+				if i, err := strconv.Atoi(toString(v)); err == nil {
+					p = i
+				}
+			}
+		}
+		return p
+	}
+
+	meta := Meta{HostVars: map[string]map[string]interface{}{}}
+	for host, inlineVars := range s.hostVars {
+		groups := map[string]bool{}
+		for _, name := range memberships[host] {
+			ancestors(name, parents, groups)
+		}
+		delete(groups, groupAll)
+
+		sorted := make([]string, 0, len(groups))
+		for name := range groups {
+			sorted = append(sorted, name)
+		}
+		sort.Slice(sorted, func(i, j int) bool {
+			a, b := sorted[i], sorted[j]
+			if depths[a] != depths[b] {
+				return depths[a] < depths[b]
+			}
+			if pa, pb := priority(a), priority(b); pa != pb {
+				return pa < pb
+			}
+			return a < b
+		})
+
+		vars := map[string]interface{}{}
+		mergeVars(vars, s.groups[groupAll].vars)
+		mergeVars(vars, groupVars[groupAll])
+		for _, name := range sorted {
+			mergeVars(vars, s.groups[name].vars)
+		}
+		for _, name := range sorted {
+			mergeVars(vars, groupVars[name])
+		}
+		mergeVars(vars, inlineVars)
+		mergeVars(vars, hostVars[host])
+		meta.HostVars[host] = vars
+	}
+
+	inventory := &Inventory{
+		Meta:   meta,
+		Groups: Groups{},
+	}
+	for name, g := range s.groups {
+		if name == groupAll {
+			continue
+		}
+		inventory.Groups[name] = Group{
+			Hosts:    g.hosts,
+			Children: g.children,
+		}
+		// groups are children of all, unless they are children of another group
+		topLevel := true
+		for _, parent := range parents[name] {
+			if parent != groupAll {
+				topLevel = false
+			}
+		}
+		if topLevel {
+			inventory.All.Children = append(inventory.All.Children, name)
+		}
+	}
+	sort.Strings(inventory.All.Children)
+
+	return inventory
+}
+
+// groupDepth returns the depth of the group in the group tree, the all group has depth 0
+func groupDepth(name string, parents map[string][]string, depths map[string]int, visiting map[string]bool) int {
+	if d, ok := depths[name]; ok {
+		return d
+	}
+	if name == groupAll {
+		depths[name] = 0
+		return 0
+	}
+	if visiting[name] {
+		// groups with cyclic children are treated as top-level groups
+		return 0
+	}
+	visiting[name] = true
+
+	depth := 1
+	for _, parent := range parents[name] {
+		if d := groupDepth(parent, parents, depths, visiting) + 1; d > depth {
+			depth = d
+		}
+	}
+	depths[name] = depth
+	return depth
+}
+
+// ancestors adds the group and all its parents to res
+func ancestors(name string, parents map[string][]string, res map[string]bool) {
+	if res[name] {
+		return
+	}
+	res[name] = true
+	for _, parent := range parents[name] {
+		ancestors(parent, parents, res)
+	}
+}
+
+// mergeVars sets all variables of src in dst, variables are replaced and not merged
+// which is ansible's default hash behaviour
+func mergeVars(dst map[string]interface{}, src map[string]interface{}) {
+	for k, v := range src {
+		dst[k] = v
+	}
+}
+
+func contains(list []string, s string) bool {
+	for i := range list {
+		if list[i] == s {
+			return true
+		}
+	}
+	return false
+}
+
+// expandHostPattern expands host ranges, e.g. web[01:03].example.com returns the hosts
+// web01.example.com, web02.example.com and web03.example.com. Ranges may be numeric or
+// alphabetic and have an optional stride, e.g. db-[a:f:2].
+func expandHostPattern(pattern string) ([]string, error) {
+	start := strings.Index(pattern, "[")
+	if start < 0 {
+		return []string{pattern}, nil
+	}
+	end := strings.Index(pattern[start:], "]")
+	if end < 0 {
+		return nil, errors.New("invalid host range in " + pattern)
+	}
+	end += start
+
+	prefix := pattern[:start]
+	suffixes, err := expandHostPattern(pattern[end+1:])
+	if err != nil {
+		return nil, err
+	}
+
+	parts := strings.Split(pattern[start+1:end], ":")
+	if len(parts) != 2 && len(parts) != 3 {
+		return nil, errors.New("invalid host range in " + pattern)
+	}
+	beg, last := parts[0], parts[1]
+	if beg == "" {
+		beg = "0"
+	}
+	stride := 1
+	if len(parts) == 3 {
+		stride, err = strconv.Atoi(parts[2])
+		if err != nil || stride <= 0 {
+			return nil, errors.New("invalid stride in host range " + pattern)
+		}
+	}
+
+	var values []string
+	if b, err := strconv.Atoi(beg); err == nil {
+		e, err := strconv.Atoi(last)
+		if err != nil {
+			return nil, errors.New("invalid host range in " + pattern)
+		}
+
+		// leading zeros determine the width of all hosts
+		width := 0
+		if len(beg) > 1 && beg[0] == '0' {
+			if len(beg) != len(last) {
+				return nil, errors.New("host range must specify equal-length begin and end formats in " + pattern)
+			}
+			width = len(beg)
+		}
+		for i := b; i <= e; i += stride {
+			s := strconv.Itoa(i)
+			for len(s) < width {
+				s = "0" + s
+			}
+			values = append(values, s)
+		}
+	} else {
+		if len(beg) != 1 || len(last) != 1 || !isLetter(beg[0]) || !isLetter(last[0]) {
+			return nil, errors.New("invalid host range in " + pattern)
+		}
+		for c := beg[0]; c <= last[0]; c += byte(stride) {
+			values = append(values, string(c))
+			if int(c)+stride > 255 {
+				break
+			}
+		}
+	}
+	if len(values) == 0 {
+		return nil, errors.New("host range does not contain any hosts in " + pattern)
+	}
+
+	res := make([]string, 0, len(values)*len(suffixes))
+	for _, v := range values {
+		for _, suffix := range suffixes {
+			res = append(res, prefix+v+suffix)
+		}
+	}
+	return res, nil
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
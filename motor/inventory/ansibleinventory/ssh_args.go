@@ -0,0 +1,145 @@
+package ansibleinventory
+
+import (
+	"errors"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/kballard/go-shellquote"
+	"go.mondoo.com/cnquery/motor/providers"
+	"go.mondoo.com/cnquery/motor/providers/ssh"
+	"go.mondoo.com/cnquery/motor/vault"
+)
+
+// sshFlagsWithValue are the ssh flags that are followed by a value
+const sshFlagsWithValue = "bcDEeFIiJLlmOopQRSWw"
+
+// parseSSHCommonArgs determines the jump host from ansible_ssh_common_args, e.g.
+// -J admin@bastion, -o ProxyJump=admin@bastion or -o ProxyCommand="ssh -W %h:%p -q admin@bastion".
+// It returns nil if no jump host is configured.
+func parseSSHCommonArgs(args string) (*providers.Config, error) {
+	fields, err := shellquote.Split(args)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < len(fields); i++ {
+		field := fields[i]
+
+		var option string
+		switch {
+		case field == "-J" || field == "-o":
+			if i+1 >= len(fields) {
+				return nil, errors.New("missing value for ssh flag " + field)
+			}
+			i++
+			if field == "-J" {
+				return ssh.ParseProxyJump(fields[i])
+			}
+			option = fields[i]
+		case strings.HasPrefix(field, "-J"):
+			return ssh.ParseProxyJump(field[2:])
+		case strings.HasPrefix(field, "-o"):
+			option = field[2:]
+		default:
+			continue
+		}
+
+		key, value := splitSSHOption(option)
+		switch strings.ToLower(key) {
+		case "proxyjump":
+			return ssh.ParseProxyJump(value)
+		case "proxycommand":
+			return parseProxyCommand(value)
+		}
+	}
+	return nil, nil
+}
+
+// splitSSHOption splits ssh options, they are either passed as Key=Value or Key Value
+func splitSSHOption(option string) (string, string) {
+	option = strings.TrimSpace(option)
+	i := strings.IndexAny(option, "= ")
+	if i < 0 {
+		return option, ""
+	}
+	value := strings.TrimSpace(option[i:])
+	value = strings.TrimSpace(strings.TrimPrefix(value, "="))
+	return option[:i], value
+}
+
+// parseProxyCommand parses proxy commands that forward the connection via ssh,
+// e.g. ssh -W %h:%p -q -p 2222 admin@bastion
+func parseProxyCommand(command string) (*providers.Config, error) {
+	fields, err := shellquote.Split(command)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 || filepath.Base(fields[0]) != "ssh" || !contains(fields, "-W") {
+		return nil, errors.New("only proxy commands that forward via ssh -W are supported: " + command)
+	}
+
+	cfg := &providers.Config{
+		Backend: providers.ProviderType_SSH,
+	}
+
+	var user, identity, destination string
+	for i := 1; i < len(fields); i++ {
+		field := fields[i]
+		if !strings.HasPrefix(field, "-") {
+			destination = field
+			continue
+		}
+		if len(field) != 2 || !strings.ContainsRune(sshFlagsWithValue, rune(field[1])) {
+			continue
+		}
+		if i+1 >= len(fields) {
+			return nil, errors.New("missing value for ssh flag " + field + " in proxy command")
+		}
+		i++
+		value := fields[i]
+
+		switch field[1] {
+		case 'p':
+			port, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, errors.New("invalid port in proxy command: " + value)
+			}
+			cfg.Port = int32(port)
+		case 'l':
+			user = value
+		case 'i':
+			identity = value
+		case 'J':
+			cfg.Bastion, err = ssh.ParseProxyJump(value)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if i := strings.LastIndex(destination, "@"); i >= 0 {
+		user = destination[:i]
+		destination = destination[i+1:]
+	}
+	if destination == "" {
+		return nil, errors.New("missing host in proxy command: " + command)
+	}
+	cfg.Host = destination
+
+	if identity != "" {
+		cfg.AddCredential(&vault.Credential{
+			Type:           vault.CredentialType_private_key,
+			User:           user,
+			PrivateKeyPath: identity,
+		})
+	} else if user != "" {
+		cfg.AddCredential(&vault.Credential{
+			Type: vault.CredentialType_password,
+			User: user,
+		})
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,89 @@
+package ansibleinventory
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/rs/zerolog/log"
+	"sigs.k8s.io/yaml"
+)
+
+var varsFileExtensions = []string{"", ".yml", ".yaml", ".json"}
+
+// loadVarsDir loads the variables of the groups or hosts from a group_vars or host_vars
+// directory. The variables are either stored in a file named like the group or host,
+// e.g. group_vars/webservers.yml, or in a directory with multiple files, e.g.
+// group_vars/webservers/main.yml. Files of a directory are loaded in lexical order.
+func loadVarsDir(dir string, names []string) (map[string]map[string]interface{}, error) {
+	res := map[string]map[string]interface{}{}
+	for _, name := range names {
+		var files []string
+		for _, ext := range varsFileExtensions {
+			path := filepath.Join(dir, name+ext)
+			fi, err := os.Stat(path)
+			if err != nil {
+				continue
+			}
+
+			if !fi.IsDir() {
+				files = append(files, path)
+				continue
+			}
+
+			err = filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
+				if err != nil {
+					return err
+				}
+				if strings.HasPrefix(d.Name(), ".") {
+					if d.IsDir() {
+						return filepath.SkipDir
+					}
+					return nil
+				}
+				if !d.IsDir() && contains(varsFileExtensions, filepath.Ext(d.Name())) {
+					files = append(files, p)
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, file := range files {
+			vars, err := loadVarsFile(file)
+			if err != nil {
+				return nil, err
+			}
+			if vars == nil {
+				continue
+			}
+			if res[name] == nil {
+				res[name] = map[string]interface{}{}
+			}
+			mergeVars(res[name], vars)
+		}
+	}
+	return res, nil
+}
+
+func loadVarsFile(path string) (map[string]interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("$ANSIBLE_VAULT")) {
+		log.Warn().Str("file", path).Msg("ansible> skip vault encrypted variables file")
+		return nil, nil
+	}
+
+	var vars map[string]interface{}
+	if err := yaml.Unmarshal(data, &vars); err != nil {
+		return nil, errors.Wrap(err, "could not parse ansible variables in "+path)
+	}
+	return vars, nil
+}
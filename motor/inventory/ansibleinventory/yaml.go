@@ -0,0 +1,102 @@
+package ansibleinventory
+
+import (
+	"errors"
+
+	"github.com/rs/zerolog/log"
+)
+
+// isListOutput returns true for the output of `ansible-inventory --list`. It lists the
+// hosts and children of groups, while inventory files use maps.
+func isListOutput(raw map[string]interface{}) bool {
+	if _, ok := raw["_meta"]; ok {
+		return true
+	}
+	for _, v := range raw {
+		group, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, key := range []string{"hosts", "children"} {
+			if _, ok := group[key].([]interface{}); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseYAML parses an ansible inventory in the YAML format
+// see https://docs.ansible.com/ansible/latest/inventory_guide/intro_inventory.html
+func parseYAML(raw map[string]interface{}) (*sourceInventory, error) {
+	if _, ok := raw["plugin"]; ok {
+		return nil, errors.New("ansible inventory plugins are not supported, convert the inventory via `ansible-inventory -i inventory.yml --list`")
+	}
+
+	s := newSourceInventory()
+	for name, v := range raw {
+		if err := s.parseYAMLGroup(name, v); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (s *sourceInventory) parseYAMLGroup(name string, v interface{}) error {
+	s.group(name)
+	if v == nil {
+		return nil
+	}
+
+	data, ok := v.(map[string]interface{})
+	if !ok {
+		return errors.New("invalid ansible inventory, group " + name + " is not a map")
+	}
+
+	for key, value := range data {
+		if value == nil {
+			continue
+		}
+
+		switch key {
+		case "hosts":
+			hosts, ok := value.(map[string]interface{})
+			if !ok {
+				return errors.New("invalid ansible inventory, hosts of group " + name + " are not a map")
+			}
+			for pattern, hv := range hosts {
+				vars, ok := hv.(map[string]interface{})
+				if hv != nil && !ok {
+					return errors.New("invalid ansible inventory, variables of host " + pattern + " are not a map")
+				}
+				expanded, err := expandHostPattern(pattern)
+				if err != nil {
+					return err
+				}
+				for _, host := range expanded {
+					s.addHost(name, host, vars)
+				}
+			}
+		case "vars":
+			vars, ok := value.(map[string]interface{})
+			if !ok {
+				return errors.New("invalid ansible inventory, variables of group " + name + " are not a map")
+			}
+			mergeVars(s.group(name).vars, vars)
+		case "children":
+			children, ok := value.(map[string]interface{})
+			if !ok {
+				return errors.New("invalid ansible inventory, children of group " + name + " are not a map")
+			}
+			for child, cv := range children {
+				s.addChild(name, child)
+				if err := s.parseYAMLGroup(child, cv); err != nil {
+					return err
+				}
+			}
+		default:
+			log.Warn().Str("group", name).Str("key", key).Msg("ansible> skip unexpected key in inventory group")
+		}
+	}
+	return nil
+}
@@ -1,12 +1,15 @@
 package v1
 
 import (
+	"context"
 	"os"
 	"path/filepath"
+	"sort"
 
 	"github.com/cockroachdb/errors"
 	"github.com/segmentio/ksuid"
 	asset "go.mondoo.com/cnquery/motor/asset"
+	"go.mondoo.com/cnquery/motor/providers"
 	"go.mondoo.com/cnquery/motor/vault"
 	"google.golang.org/protobuf/proto"
 	"sigs.k8s.io/yaml"
@@ -106,9 +109,9 @@ func (p *Inventory) PreProcess() error {
 		asset := p.Spec.Assets[i]
 
 		for j := range asset.Connections {
-			c := asset.Connections[j]
-			for k := range c.Credentials {
-				cred := c.Credentials[k]
+			creds := connectionCredentials(asset.Connections[j])
+			for k := range creds {
+				cred := creds[k]
 				if cred != nil && cred.SecretId != "" {
 					// clean credentials
 					// if a secret id with content is provided, we discard the content and always prefer the secret id
@@ -173,13 +176,69 @@ func (p *Inventory) PreProcess() error {
 	return nil
 }
 
+// MoveCredentialsToVault stores all credentials of the inventory in the given vault
+// and only keeps references to their secret ids. It returns the ids of all stored
+// secrets. The implementation expects that PreProcess was executed before.
+func (p *Inventory) MoveCredentialsToVault(ctx context.Context, v vault.Vault) ([]string, error) {
+	ids := make([]string, 0, len(p.Spec.Credentials))
+	for k := range p.Spec.Credentials {
+		ids = append(ids, k)
+	}
+	sort.Strings(ids)
+
+	for i := range ids {
+		secret, err := vault.NewSecret(p.Spec.Credentials[ids[i]], vault.SecretEncoding_encoding_json)
+		if err != nil {
+			return nil, err
+		}
+		secret.Label = "cnquery: " + ids[i]
+
+		if _, err := v.Set(ctx, secret); err != nil {
+			return nil, errors.Wrap(err, "could not store credential "+ids[i])
+		}
+	}
+
+	// vaults may return the secrets in a different encoding, therefore
+	// the references determine how stored credentials are decoded
+	for i := range p.Spec.Assets {
+		asset := p.Spec.Assets[i]
+		for j := range asset.Connections {
+			creds := connectionCredentials(asset.Connections[j])
+			for k := range creds {
+				if _, ok := p.Spec.Credentials[creds[k].SecretId]; ok {
+					creds[k].SecretEncoding = vault.SecretEncoding_encoding_json
+				}
+			}
+		}
+	}
+
+	p.Spec.Credentials = nil
+	delete(p.Metadata.Labels, InventoryFilePath)
+	return ids, nil
+}
+
 func (p *Inventory) MarkConnectionsInsecure() {
 	for i := range p.Spec.Assets {
 		asset := p.Spec.Assets[i]
 		for j := range asset.Connections {
-			asset.Connections[j].Insecure = true
+			for c := asset.Connections[j]; c != nil; c = c.Bastion {
+				c.Insecure = true
+			}
+		}
+	}
+}
+
+// connectionCredentials returns the credentials of the connection, including the
+// credentials of its bastions and privilege escalation
+func connectionCredentials(c *providers.Config) []*vault.Credential {
+	res := []*vault.Credential{}
+	for ; c != nil; c = c.Bastion {
+		res = append(res, c.Credentials...)
+		if c.Sudo != nil && c.Sudo.Credential != nil {
+			res = append(res, c.Sudo.Credential)
 		}
 	}
+	return res
 }
 
 func cleanCred(c *vault.Credential) {
@@ -209,9 +268,9 @@ func (p *Inventory) Validate() error {
 	for i := range p.Spec.Assets {
 		a := p.Spec.Assets[i]
 		for j := range a.Connections {
-			conn := a.Connections[j]
-			for k := range conn.Credentials {
-				cred := conn.Credentials[k]
+			creds := connectionCredentials(a.Connections[j])
+			for k := range creds {
+				cred := creds[k]
 				err = isValidCredentialRef(cred)
 				if err != nil {
 					return err
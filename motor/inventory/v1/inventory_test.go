@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -8,6 +9,7 @@ import (
 	"go.mondoo.com/cnquery/motor/asset"
 	"go.mondoo.com/cnquery/motor/providers"
 	"go.mondoo.com/cnquery/motor/vault"
+	"go.mondoo.com/cnquery/motor/vault/keyring"
 )
 
 func TestInventoryParser(t *testing.T) {
@@ -202,6 +204,74 @@ func TestParseSshInventory(t *testing.T) {
 	a = findAsset(inventory.Spec.Assets, "linux-identity-key")
 	require.NotNil(t, a)
 	assert.Equal(t, vault.CredentialType_private_key, inventory.Spec.Credentials[a.Connections[0].Credentials[0].SecretId].Type)
+
+	// the sudo password is moved into the credentials section as well
+	a = findAsset(inventory.Spec.Assets, "linux-with-sudo-password")
+	require.NotNil(t, a)
+	sudo := a.Connections[0].Sudo
+	assert.Equal(t, "sudo", sudo.Method)
+	assert.Empty(t, sudo.Credential.Password)
+	cred := inventory.Spec.Credentials[sudo.Credential.SecretId]
+	assert.Equal(t, vault.CredentialType_password, cred.Type)
+	assert.Equal(t, []byte("sudo-password1!"), cred.Secret)
+}
+
+func TestParseBastionInventory(t *testing.T) {
+	inventory, err := InventoryFromFile("./testdata/bastion_inventory.yaml")
+	require.NoError(t, err)
+	require.NoError(t, inventory.PreProcess())
+	require.NoError(t, inventory.Validate())
+	assert.Len(t, inventory.Spec.Credentials, 3)
+
+	a := findAsset(inventory.Spec.Assets, "linux-via-bastion")
+	require.NotNil(t, a)
+	conn := a.Connections[0]
+	assert.Equal(t, vault.CredentialType_private_key, inventory.Spec.Credentials[conn.Credentials[0].SecretId].Type)
+
+	bastion := conn.Bastion
+	require.NotNil(t, bastion)
+	assert.Equal(t, "bastion.internal", bastion.Host)
+	// embedded bastion credentials are moved into the credentials section as well
+	assert.Empty(t, bastion.Credentials[0].User)
+	assert.Equal(t, vault.CredentialType_ssh_agent, inventory.Spec.Credentials[bastion.Credentials[0].SecretId].Type)
+
+	require.NotNil(t, bastion.Bastion)
+	assert.Equal(t, int32(2222), bastion.Bastion.Port)
+	cred := inventory.Spec.Credentials[bastion.Bastion.Credentials[0].SecretId]
+	assert.Equal(t, vault.CredentialType_password, cred.Type)
+	assert.Equal(t, []byte("password1!"), cred.Secret)
+
+	inventory.MarkConnectionsInsecure()
+	assert.True(t, bastion.Insecure)
+	assert.True(t, bastion.Bastion.Insecure)
+}
+
+func TestMoveCredentialsToVault(t *testing.T) {
+	inventory, err := InventoryFromFile("./testdata/ssh_inventory.yaml")
+	require.NoError(t, err)
+	require.NoError(t, inventory.PreProcess())
+
+	v := keyring.NewEncryptedFile(t.TempDir(), "mondoo", "superpassword")
+	ids, err := inventory.MoveCredentialsToVault(context.Background(), v)
+	require.NoError(t, err)
+	assert.Len(t, ids, 6)
+	assert.Empty(t, inventory.Spec.Credentials)
+	assert.NotContains(t, inventory.Metadata.Labels, InventoryFilePath)
+	require.NoError(t, inventory.Validate())
+
+	a := findAsset(inventory.Spec.Assets, "linux-with-password")
+	require.NotNil(t, a)
+	ref := a.Connections[0].Credentials[0]
+	assert.Equal(t, vault.SecretEncoding_encoding_json, ref.SecretEncoding)
+	assert.Empty(t, ref.Password)
+
+	secret, err := v.Get(context.Background(), &vault.SecretID{Key: ref.SecretId})
+	require.NoError(t, err)
+	cred, err := secret.Credential()
+	require.NoError(t, err)
+	assert.Equal(t, vault.CredentialType_password, cred.Type)
+	assert.Equal(t, "chris", cred.User)
+	assert.Equal(t, []byte("password1!"), cred.Secret)
 }
 
 func TestParseVaultInventory(t *testing.T) {
@@ -11,6 +11,8 @@ import (
 	"go.mondoo.com/cnquery/motor/providers/arista"
 	"go.mondoo.com/cnquery/motor/providers/aws"
 	"go.mondoo.com/cnquery/motor/providers/azure"
+	"go.mondoo.com/cnquery/motor/providers/cloudformation"
+	"go.mondoo.com/cnquery/motor/providers/dockerfile"
 	"go.mondoo.com/cnquery/motor/providers/equinix"
 	"go.mondoo.com/cnquery/motor/providers/gcp"
 	"go.mondoo.com/cnquery/motor/providers/github"
@@ -125,14 +127,13 @@ func (d *Detector) Platform() (*platform.Platform, error) {
 	case *github.Provider:
 		return pt.PlatformInfo()
 	case *gitlab.Provider:
-		return &platform.Platform{
-			Name:    "gitlab",
-			Title:   "GitLab",
-			Kind:    providers.Kind_KIND_API,
-			Runtime: providers.RUNTIME_GITLAB,
-		}, nil
+		return pt.PlatformInfo(), nil
 	case *terraform.Provider:
 		return pt.PlatformInfo(), nil
+	case *dockerfile.Provider:
+		return pt.PlatformInfo(), nil
+	case *cloudformation.Provider:
+		return pt.PlatformInfo(), nil
 	case *network.Provider:
 		return &platform.Platform{
 			Name:    pt.Scheme,
@@ -0,0 +1,15 @@
+package cloudformation
+
+import (
+	"go.mondoo.com/cnquery/motor/platform"
+	"go.mondoo.com/cnquery/motor/providers"
+)
+
+func (p *Provider) PlatformInfo() *platform.Platform {
+	return &platform.Platform{
+		Name:    "cloudformation",
+		Title:   "AWS CloudFormation",
+		Kind:    providers.Kind_KIND_CODE,
+		Runtime: "cloudformation",
+	}
+}
@@ -0,0 +1,30 @@
+package cloudformation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/motor/providers"
+)
+
+func TestProvider(t *testing.T) {
+	p, err := New(&providers.Config{
+		Backend: providers.ProviderType_CLOUDFORMATION,
+		Options: map[string]string{OPTION_PATH: "testdata/bucket.yaml"},
+	})
+	require.NoError(t, err)
+	defer p.Close()
+
+	assert.Equal(t, "testdata/bucket.yaml", p.Path())
+	assert.Equal(t, providers.Kind_KIND_CODE, p.Kind())
+	assert.Equal(t, "cloudformation", p.PlatformInfo().Name)
+	assert.Len(t, p.Template().Resources, 3)
+
+	id, err := p.Identifier()
+	require.NoError(t, err)
+	assert.Contains(t, id, "//platformid.api.mondoo.app/runtime/cloudformation/hash/")
+
+	_, err = New(&providers.Config{Options: map[string]string{OPTION_PATH: "testdata"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,300 @@
+package cloudformation
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// Template is a CloudFormation template. Intrinsic functions are kept as
+// structured values in their JSON form, e.g. the YAML short form !Ref Bucket
+// is {"Ref": "Bucket"} and !GetAtt Bucket.Arn is {"Fn::GetAtt": ["Bucket", "Arn"]}.
+type Template struct {
+	Version     string
+	Description string
+	// Transform are the macros that process the template, e.g. AWS::Serverless-2016-10-31
+	Transform  []string
+	Metadata   map[string]interface{}
+	Mappings   map[string]interface{}
+	Conditions map[string]interface{}
+	Parameters []*Parameter
+	Resources  []*Resource
+	Outputs    []*Output
+	// Raw is the complete template
+	Raw map[string]interface{}
+}
+
+type Parameter struct {
+	Name           string
+	Type           string
+	Description    string
+	Default        interface{}
+	AllowedValues  []interface{}
+	AllowedPattern string
+	NoEcho         bool
+	// Properties are all attributes of the parameter
+	Properties map[string]interface{}
+}
+
+type Resource struct {
+	// Name is the logical id of the resource
+	Name                string
+	Type                string
+	Condition           string
+	DependsOn           []string
+	DeletionPolicy      string
+	UpdateReplacePolicy string
+	Metadata            map[string]interface{}
+	Properties          map[string]interface{}
+}
+
+type Output struct {
+	Name        string
+	Description string
+	Value       interface{}
+	// ExportName is the Name of the Export attribute, it is often an intrinsic function
+	ExportName interface{}
+	Condition  string
+}
+
+// ParseTemplate parses a template in JSON or YAML format
+func ParseTemplate(r io.Reader) (*Template, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw map[string]interface{}
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		err = json.Unmarshal(data, &raw)
+	} else {
+		raw, err = parseYaml(data)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse cloudformation template")
+	}
+	if raw == nil {
+		return nil, errors.New("cloudformation template is empty")
+	}
+	if _, ok := raw["Resources"].(map[string]interface{}); !ok {
+		return nil, errors.New("cloudformation template has no Resources section")
+	}
+
+	tpl := &Template{
+		Version:     str(raw["AWSTemplateFormatVersion"]),
+		Description: str(raw["Description"]),
+		Transform:   strList(raw["Transform"]),
+		Metadata:    dict(raw["Metadata"]),
+		Mappings:    dict(raw["Mappings"]),
+		Conditions:  dict(raw["Conditions"]),
+		Raw:         raw,
+	}
+
+	parameters := dict(raw["Parameters"])
+	for _, name := range sortedKeys(parameters) {
+		p := dict(parameters[name])
+		noEcho, _ := strconv.ParseBool(str(p["NoEcho"]))
+		allowedValues, _ := p["AllowedValues"].([]interface{})
+		tpl.Parameters = append(tpl.Parameters, &Parameter{
+			Name:           name,
+			Type:           str(p["Type"]),
+			Description:    str(p["Description"]),
+			Default:        p["Default"],
+			AllowedValues:  allowedValues,
+			AllowedPattern: str(p["AllowedPattern"]),
+			NoEcho:         noEcho,
+			Properties:     p,
+		})
+	}
+
+	resources := dict(raw["Resources"])
+	for _, name := range sortedKeys(resources) {
+		r := dict(resources[name])
+		tpl.Resources = append(tpl.Resources, &Resource{
+			Name:                name,
+			Type:                str(r["Type"]),
+			Condition:           str(r["Condition"]),
+			DependsOn:           strList(r["DependsOn"]),
+			DeletionPolicy:      str(r["DeletionPolicy"]),
+			UpdateReplacePolicy: str(r["UpdateReplacePolicy"]),
+			Metadata:            dict(r["Metadata"]),
+			Properties:          dict(r["Properties"]),
+		})
+	}
+
+	outputs := dict(raw["Outputs"])
+	for _, name := range sortedKeys(outputs) {
+		o := dict(outputs[name])
+		tpl.Outputs = append(tpl.Outputs, &Output{
+			Name:        name,
+			Description: str(o["Description"]),
+			Value:       o["Value"],
+			ExportName:  dict(o["Export"])["Name"],
+			Condition:   str(o["Condition"]),
+		})
+	}
+
+	return tpl, nil
+}
+
+func parseYaml(data []byte) (map[string]interface{}, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+
+	v, err := yamlValue(doc.Content[0], map[*yaml.Node]bool{})
+	if err != nil {
+		return nil, err
+	}
+	res, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("cloudformation template must be a mapping")
+	}
+	return res, nil
+}
+
+// yamlValue converts a node into the same values as a JSON template. The
+// short form of intrinsic functions, e.g. !Sub, is converted into the full
+// form, e.g. {"Fn::Sub": ...}. Numbers are float64 like in JSON templates.
+// Aliases that are resolved while their anchor is converted refer to themselves.
+func yamlValue(n *yaml.Node, aliases map[*yaml.Node]bool) (interface{}, error) {
+	switch n.Kind {
+	case yaml.AliasNode:
+		if aliases[n] {
+			return nil, errors.New("yaml anchor " + n.Value + " contains itself")
+		}
+		aliases[n] = true
+		defer delete(aliases, n)
+		return yamlValue(n.Alias, aliases)
+	case yaml.DocumentNode:
+		if len(n.Content) == 0 {
+			return nil, nil
+		}
+		return yamlValue(n.Content[0], aliases)
+	}
+
+	var v interface{}
+	switch n.Kind {
+	case yaml.MappingNode:
+		m := make(map[string]interface{}, len(n.Content)/2)
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			value, err := yamlValue(n.Content[i+1], aliases)
+			if err != nil {
+				return nil, err
+			}
+			m[n.Content[i].Value] = value
+		}
+		v = m
+	case yaml.SequenceNode:
+		list := make([]interface{}, len(n.Content))
+		for i := range n.Content {
+			value, err := yamlValue(n.Content[i], aliases)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = value
+		}
+		v = list
+	case yaml.ScalarNode:
+		switch n.ShortTag() {
+		case "!!null":
+			v = nil
+		case "!!bool":
+			var b bool
+			if err := n.Decode(&b); err != nil {
+				return nil, err
+			}
+			v = b
+		case "!!int", "!!float":
+			var f float64
+			if err := n.Decode(&f); err != nil {
+				return nil, err
+			}
+			v = f
+		default:
+			// strings, timestamps like the format version and tagged scalars
+			v = n.Value
+		}
+	}
+
+	tag := n.Tag
+	if !strings.HasPrefix(tag, "!") || strings.HasPrefix(tag, "!!") {
+		return v, nil
+	}
+	fn := strings.TrimPrefix(tag, "!")
+	switch fn {
+	case "Ref", "Condition":
+		return map[string]interface{}{fn: v}, nil
+	case "GetAtt":
+		// !GetAtt Resource.Attribute is the short form of [Resource, Attribute]
+		if s, ok := v.(string); ok {
+			parts := strings.SplitN(s, ".", 2)
+			list := make([]interface{}, len(parts))
+			for i := range parts {
+				list[i] = parts[i]
+			}
+			v = list
+		}
+		return map[string]interface{}{"Fn::GetAtt": v}, nil
+	default:
+		return map[string]interface{}{"Fn::" + fn: v}, nil
+	}
+}
+
+func str(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case bool:
+		return strconv.FormatBool(t)
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
+
+func strList(v interface{}) []string {
+	switch t := v.(type) {
+	case string:
+		return []string{t}
+	case []interface{}:
+		res := []string{}
+		for i := range t {
+			if s, ok := t[i].(string); ok {
+				res = append(res, s)
+			}
+		}
+		return res
+	default:
+		return []string{}
+	}
+}
+
+func dict(v interface{}) map[string]interface{} {
+	if m, ok := v.(map[string]interface{}); ok {
+		return m
+	}
+	return map[string]interface{}{}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
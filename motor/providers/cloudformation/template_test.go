@@ -0,0 +1,97 @@
+package cloudformation
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parseTemplateFile(t *testing.T, filename string) *Template {
+	f, err := os.Open(filename)
+	require.NoError(t, err)
+	defer f.Close()
+
+	tpl, err := ParseTemplate(f)
+	require.NoError(t, err)
+	return tpl
+}
+
+func TestParseTemplate_Yaml(t *testing.T) {
+	tpl := parseTemplateFile(t, "./testdata/bucket.yaml")
+
+	assert.Equal(t, "2010-09-09", tpl.Version)
+	assert.Equal(t, "Bucket with access logging", tpl.Description)
+	assert.Equal(t, []string{"AWS::Serverless-2016-10-31"}, tpl.Transform)
+	assert.Equal(t, map[string]interface{}{
+		"Fn::Equals": []interface{}{map[string]interface{}{"Ref": "Environment"}, "prod"},
+	}, tpl.Conditions["IsProd"])
+
+	t.Run("parameters", func(t *testing.T) {
+		require.Len(t, tpl.Parameters, 3)
+		password := tpl.Parameters[0]
+		assert.Equal(t, "DatabasePassword", password.Name)
+		assert.True(t, password.NoEcho)
+
+		env := tpl.Parameters[1]
+		assert.Equal(t, "Environment", env.Name)
+		assert.Equal(t, "dev", env.Default)
+		assert.Equal(t, []interface{}{"dev", "prod"}, env.AllowedValues)
+
+		assert.Equal(t, float64(30), tpl.Parameters[2].Default)
+	})
+
+	t.Run("resources", func(t *testing.T) {
+		require.Len(t, tpl.Resources, 3)
+		bucket := tpl.Resources[0]
+		assert.Equal(t, "Bucket", bucket.Name)
+		assert.Equal(t, "AWS::S3::Bucket", bucket.Type)
+		assert.Equal(t, "IsProd", bucket.Condition)
+		assert.Equal(t, []string{"LogBucket"}, bucket.DependsOn)
+		assert.Equal(t, map[string]interface{}{"Fn::Sub": "${AWS::StackName}-${Environment}"}, bucket.Properties["BucketName"])
+		assert.Equal(t, map[string]interface{}{"Ref": "LogBucket"}, bucket.Properties["LoggingConfiguration"].(map[string]interface{})["DestinationBucketName"])
+
+		encryption := bucket.Properties["BucketEncryption"].(map[string]interface{})["ServerSideEncryptionConfiguration"].([]interface{})
+		byDefault := encryption[0].(map[string]interface{})["ServerSideEncryptionByDefault"].(map[string]interface{})
+		assert.Equal(t, map[string]interface{}{"Fn::GetAtt": []interface{}{"Key", "Arn"}}, byDefault["KMSMasterKeyID"])
+
+		assert.Equal(t, "Retain", tpl.Resources[2].DeletionPolicy)
+		assert.Equal(t, true, tpl.Resources[1].Properties["EnableKeyRotation"])
+	})
+
+	t.Run("outputs", func(t *testing.T) {
+		require.Len(t, tpl.Outputs, 1)
+		out := tpl.Outputs[0]
+		assert.Equal(t, "BucketArn", out.Name)
+		assert.Equal(t, map[string]interface{}{"Fn::GetAtt": []interface{}{"Bucket", "Arn"}}, out.Value)
+		assert.Equal(t, map[string]interface{}{
+			"Fn::Join": []interface{}{":", []interface{}{map[string]interface{}{"Ref": "AWS::StackName"}, "BucketArn"}},
+		}, out.ExportName)
+	})
+}
+
+func TestParseTemplate_Json(t *testing.T) {
+	tpl := parseTemplateFile(t, "./testdata/bucket.json")
+
+	assert.Equal(t, "2010-09-09", tpl.Version)
+	assert.Equal(t, []string{}, tpl.Transform)
+	require.Len(t, tpl.Parameters, 1)
+	require.Len(t, tpl.Resources, 1)
+	assert.Equal(t, map[string]interface{}{"Fn::Sub": "${AWS::StackName}-${Environment}"}, tpl.Resources[0].Properties["BucketName"])
+	require.Len(t, tpl.Outputs, 1)
+	assert.Equal(t, map[string]interface{}{"Fn::GetAtt": []interface{}{"Bucket", "Arn"}}, tpl.Outputs[0].Value)
+}
+
+func TestParseTemplate_Invalid(t *testing.T) {
+	_, err := ParseTemplate(strings.NewReader("Description: no resources\n"))
+	assert.Error(t, err)
+
+	_, err = ParseTemplate(strings.NewReader(""))
+	assert.Error(t, err)
+
+	// anchors that contain their own alias
+	_, err = ParseTemplate(strings.NewReader("Resources: &loop\n  Bucket:\n    Properties: *loop\n"))
+	assert.EqualError(t, err, "could not parse cloudformation template: yaml anchor loop contains itself")
+}
@@ -45,6 +45,12 @@ func (cfg *Config) ToUrl() string {
 		return "aws-ssm://" + cfg.Host
 	case ProviderType_TAR:
 		return ProviderID_TAR + "://" + cfg.Path
+	case ProviderType_DISK_IMAGE:
+		return ProviderID_DISK_IMAGE + "://" + cfg.Options["path"]
+	case ProviderType_DOCKERFILE:
+		return ProviderID_DOCKERFILE + "://" + cfg.Options["path"]
+	case ProviderType_CLOUDFORMATION:
+		return ProviderID_CLOUDFORMATION + "://" + cfg.Options["path"]
 	case ProviderType_MOCK:
 		return ProviderID_MOCK + "://" + cfg.Path
 	case ProviderType_VSPHERE:
@@ -0,0 +1,139 @@
+package cache
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// LayerCacheEnv overwrites the directory of the layer cache, "off" disables
+	// the persistent cache so that layers are only kept for a single scan
+	LayerCacheEnv = "MONDOO_LAYER_CACHE"
+	// layers that have not been used for that long are removed from the cache
+	defaultLayerMaxAge = 7 * 24 * time.Hour
+)
+
+// LayerCache stores the uncompressed tars of image layers by their diff id, so
+// that images which share base layers don't need to download them again
+type LayerCache struct {
+	dir       string
+	temporary bool
+}
+
+// NewLayerCache opens the layer cache in the directory
+func NewLayerCache(dir string) (*LayerCache, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, errors.Wrap(err, "could not create layer cache")
+	}
+	return &LayerCache{dir: dir}, nil
+}
+
+// DefaultLayerCache opens the layer cache in the user cache directory and removes
+// layers that haven't been used for a week. If no persistent cache is available,
+// a temporary cache is used that is removed on close.
+func DefaultLayerCache() (*LayerCache, error) {
+	dir := os.Getenv(LayerCacheEnv)
+	if dir == "" {
+		userCache, err := os.UserCacheDir()
+		if err == nil {
+			dir = filepath.Join(userCache, "mondoo", "layers")
+		}
+	}
+
+	if dir != "" && dir != "off" {
+		c, err := NewLayerCache(dir)
+		if err == nil {
+			if err := c.Prune(defaultLayerMaxAge); err != nil {
+				log.Debug().Err(err).Str("dir", dir).Msg("could not prune layer cache")
+			}
+			return c, nil
+		}
+		log.Debug().Err(err).Str("dir", dir).Msg("could not open layer cache, fallback to temporary cache")
+	}
+
+	tmp, err := ioutil.TempDir("", "mondoo.layers")
+	if err != nil {
+		return nil, err
+	}
+	return &LayerCache{dir: tmp, temporary: true}, nil
+}
+
+// Layer returns the path to the uncompressed layer tar, the layer is only
+// fetched if it isn't cached yet
+func (c *LayerCache) Layer(layer v1.Layer) (string, error) {
+	diffID, err := layer.DiffID()
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(c.dir, strings.Replace(diffID.String(), ":", "-", 1)+".tar")
+
+	if _, err := os.Stat(path); err == nil {
+		log.Debug().Str("layer", diffID.String()).Msg("use cached layer")
+		// keep the layer in the cache as long as it is used
+		now := time.Now()
+		os.Chtimes(path, now, now)
+		return path, nil
+	}
+
+	log.Debug().Str("layer", diffID.String()).Msg("fetch layer")
+	rc, err := layer.Uncompressed()
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	// write into a temporary file first, so that parallel scans never see partial layers
+	f, err := ioutil.TempFile(c.dir, "layer")
+	if err != nil {
+		return "", err
+	}
+	if _, err = io.Copy(f, rc); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return "", errors.Wrap(err, "could not fetch layer "+diffID.String())
+	}
+	if err = f.Close(); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	if err = os.Rename(f.Name(), path); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return path, nil
+}
+
+// Prune removes all layers that haven't been used within maxAge
+func (c *LayerCache) Prune(maxAge time.Duration) error {
+	entries, err := ioutil.ReadDir(c.dir)
+	if err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(-maxAge)
+	for i := range entries {
+		if entries[i].IsDir() || entries[i].ModTime().After(deadline) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(c.dir, entries[i].Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close removes temporary caches, persistent caches are kept
+func (c *LayerCache) Close() error {
+	if !c.temporary {
+		return nil
+	}
+	return os.RemoveAll(c.dir)
+}
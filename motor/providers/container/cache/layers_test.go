@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLayerCache(t *testing.T) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "etc/hostname", Typeflag: tar.TypeReg, Size: 4, Mode: 0o644}))
+	_, err := tw.Write([]byte("test"))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	data := buf.Bytes()
+
+	opened := 0
+	layer, err := tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		opened++
+		return io.NopCloser(bytes.NewReader(data)), nil
+	})
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	c, err := NewLayerCache(dir)
+	require.NoError(t, err)
+
+	path, err := c.Layer(layer)
+	require.NoError(t, err)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, data, content)
+
+	// the second access uses the cached layer
+	fetched := opened
+	cachedPath, err := c.Layer(layer)
+	require.NoError(t, err)
+	assert.Equal(t, path, cachedPath)
+	assert.Equal(t, fetched, opened)
+
+	// persistent caches are kept on close
+	require.NoError(t, c.Close())
+	_, err = os.Stat(path)
+	require.NoError(t, err)
+
+	// unused layers are pruned
+	old := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, os.Chtimes(path, old, old))
+	require.NoError(t, c.Prune(time.Hour))
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestDefaultLayerCache(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "layers")
+	t.Setenv(LayerCacheEnv, dir)
+	c, err := DefaultLayerCache()
+	require.NoError(t, err)
+	assert.False(t, c.temporary)
+	assert.Equal(t, dir, c.dir)
+
+	t.Setenv(LayerCacheEnv, "off")
+	c, err = DefaultLayerCache()
+	require.NoError(t, err)
+	assert.True(t, c.temporary)
+	require.NoError(t, c.Close())
+	_, err = os.Stat(c.dir)
+	assert.True(t, os.IsNotExist(err))
+}
@@ -33,31 +33,21 @@ func NewContainerRegistryImage(tc *providers.Config) (ContainerProvider, error)
 			registryOpts = append(registryOpts, remoteOpts[i])
 		}
 
-		img, rc, err := image.LoadImageFromRegistry(ref, registryOpts...)
+		img, err := image.LoadImageFromRegistry(ref, registryOpts...)
 		if err != nil {
 			return nil, err
 		}
 
-		var identifier string
-		hash, err := img.Digest()
-		if err == nil {
-			identifier = containerid.MondooContainerImageID(hash.String())
-		}
-
-		transport, err := tar.NewWithReader(rc, nil)
+		transport, err := tar.NewWithImage(img, nil)
 		if err != nil {
 			return nil, err
 		}
-		transport.PlatformIdentifier = identifier
-		transport.Metadata.Name = containerid.ShortContainerImageID(hash.String())
 
-		// set the platform architecture using the image configuration
-		imgConfig, err := img.ConfigFile()
+		hash, err := img.Digest()
 		if err == nil {
-			transport.PlatformArchitecture = imgConfig.Architecture
+			transport.Metadata.Name = containerid.ShortContainerImageID(hash.String())
 		}
-
-		return transport, err
+		return transport, nil
 	}
 	log.Debug().Str("image", tc.Host).Msg("Could not detect a valid repository url")
 	return nil, err
@@ -111,22 +101,15 @@ func NewDockerEngineImage(endpoint *providers.Config) (ContainerProvider, error)
 	}
 
 	log.Debug().Msg("found docker engine image " + ii.ID)
-	img, rc, err := image.LoadImageFromDockerEngine(ii.ID)
+	img, err := image.LoadImageFromDockerEngine(ii.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	var identifier string
-	hash, err := img.Digest()
-	if err == nil {
-		identifier = containerid.MondooContainerImageID(hash.String())
-	}
-
-	p, err := tar.NewWithReader(rc, nil)
+	p, err := tar.NewWithImage(img, nil)
 	if err != nil {
 		return nil, err
 	}
-	p.PlatformIdentifier = identifier
 	p.Metadata.Name = ii.Name
 	p.Metadata.Labels = ii.Labels
 	return p, nil
@@ -0,0 +1,155 @@
+package image
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+)
+
+// AnnotationRefName is the annotation of OCI image layouts that stores the tag of an image
+const AnnotationRefName = "org.opencontainers.image.ref.name"
+
+// ArchiveImage describes an image that is stored in an OCI image layout or a docker archive
+type ArchiveImage struct {
+	// Digest of the image manifest, only available for OCI image layouts
+	Digest v1.Hash
+	// Tags of the image
+	Tags []string
+	// Platform of the image, only available for images of multi-platform indexes
+	Platform *v1.Platform
+}
+
+// ListOCILayoutImages returns all image manifests of an OCI image layout directory,
+// nested indexes of multi-platform images are included.
+func ListOCILayoutImages(path string) ([]ArchiveImage, error) {
+	idx, err := layout.ImageIndexFromPath(path)
+	if err != nil {
+		return nil, err
+	}
+	return listIndexImages(idx, nil)
+}
+
+func listIndexImages(idx v1.ImageIndex, tags []string) ([]ArchiveImage, error) {
+	im, err := idx.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	res := []ArchiveImage{}
+	for i := range im.Manifests {
+		desc := im.Manifests[i]
+
+		descTags := tags
+		if ref, ok := desc.Annotations[AnnotationRefName]; ok && ref != "" {
+			descTags = []string{ref}
+		}
+
+		switch {
+		case desc.MediaType.IsIndex():
+			child, err := idx.ImageIndex(desc.Digest)
+			if err != nil {
+				return nil, err
+			}
+			childImages, err := listIndexImages(child, descTags)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, childImages...)
+		case desc.MediaType.IsImage():
+			res = append(res, ArchiveImage{
+				Digest:   desc.Digest,
+				Tags:     descTags,
+				Platform: desc.Platform,
+			})
+		}
+	}
+	return res, nil
+}
+
+// LoadImageFromOCILayout loads an image from an OCI image layout directory. The digest
+// selects the image manifest and may only be omitted if the layout contains a single image.
+func LoadImageFromOCILayout(path string, digest string) (v1.Image, error) {
+	idx, err := layout.ImageIndexFromPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if digest == "" {
+		images, err := listIndexImages(idx, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(images) != 1 {
+			return nil, fmt.Errorf("oci layout %s contains %d images, please select one by digest", path, len(images))
+		}
+		return findIndexImage(idx, images[0].Digest)
+	}
+
+	h, err := v1.NewHash(digest)
+	if err != nil {
+		return nil, err
+	}
+	return findIndexImage(idx, h)
+}
+
+// findIndexImage searches the image manifest in the index and all nested indexes
+func findIndexImage(idx v1.ImageIndex, h v1.Hash) (v1.Image, error) {
+	im, err := idx.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range im.Manifests {
+		desc := im.Manifests[i]
+		if desc.Digest == h && desc.MediaType.IsImage() {
+			return idx.Image(h)
+		}
+		if desc.MediaType.IsIndex() {
+			child, err := idx.ImageIndex(desc.Digest)
+			if err != nil {
+				return nil, err
+			}
+			img, err := findIndexImage(child, h)
+			if err == nil {
+				return img, nil
+			}
+		}
+	}
+	return nil, errors.New("could not find image " + h.String() + " in oci layout")
+}
+
+// ListDockerArchiveImages returns all images of a `docker save` archive
+func ListDockerArchiveImages(path string) ([]ArchiveImage, error) {
+	m, err := tarball.LoadManifest(func() (io.ReadCloser, error) {
+		return os.Open(path)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]ArchiveImage, len(m))
+	for i := range m {
+		res[i] = ArchiveImage{Tags: m[i].RepoTags}
+	}
+	return res, nil
+}
+
+// LoadImageFromDockerArchive loads an image from a `docker save` archive. The tag selects
+// the image and may only be omitted if the archive contains a single image.
+func LoadImageFromDockerArchive(path string, tag string) (v1.Image, error) {
+	if tag == "" {
+		return tarball.ImageFromPath(path, nil)
+	}
+
+	t, err := name.NewTag(tag, name.WeakValidation)
+	if err != nil {
+		return nil, err
+	}
+	return tarball.ImageFromPath(path, &t)
+}
@@ -1,13 +1,11 @@
 package image
 
 import (
-	"io"
 	"strings"
 
 	"github.com/google/go-containerregistry/pkg/name"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/daemon"
-	"github.com/google/go-containerregistry/pkg/v1/mutate"
 )
 
 type ShaReference struct {
@@ -34,10 +32,6 @@ func (r ShaReference) Scope(scope string) string {
 	return ""
 }
 
-func LoadImageFromDockerEngine(sha string) (v1.Image, io.ReadCloser, error) {
-	img, err := daemon.Image(&ShaReference{SHA: strings.Replace(sha, "sha256:", "", -1)})
-	if err != nil {
-		return nil, nil, err
-	}
-	return img, mutate.Extract(img), nil
+func LoadImageFromDockerEngine(sha string) (v1.Image, error) {
+	return daemon.Image(&ShaReference{SHA: strings.Replace(sha, "sha256:", "", -1)})
 }
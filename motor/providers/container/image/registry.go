@@ -3,7 +3,6 @@ package image
 import (
 	"crypto/tls"
 	"fmt"
-	"io"
 	"net"
 	"net/http"
 	"time"
@@ -11,7 +10,6 @@ import (
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/name"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
-	"github.com/google/go-containerregistry/pkg/v1/mutate"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 )
 
@@ -61,14 +59,14 @@ func GetImageDescriptor(ref name.Reference, opts ...Option) (*remote.Descriptor,
 	return remote.Get(ref, remote.WithAuth(o.auth))
 }
 
-func LoadImageFromRegistry(ref name.Reference, opts ...Option) (v1.Image, io.ReadCloser, error) {
+func LoadImageFromRegistry(ref name.Reference, opts ...Option) (v1.Image, error) {
 	o := &options{
 		insecure: false,
 	}
 
 	for _, option := range opts {
 		if err := option(o); err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 	}
 
@@ -76,7 +74,7 @@ func LoadImageFromRegistry(ref name.Reference, opts ...Option) (v1.Image, io.Rea
 		auth, err := authn.DefaultKeychain.Resolve(ref.Context())
 		if err != nil {
 			fmt.Printf("getting creds for %q: %v", ref, err)
-			return nil, nil, err
+			return nil, err
 		}
 		o.auth = auth
 	}
@@ -102,9 +100,5 @@ func LoadImageFromRegistry(ref name.Reference, opts ...Option) (v1.Image, io.Rea
 		}
 	}
 
-	img, err := remote.Image(ref, remote.WithAuth(o.auth), remote.WithTransport(tr))
-	if err != nil {
-		return nil, nil, err
-	}
-	return img, mutate.Extract(img), nil
+	return remote.Image(ref, remote.WithAuth(o.auth), remote.WithTransport(tr))
 }
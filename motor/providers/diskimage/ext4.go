@@ -0,0 +1,467 @@
+package diskimage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// ext2, ext3 and ext4 share the same on-disk layout, see
+// https://www.kernel.org/doc/html/latest/filesystems/ext4/index.html
+const (
+	ext4Magic          = 0xEF53
+	ext4SuperblockOff  = 1024
+	ext4RootIno        = 2
+	ext4ExtentMagic    = 0xF30A
+	ext4MaxInitExtent  = 32768
+	ext4InlineDataSize = 60
+
+	ext4CompatJournal = 0x4
+
+	ext4IncompatFiletype   = 0x2
+	ext4IncompatMetaBG     = 0x10
+	ext4IncompatExtents    = 0x40
+	ext4Incompat64Bit      = 0x80
+	ext4IncompatFlexBG     = 0x200
+	ext4IncompatInlineData = 0x8000
+	ext4IncompatEncrypt    = 0x10000
+
+	ext4FlagExtents    = 0x80000
+	ext4FlagInlineData = 0x10000000
+	ext4FlagEncrypt    = 0x800
+)
+
+type ext4 struct {
+	dev            io.ReaderAt
+	blockSize      int64
+	inodeSize      int64
+	inodesPerGroup uint32
+	blocksPerGroup uint32
+	firstDataBlock uint32
+	groupCount     uint32
+	descSize       int64
+	compat         uint32
+	incompat       uint32
+	roCompat       uint32
+	firstMetaBG    uint32
+	uuid           string
+	label          string
+
+	descLock sync.Mutex
+	// inode table block of each group, loaded on demand
+	inodeTables map[uint32]uint64
+}
+
+// ext4Inode holds the block pointers of an inode
+type ext4Inode struct {
+	flags   uint32
+	block   [60]byte
+	blocks  uint64
+	fileACL uint64
+}
+
+func newExt4(dev io.ReaderAt) (*ext4, error) {
+	sb := make([]byte, 1024)
+	if _, err := dev.ReadAt(sb, ext4SuperblockOff); err != nil {
+		return nil, err
+	}
+	if binary.LittleEndian.Uint16(sb[56:]) != ext4Magic {
+		return nil, errors.New("no ext filesystem found")
+	}
+
+	// block sizes range from 1KiB to 64KiB
+	logBlockSize := binary.LittleEndian.Uint32(sb[24:])
+	if logBlockSize > 6 {
+		return nil, fmt.Errorf("invalid ext filesystem block size 2^(10+%d)", logBlockSize)
+	}
+
+	fs := &ext4{
+		dev:            dev,
+		blockSize:      1024 << logBlockSize,
+		inodesPerGroup: binary.LittleEndian.Uint32(sb[40:]),
+		blocksPerGroup: binary.LittleEndian.Uint32(sb[32:]),
+		firstDataBlock: binary.LittleEndian.Uint32(sb[20:]),
+		compat:         binary.LittleEndian.Uint32(sb[92:]),
+		incompat:       binary.LittleEndian.Uint32(sb[96:]),
+		roCompat:       binary.LittleEndian.Uint32(sb[100:]),
+		firstMetaBG:    binary.LittleEndian.Uint32(sb[260:]),
+		uuid:           uuidString(sb[104:120]),
+		label:          cString(sb[120:136]),
+		inodeTables:    map[uint32]uint64{},
+		inodeSize:      128,
+		descSize:       32,
+	}
+
+	// revision 0 filesystems have a fixed inode size
+	if binary.LittleEndian.Uint32(sb[76:]) > 0 {
+		fs.inodeSize = int64(binary.LittleEndian.Uint16(sb[88:]))
+	}
+	if fs.incompat&ext4Incompat64Bit != 0 {
+		fs.descSize = int64(binary.LittleEndian.Uint16(sb[254:]))
+	}
+	if fs.incompat&ext4IncompatEncrypt != 0 {
+		return nil, errors.New("encrypted ext4 filesystems are not supported")
+	}
+	// the sizes are used to locate descriptors and inodes, invalid values would let
+	// the offset calculations divide by zero or read beyond the structures
+	if fs.inodeSize < 128 || fs.inodeSize > fs.blockSize || !isPowerOfTwo(fs.inodeSize) {
+		return nil, fmt.Errorf("invalid ext filesystem inode size %d", fs.inodeSize)
+	}
+	if fs.descSize < 32 || fs.descSize > fs.blockSize || !isPowerOfTwo(fs.descSize) {
+		return nil, fmt.Errorf("invalid ext filesystem group descriptor size %d", fs.descSize)
+	}
+
+	blocks := uint64(binary.LittleEndian.Uint32(sb[4:]))
+	if fs.incompat&ext4Incompat64Bit != 0 {
+		blocks |= uint64(binary.LittleEndian.Uint32(sb[336:])) << 32
+	}
+	if fs.blocksPerGroup == 0 || fs.inodesPerGroup == 0 || blocks < uint64(fs.firstDataBlock) {
+		return nil, errors.New("invalid ext filesystem superblock")
+	}
+	fs.groupCount = uint32((blocks - uint64(fs.firstDataBlock) + uint64(fs.blocksPerGroup) - 1) / uint64(fs.blocksPerGroup))
+
+	return fs, nil
+}
+
+func isPowerOfTwo(n int64) bool {
+	return n > 0 && n&(n-1) == 0
+}
+
+// Type returns the name like blkid reports it, based on the features
+func (fs *ext4) Type() string {
+	switch {
+	case fs.incompat&(ext4IncompatExtents|ext4Incompat64Bit|ext4IncompatFlexBG) != 0:
+		return "ext4"
+	case fs.compat&ext4CompatJournal != 0:
+		return "ext3"
+	default:
+		return "ext2"
+	}
+}
+
+func (fs *ext4) UUID() string {
+	return fs.uuid
+}
+
+func (fs *ext4) Label() string {
+	return fs.label
+}
+
+func (fs *ext4) Root() uint64 {
+	return ext4RootIno
+}
+
+// hasSuperblock checks if the group holds a backup of the superblock, which
+// is only the case for group 0, 1 and powers of 3, 5 and 7 with sparse_super
+func (fs *ext4) hasSuperblock(group uint32) bool {
+	const roCompatSparseSuper = 0x1
+	if group <= 1 || fs.roCompat&roCompatSparseSuper == 0 {
+		return true
+	}
+	for _, base := range []uint32{3, 5, 7} {
+		n := base
+		for n < group {
+			n *= base
+		}
+		if n == group {
+			return true
+		}
+	}
+	return false
+}
+
+// descriptorOffset returns the location of the group descriptor
+func (fs *ext4) descriptorOffset(group uint32) int64 {
+	descPerBlock := uint32(fs.blockSize / fs.descSize)
+	metaGroup := group / descPerBlock
+
+	// with meta_bg, the descriptors are stored in the first group of each meta group
+	if fs.incompat&ext4IncompatMetaBG != 0 && metaGroup >= fs.firstMetaBG {
+		first := metaGroup * descPerBlock
+		block := uint64(fs.firstDataBlock) + uint64(first)*uint64(fs.blocksPerGroup)
+		if fs.hasSuperblock(first) {
+			block++
+		}
+		return int64(block)*fs.blockSize + int64(group%descPerBlock)*fs.descSize
+	}
+
+	gdtBlock := int64(fs.firstDataBlock) + 1
+	return gdtBlock*fs.blockSize + int64(group)*fs.descSize
+}
+
+func (fs *ext4) inodeTable(group uint32) (uint64, error) {
+	fs.descLock.Lock()
+	defer fs.descLock.Unlock()
+
+	if table, ok := fs.inodeTables[group]; ok {
+		return table, nil
+	}
+
+	desc := make([]byte, fs.descSize)
+	if _, err := fs.dev.ReadAt(desc, fs.descriptorOffset(group)); err != nil {
+		return 0, err
+	}
+	table := uint64(binary.LittleEndian.Uint32(desc[8:]))
+	if fs.descSize >= 64 {
+		table |= uint64(binary.LittleEndian.Uint32(desc[0x28:])) << 32
+	}
+	fs.inodeTables[group] = table
+	return table, nil
+}
+
+func (fs *ext4) Inode(ino uint64) (*inode, error) {
+	if ino == 0 {
+		return nil, errors.New("invalid inode 0")
+	}
+	group := uint32((ino - 1) / uint64(fs.inodesPerGroup))
+	index := int64((ino - 1) % uint64(fs.inodesPerGroup))
+	if group >= fs.groupCount {
+		return nil, fmt.Errorf("inode %d is out of range", ino)
+	}
+
+	table, err := fs.inodeTable(group)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, fs.inodeSize)
+	if _, err := fs.dev.ReadAt(buf, int64(table)*fs.blockSize+index*fs.inodeSize); err != nil {
+		return nil, err
+	}
+
+	mode := binary.LittleEndian.Uint16(buf[0:])
+	size := uint64(binary.LittleEndian.Uint32(buf[4:])) | uint64(binary.LittleEndian.Uint32(buf[108:]))<<32
+	uid := uint32(binary.LittleEndian.Uint16(buf[2:])) | uint32(binary.LittleEndian.Uint16(buf[120:]))<<16
+	gid := uint32(binary.LittleEndian.Uint16(buf[24:])) | uint32(binary.LittleEndian.Uint16(buf[122:]))<<16
+
+	data := &ext4Inode{
+		flags:   binary.LittleEndian.Uint32(buf[32:]),
+		blocks:  uint64(binary.LittleEndian.Uint32(buf[28:])) | uint64(binary.LittleEndian.Uint16(buf[116:]))<<32,
+		fileACL: uint64(binary.LittleEndian.Uint32(buf[104:])) | uint64(binary.LittleEndian.Uint16(buf[118:]))<<32,
+	}
+	copy(data.block[:], buf[40:100])
+
+	return &inode{
+		ino:   ino,
+		mode:  unixMode(uint32(mode)),
+		size:  int64(size),
+		uid:   int64(uid),
+		gid:   int64(gid),
+		mtime: time.Unix(int64(int32(binary.LittleEndian.Uint32(buf[16:]))), 0),
+		data:  data,
+	}, nil
+}
+
+// extents returns the block mapping of the inode
+func (fs *ext4) extents(n *inode) ([]extent, error) {
+	data := n.data.(*ext4Inode)
+	if data.flags&ext4FlagExtents != 0 {
+		res := []extent{}
+		err := fs.walkExtentTree(data.block[:], &res, 0)
+		return res, err
+	}
+	return fs.blockMap(data)
+}
+
+func (fs *ext4) walkExtentTree(node []byte, res *[]extent, level int) error {
+	if level > 5 {
+		return errors.New("ext4 extent tree is too deep")
+	}
+	if binary.LittleEndian.Uint16(node[0:]) != ext4ExtentMagic {
+		return errors.New("invalid ext4 extent header")
+	}
+	entries := int(binary.LittleEndian.Uint16(node[2:]))
+	depth := binary.LittleEndian.Uint16(node[6:])
+	if 12+entries*12 > len(node) {
+		return errors.New("invalid ext4 extent node")
+	}
+
+	for i := 0; i < entries; i++ {
+		e := node[12+i*12:]
+		if depth == 0 {
+			length := uint64(binary.LittleEndian.Uint16(e[4:]))
+			uninit := false
+			if length > ext4MaxInitExtent {
+				length -= ext4MaxInitExtent
+				uninit = true
+			}
+			*res = append(*res, extent{
+				logical:  uint64(binary.LittleEndian.Uint32(e[0:])),
+				physical: uint64(binary.LittleEndian.Uint16(e[6:]))<<32 | uint64(binary.LittleEndian.Uint32(e[8:])),
+				length:   length,
+				zero:     uninit,
+			})
+			continue
+		}
+
+		leaf := uint64(binary.LittleEndian.Uint32(e[4:])) | uint64(binary.LittleEndian.Uint16(e[8:]))<<32
+		child := make([]byte, fs.blockSize)
+		if _, err := fs.dev.ReadAt(child, int64(leaf)*fs.blockSize); err != nil {
+			return err
+		}
+		if err := fs.walkExtentTree(child, res, level+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// blockMap resolves the direct and indirect blocks of ext2/ext3 inodes
+func (fs *ext4) blockMap(data *ext4Inode) ([]extent, error) {
+	res := []extent{}
+	var logical uint64
+
+	add := func(physical uint64) {
+		if physical != 0 {
+			last := len(res) - 1
+			if last >= 0 && res[last].logical+res[last].length == logical && res[last].physical+res[last].length == physical {
+				res[last].length++
+			} else {
+				res = append(res, extent{logical: logical, physical: physical, length: 1})
+			}
+		}
+		logical++
+	}
+
+	perBlock := uint64(fs.blockSize / 4)
+	var walk func(block uint64, level int) error
+	walk = func(block uint64, level int) error {
+		if block == 0 {
+			// sparse indirect block, skip all blocks it would map
+			n := uint64(1)
+			for i := 0; i < level; i++ {
+				n *= perBlock
+			}
+			logical += n
+			return nil
+		}
+		buf := make([]byte, fs.blockSize)
+		if _, err := fs.dev.ReadAt(buf, int64(block)*fs.blockSize); err != nil {
+			return err
+		}
+		for i := uint64(0); i < perBlock; i++ {
+			ptr := uint64(binary.LittleEndian.Uint32(buf[i*4:]))
+			if level == 1 {
+				add(ptr)
+				continue
+			}
+			if err := walk(ptr, level-1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for i := 0; i < 12; i++ {
+		add(uint64(binary.LittleEndian.Uint32(data.block[i*4:])))
+	}
+	for level := 1; level <= 3; level++ {
+		if err := walk(uint64(binary.LittleEndian.Uint32(data.block[(11+level)*4:])), level); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (fs *ext4) Open(n *inode) (io.ReaderAt, error) {
+	data := n.data.(*ext4Inode)
+	if data.flags&ext4FlagEncrypt != 0 {
+		return nil, errors.New("encrypted files are not supported")
+	}
+	if data.flags&ext4FlagInlineData != 0 {
+		// only the part of the inline data that is stored in the inode is supported
+		size := n.size
+		if size > ext4InlineDataSize {
+			return nil, errors.New("inline data stored in extended attributes is not supported")
+		}
+		return bytes.NewReader(data.block[:size]), nil
+	}
+
+	extents, err := fs.extents(n)
+	if err != nil {
+		return nil, err
+	}
+	return newExtentReader(fs.dev, fs.blockSize, n.size, extents), nil
+}
+
+func (fs *ext4) Readlink(n *inode) (string, error) {
+	data := n.data.(*ext4Inode)
+
+	// fast symlinks store the target in the block pointers
+	eaBlocks := uint64(0)
+	if data.fileACL != 0 {
+		eaBlocks = uint64(fs.blockSize / 512)
+	}
+	if n.size < ext4InlineDataSize && data.blocks == eaBlocks || data.flags&ext4FlagInlineData != 0 {
+		if n.size > ext4InlineDataSize {
+			return "", errors.New("invalid symlink size")
+		}
+		return string(data.block[:n.size]), nil
+	}
+
+	r, err := fs.Open(n)
+	if err != nil {
+		return "", err
+	}
+	buf := make([]byte, n.size)
+	if _, err := r.ReadAt(buf, 0); err != nil && err != io.EOF {
+		return "", err
+	}
+	return string(buf), nil
+}
+
+func (fs *ext4) ReadDir(n *inode) ([]dirEntry, error) {
+	data := n.data.(*ext4Inode)
+	if data.flags&ext4FlagEncrypt != 0 {
+		return nil, errors.New("encrypted directories are not supported")
+	}
+
+	// inline directories start with the inode of the parent directory
+	if data.flags&ext4FlagInlineData != 0 {
+		return fs.parseDirBlock(data.block[4:]), nil
+	}
+
+	r, err := fs.Open(n)
+	if err != nil {
+		return nil, err
+	}
+
+	// hash tree directories are read linearly, their index blocks look like empty blocks
+	res := []dirEntry{}
+	buf := make([]byte, fs.blockSize)
+	for off := int64(0); off < n.size; off += fs.blockSize {
+		if _, err := r.ReadAt(buf, off); err != nil && err != io.EOF {
+			return nil, err
+		}
+		res = append(res, fs.parseDirBlock(buf)...)
+	}
+	return res, nil
+}
+
+func (fs *ext4) parseDirBlock(buf []byte) []dirEntry {
+	res := []dirEntry{}
+	for pos := 0; pos+8 <= len(buf); {
+		ino := binary.LittleEndian.Uint32(buf[pos:])
+		recLen := int(binary.LittleEndian.Uint16(buf[pos+4:]))
+		nameLen := int(buf[pos+6])
+		if fs.incompat&ext4IncompatFiletype == 0 {
+			nameLen = int(binary.LittleEndian.Uint16(buf[pos+6:]))
+		}
+		if recLen < 8 || pos+recLen > len(buf) || 8+nameLen > recLen {
+			break
+		}
+
+		if ino != 0 && nameLen > 0 {
+			name := string(buf[pos+8 : pos+8+nameLen])
+			if name != "." && name != ".." {
+				res = append(res, dirEntry{name: name, ino: uint64(ino)})
+			}
+		}
+		pos += recLen
+	}
+	return res
+}
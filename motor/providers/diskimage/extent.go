@@ -0,0 +1,101 @@
+package diskimage
+
+import (
+	"io"
+	"sort"
+)
+
+// extent maps a range of logical blocks of a file to the physical blocks of
+// the device, sparse and unwritten extents are read as zeros
+type extent struct {
+	logical  uint64
+	physical uint64
+	length   uint64
+	zero     bool
+}
+
+// extentReader reads the content of a file that is stored in extents
+type extentReader struct {
+	dev       io.ReaderAt
+	blockSize int64
+	size      int64
+	// extents are sorted by their logical block
+	extents []extent
+}
+
+func newExtentReader(dev io.ReaderAt, blockSize int64, size int64, extents []extent) *extentReader {
+	sort.Slice(extents, func(i, j int) bool {
+		return extents[i].logical < extents[j].logical
+	})
+	return &extentReader{
+		dev:       dev,
+		blockSize: blockSize,
+		size:      size,
+		extents:   extents,
+	}
+}
+
+// find returns the extent that contains the logical block or the next extent after it
+func (r *extentReader) find(block uint64) int {
+	return sort.Search(len(r.extents), func(i int) bool {
+		return r.extents[i].logical+r.extents[i].length > block
+	})
+}
+
+func (r *extentReader) ReadAt(p []byte, off int64) (int, error) {
+	if off >= r.size {
+		return 0, io.EOF
+	}
+
+	var err error
+	if remaining := r.size - off; int64(len(p)) > remaining {
+		p = p[:remaining]
+		err = io.EOF
+	}
+
+	n := 0
+	for n < len(p) {
+		pos := off + int64(n)
+		block := uint64(pos / r.blockSize)
+		inBlock := pos % r.blockSize
+
+		i := r.find(block)
+		if i == len(r.extents) || r.extents[i].logical > block {
+			// hole in the file, fill it up to the next extent
+			end := int64(len(p))
+			if i < len(r.extents) {
+				next := int64(r.extents[i].logical)*r.blockSize - off
+				if next < end {
+					end = next
+				}
+			}
+			zero(p[n:end])
+			n = int(end)
+			continue
+		}
+
+		e := r.extents[i]
+		chunk := int64(e.logical+e.length-block)*r.blockSize - inBlock
+		if chunk > int64(len(p)-n) {
+			chunk = int64(len(p) - n)
+		}
+
+		if e.zero {
+			zero(p[n : int64(n)+chunk])
+		} else {
+			devOff := int64(e.physical+(block-e.logical))*r.blockSize + inBlock
+			read, rerr := r.dev.ReadAt(p[n:int64(n)+chunk], devOff)
+			if rerr != nil && !(rerr == io.EOF && int64(read) == chunk) {
+				return n + read, rerr
+			}
+		}
+		n += int(chunk)
+	}
+	return n, err
+}
+
+func zero(p []byte) {
+	for i := range p {
+		p[i] = 0
+	}
+}
@@ -0,0 +1,390 @@
+package diskimage
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf16"
+)
+
+const (
+	fatRootIno = 1
+
+	fatAttrDir    = 0x10
+	fatAttrVolume = 0x08
+	fatAttrLFN    = 0x0f
+
+	fatLowerBase = 0x08
+	fatLowerExt  = 0x10
+)
+
+// fat implements FAT12, FAT16 and FAT32 as used for EFI system partitions.
+// FAT has no inodes, the inode number of a file is the offset of its
+// directory entry on the device.
+type fat struct {
+	dev           io.ReaderAt
+	bits          int
+	sectorSize    int64
+	clusterSize   int64
+	secPerCluster uint64
+	fatOffset     int64
+	fatSize       int64
+	dataSector    uint64
+	clusters      uint32
+	rootSector    uint64
+	rootEntries   uint64
+	rootCluster   uint32
+	uuid          string
+	label         string
+
+	once  sync.Once
+	table []byte
+	err   error
+}
+
+type fatInode struct {
+	cluster uint32
+}
+
+// isFAT checks for a valid FAT boot sector
+func isFAT(buf []byte) bool {
+	if len(buf) < 512 || buf[510] != 0x55 || buf[511] != 0xaa {
+		return false
+	}
+	if buf[0] != 0xeb && buf[0] != 0xe9 {
+		return false
+	}
+	sectorSize := binary.LittleEndian.Uint16(buf[11:])
+	secPerCluster := buf[13]
+	if sectorSize < 512 || sectorSize > 4096 || sectorSize&(sectorSize-1) != 0 {
+		return false
+	}
+	if secPerCluster == 0 || secPerCluster&(secPerCluster-1) != 0 {
+		return false
+	}
+	return buf[16] != 0 && binary.LittleEndian.Uint16(buf[14:]) != 0
+}
+
+func newFAT(dev io.ReaderAt, size int64) (*fat, error) {
+	bs := make([]byte, 512)
+	if _, err := dev.ReadAt(bs, 0); err != nil {
+		return nil, err
+	}
+	if !isFAT(bs) {
+		return nil, errors.New("no fat filesystem found")
+	}
+
+	sectorSize := uint64(binary.LittleEndian.Uint16(bs[11:]))
+	secPerCluster := uint64(bs[13])
+	reserved := uint64(binary.LittleEndian.Uint16(bs[14:]))
+	numFATs := uint64(bs[16])
+	rootEntries := uint64(binary.LittleEndian.Uint16(bs[17:]))
+	totalSectors := uint64(binary.LittleEndian.Uint16(bs[19:]))
+	if totalSectors == 0 {
+		totalSectors = uint64(binary.LittleEndian.Uint32(bs[32:]))
+	}
+	fatSize := uint64(binary.LittleEndian.Uint16(bs[22:]))
+	if fatSize == 0 {
+		fatSize = uint64(binary.LittleEndian.Uint32(bs[36:]))
+	}
+
+	rootSectors := (rootEntries*32 + sectorSize - 1) / sectorSize
+	dataSector := reserved + numFATs*fatSize + rootSectors
+	if totalSectors <= dataSector || (size > 0 && totalSectors*sectorSize > uint64(size)) {
+		return nil, errors.New("invalid fat boot sector")
+	}
+	clusters := (totalSectors - dataSector) / secPerCluster
+
+	fs := &fat{
+		dev:           dev,
+		sectorSize:    int64(sectorSize),
+		clusterSize:   int64(sectorSize * secPerCluster),
+		secPerCluster: secPerCluster,
+		fatOffset:     int64(reserved * sectorSize),
+		fatSize:       int64(fatSize * sectorSize),
+		dataSector:    dataSector,
+		clusters:      uint32(clusters),
+		rootSector:    reserved + numFATs*fatSize,
+		rootEntries:   rootEntries,
+	}
+
+	var serial []byte
+	switch {
+	case clusters < 4085:
+		fs.bits = 12
+	case clusters < 65525:
+		fs.bits = 16
+	default:
+		fs.bits = 32
+	}
+	if fs.bits == 32 {
+		fs.rootCluster = binary.LittleEndian.Uint32(bs[44:])
+		serial = bs[67:71]
+		fs.label = bs2label(bs[71:82])
+	} else {
+		serial = bs[39:43]
+		fs.label = bs2label(bs[43:54])
+	}
+	fs.uuid = fmt.Sprintf("%02X%02X-%02X%02X", serial[3], serial[2], serial[1], serial[0])
+	return fs, nil
+}
+
+func bs2label(b []byte) string {
+	label := strings.TrimRight(string(b), " ")
+	if label == "NO NAME" {
+		return ""
+	}
+	return label
+}
+
+func (fs *fat) Type() string {
+	return "vfat"
+}
+
+func (fs *fat) UUID() string {
+	return fs.uuid
+}
+
+func (fs *fat) Label() string {
+	return fs.label
+}
+
+func (fs *fat) Root() uint64 {
+	return fatRootIno
+}
+
+// next returns the next cluster of the chain, the allocation table is
+// loaded on first use
+func (fs *fat) next(cluster uint32) (uint32, bool, error) {
+	fs.once.Do(func() {
+		fs.table = make([]byte, fs.fatSize)
+		_, fs.err = fs.dev.ReadAt(fs.table, fs.fatOffset)
+	})
+	if fs.err != nil {
+		return 0, false, fs.err
+	}
+
+	var next, eoc uint32
+	switch fs.bits {
+	case 12:
+		off := int(cluster) + int(cluster)/2
+		if off+2 > len(fs.table) {
+			return 0, false, errors.New("invalid fat cluster")
+		}
+		v := uint32(binary.LittleEndian.Uint16(fs.table[off:]))
+		if cluster&1 == 1 {
+			next = v >> 4
+		} else {
+			next = v & 0xfff
+		}
+		eoc = 0xff8
+	case 16:
+		off := int(cluster) * 2
+		if off+2 > len(fs.table) {
+			return 0, false, errors.New("invalid fat cluster")
+		}
+		next = uint32(binary.LittleEndian.Uint16(fs.table[off:]))
+		eoc = 0xfff8
+	default:
+		off := int(cluster) * 4
+		if off+4 > len(fs.table) {
+			return 0, false, errors.New("invalid fat cluster")
+		}
+		next = binary.LittleEndian.Uint32(fs.table[off:]) & 0x0fffffff
+		eoc = 0x0ffffff8
+	}
+	return next, next >= 2 && next < eoc, nil
+}
+
+// chain follows the cluster chain and returns the extents in sectors
+func (fs *fat) chain(cluster uint32) ([]extent, error) {
+	res := []extent{}
+	if cluster < 2 {
+		return res, nil
+	}
+
+	var logical uint64
+	for i := uint32(0); ; i++ {
+		if i > fs.clusters || cluster-2 >= fs.clusters {
+			return nil, errors.New("invalid fat cluster chain")
+		}
+		physical := fs.dataSector + uint64(cluster-2)*fs.secPerCluster
+		if l := len(res); l > 0 && res[l-1].physical+res[l-1].length == physical {
+			res[l-1].length += fs.secPerCluster
+		} else {
+			res = append(res, extent{logical: logical, physical: physical, length: fs.secPerCluster})
+		}
+		logical += fs.secPerCluster
+
+		next, ok, err := fs.next(cluster)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return res, nil
+		}
+		cluster = next
+	}
+}
+
+func (fs *fat) Inode(ino uint64) (*inode, error) {
+	if ino == fatRootIno {
+		return &inode{
+			ino:  ino,
+			mode: os.ModeDir | 0o755,
+			data: &fatInode{cluster: fs.rootCluster},
+		}, nil
+	}
+
+	buf := make([]byte, 32)
+	if _, err := fs.dev.ReadAt(buf, int64(ino)); err != nil {
+		return nil, err
+	}
+
+	attr := buf[11]
+	cluster := uint32(binary.LittleEndian.Uint16(buf[20:]))<<16 | uint32(binary.LittleEndian.Uint16(buf[26:]))
+	n := &inode{
+		ino:   ino,
+		mode:  0o644,
+		size:  int64(binary.LittleEndian.Uint32(buf[28:])),
+		mtime: fatTime(binary.LittleEndian.Uint16(buf[24:]), binary.LittleEndian.Uint16(buf[22:])),
+		data:  &fatInode{cluster: cluster},
+	}
+	if attr&fatAttrDir != 0 {
+		n.mode = os.ModeDir | 0o755
+		n.size = 0
+	}
+	return n, nil
+}
+
+func fatTime(date uint16, t uint16) time.Time {
+	return time.Date(1980+int(date>>9), time.Month(date>>5&0xf), int(date&0x1f),
+		int(t>>11), int(t>>5&0x3f), int(t&0x1f)*2, 0, time.UTC)
+}
+
+func (fs *fat) Open(n *inode) (io.ReaderAt, error) {
+	extents, err := fs.chain(n.data.(*fatInode).cluster)
+	if err != nil {
+		return nil, err
+	}
+	return newExtentReader(fs.dev, fs.sectorSize, n.size, extents), nil
+}
+
+func (fs *fat) Readlink(n *inode) (string, error) {
+	return "", errors.New("fat does not support symlinks")
+}
+
+func (fs *fat) ReadDir(n *inode) ([]dirEntry, error) {
+	var extents []extent
+	if n.ino == fatRootIno && fs.bits != 32 {
+		// FAT12 and FAT16 store the root directory in a fixed region
+		extents = []extent{{physical: fs.rootSector, length: (fs.rootEntries*32 + uint64(fs.sectorSize) - 1) / uint64(fs.sectorSize)}}
+	} else {
+		var err error
+		extents, err = fs.chain(n.data.(*fatInode).cluster)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := []dirEntry{}
+	var lfn []uint16
+	var lfnChecksum byte
+	for _, e := range extents {
+		buf := make([]byte, int64(e.length)*fs.sectorSize)
+		base := int64(e.physical) * fs.sectorSize
+		if _, err := fs.dev.ReadAt(buf, base); err != nil {
+			return nil, err
+		}
+
+		for pos := 0; pos+32 <= len(buf); pos += 32 {
+			entry := buf[pos : pos+32]
+			switch {
+			case entry[0] == 0:
+				return res, nil
+			case entry[0] == 0xe5:
+				lfn = nil
+				continue
+			case entry[11]&0x3f == fatAttrLFN:
+				seq := int(entry[0] & 0x1f)
+				if entry[0]&0x40 != 0 {
+					lfn = make([]uint16, seq*13)
+					lfnChecksum = entry[13]
+				}
+				if seq == 0 || seq*13 > len(lfn) || entry[13] != lfnChecksum {
+					lfn = nil
+					continue
+				}
+				chars := lfn[(seq-1)*13:]
+				for i := 0; i < 5; i++ {
+					chars[i] = binary.LittleEndian.Uint16(entry[1+i*2:])
+				}
+				for i := 0; i < 6; i++ {
+					chars[5+i] = binary.LittleEndian.Uint16(entry[14+i*2:])
+				}
+				for i := 0; i < 2; i++ {
+					chars[11+i] = binary.LittleEndian.Uint16(entry[28+i*2:])
+				}
+				continue
+			case entry[11]&fatAttrVolume != 0:
+				lfn = nil
+				continue
+			}
+
+			name := shortName(entry)
+			if lfn != nil && lfnChecksum == shortNameChecksum(entry[0:11]) {
+				name = longName(lfn)
+			}
+			lfn = nil
+
+			if name == "." || name == ".." {
+				continue
+			}
+			res = append(res, dirEntry{name: name, ino: uint64(base + int64(pos))})
+		}
+	}
+	return res, nil
+}
+
+func shortName(entry []byte) string {
+	raw := make([]byte, 11)
+	copy(raw, entry[0:11])
+	if raw[0] == 0x05 {
+		raw[0] = 0xe5
+	}
+	base := strings.TrimRight(string(raw[0:8]), " ")
+	ext := strings.TrimRight(string(raw[8:11]), " ")
+	if entry[12]&fatLowerBase != 0 {
+		base = strings.ToLower(base)
+	}
+	if entry[12]&fatLowerExt != 0 {
+		ext = strings.ToLower(ext)
+	}
+	if ext == "" {
+		return base
+	}
+	return base + "." + ext
+}
+
+func shortNameChecksum(name []byte) byte {
+	var sum byte
+	for _, c := range name {
+		sum = (sum>>1 | sum<<7) + c
+	}
+	return sum
+}
+
+func longName(chars []uint16) string {
+	for i, c := range chars {
+		if c == 0 {
+			chars = chars[:i]
+			break
+		}
+	}
+	return string(utf16.Decode(chars))
+}
@@ -0,0 +1,118 @@
+package diskimage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"time"
+)
+
+var errUnsupportedFilesystem = errors.New("unsupported filesystem")
+
+// filesystem is a read-only filesystem stored on a partition of the disk image
+type filesystem interface {
+	// Type returns the name of the filesystem, e.g. ext4
+	Type() string
+	// UUID of the filesystem as it is referenced in /etc/fstab
+	UUID() string
+	// Label of the filesystem
+	Label() string
+	// Root returns the inode number of the root directory
+	Root() uint64
+	// Inode loads the metadata of an inode
+	Inode(ino uint64) (*inode, error)
+	// ReadDir returns all entries of a directory, except . and ..
+	ReadDir(n *inode) ([]dirEntry, error)
+	// Readlink returns the target of a symlink
+	Readlink(n *inode) (string, error)
+	// Open returns a reader for the content of a regular file
+	Open(n *inode) (io.ReaderAt, error)
+}
+
+// inode holds the metadata of a file, the filesystem specific data is
+// stored in data
+type inode struct {
+	ino   uint64
+	mode  os.FileMode
+	size  int64
+	uid   int64
+	gid   int64
+	mtime time.Time
+	data  interface{}
+}
+
+type dirEntry struct {
+	name string
+	ino  uint64
+}
+
+// unixMode converts the file mode of an inode into the go representation
+func unixMode(mode uint32) os.FileMode {
+	m := os.FileMode(mode & 0o777)
+	switch mode & 0o170000 {
+	case 0o040000:
+		m |= os.ModeDir
+	case 0o120000:
+		m |= os.ModeSymlink
+	case 0o020000:
+		m |= os.ModeDevice | os.ModeCharDevice
+	case 0o060000:
+		m |= os.ModeDevice
+	case 0o010000:
+		m |= os.ModeNamedPipe
+	case 0o140000:
+		m |= os.ModeSocket
+	}
+	if mode&0o4000 != 0 {
+		m |= os.ModeSetuid
+	}
+	if mode&0o2000 != 0 {
+		m |= os.ModeSetgid
+	}
+	if mode&0o1000 != 0 {
+		m |= os.ModeSticky
+	}
+	return m
+}
+
+// openFilesystem detects the filesystem of the partition
+func openFilesystem(dev io.ReaderAt, size int64) (filesystem, error) {
+	buf := make([]byte, 2048)
+	n, err := dev.ReadAt(buf, 0)
+	if err != nil && n < len(buf) {
+		return nil, err
+	}
+
+	switch {
+	case binary.LittleEndian.Uint16(buf[1024+56:]) == ext4Magic:
+		return newExt4(dev)
+	case bytes.Equal(buf[0:4], []byte(xfsMagic)):
+		return newXFS(dev)
+	case isFAT(buf):
+		return newFAT(dev, size)
+	}
+	return nil, errUnsupportedFilesystem
+}
+
+// uuidString formats the bytes like blkid does
+func uuidString(b []byte) string {
+	const hex = "0123456789abcdef"
+	res := make([]byte, 0, 36)
+	for i := range b {
+		if i == 4 || i == 6 || i == 8 || i == 10 {
+			res = append(res, '-')
+		}
+		res = append(res, hex[b[i]>>4], hex[b[i]&0xf])
+	}
+	return string(res)
+}
+
+// cString returns the string up to the first null byte
+func cString(b []byte) string {
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		b = b[:i]
+	}
+	return string(b)
+}
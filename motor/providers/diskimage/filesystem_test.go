@@ -0,0 +1,439 @@
+package diskimage
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadTestImage(t *testing.T, name string) []byte {
+	f, err := os.Open("testdata/" + name)
+	require.NoError(t, err)
+	defer f.Close()
+	r, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return data
+}
+
+func openTestFS(t *testing.T, data []byte) (filesystem, *FS) {
+	fsys, err := openFilesystem(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	return fsys, newFS(fsys)
+}
+
+func testContent(size int) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+	return data
+}
+
+func TestExt4(t *testing.T) {
+	fsys, fs := openTestFS(t, loadTestImage(t, "ext4.img.gz"))
+	assert.Equal(t, "ext4", fsys.Type())
+	assert.Equal(t, "2f1e8c1a-6c31-4b8e-9d1a-0b7f5a3c2e11", fsys.UUID())
+	assert.Equal(t, "root", fsys.Label())
+
+	data, err := afero.ReadFile(fs, "/etc/os-release")
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "ID=debian")
+
+	// parent directories that are symlinks are resolved
+	data, err = afero.ReadFile(fs, "/lib/modules/test.conf")
+	require.NoError(t, err)
+	assert.Equal(t, "options test\n", string(data))
+	stat, _, err := fs.LstatIfPossible("/lib")
+	require.NoError(t, err)
+	assert.True(t, stat.Mode()&os.ModeSymlink != 0)
+
+	// fast symlinks are stored in the inode, longer targets in a block
+	link, err := fs.ReadlinkIfPossible("/etc/a-very-long-symlink-name-that-does-not-fit-into-the-inode")
+	require.NoError(t, err)
+	assert.Equal(t, "/usr/lib/modules/test.conf", link)
+	link, err = fs.ReadlinkIfPossible("/etc/long-target")
+	require.NoError(t, err)
+	assert.Equal(t, "../usr/lib/modules/../../lib/modules/../../lib/modules/test.conf", link)
+	data, err = afero.ReadFile(fs, "/etc/long-target")
+	require.NoError(t, err)
+	assert.Equal(t, "options test\n", string(data))
+
+	stat, err = fs.Stat("/etc/shadow")
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o640), stat.Mode())
+	assert.Equal(t, int64(0), stat.Sys().(*inode).uid)
+	assert.Equal(t, int64(42), stat.Sys().(*inode).gid)
+	stat, err = fs.Stat("/home/user/file")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000), stat.Sys().(*inode).uid)
+
+	names, err := afero.ReadDir(fs, "/etc/many")
+	require.NoError(t, err)
+	assert.Len(t, names, 200)
+
+	data, err = afero.ReadFile(fs, "/var/big")
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat("0123456789", 30000), string(data))
+
+	data, err = afero.ReadFile(fs, "/var/sparse")
+	require.NoError(t, err)
+	assert.Len(t, data, 1048579)
+	assert.Equal(t, make([]byte, 4096), data[:4096])
+	assert.Equal(t, "end", string(data[1048576:]))
+
+	files, err := fs.Find("/etc/many", regexp.MustCompile("/etc/many/file-1.*"), "file")
+	require.NoError(t, err)
+	assert.Len(t, files, 111)
+
+	_, err = fs.Open("/etc/missing")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestExt4InvalidSuperblock(t *testing.T) {
+	img := loadTestImage(t, "ext4.img.gz")
+	sb := func(modify func(sb []byte)) []byte {
+		data := append([]byte{}, img...)
+		modify(data[ext4SuperblockOff:])
+		return data
+	}
+	descSize := func(size uint16) func([]byte) {
+		return func(sb []byte) {
+			binary.LittleEndian.PutUint32(sb[96:], binary.LittleEndian.Uint32(sb[96:])|ext4Incompat64Bit)
+			binary.LittleEndian.PutUint16(sb[254:], size)
+		}
+	}
+
+	for name, data := range map[string][]byte{
+		"desc size zero":         sb(descSize(0)),
+		"desc size not pow2":     sb(descSize(48)),
+		"desc size > block size": sb(descSize(32768)),
+		"block size":             sb(func(sb []byte) { binary.LittleEndian.PutUint32(sb[24:], 60) }),
+		"inode size":             sb(func(sb []byte) { binary.LittleEndian.PutUint16(sb[88:], 64) }),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := newExt4(bytes.NewReader(data))
+			assert.ErrorContains(t, err, "invalid ext filesystem")
+		})
+	}
+}
+
+func TestExt2(t *testing.T) {
+	fsys, fs := openTestFS(t, loadTestImage(t, "ext2.img.gz"))
+	assert.Equal(t, "ext2", fsys.Type())
+	assert.Equal(t, "legacy", fsys.Label())
+
+	// the file uses direct, indirect and double indirect blocks
+	data, err := afero.ReadFile(fs, "/etc/link")
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat("0123456789", 30000), string(data))
+}
+
+const (
+	fatSectors   = 32768
+	fatReserved  = 1
+	fatSize      = 32
+	fatRootStart = fatReserved + 2*fatSize
+	fatDataStart = fatRootStart + 32
+)
+
+func fatEntry(name string, attr byte, ntres byte, cluster uint16, size uint32) []byte {
+	e := make([]byte, 32)
+	copy(e[0:11], name)
+	e[11] = attr
+	e[12] = ntres
+	binary.LittleEndian.PutUint16(e[22:], 0x6000)
+	binary.LittleEndian.PutUint16(e[24:], 0x5721)
+	binary.LittleEndian.PutUint16(e[26:], cluster)
+	binary.LittleEndian.PutUint32(e[28:], size)
+	return e
+}
+
+// lfnEntries returns the long file name entries that precede the short entry
+func lfnEntries(name string, short string) []byte {
+	chars := utf16.Encode([]rune(name))
+	chars = append(chars, 0)
+	for len(chars)%13 != 0 {
+		chars = append(chars, 0xffff)
+	}
+	sum := shortNameChecksum([]byte(short))
+
+	res := []byte{}
+	count := len(chars) / 13
+	for seq := count; seq > 0; seq-- {
+		e := make([]byte, 32)
+		e[0] = byte(seq)
+		if seq == count {
+			e[0] |= 0x40
+		}
+		e[11] = fatAttrLFN
+		e[13] = sum
+		part := chars[(seq-1)*13 : seq*13]
+		for i := 0; i < 5; i++ {
+			binary.LittleEndian.PutUint16(e[1+i*2:], part[i])
+		}
+		for i := 0; i < 6; i++ {
+			binary.LittleEndian.PutUint16(e[14+i*2:], part[5+i])
+		}
+		for i := 0; i < 2; i++ {
+			binary.LittleEndian.PutUint16(e[28+i*2:], part[11+i])
+		}
+		res = append(res, e...)
+	}
+	return res
+}
+
+// buildFAT creates a FAT16 filesystem like an EFI system partition
+func buildFAT() []byte {
+	img := make([]byte, fatSectors*sectorSize)
+	bs := img[0:sectorSize]
+	copy(bs, []byte{0xeb, 0x3c, 0x90})
+	copy(bs[3:], "MSDOS5.0")
+	binary.LittleEndian.PutUint16(bs[11:], sectorSize)
+	bs[13] = 4
+	binary.LittleEndian.PutUint16(bs[14:], fatReserved)
+	bs[16] = 2
+	binary.LittleEndian.PutUint16(bs[17:], 512)
+	binary.LittleEndian.PutUint16(bs[19:], fatSectors)
+	bs[21] = 0xf8
+	binary.LittleEndian.PutUint16(bs[22:], fatSize)
+	bs[38] = 0x29
+	binary.LittleEndian.PutUint32(bs[39:], 0x1234abcd)
+	copy(bs[43:], "EFI        ")
+	copy(bs[54:], "FAT16   ")
+	bs[510], bs[511] = 0x55, 0xaa
+
+	setFAT := func(cluster uint16, next uint16) {
+		for i := 0; i < 2; i++ {
+			binary.LittleEndian.PutUint16(img[(fatReserved+i*fatSize)*sectorSize+int(cluster)*2:], next)
+		}
+	}
+	setFAT(0, 0xfff8)
+	setFAT(1, 0xffff)
+	cluster := func(c int) []byte {
+		off := (fatDataStart + (c-2)*4) * sectorSize
+		return img[off : off+4*sectorSize]
+	}
+
+	root := img[fatRootStart*sectorSize:]
+	entries := [][]byte{
+		fatEntry("EFI        ", fatAttrVolume, 0, 0, 0),
+		fatEntry("EFI        ", fatAttrDir, 0, 2, 0),
+		fatEntry("STARTUP NSH", 0, fatLowerBase|fatLowerExt, 8, 11),
+		lfnEntries("Long File Name.txt", "LONGFI~1TXT"),
+		fatEntry("LONGFI~1TXT", 0, 0, 9, 5),
+		fatEntry("\xe5ELETED TXT", 0, 0, 10, 5),
+	}
+	copy(root, bytes.Join(entries, nil))
+
+	copy(cluster(2), bytes.Join([][]byte{
+		fatEntry(".          ", fatAttrDir, 0, 2, 0),
+		fatEntry("..         ", fatAttrDir, 0, 0, 0),
+		fatEntry("BOOT       ", fatAttrDir, 0, 3, 0),
+	}, nil))
+	setFAT(2, 0xffff)
+
+	boot := testContent(3*2048 - 100)
+	copy(cluster(3), bytes.Join([][]byte{
+		fatEntry(".          ", fatAttrDir, 0, 3, 0),
+		fatEntry("..         ", fatAttrDir, 0, 2, 0),
+		fatEntry("BOOTX64 EFI", 0, 0, 4, uint32(len(boot))),
+	}, nil))
+	setFAT(3, 0xffff)
+
+	// the file is fragmented
+	copy(cluster(4), boot[0:2048])
+	copy(cluster(6), boot[2048:4096])
+	copy(cluster(7), boot[4096:])
+	setFAT(4, 6)
+	setFAT(6, 7)
+	setFAT(7, 0xffff)
+
+	copy(cluster(8), "echo hello\n")
+	setFAT(8, 0xffff)
+	copy(cluster(9), "long\n")
+	setFAT(9, 0xffff)
+	return img
+}
+
+func TestFAT(t *testing.T) {
+	fsys, fs := openTestFS(t, buildFAT())
+	assert.Equal(t, "vfat", fsys.Type())
+	assert.Equal(t, "1234-ABCD", fsys.UUID())
+	assert.Equal(t, "EFI", fsys.Label())
+
+	names, err := afero.ReadDir(fs, "/")
+	require.NoError(t, err)
+	list := []string{}
+	for i := range names {
+		list = append(list, names[i].Name())
+	}
+	assert.Equal(t, []string{"EFI", "Long File Name.txt", "startup.nsh"}, list)
+
+	data, err := afero.ReadFile(fs, "/EFI/BOOT/BOOTX64.EFI")
+	require.NoError(t, err)
+	assert.Equal(t, testContent(3*2048-100), data)
+
+	data, err = afero.ReadFile(fs, "/Long File Name.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "long\n", string(data))
+
+	stat, err := fs.Stat("/startup.nsh")
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), stat.Size())
+	assert.Equal(t, 2023, stat.ModTime().Year())
+
+	stat, err = fs.Stat("/EFI/BOOT")
+	require.NoError(t, err)
+	assert.True(t, stat.IsDir())
+}
+
+const (
+	xfsTestBlockSize = 4096
+	xfsTestRootIno   = 32
+)
+
+func xfsExtent(startoff uint64, startblock uint64, count uint64) []byte {
+	b := make([]byte, 16)
+	binary.BigEndian.PutUint64(b, startoff<<9|startblock>>43)
+	binary.BigEndian.PutUint64(b[8:], startblock<<21|count)
+	return b
+}
+
+func xfsDinode(mode uint16, format byte, size uint64, nextents uint32, uid uint32, gid uint32, fork []byte) []byte {
+	b := make([]byte, 512)
+	copy(b, xfsInodeMagic)
+	binary.BigEndian.PutUint16(b[2:], mode)
+	b[4] = 3
+	b[5] = format
+	binary.BigEndian.PutUint32(b[8:], uid)
+	binary.BigEndian.PutUint32(b[12:], gid)
+	binary.BigEndian.PutUint32(b[40:], 1700000000)
+	binary.BigEndian.PutUint64(b[56:], size)
+	binary.BigEndian.PutUint32(b[76:], nextents)
+	copy(b[xfsCoreSizeV3:], fork)
+	return b
+}
+
+func xfsDirEntry(ino uint64, name string, ftype byte) []byte {
+	size := (8 + 1 + len(name) + 1 + 2 + 7) &^ 7
+	b := make([]byte, size)
+	binary.BigEndian.PutUint64(b, ino)
+	b[8] = byte(len(name))
+	copy(b[9:], name)
+	b[9+len(name)] = ftype
+	return b
+}
+
+// buildXFS creates a v5 filesystem with a shortform root directory, a block
+// directory, a file stored in an extent and local and remote symlinks
+func buildXFS() []byte {
+	img := make([]byte, 64*xfsTestBlockSize)
+	sb := img[0:512]
+	copy(sb, xfsMagic)
+	binary.BigEndian.PutUint32(sb[4:], xfsTestBlockSize)
+	binary.BigEndian.PutUint64(sb[8:], 64)
+	copy(sb[32:], []byte{0x4a, 0x1b, 0x2c, 0x3d, 0x4e, 0x5f, 0x40, 0x71, 0x82, 0x93, 0xa4, 0xb5, 0xc6, 0xd7, 0xe8, 0xf9})
+	binary.BigEndian.PutUint64(sb[56:], xfsTestRootIno)
+	binary.BigEndian.PutUint32(sb[84:], 1024)
+	binary.BigEndian.PutUint32(sb[88:], 1)
+	binary.BigEndian.PutUint16(sb[100:], 5)
+	binary.BigEndian.PutUint16(sb[102:], 512)
+	binary.BigEndian.PutUint16(sb[104:], 512)
+	binary.BigEndian.PutUint16(sb[106:], 8)
+	copy(sb[108:], "data")
+	sb[120], sb[121], sb[122], sb[123], sb[124] = 12, 9, 9, 3, 10
+	binary.BigEndian.PutUint32(sb[216:], xfsIncompatFtype)
+
+	inode := func(ino int, data []byte) {
+		copy(img[4*xfsTestBlockSize+(ino-xfsTestRootIno)*512:], data)
+	}
+	block := func(b int) []byte {
+		return img[b*xfsTestBlockSize : (b+1)*xfsTestBlockSize]
+	}
+
+	// root directory in shortform with a 4 byte parent and inode numbers
+	root := []byte{1, 0, 0, 0, 0, xfsTestRootIno, 3, 0, 0x60}
+	root = append(root, "etc"...)
+	root = append(root, 2, 0, 0, 0, 33)
+	inode(32, xfsDinode(0o40755, xfsFormatLocal, uint64(len(root)), 0, 0, 0, root))
+
+	// etc directory in a single block
+	inode(33, xfsDinode(0o40755, xfsFormatExtents, xfsTestBlockSize, 1, 0, 0, xfsExtent(0, 10, 1)))
+	dir := block(10)
+	copy(dir, xfsDir3Block)
+	entries := bytes.Join([][]byte{
+		xfsDirEntry(33, ".", 2),
+		xfsDirEntry(32, "..", 2),
+		xfsDirEntry(34, "os-release", 1),
+		xfsDirEntry(35, "link", 7),
+		xfsDirEntry(36, "remote", 7),
+	}, nil)
+	copy(dir[64:], entries)
+	free := 64 + len(entries)
+	end := xfsTestBlockSize - 8 - 5*8
+	binary.BigEndian.PutUint16(dir[free:], xfsDirFreeTag)
+	binary.BigEndian.PutUint16(dir[free+2:], uint16(end-free))
+	binary.BigEndian.PutUint32(dir[xfsTestBlockSize-8:], 5)
+
+	content := testContent(5000)
+	inode(34, xfsDinode(0o100640, xfsFormatExtents, uint64(len(content)), 1, 0, 4, xfsExtent(0, 11, 2)))
+	copy(img[11*xfsTestBlockSize:], content)
+
+	inode(35, xfsDinode(0o120777, xfsFormatLocal, 10, 0, 0, 0, []byte("os-release")))
+
+	target := "../etc/./os-release"
+	inode(36, xfsDinode(0o120777, xfsFormatExtents, uint64(len(target)), 1, 0, 0, xfsExtent(0, 13, 1)))
+	remote := block(13)
+	copy(remote, xfsSymlink3)
+	binary.BigEndian.PutUint32(remote[8:], uint32(len(target)))
+	copy(remote[xfsSymlinkHeaderV5:], target)
+	return img
+}
+
+func TestXFS(t *testing.T) {
+	fsys, fs := openTestFS(t, buildXFS())
+	assert.Equal(t, "xfs", fsys.Type())
+	assert.Equal(t, "4a1b2c3d-4e5f-4071-8293-a4b5c6d7e8f9", fsys.UUID())
+	assert.Equal(t, "data", fsys.Label())
+
+	names, err := afero.ReadDir(fs, "/etc")
+	require.NoError(t, err)
+	list := []string{}
+	for i := range names {
+		list = append(list, names[i].Name())
+	}
+	assert.Equal(t, []string{"link", "os-release", "remote"}, list)
+
+	data, err := afero.ReadFile(fs, "/etc/os-release")
+	require.NoError(t, err)
+	assert.Equal(t, testContent(5000), data)
+
+	stat, err := fs.Stat("/etc/os-release")
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o640), stat.Mode())
+	assert.Equal(t, int64(4), stat.Sys().(*inode).gid)
+	assert.Equal(t, int64(1700000000), stat.ModTime().Unix())
+
+	link, err := fs.ReadlinkIfPossible("/etc/link")
+	require.NoError(t, err)
+	assert.Equal(t, "os-release", link)
+
+	link, err = fs.ReadlinkIfPossible("/etc/remote")
+	require.NoError(t, err)
+	assert.Equal(t, "../etc/./os-release", link)
+	data, err = afero.ReadFile(fs, "/etc/remote")
+	require.NoError(t, err)
+	assert.Equal(t, testContent(5000), data)
+}
@@ -0,0 +1,432 @@
+package diskimage
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/motor/providers/os/find"
+)
+
+const maxSymlinks = 40
+
+var notSupported = errors.New("not supported")
+
+// mount is a filesystem that is mounted at the path
+type mount struct {
+	path string
+	fs   filesystem
+}
+
+// FS is a read-only afero.Fs of the filesystems of a disk image, the root
+// filesystem and everything mounted below it via /etc/fstab
+type FS struct {
+	// mounts are sorted by path
+	mounts []mount
+
+	mu sync.Mutex
+	// dirs caches the directory entries by filesystem and inode
+	dirs map[filesystem]map[uint64]map[string]uint64
+}
+
+// node is a resolved path
+type node struct {
+	fs    filesystem
+	inode *inode
+}
+
+func newFS(root filesystem) *FS {
+	return &FS{
+		mounts: []mount{{path: "/", fs: root}},
+		dirs:   map[filesystem]map[uint64]map[string]uint64{},
+	}
+}
+
+// mount adds a filesystem, the mount point has to exist in the filesystem
+// it is mounted on
+func (fs *FS) mount(p string, fsys filesystem) {
+	fs.mounts = append(fs.mounts, mount{path: path.Clean(p), fs: fsys})
+	sort.Slice(fs.mounts, func(i, j int) bool {
+		return fs.mounts[i].path < fs.mounts[j].path
+	})
+}
+
+func (fs *FS) mountAt(p string) filesystem {
+	for i := range fs.mounts {
+		if fs.mounts[i].path == p {
+			return fs.mounts[i].fs
+		}
+	}
+	return nil
+}
+
+func (fs *FS) dir(fsys filesystem, n *inode) (map[string]uint64, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	cache, ok := fs.dirs[fsys]
+	if !ok {
+		cache = map[uint64]map[string]uint64{}
+		fs.dirs[fsys] = cache
+	}
+	if entries, ok := cache[n.ino]; ok {
+		return entries, nil
+	}
+
+	list, err := fsys.ReadDir(n)
+	if err != nil {
+		return nil, err
+	}
+	entries := make(map[string]uint64, len(list))
+	for i := range list {
+		entries[list[i].name] = list[i].ino
+	}
+	cache[n.ino] = entries
+	return entries, nil
+}
+
+func (fs *FS) root(p string) (node, error) {
+	fsys := fs.mountAt(p)
+	n, err := fsys.Inode(fsys.Root())
+	return node{fs: fsys, inode: n}, err
+}
+
+// lookup resolves the path, symlinks of parent directories are always
+// followed, the symlink of the path itself only if follow is set
+func (fs *FS) lookup(name string, follow bool) (node, error) {
+	p := path.Clean("/" + name)
+
+	for links := 0; ; {
+		cur, err := fs.root("/")
+		if err != nil {
+			return node{}, err
+		}
+
+		components := strings.Split(strings.TrimPrefix(p, "/"), "/")
+		if p == "/" {
+			components = nil
+		}
+
+		resolved := "/"
+		restart := false
+		for i, c := range components {
+			if !cur.inode.mode.IsDir() {
+				return node{}, os.ErrNotExist
+			}
+			entries, err := fs.dir(cur.fs, cur.inode)
+			if err != nil {
+				return node{}, err
+			}
+			ino, ok := entries[c]
+			if !ok {
+				return node{}, os.ErrNotExist
+			}
+
+			resolved = path.Join(resolved, c)
+			if fs.mountAt(resolved) != nil {
+				cur, err = fs.root(resolved)
+			} else {
+				var n *inode
+				n, err = cur.fs.Inode(ino)
+				cur = node{fs: cur.fs, inode: n}
+			}
+			if err != nil {
+				return node{}, err
+			}
+
+			last := i == len(components)-1
+			if cur.inode.mode&os.ModeSymlink != 0 && (!last || follow) {
+				links++
+				if links > maxSymlinks {
+					return node{}, errors.New("too many levels of symbolic links: " + name)
+				}
+				target, err := cur.fs.Readlink(cur.inode)
+				if err != nil {
+					return node{}, err
+				}
+				if !path.IsAbs(target) {
+					target = path.Join(path.Dir(resolved), target)
+				}
+				p = path.Clean(path.Join(append([]string{target}, components[i+1:]...)...))
+				restart = true
+				break
+			}
+		}
+		if !restart {
+			return cur, nil
+		}
+	}
+}
+
+func (fs *FS) Name() string {
+	return "Disk Image FS"
+}
+
+func (fs *FS) Create(name string) (afero.File, error) {
+	return nil, notSupported
+}
+
+func (fs *FS) Mkdir(name string, perm os.FileMode) error {
+	return notSupported
+}
+
+func (fs *FS) MkdirAll(path string, perm os.FileMode) error {
+	return notSupported
+}
+
+func (fs *FS) Open(name string) (afero.File, error) {
+	n, err := fs.lookup(name, true)
+	if err != nil {
+		return nil, &os.PathError{Op: "open", Path: name, Err: err}
+	}
+
+	f := &File{
+		path: path.Clean("/" + name),
+		fs:   fs,
+		node: n,
+	}
+	if n.inode.mode.IsRegular() {
+		r, err := n.fs.Open(n.inode)
+		if err != nil {
+			return nil, &os.PathError{Op: "open", Path: name, Err: err}
+		}
+		f.reader = io.NewSectionReader(r, 0, n.inode.size)
+	}
+	return f, nil
+}
+
+func (fs *FS) OpenFile(name string, flag int, perm os.FileMode) (afero.File, error) {
+	if flag&(os.O_WRONLY|os.O_RDWR|os.O_CREATE|os.O_APPEND|os.O_TRUNC) != 0 {
+		return nil, notSupported
+	}
+	return fs.Open(name)
+}
+
+func (fs *FS) Remove(name string) error {
+	return notSupported
+}
+
+func (fs *FS) RemoveAll(path string) error {
+	return notSupported
+}
+
+func (fs *FS) Rename(oldname, newname string) error {
+	return notSupported
+}
+
+func (fs *FS) Stat(name string) (os.FileInfo, error) {
+	n, err := fs.lookup(name, true)
+	if err != nil {
+		return nil, &os.PathError{Op: "stat", Path: name, Err: err}
+	}
+	return &fileInfo{name: path.Base(path.Clean("/" + name)), inode: n.inode}, nil
+}
+
+func (fs *FS) LstatIfPossible(name string) (os.FileInfo, bool, error) {
+	n, err := fs.lookup(name, false)
+	if err != nil {
+		return nil, true, &os.PathError{Op: "lstat", Path: name, Err: err}
+	}
+	return &fileInfo{name: path.Base(path.Clean("/" + name)), inode: n.inode}, true, nil
+}
+
+func (fs *FS) ReadlinkIfPossible(name string) (string, error) {
+	n, err := fs.lookup(name, false)
+	if err != nil {
+		return "", &os.PathError{Op: "readlink", Path: name, Err: err}
+	}
+	if n.inode.mode&os.ModeSymlink == 0 {
+		return "", &os.PathError{Op: "readlink", Path: name, Err: errors.New("not a symlink")}
+	}
+	return n.fs.Readlink(n.inode)
+}
+
+func (fs *FS) Chmod(name string, mode os.FileMode) error {
+	return notSupported
+}
+
+func (fs *FS) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	return notSupported
+}
+
+func (fs *FS) Chown(name string, uid, gid int) error {
+	return notSupported
+}
+
+func (fs *FS) Find(from string, r *regexp.Regexp, typ string) ([]string, error) {
+	iofs := afero.NewIOFS(fs)
+	return find.FindFiles(iofs, from, r, typ)
+}
+
+type fileInfo struct {
+	name  string
+	inode *inode
+}
+
+func (fi *fileInfo) Name() string {
+	return fi.name
+}
+
+func (fi *fileInfo) Size() int64 {
+	return fi.inode.size
+}
+
+func (fi *fileInfo) Mode() os.FileMode {
+	return fi.inode.mode
+}
+
+func (fi *fileInfo) ModTime() time.Time {
+	return fi.inode.mtime
+}
+
+func (fi *fileInfo) IsDir() bool {
+	return fi.inode.mode.IsDir()
+}
+
+// Sys returns the *inode with the owner of the file
+func (fi *fileInfo) Sys() interface{} {
+	return fi.inode
+}
+
+type File struct {
+	path   string
+	fs     *FS
+	node   node
+	reader *io.SectionReader
+	// names holds the remaining directory entries for Readdir
+	names []string
+}
+
+func (f *File) Name() string {
+	return f.path
+}
+
+func (f *File) Close() error {
+	return nil
+}
+
+func (f *File) Stat() (os.FileInfo, error) {
+	return &fileInfo{name: path.Base(f.path), inode: f.node.inode}, nil
+}
+
+func (f *File) Sync() error {
+	return notSupported
+}
+
+func (f *File) Truncate(size int64) error {
+	return notSupported
+}
+
+func (f *File) Read(b []byte) (n int, err error) {
+	if f.reader == nil {
+		return 0, errors.New("cannot read " + f.path + ", it is not a file")
+	}
+	return f.reader.Read(b)
+}
+
+func (f *File) ReadAt(b []byte, off int64) (n int, err error) {
+	if f.reader == nil {
+		return 0, errors.New("cannot read " + f.path + ", it is not a file")
+	}
+	return f.reader.ReadAt(b, off)
+}
+
+func (f *File) Seek(offset int64, whence int) (int64, error) {
+	if f.reader == nil {
+		return 0, errors.New("cannot seek " + f.path + ", it is not a file")
+	}
+	return f.reader.Seek(offset, whence)
+}
+
+func (f *File) entries() (map[string]uint64, error) {
+	if !f.node.inode.mode.IsDir() {
+		return nil, errors.New("cannot read " + f.path + ", it is not a directory")
+	}
+	return f.fs.dir(f.node.fs, f.node.inode)
+}
+
+// next returns the next n names of the directory, all remaining names if n <= 0
+func (f *File) next(n int) ([]string, error) {
+	if f.names == nil {
+		entries, err := f.entries()
+		if err != nil {
+			return nil, err
+		}
+		f.names = make([]string, 0, len(entries))
+		for name := range entries {
+			f.names = append(f.names, name)
+		}
+		sort.Strings(f.names)
+	}
+
+	if n <= 0 || n > len(f.names) {
+		n = len(f.names)
+	}
+	res := f.names[:n]
+	f.names = f.names[n:]
+	return res, nil
+}
+
+func (f *File) Readdir(n int) ([]os.FileInfo, error) {
+	names, err := f.next(n)
+	if err != nil {
+		return nil, err
+	}
+	if n > 0 && len(names) == 0 {
+		return nil, io.EOF
+	}
+	entries, err := f.entries()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]os.FileInfo, 0, len(names))
+	for _, name := range names {
+		child := path.Join(f.path, name)
+		var ino *inode
+		if f.fs.mountAt(child) != nil {
+			root, err := f.fs.root(child)
+			if err != nil {
+				return nil, err
+			}
+			ino = root.inode
+		} else {
+			ino, err = f.node.fs.Inode(entries[name])
+			if err != nil {
+				return nil, err
+			}
+		}
+		res = append(res, &fileInfo{name: name, inode: ino})
+	}
+	return res, nil
+}
+
+func (f *File) Readdirnames(n int) ([]string, error) {
+	names, err := f.next(n)
+	if err != nil {
+		return nil, err
+	}
+	if n > 0 && len(names) == 0 {
+		return nil, io.EOF
+	}
+	return names, nil
+}
+
+func (f *File) Write(b []byte) (n int, err error) {
+	return 0, notSupported
+}
+
+func (f *File) WriteAt(b []byte, off int64) (n int, err error) {
+	return 0, notSupported
+}
+
+func (f *File) WriteString(s string) (ret int, err error) {
+	return 0, notSupported
+}
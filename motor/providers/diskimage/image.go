@@ -0,0 +1,139 @@
+package diskimage
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Image is the virtual disk that is stored in an image file
+type Image interface {
+	io.ReaderAt
+	// Format of the image file, e.g. qcow2
+	Format() string
+	// Size of the virtual disk
+	Size() int64
+	Close() error
+}
+
+// OpenImage detects the format of the image file and returns the virtual
+// disk, files without a known header are read as raw disk
+func OpenImage(path string) (Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := openImage(path, f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return img, nil
+}
+
+func openImage(path string, f *os.File) (Image, error) {
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if stat.IsDir() {
+		return nil, errors.New("disk image must be a file")
+	}
+
+	header := make([]byte, sectorSize)
+	if _, err := f.ReadAt(header, 0); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	switch {
+	case bytes.HasPrefix(header, []byte(qcow2Magic)):
+		return newQcow2(f)
+	case bytes.HasPrefix(header, []byte(vmdkSparseMagic)):
+		return newVMDKSparse(f)
+	case bytes.HasPrefix(header, []byte(vmdkDescriptorMagic)):
+		return newVMDKDescriptor(path, f)
+	case bytes.HasPrefix(header, []byte(vhdxMagic)):
+		return nil, errors.New("vhdx images are not supported")
+	}
+
+	if stat.Size() >= sectorSize {
+		footer := make([]byte, sectorSize)
+		if _, err := f.ReadAt(footer, stat.Size()-sectorSize); err != nil {
+			return nil, err
+		}
+		if bytes.HasPrefix(footer, []byte(vhdMagic)) {
+			return newVHD(f, footer)
+		}
+	}
+
+	return &rawImage{File: f, size: stat.Size()}, nil
+}
+
+type rawImage struct {
+	*os.File
+	size int64
+}
+
+func (r *rawImage) Format() string {
+	return "raw"
+}
+
+func (r *rawImage) Size() int64 {
+	return r.size
+}
+
+// checkRange verifies that a table or data, which is referenced by an image header, is
+// stored within the image file. Corrupt headers would otherwise let us allocate more
+// memory than the image file holds.
+func checkRange(f *os.File, off int64, size int64) error {
+	stat, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if off < 0 || size < 0 || size > stat.Size() || off > stat.Size()-size {
+		return fmt.Errorf("range of %d bytes at offset %d is outside of the image file", size, off)
+	}
+	return nil
+}
+
+// readFull reads len(p) bytes and tolerates a short read at the end of the file
+func readFull(r io.ReaderAt, p []byte, off int64) error {
+	n, err := r.ReadAt(p, off)
+	if err == io.EOF {
+		zero(p[n:])
+		return nil
+	}
+	return err
+}
+
+// readBlocks splits a read of a virtual disk into reads of the blocks that
+// are mapped by the image format
+func readBlocks(p []byte, off int64, size int64, blockSize int64, read func(block int64, p []byte, off int64) error) (int, error) {
+	if off >= size {
+		return 0, io.EOF
+	}
+
+	var eof error
+	if remaining := size - off; int64(len(p)) > remaining {
+		p = p[:remaining]
+		eof = io.EOF
+	}
+
+	n := 0
+	for n < len(p) {
+		pos := off + int64(n)
+		inBlock := pos % blockSize
+		chunk := blockSize - inBlock
+		if chunk > int64(len(p)-n) {
+			chunk = int64(len(p) - n)
+		}
+		if err := read(pos/blockSize, p[n:int64(n)+chunk], inBlock); err != nil {
+			return n, err
+		}
+		n += int(chunk)
+	}
+	return n, eof
+}
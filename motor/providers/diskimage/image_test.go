@@ -0,0 +1,338 @@
+package diskimage
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/zlib"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func isZero(b []byte) bool {
+	for i := range b {
+		if b[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// writeQcow2 writes a qcow2 v3 image with 64k clusters, the first allocated
+// cluster is compressed
+func writeQcow2(t *testing.T, path string, disk []byte) {
+	const clusterBits = 16
+	const clusterSize = 1 << clusterBits
+
+	header := make([]byte, clusterSize)
+	copy(header, qcow2Magic)
+	binary.BigEndian.PutUint32(header[4:], 3)
+	binary.BigEndian.PutUint32(header[20:], clusterBits)
+	binary.BigEndian.PutUint64(header[24:], uint64(len(disk)))
+	binary.BigEndian.PutUint32(header[36:], 1)
+	binary.BigEndian.PutUint64(header[40:], clusterSize)
+	binary.BigEndian.PutUint32(header[96:], 4)
+	binary.BigEndian.PutUint32(header[100:], 104)
+
+	l1 := make([]byte, clusterSize)
+	binary.BigEndian.PutUint64(l1, 2*clusterSize|1<<63)
+	l2 := make([]byte, clusterSize)
+
+	data := []byte{}
+	next := int64(3 * clusterSize)
+	compressed := false
+	for i := 0; i*clusterSize < len(disk); i++ {
+		cluster := disk[i*clusterSize : (i+1)*clusterSize]
+		if isZero(cluster) {
+			continue
+		}
+		if !compressed {
+			var buf bytes.Buffer
+			w, err := flate.NewWriter(&buf, flate.BestCompression)
+			require.NoError(t, err)
+			_, err = w.Write(cluster)
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+			x := 62 - (clusterBits - 8)
+			sectors := (buf.Len() + sectorSize - 1) / sectorSize
+			binary.BigEndian.PutUint64(l2[i*8:], qcow2Compressed|uint64(sectors-1)<<x|uint64(next))
+			data = append(data, buf.Bytes()...)
+			data = append(data, make([]byte, sectors*sectorSize-buf.Len())...)
+			next += int64(sectors * sectorSize)
+			compressed = true
+			continue
+		}
+		binary.BigEndian.PutUint64(l2[i*8:], uint64(next)|1<<63)
+		data = append(data, cluster...)
+		next += clusterSize
+	}
+
+	require.NoError(t, os.WriteFile(path, bytes.Join([][]byte{header, l1, l2, data}, nil), 0o644))
+}
+
+// writeVHD writes a dynamic vhd with 512k blocks, empty blocks are not allocated
+func writeVHD(t *testing.T, path string, disk []byte) {
+	const blockSize = 512 * 1024
+	footer := make([]byte, sectorSize)
+	copy(footer, vhdMagic)
+	binary.BigEndian.PutUint64(footer[16:], sectorSize)
+	binary.BigEndian.PutUint64(footer[48:], uint64(len(disk)))
+	binary.BigEndian.PutUint32(footer[60:], vhdDynamic)
+
+	entries := (len(disk) + blockSize - 1) / blockSize
+	header := make([]byte, 1024)
+	copy(header, vhdSparseMagic)
+	binary.BigEndian.PutUint64(header[16:], 3*sectorSize)
+	binary.BigEndian.PutUint32(header[28:], uint32(entries))
+	binary.BigEndian.PutUint32(header[32:], blockSize)
+
+	bat := make([]byte, (entries*4+sectorSize-1)/sectorSize*sectorSize)
+	data := []byte{}
+	next := (3*sectorSize + len(bat)) / sectorSize
+	for i := 0; i < entries; i++ {
+		block := disk[i*blockSize : (i+1)*blockSize]
+		if isZero(block) {
+			binary.BigEndian.PutUint32(bat[i*4:], vhdUnallocated)
+			continue
+		}
+		binary.BigEndian.PutUint32(bat[i*4:], uint32(next))
+		bitmap := bytes.Repeat([]byte{0xff}, sectorSize)
+		data = append(data, bitmap...)
+		data = append(data, block...)
+		next += (sectorSize + blockSize) / sectorSize
+	}
+
+	require.NoError(t, os.WriteFile(path, bytes.Join([][]byte{footer, header, bat, data, footer}, nil), 0o644))
+}
+
+// writeVMDK writes a monolithic sparse vmdk with 64k grains, stream optimized
+// images compress the grains and store the grain directory at the end
+func writeVMDK(t *testing.T, path string, disk []byte, streamOptimized bool) {
+	const grainSectors = 128
+	const grainSize = grainSectors * sectorSize
+	const gtEntries = 512
+
+	header := make([]byte, sectorSize)
+	copy(header, vmdkSparseMagic)
+	binary.LittleEndian.PutUint32(header[4:], 3)
+	binary.LittleEndian.PutUint64(header[12:], uint64(len(disk)/sectorSize))
+	binary.LittleEndian.PutUint64(header[20:], grainSectors)
+	binary.LittleEndian.PutUint32(header[44:], gtEntries)
+
+	grains := len(disk) / grainSize
+	gts := (grains + gtEntries - 1) / gtEntries
+	gdSectors := (gts*4 + sectorSize - 1) / sectorSize
+	gtSectors := gtEntries * 4 / sectorSize
+
+	gd := make([]byte, gdSectors*sectorSize)
+	gt := make([]byte, gts*gtSectors*sectorSize)
+	data := []byte{}
+
+	// grains follow the header and descriptor in stream optimized images,
+	// the tables follow the header in regular ones
+	next := 1 + gdSectors + gts*gtSectors
+	if streamOptimized {
+		next = 2
+	}
+	for i := 0; i < grains; i++ {
+		grain := disk[i*grainSize : (i+1)*grainSize]
+		if isZero(grain) {
+			continue
+		}
+		binary.LittleEndian.PutUint32(gt[i*4:], uint32(next))
+		if streamOptimized {
+			var buf bytes.Buffer
+			w := zlib.NewWriter(&buf)
+			_, err := w.Write(grain)
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+			marker := make([]byte, 12)
+			binary.LittleEndian.PutUint64(marker, uint64(i*grainSectors))
+			binary.LittleEndian.PutUint32(marker[8:], uint32(buf.Len()))
+			grain = append(marker, buf.Bytes()...)
+			grain = append(grain, make([]byte, (len(grain)+sectorSize-1)/sectorSize*sectorSize-len(grain))...)
+		}
+		data = append(data, grain...)
+		next += len(grain) / sectorSize
+	}
+
+	tables := func(gdOffset int) []byte {
+		for i := 0; i < gts; i++ {
+			binary.LittleEndian.PutUint32(gd[i*4:], uint32(gdOffset+gdSectors+i*gtSectors))
+		}
+		return append(append([]byte{}, gd...), gt...)
+	}
+
+	if !streamOptimized {
+		binary.LittleEndian.PutUint64(header[56:], 1)
+		require.NoError(t, os.WriteFile(path, bytes.Join([][]byte{header, tables(1), data}, nil), 0o644))
+		return
+	}
+
+	binary.LittleEndian.PutUint32(header[8:], vmdkFlagCompressed)
+	binary.LittleEndian.PutUint64(header[56:], vmdkGDAtEnd)
+	footer := append([]byte{}, header...)
+	binary.LittleEndian.PutUint64(footer[56:], uint64(next))
+	marker := make([]byte, sectorSize)
+	require.NoError(t, os.WriteFile(path, bytes.Join([][]byte{header, marker, data, tables(next), marker, footer, marker}, nil), 0o644))
+}
+
+func readImage(t *testing.T, img Image) []byte {
+	data := make([]byte, img.Size())
+	n, err := img.ReadAt(data, 0)
+	require.NoError(t, err)
+	require.Equal(t, len(data), n)
+
+	// reads past the end of the disk return io.EOF
+	_, err = img.ReadAt(make([]byte, 10), img.Size()-5)
+	assert.Equal(t, io.EOF, err)
+	return data
+}
+
+func TestImageFormats(t *testing.T) {
+	disk := loadTestImage(t, "ext2.img.gz")
+	dir := t.TempDir()
+
+	tests := []struct {
+		format string
+		write  func(path string)
+	}{
+		{"raw", func(path string) { require.NoError(t, os.WriteFile(path, disk, 0o644)) }},
+		{"qcow2", func(path string) { writeQcow2(t, path, disk) }},
+		{"vhd", func(path string) { writeVHD(t, path, disk) }},
+		{"vmdk", func(path string) { writeVMDK(t, path, disk, false) }},
+		{"vmdk", func(path string) { writeVMDK(t, path, disk, true) }},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		path := filepath.Join(dir, test.format)
+		test.write(path)
+
+		img, err := OpenImage(path)
+		require.NoError(t, err, test.format)
+		assert.Equal(t, test.format, img.Format())
+		assert.Equal(t, int64(len(disk)), img.Size())
+		assert.True(t, bytes.Equal(disk, readImage(t, img)), test.format)
+		img.Close()
+	}
+}
+
+func TestVMDKDescriptor(t *testing.T) {
+	disk := loadTestImage(t, "ext2.img.gz")
+	dir := t.TempDir()
+
+	// the flat extent starts after a header, followed by an empty extent
+	flat := append(make([]byte, 4*sectorSize), disk...)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "disk-flat.vmdk"), flat, 0o644))
+	descriptor := `# Disk DescriptorFile
+version=1
+CID=fffffffe
+parentCID=ffffffff
+createType="monolithicFlat"
+
+# Extent description
+RW 4096 FLAT "disk-flat.vmdk" 4
+RW 2048 ZERO
+
+ddb.adapterType = "lsilogic"
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "disk.vmdk"), []byte(descriptor), 0o644))
+
+	img, err := OpenImage(filepath.Join(dir, "disk.vmdk"))
+	require.NoError(t, err)
+	defer img.Close()
+	assert.Equal(t, "vmdk", img.Format())
+	assert.Equal(t, int64(len(disk)+2048*sectorSize), img.Size())
+
+	data := readImage(t, img)
+	assert.True(t, bytes.Equal(disk, data[:len(disk)]))
+	assert.True(t, isZero(data[len(disk):]))
+}
+
+func TestUnsupportedImages(t *testing.T) {
+	dir := t.TempDir()
+
+	qcow := make([]byte, 512)
+	copy(qcow, qcow2Magic)
+	binary.BigEndian.PutUint32(qcow[4:], 3)
+	binary.BigEndian.PutUint64(qcow[8:], 512)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "backing.qcow2"), qcow, 0o644))
+	_, err := OpenImage(filepath.Join(dir, "backing.qcow2"))
+	assert.EqualError(t, err, "qcow2 images with backing file are not supported")
+
+	vhdx := make([]byte, 512)
+	copy(vhdx, vhdxMagic)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "disk.vhdx"), vhdx, 0o644))
+	_, err = OpenImage(filepath.Join(dir, "disk.vhdx"))
+	assert.EqualError(t, err, "vhdx images are not supported")
+}
+
+func TestCorruptImageTables(t *testing.T) {
+	dir := t.TempDir()
+	disk := make([]byte, 1<<20)
+	disk[0] = 1
+
+	// the tables are modified so that they would need gigabytes of memory or are outside of the file
+	corrupt := func(name string, write func(t *testing.T, path string, disk []byte), modify func(data []byte)) string {
+		path := filepath.Join(dir, name)
+		write(t, path, disk)
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		modify(data)
+		require.NoError(t, os.WriteFile(path, data, 0o644))
+		return path
+	}
+	writeStreamVMDK := func(t *testing.T, path string, disk []byte) { writeVMDK(t, path, disk, true) }
+	writeSparseVMDK := func(t *testing.T, path string, disk []byte) { writeVMDK(t, path, disk, false) }
+
+	for _, tc := range []struct {
+		path string
+		err  string
+	}{
+		{
+			path: corrupt("l1-size.qcow2", writeQcow2, func(data []byte) { binary.BigEndian.PutUint32(data[36:], 0xffffffff) }),
+			err:  "invalid qcow2 l1 table with 4294967295 entries",
+		},
+		{
+			path: corrupt("l1-offset.qcow2", writeQcow2, func(data []byte) { binary.BigEndian.PutUint64(data[40:], uint64(len(data))) }),
+			err:  "invalid qcow2 l1 table",
+		},
+		{
+			path: corrupt("bat.vhd", writeVHD, func(data []byte) { binary.BigEndian.PutUint32(data[sectorSize+28:], 0xffffffff) }),
+			err:  "invalid vhd block allocation table",
+		},
+		{
+			path: corrupt("gt.vmdk", writeSparseVMDK, func(data []byte) { binary.LittleEndian.PutUint32(data[44:], 0xffffffff) }),
+			err:  "invalid vmdk grain table",
+		},
+		{
+			path: corrupt("gd.vmdk", writeSparseVMDK, func(data []byte) { binary.LittleEndian.PutUint64(data[56:], 1<<60) }),
+			err:  "invalid vmdk grain directory offset",
+		},
+		{
+			path: corrupt("grain.vmdk", writeSparseVMDK, func(data []byte) { binary.LittleEndian.PutUint64(data[20:], 1<<40) }),
+			err:  "invalid vmdk header",
+		},
+	} {
+		t.Run(filepath.Base(tc.path), func(t *testing.T) {
+			_, err := OpenImage(tc.path)
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+
+	// compressed grains with a size beyond the end of the file
+	path := corrupt("marker.vmdk", writeStreamVMDK, func(data []byte) {
+		binary.LittleEndian.PutUint32(data[2*sectorSize+8:], 0xffffffff)
+	})
+	img, err := OpenImage(path)
+	require.NoError(t, err)
+	defer img.Close()
+	_, err = img.ReadAt(make([]byte, 10), 0)
+	assert.ErrorContains(t, err, "invalid vmdk grain")
+}
@@ -0,0 +1,202 @@
+package diskimage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	sectorSize = 512
+	gptMagic   = "EFI PART"
+
+	gptMaxEntrySize = 4096
+
+	mbrProtective    = 0xee
+	mbrExtendedCHS   = 0x05
+	mbrExtendedLBA   = 0x0f
+	mbrExtendedLinux = 0x85
+)
+
+// Partition is a partition of the disk image. Disks without partition table
+// are represented by a single partition that spans the whole disk.
+type Partition struct {
+	// Index of the partition, starting at 1 like the kernel names them
+	Index  int
+	Offset int64
+	Size   int64
+	// Type is the mbr partition type or the gpt type guid
+	Type string
+	// UUID is the gpt partition guid, referenced by PARTUUID in fstab
+	UUID  string
+	Label string
+}
+
+// Partitions reads the gpt or mbr partition table of the disk
+func Partitions(disk io.ReaderAt, size int64) ([]Partition, error) {
+	buf := make([]byte, 2*sectorSize)
+	if _, err := disk.ReadAt(buf, 0); err != nil {
+		return nil, err
+	}
+
+	// a filesystem at the beginning of the disk means it is not partitioned,
+	// checked first because fat boot sectors look like an mbr
+	if _, err := openFilesystem(io.NewSectionReader(disk, 0, size), size); err == nil {
+		return []Partition{{Index: 1, Offset: 0, Size: size}}, nil
+	}
+
+	if buf[510] != 0x55 || buf[511] != 0xaa {
+		return nil, errors.New("no partition table found")
+	}
+
+	if bytes.Equal(buf[sectorSize:sectorSize+8], []byte(gptMagic)) {
+		return gptPartitions(disk, buf[sectorSize:], size)
+	}
+
+	// a protective mbr without gpt header is invalid
+	for i := 0; i < 4; i++ {
+		if buf[446+i*16+4] == mbrProtective {
+			return nil, errors.New("protective mbr found but gpt header is missing")
+		}
+	}
+	return mbrPartitions(disk, buf[:sectorSize], size)
+}
+
+func gptPartitions(disk io.ReaderAt, header []byte, size int64) ([]Partition, error) {
+	entriesLBA := int64(binary.LittleEndian.Uint64(header[72:]))
+	count := int64(binary.LittleEndian.Uint32(header[80:]))
+	entrySize := int64(binary.LittleEndian.Uint32(header[84:]))
+	// entries are 128 bytes multiplied by a power of two, larger entries than a few
+	// sectors are not used by any implementation
+	if entrySize < 128 || entrySize > gptMaxEntrySize || !isPowerOfTwo(entrySize) || count > 1024 {
+		return nil, errors.New("invalid gpt header")
+	}
+	if entriesLBA < 2 || entriesLBA > (size-count*entrySize)/sectorSize {
+		return nil, errors.New("invalid gpt partition entries location")
+	}
+
+	entries := make([]byte, count*entrySize)
+	if _, err := disk.ReadAt(entries, entriesLBA*sectorSize); err != nil {
+		return nil, err
+	}
+
+	res := []Partition{}
+	for i := int64(0); i < count; i++ {
+		entry := entries[i*entrySize : (i+1)*entrySize]
+		if bytes.Equal(entry[0:16], make([]byte, 16)) {
+			continue
+		}
+		first := int64(binary.LittleEndian.Uint64(entry[32:]))
+		last := int64(binary.LittleEndian.Uint64(entry[40:]))
+		if last < first || (last+1)*sectorSize > size {
+			return nil, fmt.Errorf("invalid gpt partition %d", i+1)
+		}
+
+		res = append(res, Partition{
+			Index:  int(i + 1),
+			Offset: first * sectorSize,
+			Size:   (last - first + 1) * sectorSize,
+			Type:   guidString(entry[0:16]),
+			UUID:   guidString(entry[16:32]),
+			Label:  utf16String(entry[56:128]),
+		})
+	}
+	return res, nil
+}
+
+func mbrPartitions(disk io.ReaderAt, mbr []byte, size int64) ([]Partition, error) {
+	res := []Partition{}
+	for i := 0; i < 4; i++ {
+		entry := mbr[446+i*16 : 446+(i+1)*16]
+		typ := entry[4]
+		start := int64(binary.LittleEndian.Uint32(entry[8:]))
+		sectors := int64(binary.LittleEndian.Uint32(entry[12:]))
+		if typ == 0 || sectors == 0 {
+			continue
+		}
+
+		if isExtended(typ) {
+			logical, err := ebrPartitions(disk, start, size)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, logical...)
+			continue
+		}
+
+		if (start+sectors)*sectorSize > size {
+			return nil, fmt.Errorf("invalid mbr partition %d", i+1)
+		}
+		res = append(res, Partition{
+			Index:  i + 1,
+			Offset: start * sectorSize,
+			Size:   sectors * sectorSize,
+			Type:   fmt.Sprintf("0x%02x", typ),
+		})
+	}
+	return res, nil
+}
+
+// ebrPartitions follows the chain of extended boot records, logical
+// partitions are numbered from 5
+func ebrPartitions(disk io.ReaderAt, extStart int64, size int64) ([]Partition, error) {
+	res := []Partition{}
+	buf := make([]byte, sectorSize)
+	ebr := extStart
+	for i := 0; ; i++ {
+		if i > 128 {
+			return nil, errors.New("too many logical partitions")
+		}
+		if _, err := disk.ReadAt(buf, ebr*sectorSize); err != nil {
+			return nil, err
+		}
+		if buf[510] != 0x55 || buf[511] != 0xaa {
+			return nil, errors.New("invalid extended boot record")
+		}
+
+		entry := buf[446:462]
+		start := int64(binary.LittleEndian.Uint32(entry[8:]))
+		sectors := int64(binary.LittleEndian.Uint32(entry[12:]))
+		if entry[4] != 0 && sectors != 0 {
+			if (ebr+start+sectors)*sectorSize > size {
+				return nil, fmt.Errorf("invalid mbr partition %d", 5+i)
+			}
+			res = append(res, Partition{
+				Index:  5 + i,
+				Offset: (ebr + start) * sectorSize,
+				Size:   sectors * sectorSize,
+				Type:   fmt.Sprintf("0x%02x", entry[4]),
+			})
+		}
+
+		// the next ebr is relative to the start of the extended partition
+		next := buf[462:478]
+		if !isExtended(next[4]) {
+			return res, nil
+		}
+		ebr = extStart + int64(binary.LittleEndian.Uint32(next[8:]))
+	}
+}
+
+func isExtended(typ byte) bool {
+	return typ == mbrExtendedCHS || typ == mbrExtendedLBA || typ == mbrExtendedLinux
+}
+
+// guidString formats a mixed-endian guid as stored in the gpt
+func guidString(b []byte) string {
+	return fmt.Sprintf("%08x-%04x-%04x-%x-%x",
+		binary.LittleEndian.Uint32(b[0:4]),
+		binary.LittleEndian.Uint16(b[4:6]),
+		binary.LittleEndian.Uint16(b[6:8]),
+		b[8:10], b[10:16])
+}
+
+func utf16String(b []byte) string {
+	chars := make([]uint16, 0, len(b)/2)
+	for i := 0; i+1 < len(b); i += 2 {
+		chars = append(chars, binary.LittleEndian.Uint16(b[i:]))
+	}
+	return longName(chars)
+}
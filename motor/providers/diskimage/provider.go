@@ -0,0 +1,276 @@
+package diskimage
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/motor/providers"
+	os_provider "go.mondoo.com/cnquery/motor/providers/os"
+	"go.mondoo.com/cnquery/motor/providers/os/fsutil"
+)
+
+const (
+	OPTION_PATH = "path"
+	// OPTION_PARTITION selects the partition of the root filesystem, by
+	// default the partition with /etc/os-release is used
+	OPTION_PARTITION = "partition"
+)
+
+var (
+	_ providers.Instance                  = (*Provider)(nil)
+	_ providers.PlatformIdentifier        = (*Provider)(nil)
+	_ os_provider.OperatingSystemProvider = (*Provider)(nil)
+)
+
+// volume is a partition with a supported filesystem
+type volume struct {
+	partition Partition
+	fs        filesystem
+}
+
+func New(pCfg *providers.Config) (*Provider, error) {
+	if pCfg == nil || len(pCfg.Options[OPTION_PATH]) == 0 {
+		return nil, errors.New("path to the disk image is required")
+	}
+	path := pCfg.Options[OPTION_PATH]
+
+	img, err := OpenImage(path)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := newProvider(img, pCfg)
+	if err != nil {
+		img.Close()
+		return nil, err
+	}
+	log.Debug().Str("path", path).Str("format", img.Format()).Str("filesystem", p.root.fs.Type()).
+		Int("partition", p.root.partition.Index).Msg("load disk image")
+
+	if p.identifier == "" {
+		if uuid := p.root.fs.UUID(); uuid != "" {
+			p.identifier = "//platformid.api.mondoo.app/runtime/disk-image/uuid/" + uuid
+		} else {
+			hash, err := fsutil.LocalFileSha256(path)
+			if err != nil {
+				p.Close()
+				return nil, err
+			}
+			p.identifier = "//platformid.api.mondoo.app/runtime/disk-image/hash/" + hash
+		}
+	}
+	return p, nil
+}
+
+func newProvider(img Image, pCfg *providers.Config) (*Provider, error) {
+	partitions, err := Partitions(img, img.Size())
+	if err != nil {
+		return nil, err
+	}
+
+	volumes := []volume{}
+	for i := range partitions {
+		part := partitions[i]
+		fsys, err := openFilesystem(io.NewSectionReader(img, part.Offset, part.Size), part.Size)
+		if err != nil {
+			log.Debug().Err(err).Int("partition", part.Index).Msg("skip partition of disk image")
+			continue
+		}
+		volumes = append(volumes, volume{partition: part, fs: fsys})
+	}
+	if len(volumes) == 0 {
+		return nil, errors.New("no supported filesystem found in disk image")
+	}
+
+	root, err := rootVolume(volumes, pCfg.Options[OPTION_PARTITION])
+	if err != nil {
+		return nil, err
+	}
+
+	fs := newFS(root.fs)
+	mountVolumes(fs, root, volumes)
+
+	return &Provider{
+		image:      img,
+		fs:         fs,
+		root:       root,
+		volumes:    volumes,
+		kind:       pCfg.Kind,
+		runtime:    pCfg.Runtime,
+		identifier: pCfg.PlatformId,
+	}, nil
+}
+
+// rootVolume selects the partition that holds the operating system
+func rootVolume(volumes []volume, partition string) (volume, error) {
+	if partition != "" {
+		index, err := strconv.Atoi(partition)
+		if err != nil {
+			return volume{}, fmt.Errorf("invalid partition '%s'", partition)
+		}
+		for i := range volumes {
+			if volumes[i].partition.Index == index {
+				return volumes[i], nil
+			}
+		}
+		return volume{}, fmt.Errorf("partition %d does not contain a supported filesystem", index)
+	}
+
+	largest := volumes[0]
+	for i := range volumes {
+		if _, err := newFS(volumes[i].fs).Stat("/etc/os-release"); err == nil {
+			return volumes[i], nil
+		}
+		if volumes[i].partition.Size > largest.partition.Size {
+			largest = volumes[i]
+		}
+	}
+	return largest, nil
+}
+
+// mountVolumes mounts the other partitions like the system would do it
+// according to /etc/fstab, e.g. /boot and /boot/efi
+func mountVolumes(fs *FS, root volume, volumes []volume) {
+	f, err := fs.Open("/etc/fstab")
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		mountpoint := fields[1]
+		if !strings.HasPrefix(mountpoint, "/") || mountpoint == "/" {
+			continue
+		}
+
+		for i := range volumes {
+			v := volumes[i]
+			if v.fs == root.fs || !matchesFstab(fields[0], v) {
+				continue
+			}
+			log.Debug().Str("mountpoint", mountpoint).Int("partition", v.partition.Index).Msg("mount partition of disk image")
+			fs.mount(mountpoint, v.fs)
+			break
+		}
+	}
+}
+
+// matchesFstab checks if the fstab source references the volume
+func matchesFstab(source string, v volume) bool {
+	var kind, value string
+	switch {
+	case strings.HasPrefix(source, "/dev/disk/by-uuid/"):
+		kind, value = "UUID", strings.TrimPrefix(source, "/dev/disk/by-uuid/")
+	case strings.HasPrefix(source, "/dev/disk/by-label/"):
+		kind, value = "LABEL", strings.TrimPrefix(source, "/dev/disk/by-label/")
+	case strings.HasPrefix(source, "/dev/disk/by-partuuid/"):
+		kind, value = "PARTUUID", strings.TrimPrefix(source, "/dev/disk/by-partuuid/")
+	default:
+		var ok bool
+		kind, value, ok = strings.Cut(source, "=")
+		if !ok {
+			return false
+		}
+		value = strings.Trim(value, "\"")
+	}
+	if value == "" {
+		return false
+	}
+
+	switch kind {
+	case "UUID":
+		return strings.EqualFold(value, v.fs.UUID())
+	case "LABEL":
+		return value == v.fs.Label()
+	case "PARTUUID":
+		return strings.EqualFold(value, v.partition.UUID)
+	}
+	return false
+}
+
+// Provider reads the filesystems of a disk image without mounting them
+type Provider struct {
+	image      Image
+	fs         *FS
+	root       volume
+	volumes    []volume
+	kind       providers.Kind
+	runtime    string
+	identifier string
+}
+
+func (p *Provider) Identifier() (string, error) {
+	return p.identifier, nil
+}
+
+func (p *Provider) RunCommand(command string) (*os_provider.Command, error) {
+	return nil, providers.ErrRunCommandNotImplemented
+}
+
+func (p *Provider) FS() afero.Fs {
+	return p.fs
+}
+
+func (p *Provider) FileInfo(path string) (os_provider.FileInfoDetails, error) {
+	afs := &afero.Afero{Fs: p.fs}
+	stat, err := afs.Stat(path)
+	if err != nil {
+		return os_provider.FileInfoDetails{}, err
+	}
+
+	uid := int64(-1)
+	gid := int64(-1)
+	if n, ok := stat.Sys().(*inode); ok {
+		uid = n.uid
+		gid = n.gid
+	}
+	mode := stat.Mode()
+
+	return os_provider.FileInfoDetails{
+		Mode: os_provider.FileModeDetails{FileMode: mode},
+		Size: stat.Size(),
+		Uid:  uid,
+		Gid:  gid,
+	}, nil
+}
+
+func (p *Provider) Close() {
+	if p.image != nil {
+		p.image.Close()
+	}
+}
+
+func (p *Provider) Capabilities() providers.Capabilities {
+	return providers.Capabilities{
+		providers.Capability_File,
+		providers.Capability_FileSearch,
+	}
+}
+
+func (p *Provider) Kind() providers.Kind {
+	if p.kind == providers.Kind_KIND_UNKNOWN {
+		return providers.Kind_KIND_VIRTUAL_MACHINE_IMAGE
+	}
+	return p.kind
+}
+
+func (p *Provider) Runtime() string {
+	return p.runtime
+}
+
+func (p *Provider) PlatformIdDetectors() []providers.PlatformIdDetector {
+	return []providers.PlatformIdDetector{
+		providers.TransportPlatformIdentifierDetector,
+	}
+}
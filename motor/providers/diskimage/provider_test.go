@@ -0,0 +1,275 @@
+package diskimage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/motor/providers"
+)
+
+const (
+	espType   = "c12a7328-f81f-11d2-ba4b-00a0c93ec93b"
+	linuxType = "0fc63daf-8483-4772-8e79-3d69d8477de4"
+)
+
+// guidBytes encodes the guid in the mixed-endian gpt format
+func guidBytes(guid string) []byte {
+	raw, _ := hex.DecodeString(strings.ReplaceAll(guid, "-", ""))
+	b := make([]byte, 16)
+	binary.LittleEndian.PutUint32(b, binary.BigEndian.Uint32(raw[0:]))
+	binary.LittleEndian.PutUint16(b[4:], binary.BigEndian.Uint16(raw[4:]))
+	binary.LittleEndian.PutUint16(b[6:], binary.BigEndian.Uint16(raw[6:]))
+	copy(b[8:], raw[8:])
+	return b
+}
+
+type testPartition struct {
+	typ   string
+	uuid  string
+	label string
+	data  []byte
+}
+
+// buildGPT creates a disk with a protective mbr and gpt, partitions are
+// aligned to 1MiB
+func buildGPT(parts []testPartition) []byte {
+	const align = 2048
+	lba := align
+	entries := make([]byte, 128*128)
+	for i, part := range parts {
+		sectors := (len(part.data) + sectorSize - 1) / sectorSize
+		e := entries[i*128:]
+		copy(e[0:], guidBytes(part.typ))
+		copy(e[16:], guidBytes(part.uuid))
+		binary.LittleEndian.PutUint64(e[32:], uint64(lba))
+		binary.LittleEndian.PutUint64(e[40:], uint64(lba+sectors-1))
+		for j, c := range utf16.Encode([]rune(part.label)) {
+			binary.LittleEndian.PutUint16(e[56+j*2:], c)
+		}
+		lba = (lba + sectors + align - 1) / align * align
+	}
+	disk := make([]byte, (lba+align)*sectorSize)
+
+	mbr := disk[0:sectorSize]
+	mbr[446+4] = mbrProtective
+	binary.LittleEndian.PutUint32(mbr[446+8:], 1)
+	binary.LittleEndian.PutUint32(mbr[446+12:], uint32(len(disk)/sectorSize-1))
+	mbr[510], mbr[511] = 0x55, 0xaa
+
+	header := disk[sectorSize : 2*sectorSize]
+	copy(header, gptMagic)
+	binary.LittleEndian.PutUint64(header[72:], 2)
+	binary.LittleEndian.PutUint32(header[80:], 128)
+	binary.LittleEndian.PutUint32(header[84:], 128)
+	copy(disk[2*sectorSize:], entries)
+
+	for i := range parts {
+		start := binary.LittleEndian.Uint64(entries[i*128+32:])
+		copy(disk[start*sectorSize:], parts[i].data)
+	}
+	return disk
+}
+
+type mbrPartition struct {
+	typ  byte
+	data []byte
+}
+
+// buildMBR creates a disk with primary partitions and logical partitions in
+// an extended partition
+func buildMBR(primary []mbrPartition, logical []mbrPartition) []byte {
+	const align = 2048
+	sectors := func(data []byte) int {
+		return (len(data) + sectorSize - 1) / sectorSize
+	}
+
+	size := align
+	for _, p := range primary {
+		size += (sectors(p.data) + align - 1) / align * align
+	}
+	extStart := size
+	for _, p := range logical {
+		size += align + (sectors(p.data)+align-1)/align*align
+	}
+	disk := make([]byte, size*sectorSize)
+	setEntry := func(table []byte, i int, typ byte, start int, count int) {
+		e := table[446+i*16:]
+		e[4] = typ
+		binary.LittleEndian.PutUint32(e[8:], uint32(start))
+		binary.LittleEndian.PutUint32(e[12:], uint32(count))
+		table[510], table[511] = 0x55, 0xaa
+	}
+
+	lba := align
+	for i, p := range primary {
+		setEntry(disk, i, p.typ, lba, sectors(p.data))
+		copy(disk[lba*sectorSize:], p.data)
+		lba += (sectors(p.data) + align - 1) / align * align
+	}
+	if len(logical) == 0 {
+		return disk
+	}
+	setEntry(disk, len(primary), mbrExtendedLBA, extStart, size-extStart)
+
+	// every logical partition is preceded by an ebr that links to the next one
+	for i, p := range logical {
+		ebr := disk[lba*sectorSize:]
+		setEntry(ebr, 0, p.typ, align, sectors(p.data))
+		copy(disk[(lba+align)*sectorSize:], p.data)
+		next := lba + align + (sectors(p.data)+align-1)/align*align
+		if i < len(logical)-1 {
+			setEntry(ebr, 1, mbrExtendedCHS, next-extStart, 0)
+		}
+		lba = next
+	}
+	return disk
+}
+
+func TestProvider_GPT(t *testing.T) {
+	rootfs := loadTestImage(t, "ext4.img.gz")
+	disk := buildGPT([]testPartition{
+		{typ: espType, uuid: "8a4f2b1c-3d5e-4f60-8172-93a4b5c6d7e8", label: "EFI System Partition", data: buildFAT()},
+		{typ: linuxType, uuid: "5c3b2a19-0817-4e6d-9c5b-4a3928170615", label: "root", data: rootfs},
+	})
+
+	partitions, err := Partitions(bytes.NewReader(disk), int64(len(disk)))
+	require.NoError(t, err)
+	require.Len(t, partitions, 2)
+	assert.Equal(t, 1, partitions[0].Index)
+	assert.Equal(t, int64(2048*sectorSize), partitions[0].Offset)
+	assert.Equal(t, espType, partitions[0].Type)
+	assert.Equal(t, "EFI System Partition", partitions[0].Label)
+	assert.Equal(t, "5c3b2a19-0817-4e6d-9c5b-4a3928170615", partitions[1].UUID)
+	assert.Equal(t, int64(len(rootfs)), partitions[1].Size)
+
+	// the disk is scanned as qcow2 image
+	path := filepath.Join(t.TempDir(), "disk.qcow2")
+	writeQcow2(t, path, disk)
+	p, err := New(&providers.Config{
+		Backend: providers.ProviderType_DISK_IMAGE,
+		Options: map[string]string{OPTION_PATH: path},
+	})
+	require.NoError(t, err)
+	defer p.Close()
+
+	assert.Equal(t, providers.Kind_KIND_VIRTUAL_MACHINE_IMAGE, p.Kind())
+	id, err := p.Identifier()
+	require.NoError(t, err)
+	assert.Equal(t, "//platformid.api.mondoo.app/runtime/disk-image/uuid/2f1e8c1a-6c31-4b8e-9d1a-0b7f5a3c2e11", id)
+
+	data, err := afero.ReadFile(p.FS(), "/etc/os-release")
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "ID=debian")
+
+	// the efi system partition is mounted according to /etc/fstab
+	data, err = afero.ReadFile(p.FS(), "/boot/efi/EFI/BOOT/BOOTX64.EFI")
+	require.NoError(t, err)
+	assert.Equal(t, testContent(3*2048-100), data)
+	names, err := afero.ReadDir(p.FS(), "/boot")
+	require.NoError(t, err)
+	require.Len(t, names, 1)
+	assert.True(t, names[0].IsDir())
+
+	details, err := p.FileInfo("/etc/shadow")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), details.Uid)
+	assert.Equal(t, int64(42), details.Gid)
+	assert.Equal(t, os.FileMode(0o640), details.Mode.FileMode)
+
+	files, err := p.fs.Find("/boot", regexp.MustCompile(".*\\.EFI"), "file")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/boot/efi/EFI/BOOT/BOOTX64.EFI"}, files)
+
+	_, err = p.RunCommand("ls")
+	assert.Equal(t, providers.ErrRunCommandNotImplemented, err)
+}
+
+func TestInvalidGPT(t *testing.T) {
+	disk := buildGPT([]testPartition{
+		{typ: linuxType, uuid: "5c3b2a19-0817-4e6d-9c5b-4a3928170615", label: "root", data: buildFAT()},
+	})
+	header := disk[sectorSize:]
+
+	binary.LittleEndian.PutUint32(header[84:], 0x80000000)
+	_, err := Partitions(bytes.NewReader(disk), int64(len(disk)))
+	assert.EqualError(t, err, "invalid gpt header")
+
+	binary.LittleEndian.PutUint32(header[84:], 128)
+	binary.LittleEndian.PutUint64(header[72:], 1<<60)
+	_, err = Partitions(bytes.NewReader(disk), int64(len(disk)))
+	assert.EqualError(t, err, "invalid gpt partition entries location")
+}
+
+func TestProvider_MBR(t *testing.T) {
+	legacy := loadTestImage(t, "ext2.img.gz")
+	rootfs := loadTestImage(t, "ext4.img.gz")
+	disk := buildMBR(
+		[]mbrPartition{{typ: 0x0c, data: buildFAT()}},
+		[]mbrPartition{{typ: 0x83, data: legacy}, {typ: 0x83, data: rootfs}},
+	)
+
+	partitions, err := Partitions(bytes.NewReader(disk), int64(len(disk)))
+	require.NoError(t, err)
+	require.Len(t, partitions, 3)
+	assert.Equal(t, []int{1, 5, 6}, []int{partitions[0].Index, partitions[1].Index, partitions[2].Index})
+	assert.Equal(t, "0x0c", partitions[0].Type)
+	assert.Equal(t, int64(len(rootfs)), partitions[2].Size)
+
+	path := filepath.Join(t.TempDir(), "disk.img")
+	require.NoError(t, os.WriteFile(path, disk, 0o644))
+
+	// both linux partitions have an os-release, the first one is used
+	p, err := New(&providers.Config{Options: map[string]string{OPTION_PATH: path}})
+	require.NoError(t, err)
+	assert.Equal(t, 5, p.root.partition.Index)
+	p.Close()
+
+	p, err = New(&providers.Config{Options: map[string]string{OPTION_PATH: path, OPTION_PARTITION: "6"}})
+	require.NoError(t, err)
+	defer p.Close()
+	assert.Equal(t, 6, p.root.partition.Index)
+	_, err = p.FS().Stat("/boot/efi/EFI")
+	assert.NoError(t, err)
+
+	esp, err := New(&providers.Config{Options: map[string]string{OPTION_PATH: path, OPTION_PARTITION: "1"}})
+	require.NoError(t, err)
+	assert.Equal(t, "vfat", esp.root.fs.Type())
+	esp.Close()
+	_, err = New(&providers.Config{Options: map[string]string{OPTION_PATH: path, OPTION_PARTITION: "2"}})
+	assert.EqualError(t, err, "partition 2 does not contain a supported filesystem")
+}
+
+func TestProvider_Unpartitioned(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "disk.vhd")
+	writeVHD(t, path, loadTestImage(t, "ext2.img.gz"))
+
+	p, err := New(&providers.Config{Options: map[string]string{OPTION_PATH: path}})
+	require.NoError(t, err)
+	defer p.Close()
+	assert.Equal(t, int64(0), p.root.partition.Offset)
+	_, err = p.FS().Stat("/var/big")
+	assert.NoError(t, err)
+}
+
+func TestMatchesFstab(t *testing.T) {
+	v := volume{
+		partition: Partition{UUID: "5c3b2a19-0817-4e6d-9c5b-4a3928170615"},
+		fs:        &fat{uuid: "1234-ABCD", label: "EFI"},
+	}
+	assert.True(t, matchesFstab("UUID=1234-abcd", v))
+	assert.True(t, matchesFstab("LABEL=EFI", v))
+	assert.True(t, matchesFstab("PARTUUID=5C3B2A19-0817-4E6D-9C5B-4A3928170615", v))
+	assert.True(t, matchesFstab("/dev/disk/by-uuid/1234-ABCD", v))
+	assert.False(t, matchesFstab("/dev/sda1", v))
+	assert.False(t, matchesFstab("UUID=", v))
+}
@@ -0,0 +1,197 @@
+package diskimage
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// the format is described in the qemu repository
+// https://gitlab.com/qemu-project/qemu/-/blob/master/docs/interop/qcow2.txt
+const (
+	qcow2Magic = "QFI\xfb"
+
+	qcow2OffsetMask      = 0x00fffffffffffe00
+	qcow2Compressed      = 1 << 62
+	qcow2ZeroCluster     = 1
+	qcow2IncompatDirty   = 1 << 0
+	qcow2IncompatCorrupt = 1 << 1
+
+	// qemu limits the l1 table to 32MiB
+	qcow2MaxL1Size = 32 << 20
+)
+
+type qcow2 struct {
+	f           *os.File
+	size        int64
+	clusterBits uint
+	clusterSize int64
+	l1          []uint64
+
+	mu sync.Mutex
+	// l2 tables are cached by their offset in the image file
+	l2 map[uint64][]uint64
+}
+
+func newQcow2(f *os.File) (*qcow2, error) {
+	header := make([]byte, 104)
+	if _, err := f.ReadAt(header, 0); err != nil {
+		return nil, err
+	}
+
+	version := binary.BigEndian.Uint32(header[4:])
+	if version != 2 && version != 3 {
+		return nil, fmt.Errorf("unsupported qcow2 version %d", version)
+	}
+	if binary.BigEndian.Uint64(header[8:]) != 0 {
+		return nil, errors.New("qcow2 images with backing file are not supported")
+	}
+	if binary.BigEndian.Uint32(header[32:]) != 0 {
+		return nil, errors.New("encrypted qcow2 images are not supported")
+	}
+	if version == 3 {
+		// external data files, zstd compression and extended l2 entries change
+		// the cluster mapping
+		incompat := binary.BigEndian.Uint64(header[72:])
+		if incompat&^(qcow2IncompatDirty|qcow2IncompatCorrupt) != 0 {
+			return nil, fmt.Errorf("unsupported qcow2 features 0x%x", incompat)
+		}
+	}
+
+	q := &qcow2{
+		f:           f,
+		size:        int64(binary.BigEndian.Uint64(header[24:])),
+		clusterBits: uint(binary.BigEndian.Uint32(header[20:])),
+		l2:          map[uint64][]uint64{},
+	}
+	if q.clusterBits < 9 || q.clusterBits > 21 {
+		return nil, errors.New("invalid qcow2 cluster size")
+	}
+	q.clusterSize = 1 << q.clusterBits
+
+	if q.size < 0 {
+		return nil, errors.New("invalid qcow2 image size")
+	}
+
+	// the l1 table is read completely, ensure it fits into the image file before
+	// allocating it
+	l1Size := int64(binary.BigEndian.Uint32(header[36:]))
+	l1Offset := int64(binary.BigEndian.Uint64(header[40:]))
+	if l1Size*8 > qcow2MaxL1Size {
+		return nil, fmt.Errorf("invalid qcow2 l1 table with %d entries", l1Size)
+	}
+	if err := checkRange(f, l1Offset, l1Size*8); err != nil {
+		return nil, fmt.Errorf("invalid qcow2 l1 table: %w", err)
+	}
+	var err error
+	q.l1, err = readTable(f, l1Offset, int(l1Size))
+	if err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+func readTable(r io.ReaderAt, off int64, entries int) ([]uint64, error) {
+	buf := make([]byte, entries*8)
+	if _, err := r.ReadAt(buf, off); err != nil {
+		return nil, err
+	}
+	res := make([]uint64, entries)
+	for i := range res {
+		res[i] = binary.BigEndian.Uint64(buf[i*8:])
+	}
+	return res, nil
+}
+
+func (q *qcow2) Format() string {
+	return "qcow2"
+}
+
+func (q *qcow2) Size() int64 {
+	return q.size
+}
+
+func (q *qcow2) Close() error {
+	return q.f.Close()
+}
+
+func (q *qcow2) l2Table(off uint64) ([]uint64, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if table, ok := q.l2[off]; ok {
+		return table, nil
+	}
+	table, err := readTable(q.f, int64(off), int(q.clusterSize/8))
+	if err != nil {
+		return nil, err
+	}
+	q.l2[off] = table
+	return table, nil
+}
+
+func (q *qcow2) ReadAt(p []byte, off int64) (int, error) {
+	return readBlocks(p, off, q.size, q.clusterSize, q.readCluster)
+}
+
+func (q *qcow2) readCluster(cluster int64, p []byte, off int64) error {
+	l2Entries := int64(q.clusterSize / 8)
+	l1Index := cluster / l2Entries
+	if l1Index >= int64(len(q.l1)) {
+		zero(p)
+		return nil
+	}
+	l2Offset := q.l1[l1Index] & qcow2OffsetMask
+	if l2Offset == 0 {
+		zero(p)
+		return nil
+	}
+
+	table, err := q.l2Table(l2Offset)
+	if err != nil {
+		return err
+	}
+	entry := table[cluster%l2Entries]
+
+	if entry&qcow2Compressed != 0 {
+		data, err := q.readCompressed(entry)
+		if err != nil {
+			return err
+		}
+		copy(p, data[off:])
+		return nil
+	}
+
+	hostOffset := entry & qcow2OffsetMask
+	if hostOffset == 0 || entry&qcow2ZeroCluster != 0 {
+		zero(p)
+		return nil
+	}
+	return readFull(q.f, p, int64(hostOffset)+off)
+}
+
+// readCompressed inflates a compressed cluster, the entry encodes the offset
+// and the number of additional sectors
+func (q *qcow2) readCompressed(entry uint64) ([]byte, error) {
+	x := 62 - (q.clusterBits - 8)
+	hostOffset := entry & (1<<x - 1)
+	sectors := (entry>>x)&(1<<(q.clusterBits-8)-1) + 1
+	compressedSize := int64(sectors*sectorSize) - int64(hostOffset%sectorSize)
+
+	buf := make([]byte, compressedSize)
+	if err := readFull(q.f, buf, int64(hostOffset)); err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, q.clusterSize)
+	r := flate.NewReader(bytes.NewReader(buf))
+	defer r.Close()
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, fmt.Errorf("failed to decompress qcow2 cluster: %w", err)
+	}
+	return data, nil
+}
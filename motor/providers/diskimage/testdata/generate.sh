@@ -0,0 +1,38 @@
+#!/bin/sh
+# Generates the filesystem images used by the tests, requires e2fsprogs and
+# root permissions to set the file owners. FAT and XFS filesystems as well as
+# the partition tables and image formats are built by the tests.
+set -e
+
+tmp=$(mktemp -d)
+trap 'rm -rf "$tmp"' EXIT
+out=$(cd "$(dirname "$0")" && pwd)
+
+cd "$tmp"
+mkdir -p root/etc root/boot/efi root/usr/lib/modules root/home/user root/var root/etc/many
+printf 'NAME="Debian GNU/Linux"\nID=debian\nVERSION_ID="11"\n' > root/etc/os-release
+printf '# /etc/fstab\nUUID=2f1e8c1a-6c31-4b8e-9d1a-0b7f5a3c2e11 / ext4 errors=remount-ro 0 1\nUUID=1234-ABCD /boot/efi vfat umask=0077 0 1\n' > root/etc/fstab
+ln -s usr/lib root/lib
+echo "options test" > root/usr/lib/modules/test.conf
+ln -s /usr/lib/modules/test.conf root/etc/a-very-long-symlink-name-that-does-not-fit-into-the-inode
+ln -s ../usr/lib/modules/../../lib/modules/../../lib/modules/test.conf root/etc/long-target
+echo "root:*:19000:0:99999:7:::" > root/etc/shadow
+chown 0:42 root/etc/shadow
+chmod 0640 root/etc/shadow
+echo hello > root/home/user/file
+chown -R 1000:1000 root/home/user
+python3 -c "import sys; sys.stdout.write('0123456789' * 30000)" > root/var/big
+truncate -s 1048576 root/var/sparse
+printf end >> root/var/sparse
+for i in $(seq 1 200); do echo "$i" > "root/etc/many/file-$i"; done
+mkfs.ext4 -q -b 1024 -L root -U 2f1e8c1a-6c31-4b8e-9d1a-0b7f5a3c2e11 -d root ext4.img 8M
+
+# ext2 uses indirect block maps instead of extents
+mkdir -p root2/etc root2/var
+cp root/etc/os-release root2/etc/
+cp root/var/big root2/var/
+ln -s ../var/big root2/etc/link
+mkfs.ext2 -q -b 1024 -L legacy -U 7b0c9a2e-1d3f-4e5a-8b6c-9d0e1f2a3b4c -d root2 ext2.img 2M
+
+gzip -9 -n ext4.img ext2.img
+mv ext4.img.gz ext2.img.gz "$out"
@@ -0,0 +1,118 @@
+package diskimage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// the format is described in the Virtual Hard Disk Image Format Specification
+// https://www.microsoft.com/en-us/download/details.aspx?id=23850
+const (
+	vhdMagic        = "conectix"
+	vhdSparseMagic  = "cxsparse"
+	vhdxMagic       = "vhdxfile"
+	vhdFixed        = 2
+	vhdDynamic      = 3
+	vhdDifferencing = 4
+	vhdUnallocated  = 0xffffffff
+)
+
+type vhd struct {
+	f         *os.File
+	size      int64
+	dynamic   bool
+	blockSize int64
+	// bitmapSize is the size of the sector bitmap in front of each block
+	bitmapSize int64
+	bat        []uint32
+}
+
+func newVHD(f *os.File, footer []byte) (*vhd, error) {
+	v := &vhd{
+		f:    f,
+		size: int64(binary.BigEndian.Uint64(footer[48:])),
+	}
+
+	switch diskType := binary.BigEndian.Uint32(footer[60:]); diskType {
+	case vhdFixed:
+		return v, nil
+	case vhdDynamic:
+		v.dynamic = true
+	case vhdDifferencing:
+		return nil, errors.New("differencing vhd images are not supported")
+	default:
+		return nil, fmt.Errorf("unsupported vhd disk type %d", diskType)
+	}
+
+	header := make([]byte, 1024)
+	if _, err := f.ReadAt(header, int64(binary.BigEndian.Uint64(footer[16:]))); err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(header, []byte(vhdSparseMagic)) {
+		return nil, errors.New("invalid vhd dynamic disk header")
+	}
+
+	tableOffset := int64(binary.BigEndian.Uint64(header[16:]))
+	entries := int64(binary.BigEndian.Uint32(header[28:]))
+	v.blockSize = int64(binary.BigEndian.Uint32(header[32:]))
+	if v.blockSize == 0 || v.blockSize%sectorSize != 0 {
+		return nil, errors.New("invalid vhd block size")
+	}
+	bitmap := v.blockSize / sectorSize / 8
+	v.bitmapSize = (bitmap + sectorSize - 1) / sectorSize * sectorSize
+
+	if err := checkRange(f, tableOffset, entries*4); err != nil {
+		return nil, fmt.Errorf("invalid vhd block allocation table: %w", err)
+	}
+	buf := make([]byte, entries*4)
+	if _, err := f.ReadAt(buf, tableOffset); err != nil {
+		return nil, err
+	}
+	v.bat = make([]uint32, entries)
+	for i := range v.bat {
+		v.bat[i] = binary.BigEndian.Uint32(buf[i*4:])
+	}
+	return v, nil
+}
+
+func (v *vhd) Format() string {
+	return "vhd"
+}
+
+func (v *vhd) Size() int64 {
+	return v.size
+}
+
+func (v *vhd) Close() error {
+	return v.f.Close()
+}
+
+func (v *vhd) ReadAt(p []byte, off int64) (int, error) {
+	if !v.dynamic {
+		// fixed disks are raw disks followed by the footer
+		if off >= v.size {
+			return 0, io.EOF
+		}
+		if remaining := v.size - off; int64(len(p)) > remaining {
+			n, err := v.f.ReadAt(p[:remaining], off)
+			if err == nil {
+				err = io.EOF
+			}
+			return n, err
+		}
+		return v.f.ReadAt(p, off)
+	}
+	return readBlocks(p, off, v.size, v.blockSize, v.readBlock)
+}
+
+func (v *vhd) readBlock(block int64, p []byte, off int64) error {
+	if block >= int64(len(v.bat)) || v.bat[block] == vhdUnallocated {
+		zero(p)
+		return nil
+	}
+	return readFull(v.f, p, int64(v.bat[block])*sectorSize+v.bitmapSize+off)
+}
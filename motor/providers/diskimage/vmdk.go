@@ -0,0 +1,340 @@
+package diskimage
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// the sparse extent format is described in the Virtual Disk Format 5.0 specification
+// https://www.vmware.com/app/vmdk/?src=vmdk
+const (
+	vmdkSparseMagic     = "KDMV"
+	vmdkDescriptorMagic = "# Disk DescriptorFile"
+
+	vmdkGDAtEnd          = 0xffffffffffffffff
+	vmdkFlagCompressed   = 1 << 16
+	vmdkGrainUnallocated = 0
+	vmdkGrainZero        = 1
+	// grains are 64KiB by default, the limit protects against corrupt headers
+	vmdkMaxGrainSize = 16 << 20
+)
+
+// vmdkSparse is a hosted sparse extent, the data is stored in grains that
+// are referenced by grain tables
+type vmdkSparse struct {
+	f          *os.File
+	size       int64
+	grainSize  int64
+	gtEntries  int64
+	compressed bool
+	gd         []uint32
+
+	mu sync.Mutex
+	gt map[uint32][]uint32
+}
+
+func newVMDKSparse(f *os.File) (*vmdkSparse, error) {
+	header := make([]byte, sectorSize)
+	if _, err := f.ReadAt(header, 0); err != nil {
+		return nil, err
+	}
+
+	// stream optimized images write the grain directory after the grains and
+	// store the final header in a footer
+	if binary.LittleEndian.Uint64(header[56:]) == vmdkGDAtEnd {
+		stat, err := f.Stat()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := f.ReadAt(header, stat.Size()-2*sectorSize); err != nil {
+			return nil, err
+		}
+		if !bytes.HasPrefix(header, []byte(vmdkSparseMagic)) {
+			return nil, errors.New("invalid vmdk footer")
+		}
+	}
+
+	v := &vmdkSparse{
+		f:          f,
+		size:       int64(binary.LittleEndian.Uint64(header[12:])) * sectorSize,
+		grainSize:  int64(binary.LittleEndian.Uint64(header[20:])) * sectorSize,
+		gtEntries:  int64(binary.LittleEndian.Uint32(header[44:])),
+		compressed: binary.LittleEndian.Uint32(header[8:])&vmdkFlagCompressed != 0,
+		gt:         map[uint32][]uint32{},
+	}
+	if v.size < 0 || v.grainSize <= 0 || v.grainSize > vmdkMaxGrainSize || v.gtEntries == 0 {
+		return nil, errors.New("invalid vmdk header")
+	}
+	// grain tables are read on demand, but they are stored in the file like the directory
+	if err := checkRange(f, 0, v.gtEntries*4); err != nil {
+		return nil, fmt.Errorf("invalid vmdk grain table: %w", err)
+	}
+
+	gdOffset := int64(binary.LittleEndian.Uint64(header[56:]))
+	gdEntries := (v.size/v.grainSize + v.gtEntries - 1) / v.gtEntries
+	if gdOffset > math.MaxInt64/sectorSize {
+		return nil, errors.New("invalid vmdk grain directory offset")
+	}
+	if err := checkRange(f, gdOffset*sectorSize, gdEntries*4); err != nil {
+		return nil, fmt.Errorf("invalid vmdk grain directory: %w", err)
+	}
+	buf := make([]byte, gdEntries*4)
+	if _, err := f.ReadAt(buf, gdOffset*sectorSize); err != nil {
+		return nil, err
+	}
+	v.gd = make([]uint32, gdEntries)
+	for i := range v.gd {
+		v.gd[i] = binary.LittleEndian.Uint32(buf[i*4:])
+	}
+	return v, nil
+}
+
+func (v *vmdkSparse) Format() string {
+	return "vmdk"
+}
+
+func (v *vmdkSparse) Size() int64 {
+	return v.size
+}
+
+func (v *vmdkSparse) Close() error {
+	return v.f.Close()
+}
+
+func (v *vmdkSparse) grainTable(sector uint32) ([]uint32, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if table, ok := v.gt[sector]; ok {
+		return table, nil
+	}
+	buf := make([]byte, v.gtEntries*4)
+	if _, err := v.f.ReadAt(buf, int64(sector)*sectorSize); err != nil {
+		return nil, err
+	}
+	table := make([]uint32, v.gtEntries)
+	for i := range table {
+		table[i] = binary.LittleEndian.Uint32(buf[i*4:])
+	}
+	v.gt[sector] = table
+	return table, nil
+}
+
+func (v *vmdkSparse) ReadAt(p []byte, off int64) (int, error) {
+	return readBlocks(p, off, v.size, v.grainSize, v.readGrain)
+}
+
+func (v *vmdkSparse) readGrain(grain int64, p []byte, off int64) error {
+	gdIndex := grain / v.gtEntries
+	if gdIndex >= int64(len(v.gd)) || v.gd[gdIndex] == 0 {
+		zero(p)
+		return nil
+	}
+	table, err := v.grainTable(v.gd[gdIndex])
+	if err != nil {
+		return err
+	}
+
+	sector := table[grain%v.gtEntries]
+	if sector == vmdkGrainUnallocated || sector == vmdkGrainZero {
+		zero(p)
+		return nil
+	}
+
+	if !v.compressed {
+		return readFull(v.f, p, int64(sector)*sectorSize+off)
+	}
+
+	// compressed grains start with a marker of the lba and the size of the data
+	marker := make([]byte, 12)
+	if _, err := v.f.ReadAt(marker, int64(sector)*sectorSize); err != nil {
+		return err
+	}
+	size := int64(binary.LittleEndian.Uint32(marker[8:]))
+	if err := checkRange(v.f, int64(sector)*sectorSize+12, size); err != nil {
+		return fmt.Errorf("invalid vmdk grain: %w", err)
+	}
+	compressed := make([]byte, size)
+	if err := readFull(v.f, compressed, int64(sector)*sectorSize+12); err != nil {
+		return err
+	}
+	r, err := zlib.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	data := make([]byte, v.grainSize)
+	if _, err := io.ReadFull(r, data); err != nil && err != io.ErrUnexpectedEOF {
+		return fmt.Errorf("failed to decompress vmdk grain: %w", err)
+	}
+	copy(p, data[off:])
+	return nil
+}
+
+// vmdkDescriptor is a disk that consists of multiple extent files
+type vmdkDescriptor struct {
+	extents []vmdkExtent
+	size    int64
+}
+
+type vmdkExtent struct {
+	offset int64
+	size   int64
+	// data is nil for zero extents
+	data   io.ReaderAt
+	closer io.Closer
+}
+
+func newVMDKDescriptor(path string, f *os.File) (*vmdkDescriptor, error) {
+	// the descriptor file is not needed anymore once the extents are opened
+	defer f.Close()
+
+	d := &vmdkDescriptor{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "parentFileNameHint") {
+			d.Close()
+			return nil, errors.New("vmdk images with parent disk are not supported")
+		}
+		if !strings.HasPrefix(line, "RW ") && !strings.HasPrefix(line, "RDONLY ") {
+			continue
+		}
+
+		extent, err := parseVMDKExtent(filepath.Dir(path), line)
+		if err != nil {
+			d.Close()
+			return nil, err
+		}
+		extent.offset = d.size
+		d.size += extent.size
+		d.extents = append(d.extents, extent)
+	}
+	if err := scanner.Err(); err != nil {
+		d.Close()
+		return nil, err
+	}
+	if len(d.extents) == 0 {
+		return nil, errors.New("vmdk descriptor has no extents")
+	}
+	return d, nil
+}
+
+// parseVMDKExtent parses an extent line like: RW 4192256 FLAT "disk-flat.vmdk" 0
+func parseVMDKExtent(dir string, line string) (vmdkExtent, error) {
+	fields := strings.Fields(line)
+	if len(fields) < 3 {
+		return vmdkExtent{}, fmt.Errorf("invalid vmdk extent: %s", line)
+	}
+	sectors, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return vmdkExtent{}, fmt.Errorf("invalid vmdk extent: %s", line)
+	}
+	extent := vmdkExtent{size: sectors * sectorSize}
+
+	typ := fields[2]
+	if typ == "ZERO" {
+		return extent, nil
+	}
+	if len(fields) < 4 {
+		return vmdkExtent{}, fmt.Errorf("invalid vmdk extent: %s", line)
+	}
+	name := strings.Trim(fields[3], "\"")
+	if !filepath.IsAbs(name) {
+		name = filepath.Join(dir, name)
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		return vmdkExtent{}, err
+	}
+
+	switch typ {
+	case "FLAT", "VMFS":
+		var offset int64
+		if len(fields) > 4 {
+			offset, err = strconv.ParseInt(fields[4], 10, 64)
+			if err != nil {
+				f.Close()
+				return vmdkExtent{}, fmt.Errorf("invalid vmdk extent: %s", line)
+			}
+		}
+		extent.data = io.NewSectionReader(f, offset*sectorSize, extent.size)
+		extent.closer = f
+	case "SPARSE":
+		sparse, err := newVMDKSparse(f)
+		if err != nil {
+			f.Close()
+			return vmdkExtent{}, err
+		}
+		extent.data = sparse
+		extent.closer = sparse
+	default:
+		f.Close()
+		return vmdkExtent{}, fmt.Errorf("unsupported vmdk extent type %s", typ)
+	}
+	return extent, nil
+}
+
+func (d *vmdkDescriptor) Format() string {
+	return "vmdk"
+}
+
+func (d *vmdkDescriptor) Size() int64 {
+	return d.size
+}
+
+func (d *vmdkDescriptor) Close() error {
+	for i := range d.extents {
+		if d.extents[i].closer != nil {
+			d.extents[i].closer.Close()
+		}
+	}
+	return nil
+}
+
+func (d *vmdkDescriptor) ReadAt(p []byte, off int64) (int, error) {
+	if off >= d.size {
+		return 0, io.EOF
+	}
+
+	var eof error
+	if remaining := d.size - off; int64(len(p)) > remaining {
+		p = p[:remaining]
+		eof = io.EOF
+	}
+
+	n := 0
+	for i := range d.extents {
+		e := d.extents[i]
+		pos := off + int64(n)
+		if n == len(p) {
+			break
+		}
+		if pos >= e.offset+e.size {
+			continue
+		}
+		chunk := e.offset + e.size - pos
+		if chunk > int64(len(p)-n) {
+			chunk = int64(len(p) - n)
+		}
+		buf := p[n : int64(n)+chunk]
+		if e.data == nil {
+			zero(buf)
+		} else if err := readFull(e.data, buf, pos-e.offset); err != nil {
+			return n, err
+		}
+		n += int(chunk)
+	}
+	return n, eof
+}
@@ -0,0 +1,462 @@
+package diskimage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// the on-disk format is described in the XFS Algorithms & Data Structures document
+// https://mirrors.edge.kernel.org/pub/linux/utils/fs/xfs/docs/xfs_filesystem_structure.pdf
+const (
+	xfsMagic       = "XFSB"
+	xfsInodeMagic  = "IN"
+	xfsBmapMagic   = "BMAP"
+	xfsBmap3Magic  = "BMA3"
+	xfsSymlink3    = "XSLM"
+	xfsDirBlock    = "XD2B"
+	xfsDirData     = "XD2D"
+	xfsDir3Block   = "XDB3"
+	xfsDir3Data    = "XDD3"
+	xfsDirLeafOff  = 32 << 30
+	xfsDirFreeTag  = 0xffff
+	xfsBigtimeBias = 1 << 31
+
+	xfsFormatLocal   = 1
+	xfsFormatExtents = 2
+	xfsFormatBtree   = 3
+
+	xfsVersion2Ftype   = 0x200
+	xfsIncompatFtype   = 0x1
+	xfsDiflag2Bigtime  = 1 << 3
+	xfsDiflag2Nrext64  = 1 << 4
+	xfsCoreSizeV2      = 100
+	xfsCoreSizeV3      = 176
+	xfsBmbtHeaderV4    = 24
+	xfsBmbtHeaderV5    = 72
+	xfsSymlinkHeaderV5 = 56
+)
+
+type xfs struct {
+	dev       io.ReaderAt
+	blockSize int64
+	agBlocks  uint64
+	agBlkLog  uint
+	inoPBLog  uint
+	inodeSize int64
+	rootIno   uint64
+	dirBlkLog uint
+	v5        bool
+	ftype     bool
+	uuid      string
+	label     string
+}
+
+// xfsInode holds the data fork of an inode
+type xfsInode struct {
+	format   uint8
+	nextents uint64
+	fork     []byte
+}
+
+func newXFS(dev io.ReaderAt) (*xfs, error) {
+	sb := make([]byte, 512)
+	if _, err := dev.ReadAt(sb, 0); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(sb[0:4], []byte(xfsMagic)) {
+		return nil, errors.New("no xfs filesystem found")
+	}
+
+	fs := &xfs{
+		dev:       dev,
+		blockSize: int64(binary.BigEndian.Uint32(sb[4:])),
+		uuid:      uuidString(sb[32:48]),
+		rootIno:   binary.BigEndian.Uint64(sb[56:]),
+		agBlocks:  uint64(binary.BigEndian.Uint32(sb[84:])),
+		inodeSize: int64(binary.BigEndian.Uint16(sb[104:])),
+		label:     cString(sb[108:120]),
+		inoPBLog:  uint(sb[123]),
+		agBlkLog:  uint(sb[124]),
+		dirBlkLog: uint(sb[192]),
+	}
+
+	version := binary.BigEndian.Uint16(sb[100:]) & 0xf
+	fs.v5 = version == 5
+	if fs.v5 {
+		fs.ftype = binary.BigEndian.Uint32(sb[216:])&xfsIncompatFtype != 0
+	} else {
+		fs.ftype = binary.BigEndian.Uint32(sb[200:])&xfsVersion2Ftype != 0
+	}
+	if fs.blockSize == 0 || fs.inodeSize == 0 {
+		return nil, errors.New("invalid xfs superblock")
+	}
+	return fs, nil
+}
+
+func (fs *xfs) Type() string {
+	return "xfs"
+}
+
+func (fs *xfs) UUID() string {
+	return fs.uuid
+}
+
+func (fs *xfs) Label() string {
+	return fs.label
+}
+
+func (fs *xfs) Root() uint64 {
+	return fs.rootIno
+}
+
+// fsbOffset converts a filesystem block number into the offset on the device,
+// block numbers encode the allocation group in the upper bits
+func (fs *xfs) fsbOffset(fsb uint64) int64 {
+	ag := fsb >> fs.agBlkLog
+	agBlock := fsb & (1<<fs.agBlkLog - 1)
+	return int64(ag*fs.agBlocks+agBlock) * fs.blockSize
+}
+
+func (fs *xfs) Inode(ino uint64) (*inode, error) {
+	block := ino >> fs.inoPBLog
+	index := int64(ino & (1<<fs.inoPBLog - 1))
+
+	buf := make([]byte, fs.inodeSize)
+	if _, err := fs.dev.ReadAt(buf, fs.fsbOffset(block)+index*fs.inodeSize); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(buf[0:2], []byte(xfsInodeMagic)) {
+		return nil, fmt.Errorf("invalid xfs inode %d", ino)
+	}
+
+	mode := binary.BigEndian.Uint16(buf[2:])
+	version := buf[4]
+	core := int64(xfsCoreSizeV2)
+	var flags2 uint64
+	if version >= 3 {
+		core = xfsCoreSizeV3
+		flags2 = binary.BigEndian.Uint64(buf[120:])
+	}
+
+	nextents := uint64(binary.BigEndian.Uint32(buf[76:]))
+	if flags2&xfsDiflag2Nrext64 != 0 {
+		nextents = binary.BigEndian.Uint64(buf[24:])
+	}
+
+	forkSize := fs.inodeSize - core
+	if forkOff := int64(buf[82]); forkOff != 0 {
+		forkSize = forkOff * 8
+	}
+
+	var mtime time.Time
+	if flags2&xfsDiflag2Bigtime != 0 {
+		ns := binary.BigEndian.Uint64(buf[40:])
+		mtime = time.Unix(int64(ns/1e9)-xfsBigtimeBias, int64(ns%1e9))
+	} else {
+		mtime = time.Unix(int64(int32(binary.BigEndian.Uint32(buf[40:]))), int64(binary.BigEndian.Uint32(buf[44:])))
+	}
+
+	return &inode{
+		ino:   ino,
+		mode:  unixMode(uint32(mode)),
+		size:  int64(binary.BigEndian.Uint64(buf[56:])),
+		uid:   int64(binary.BigEndian.Uint32(buf[8:])),
+		gid:   int64(binary.BigEndian.Uint32(buf[12:])),
+		mtime: mtime,
+		data: &xfsInode{
+			format:   buf[5],
+			nextents: nextents,
+			fork:     buf[core : core+forkSize],
+		},
+	}, nil
+}
+
+// parseExtents decodes the packed 128 bit extent records
+func parseExtents(buf []byte, count uint64) []extent {
+	res := make([]extent, 0, count)
+	for i := uint64(0); i < count && int(i*16+16) <= len(buf); i++ {
+		l0 := binary.BigEndian.Uint64(buf[i*16:])
+		l1 := binary.BigEndian.Uint64(buf[i*16+8:])
+		res = append(res, extent{
+			logical:  (l0 & (1<<63 - 1)) >> 9,
+			physical: (l0&0x1ff)<<43 | l1>>21,
+			length:   l1 & (1<<21 - 1),
+			zero:     l0>>63 != 0,
+		})
+	}
+	return res
+}
+
+// extents returns the block mapping of the data fork, block numbers are
+// converted from filesystem blocks into device blocks
+func (fs *xfs) extents(n *inode) ([]extent, error) {
+	data := n.data.(*xfsInode)
+
+	var res []extent
+	switch data.format {
+	case xfsFormatExtents:
+		res = parseExtents(data.fork, data.nextents)
+	case xfsFormatBtree:
+		// the root of the btree is stored in the inode, without block header
+		level := binary.BigEndian.Uint16(data.fork[0:])
+		numrecs := int(binary.BigEndian.Uint16(data.fork[2:]))
+		maxrecs := (len(data.fork) - 4) / 16
+		if numrecs > maxrecs {
+			return nil, errors.New("invalid xfs bmap btree root")
+		}
+		for i := 0; i < numrecs; i++ {
+			ptr := binary.BigEndian.Uint64(data.fork[4+maxrecs*8+i*8:])
+			if err := fs.walkBmbt(ptr, int(level)-1, &res); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported xfs data fork format %d", data.format)
+	}
+
+	for i := range res {
+		res[i].physical = uint64(fs.fsbOffset(res[i].physical) / fs.blockSize)
+	}
+	return res, nil
+}
+
+func (fs *xfs) walkBmbt(fsb uint64, level int, res *[]extent) error {
+	if level < 0 || level > 10 {
+		return errors.New("invalid xfs bmap btree level")
+	}
+
+	buf := make([]byte, fs.blockSize)
+	if _, err := fs.dev.ReadAt(buf, fs.fsbOffset(fsb)); err != nil {
+		return err
+	}
+
+	header := xfsBmbtHeaderV4
+	switch string(buf[0:4]) {
+	case xfsBmapMagic:
+	case xfsBmap3Magic:
+		header = xfsBmbtHeaderV5
+	default:
+		return errors.New("invalid xfs bmap btree block")
+	}
+	numrecs := int(binary.BigEndian.Uint16(buf[6:]))
+
+	if binary.BigEndian.Uint16(buf[4:]) == 0 {
+		*res = append(*res, parseExtents(buf[header:], uint64(numrecs))...)
+		return nil
+	}
+
+	maxrecs := (len(buf) - header) / 16
+	if numrecs > maxrecs {
+		return errors.New("invalid xfs bmap btree node")
+	}
+	for i := 0; i < numrecs; i++ {
+		ptr := binary.BigEndian.Uint64(buf[header+maxrecs*8+i*8:])
+		if err := fs.walkBmbt(ptr, level-1, res); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (fs *xfs) Open(n *inode) (io.ReaderAt, error) {
+	data := n.data.(*xfsInode)
+	if data.format == xfsFormatLocal {
+		if int64(len(data.fork)) < n.size {
+			return nil, errors.New("invalid xfs inline data")
+		}
+		return bytes.NewReader(data.fork[:n.size]), nil
+	}
+
+	extents, err := fs.extents(n)
+	if err != nil {
+		return nil, err
+	}
+	return newExtentReader(fs.dev, fs.blockSize, n.size, extents), nil
+}
+
+func (fs *xfs) Readlink(n *inode) (string, error) {
+	data := n.data.(*xfsInode)
+	if data.format == xfsFormatLocal {
+		r, err := fs.Open(n)
+		if err != nil {
+			return "", err
+		}
+		buf := make([]byte, n.size)
+		_, err = r.ReadAt(buf, 0)
+		return string(buf), err
+	}
+
+	extents, err := fs.extents(n)
+	if err != nil {
+		return "", err
+	}
+
+	// remote symlinks on v5 filesystems have a header in each block
+	res := []byte{}
+	for _, e := range extents {
+		for b := uint64(0); b < e.length; b++ {
+			buf := make([]byte, fs.blockSize)
+			if _, err := fs.dev.ReadAt(buf, int64(e.physical+b)*fs.blockSize); err != nil {
+				return "", err
+			}
+			if fs.v5 {
+				if !bytes.Equal(buf[0:4], []byte(xfsSymlink3)) {
+					return "", errors.New("invalid xfs symlink block")
+				}
+				size := int(binary.BigEndian.Uint32(buf[8:]))
+				if xfsSymlinkHeaderV5+size > len(buf) {
+					return "", errors.New("invalid xfs symlink block")
+				}
+				buf = buf[xfsSymlinkHeaderV5 : xfsSymlinkHeaderV5+size]
+			}
+			res = append(res, buf...)
+		}
+	}
+	if int64(len(res)) > n.size {
+		res = res[:n.size]
+	}
+	return string(res), nil
+}
+
+func (fs *xfs) ReadDir(n *inode) ([]dirEntry, error) {
+	data := n.data.(*xfsInode)
+	if data.format == xfsFormatLocal {
+		return fs.readShortformDir(data.fork)
+	}
+
+	extents, err := fs.extents(n)
+	if err != nil {
+		return nil, err
+	}
+
+	// entries are only stored in the data blocks, the leaf and free index
+	// blocks follow at fixed offsets and are ignored
+	dirBlockSize := fs.blockSize << fs.dirBlkLog
+	leafBlock := uint64(xfsDirLeafOff / fs.blockSize)
+	r := newExtentReader(fs.dev, fs.blockSize, int64(leafBlock)*fs.blockSize, extents)
+
+	res := []dirEntry{}
+	buf := make([]byte, dirBlockSize)
+	for _, e := range extents {
+		if e.logical >= leafBlock || e.zero {
+			continue
+		}
+		end := e.logical + e.length
+		if end > leafBlock {
+			end = leafBlock
+		}
+		// extents are aligned to directory blocks
+		for b := e.logical; b < end; b += uint64(1 << fs.dirBlkLog) {
+			if _, err := r.ReadAt(buf, int64(b)*fs.blockSize); err != nil && err != io.EOF {
+				return nil, err
+			}
+			entries, err := fs.parseDirBlock(buf)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, entries...)
+		}
+	}
+	return res, nil
+}
+
+func (fs *xfs) readShortformDir(fork []byte) ([]dirEntry, error) {
+	if len(fork) < 6 {
+		return nil, errors.New("invalid xfs shortform directory")
+	}
+	count := int(fork[0])
+	i8count := int(fork[1])
+	inoSize := 4
+	if i8count > 0 {
+		count = i8count
+		inoSize = 8
+	}
+
+	res := []dirEntry{}
+	pos := 2 + inoSize
+	for i := 0; i < count; i++ {
+		if pos+3 > len(fork) {
+			return nil, errors.New("invalid xfs shortform directory entry")
+		}
+		nameLen := int(fork[pos])
+		pos += 3
+		if pos+nameLen > len(fork) {
+			return nil, errors.New("invalid xfs shortform directory entry")
+		}
+		name := string(fork[pos : pos+nameLen])
+		pos += nameLen
+		if fs.ftype {
+			pos++
+		}
+		if pos+inoSize > len(fork) {
+			return nil, errors.New("invalid xfs shortform directory entry")
+		}
+		var ino uint64
+		if inoSize == 8 {
+			ino = binary.BigEndian.Uint64(fork[pos:])
+		} else {
+			ino = uint64(binary.BigEndian.Uint32(fork[pos:]))
+		}
+		pos += inoSize
+		res = append(res, dirEntry{name: name, ino: ino})
+	}
+	return res, nil
+}
+
+func (fs *xfs) parseDirBlock(buf []byte) ([]dirEntry, error) {
+	header := 16
+	end := len(buf)
+	switch string(buf[0:4]) {
+	case xfsDirData:
+	case xfsDir3Data:
+		header = 64
+	case xfsDirBlock, xfsDir3Block:
+		if string(buf[0:4]) == xfsDir3Block {
+			header = 64
+		}
+		// single block directories keep the leaf entries and a tail at the end
+		count := int(binary.BigEndian.Uint32(buf[len(buf)-8:]))
+		end = len(buf) - 8 - count*8
+	default:
+		// holes in the directory or unknown blocks don't contain entries
+		return nil, nil
+	}
+	if end < header {
+		return nil, errors.New("invalid xfs directory block")
+	}
+
+	res := []dirEntry{}
+	for pos := header; pos+8 <= end; {
+		if binary.BigEndian.Uint16(buf[pos:]) == xfsDirFreeTag {
+			length := int(binary.BigEndian.Uint16(buf[pos+2:]))
+			if length < 8 {
+				return nil, errors.New("invalid xfs directory free space")
+			}
+			pos += length
+			continue
+		}
+
+		ino := binary.BigEndian.Uint64(buf[pos:])
+		nameLen := int(buf[pos+8])
+		if pos+9+nameLen > end {
+			return nil, errors.New("invalid xfs directory entry")
+		}
+		name := string(buf[pos+9 : pos+9+nameLen])
+
+		// inode, name length, name, file type and tag are aligned to 8 bytes
+		size := 8 + 1 + nameLen + 2
+		if fs.ftype {
+			size++
+		}
+		pos += (size + 7) &^ 7
+
+		if name != "." && name != ".." {
+			res = append(res, dirEntry{name: name, ino: ino})
+		}
+	}
+	return res, nil
+}
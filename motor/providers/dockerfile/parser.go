@@ -0,0 +1,525 @@
+package dockerfile
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Position is the location of an instruction, lines and columns start with 1
+type Position struct {
+	Line   int
+	Column int
+}
+
+// Instruction is a Dockerfile instruction like RUN or COPY, continued lines
+// are joined and heredocs are appended to the value
+type Instruction struct {
+	// Keyword is the instruction in upper case
+	Keyword string
+	// Flags are the options before the arguments, e.g. --from=builder
+	Flags map[string]string
+	// Value is the argument text after the flags
+	Value string
+	// Arguments are the parsed values of the JSON form, the command of the
+	// shell form for RUN, CMD, ENTRYPOINT and SHELL, or the words otherwise
+	Arguments []string
+	// JSON is set for the JSON (exec) form, e.g. CMD ["nginx", "-g", "daemon off;"]
+	JSON     bool
+	Original string
+	Start    Position
+	End      Position
+}
+
+// Copy is a COPY or ADD instruction
+type Copy struct {
+	Keyword     string
+	Sources     []string
+	Destination string
+	// From is the stage or image of --from
+	From  string
+	Chown string
+	Chmod string
+	Start Position
+}
+
+// Healthcheck is the health check of a stage, Test follows the format of the
+// image configuration, i.e. [NONE], [CMD, args...] or [CMD-SHELL, command]
+type Healthcheck struct {
+	Test          []string
+	Interval      string
+	Timeout       string
+	StartPeriod   string
+	StartInterval string
+	Retries       int
+}
+
+// Stage is a build stage that starts with a FROM instruction
+type Stage struct {
+	Index int
+	// Name is set with FROM image AS name
+	Name string
+	// From is the base image as written in the FROM instruction
+	From     string
+	Platform string
+	// Image, Tag and Digest describe the base image with resolved build
+	// arguments, they are empty if the stage is based on a previous stage
+	Image  string
+	Tag    string
+	Digest string
+	// BaseStage is the name of the previous stage the stage is based on
+	BaseStage string
+
+	// the configuration is inherited from the base stage
+	User        string
+	Workdir     string
+	Env         map[string]string
+	Labels      map[string]string
+	Expose      []string
+	Entrypoint  []string
+	Cmd         []string
+	Healthcheck *Healthcheck
+
+	Run          []string
+	Copies       []Copy
+	Instructions []Instruction
+	Start        Position
+	End          Position
+}
+
+// Dockerfile is a parsed Dockerfile
+type Dockerfile struct {
+	// Directives are the parser directives, e.g. syntax or escape
+	Directives map[string]string
+	// Args are the build arguments before the first stage with their defaults
+	Args         map[string]string
+	Instructions []Instruction
+	Stages       []*Stage
+}
+
+var (
+	directiveRegex = regexp.MustCompile(`^#\s*([a-zA-Z][a-zA-Z0-9]*)\s*=\s*(.+?)\s*$`)
+	heredocRegex   = regexp.MustCompile(`<<(-?)(["']?)([A-Za-z_][A-Za-z0-9_]*)(["']?)`)
+)
+
+// instructions that support the JSON form
+var jsonInstructions = map[string]struct{}{
+	"RUN": {}, "CMD": {}, "ENTRYPOINT": {}, "SHELL": {}, "COPY": {}, "ADD": {}, "VOLUME": {},
+}
+
+// instructions that take a shell command in the shell form
+var shellInstructions = map[string]struct{}{
+	"RUN": {}, "CMD": {}, "ENTRYPOINT": {}, "SHELL": {},
+}
+
+// instructions that have --flags
+var flagInstructions = map[string]struct{}{
+	"FROM": {}, "RUN": {}, "COPY": {}, "ADD": {}, "HEALTHCHECK": {},
+}
+
+// Parse reads a Dockerfile
+func Parse(r io.Reader) (*Dockerfile, error) {
+	lines := []string{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	res := &Dockerfile{
+		Directives:   map[string]string{},
+		Args:         map[string]string{},
+		Instructions: []Instruction{},
+		Stages:       []*Stage{},
+	}
+
+	// parser directives are only read at the top of the file
+	i := 0
+	for ; i < len(lines); i++ {
+		m := directiveRegex.FindStringSubmatch(lines[i])
+		if m == nil {
+			break
+		}
+		res.Directives[strings.ToLower(m[1])] = m[2]
+	}
+	escape := byte('\\')
+	if res.Directives["escape"] == "`" {
+		escape = '`'
+	}
+
+	for i < len(lines) {
+		trimmed := strings.TrimSpace(lines[i])
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			i++
+			continue
+		}
+
+		instruction := Instruction{
+			Start: Position{Line: i + 1, Column: len(lines[i]) - len(strings.TrimLeft(lines[i], " \t")) + 1},
+		}
+		original := []string{lines[i]}
+		text := trimmed
+		for {
+			line := strings.TrimRight(text, " \t")
+			if len(line) == 0 || line[len(line)-1] != escape || i+1 >= len(lines) {
+				text = line
+				break
+			}
+			text = line[:len(line)-1]
+			i++
+			// comments and empty lines within continued lines are removed
+			for i < len(lines) && isSkippedLine(lines[i]) && i+1 < len(lines) {
+				original = append(original, lines[i])
+				i++
+			}
+			original = append(original, lines[i])
+			text += lines[i]
+		}
+
+		keyword, value := cutWord(text)
+		instruction.Keyword = strings.ToUpper(keyword)
+
+		instruction.Flags = map[string]string{}
+		if _, ok := flagInstructions[instruction.Keyword]; ok {
+			for strings.HasPrefix(value, "--") {
+				var flag string
+				flag, value = cutWord(value)
+				name, flagValue, _ := strings.Cut(flag[2:], "=")
+				instruction.Flags[strings.ToLower(name)] = flagValue
+			}
+		}
+
+		// heredocs follow the instruction, e.g. RUN <<EOF
+		if instruction.Keyword == "RUN" || instruction.Keyword == "COPY" || instruction.Keyword == "ADD" {
+			for _, m := range heredocRegex.FindAllStringSubmatch(value, -1) {
+				if m[2] != m[4] {
+					continue
+				}
+				for i+1 < len(lines) {
+					i++
+					original = append(original, lines[i])
+					line := lines[i]
+					if m[1] == "-" {
+						line = strings.TrimLeft(line, "\t")
+					}
+					value += "\n" + line
+					if line == m[3] {
+						break
+					}
+				}
+			}
+		}
+
+		instruction.Value = value
+		instruction.Original = strings.Join(original, "\n")
+		last := original[len(original)-1]
+		instruction.End = Position{Line: i + 1, Column: len(last) + 1}
+		instruction.Arguments, instruction.JSON = parseArguments(instruction.Keyword, value, escape)
+		res.Instructions = append(res.Instructions, instruction)
+		i++
+	}
+
+	res.buildStages(escape)
+	return res, nil
+}
+
+func isSkippedLine(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return trimmed == "" || strings.HasPrefix(trimmed, "#")
+}
+
+// cutWord splits the first word of the text
+func cutWord(text string) (string, string) {
+	text = strings.TrimLeft(text, " \t")
+	idx := strings.IndexAny(text, " \t")
+	if idx < 0 {
+		return text, ""
+	}
+	return text[:idx], strings.TrimLeft(text[idx:], " \t")
+}
+
+func parseArguments(keyword string, value string, escape byte) ([]string, bool) {
+	if _, ok := jsonInstructions[keyword]; ok && strings.HasPrefix(value, "[") {
+		var args []string
+		if err := json.Unmarshal([]byte(value), &args); err == nil {
+			return args, true
+		}
+	}
+	if _, ok := shellInstructions[keyword]; ok {
+		if value == "" {
+			return []string{}, false
+		}
+		return []string{value}, false
+	}
+	return splitWords(value, escape), false
+}
+
+// splitWords splits the text on whitespace, quotes and escaped characters
+// are removed
+func splitWords(text string, escape byte) []string {
+	res := []string{}
+	var b strings.Builder
+	inWord := false
+	var quote byte
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case c == escape && i+1 < len(text) && quote != '\'':
+			i++
+			b.WriteByte(text[i])
+			inWord = true
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else {
+				b.WriteByte(c)
+			}
+		case c == '"' || c == '\'':
+			quote = c
+			inWord = true
+		case c == ' ' || c == '\t' || c == '\n':
+			if inWord {
+				res = append(res, b.String())
+				b.Reset()
+				inWord = false
+			}
+		default:
+			b.WriteByte(c)
+			inWord = true
+		}
+	}
+	if inWord {
+		res = append(res, b.String())
+	}
+	return res
+}
+
+// keyValues parses the pairs of ENV, LABEL and ARG instructions, the legacy
+// form ENV key value sets a single variable
+func keyValues(value string, escape byte) [][2]string {
+	res := [][2]string{}
+	words := splitWords(value, escape)
+	if len(words) == 0 {
+		return res
+	}
+	if !strings.Contains(words[0], "=") {
+		key, rest := cutWord(value)
+		if rest == "" {
+			return append(res, [2]string{key, ""})
+		}
+		return append(res, [2]string{key, strings.Join(splitWords(rest, escape), " ")})
+	}
+	for _, word := range words {
+		k, v, _ := strings.Cut(word, "=")
+		res = append(res, [2]string{k, v})
+	}
+	return res
+}
+
+var varRegex = regexp.MustCompile(`\$(?:([A-Za-z_][A-Za-z0-9_]*)|\{([A-Za-z_][A-Za-z0-9_]*)(?:(:[-+])([^}]*))?\})`)
+
+// expandVars replaces $name, ${name}, ${name:-default} and ${name:+value}
+func expandVars(text string, vars map[string]string) string {
+	return varRegex.ReplaceAllStringFunc(text, func(s string) string {
+		m := varRegex.FindStringSubmatch(s)
+		name := m[1] + m[2]
+		value, ok := vars[name]
+		switch m[3] {
+		case ":-":
+			if !ok || value == "" {
+				return m[4]
+			}
+		case ":+":
+			if ok && value != "" {
+				return m[4]
+			}
+			return ""
+		}
+		return value
+	})
+}
+
+// ParseImage splits an image reference into name, tag and digest, e.g.
+// nginx:1.25@sha256:... into nginx, 1.25 and sha256:...
+func ParseImage(ref string) (string, string, string) {
+	name, digest, _ := strings.Cut(ref, "@")
+	tag := ""
+	if idx := strings.LastIndex(name, ":"); idx > strings.LastIndex(name, "/") {
+		name, tag = name[:idx], name[idx+1:]
+	}
+	return name, tag, digest
+}
+
+func (d *Dockerfile) buildStages(escape byte) {
+	var stage *Stage
+	for i := range d.Instructions {
+		instruction := d.Instructions[i]
+		if instruction.Keyword == "FROM" {
+			stage = d.newStage(instruction)
+			d.Stages = append(d.Stages, stage)
+			continue
+		}
+
+		if stage == nil {
+			// build arguments before the first stage can be used in FROM
+			if instruction.Keyword == "ARG" {
+				for _, kv := range keyValues(instruction.Value, escape) {
+					d.Args[kv[0]] = kv[1]
+				}
+			}
+			continue
+		}
+
+		stage.Instructions = append(stage.Instructions, instruction)
+		stage.End = instruction.End
+		args := instruction.Arguments
+		switch instruction.Keyword {
+		case "USER":
+			if len(args) > 0 {
+				stage.User = args[0]
+			}
+		case "WORKDIR":
+			if len(args) > 0 {
+				stage.Workdir = args[0]
+			}
+		case "ENV":
+			for _, kv := range keyValues(instruction.Value, escape) {
+				stage.Env[kv[0]] = kv[1]
+			}
+		case "LABEL":
+			for _, kv := range keyValues(instruction.Value, escape) {
+				stage.Labels[kv[0]] = kv[1]
+			}
+		case "EXPOSE":
+			for _, port := range args {
+				if !strings.Contains(port, "/") {
+					port += "/tcp"
+				}
+				stage.Expose = append(stage.Expose, strings.ToLower(port))
+			}
+		case "ENTRYPOINT":
+			stage.Entrypoint = shellCommand(instruction)
+			// the entrypoint resets the command of the base image
+			if !hasInstruction(stage, "CMD") {
+				stage.Cmd = nil
+			}
+		case "CMD":
+			stage.Cmd = shellCommand(instruction)
+		case "HEALTHCHECK":
+			stage.Healthcheck = parseHealthcheck(instruction)
+		case "RUN":
+			stage.Run = append(stage.Run, strings.Join(args, " "))
+		case "COPY", "ADD":
+			if len(args) < 2 {
+				continue
+			}
+			stage.Copies = append(stage.Copies, Copy{
+				Keyword:     instruction.Keyword,
+				Sources:     args[:len(args)-1],
+				Destination: args[len(args)-1],
+				From:        instruction.Flags["from"],
+				Chown:       instruction.Flags["chown"],
+				Chmod:       instruction.Flags["chmod"],
+				Start:       instruction.Start,
+			})
+		}
+	}
+}
+
+func (d *Dockerfile) newStage(from Instruction) *Stage {
+	stage := &Stage{
+		Index:        len(d.Stages),
+		Platform:     from.Flags["platform"],
+		Env:          map[string]string{},
+		Labels:       map[string]string{},
+		Expose:       []string{},
+		Run:          []string{},
+		Copies:       []Copy{},
+		Instructions: []Instruction{from},
+		Start:        from.Start,
+		End:          from.End,
+	}
+	args := from.Arguments
+	if len(args) > 0 {
+		stage.From = args[0]
+	}
+	if len(args) > 2 && strings.EqualFold(args[1], "AS") {
+		stage.Name = args[2]
+	}
+
+	ref := expandVars(stage.From, d.Args)
+	for _, prev := range d.Stages {
+		if prev.Name == "" || !strings.EqualFold(prev.Name, ref) {
+			continue
+		}
+		stage.BaseStage = prev.Name
+		stage.User = prev.User
+		stage.Workdir = prev.Workdir
+		stage.Expose = append(stage.Expose, prev.Expose...)
+		stage.Entrypoint = prev.Entrypoint
+		stage.Cmd = prev.Cmd
+		stage.Healthcheck = prev.Healthcheck
+		for k, v := range prev.Env {
+			stage.Env[k] = v
+		}
+		for k, v := range prev.Labels {
+			stage.Labels[k] = v
+		}
+		return stage
+	}
+
+	stage.Image, stage.Tag, stage.Digest = ParseImage(ref)
+	return stage
+}
+
+func hasInstruction(stage *Stage, keyword string) bool {
+	for i := range stage.Instructions {
+		if stage.Instructions[i].Keyword == keyword {
+			return true
+		}
+	}
+	return false
+}
+
+// shellCommand returns the command of the exec form or the shell form, which
+// is run with /bin/sh -c
+func shellCommand(instruction Instruction) []string {
+	if instruction.JSON || len(instruction.Arguments) == 0 {
+		return instruction.Arguments
+	}
+	return []string{"/bin/sh", "-c", instruction.Arguments[0]}
+}
+
+func parseHealthcheck(instruction Instruction) *Healthcheck {
+	res := &Healthcheck{
+		Interval:      instruction.Flags["interval"],
+		Timeout:       instruction.Flags["timeout"],
+		StartPeriod:   instruction.Flags["start-period"],
+		StartInterval: instruction.Flags["start-interval"],
+	}
+	if retries, err := strconv.Atoi(instruction.Flags["retries"]); err == nil {
+		res.Retries = retries
+	}
+
+	typ, cmd := cutWord(instruction.Value)
+	switch strings.ToUpper(typ) {
+	case "NONE":
+		res.Test = []string{"NONE"}
+	case "CMD":
+		var args []string
+		if err := json.Unmarshal([]byte(cmd), &args); err == nil && strings.HasPrefix(cmd, "[") {
+			res.Test = append([]string{"CMD"}, args...)
+		} else {
+			res.Test = []string{"CMD-SHELL", cmd}
+		}
+	default:
+		res.Test = []string{}
+	}
+	return res
+}
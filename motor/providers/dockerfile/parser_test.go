@@ -0,0 +1,122 @@
+package dockerfile
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	f, err := os.Open("testdata/app/Dockerfile")
+	require.NoError(t, err)
+	defer f.Close()
+
+	dockerfile, err := Parse(f)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"syntax": "docker/dockerfile:1.4"}, dockerfile.Directives)
+	assert.Equal(t, map[string]string{"GO_VERSION": "1.19", "BASE_DIGEST": ""}, dockerfile.Args)
+	require.Len(t, dockerfile.Instructions, 21)
+	require.Len(t, dockerfile.Stages, 3)
+
+	t.Run("continued lines", func(t *testing.T) {
+		run := dockerfile.Instructions[8]
+		assert.Equal(t, "RUN", run.Keyword)
+		assert.Equal(t, map[string]string{"mount": "type=cache,target=/root/.cache/go-build"}, run.Flags)
+		assert.Equal(t, []string{"go build -o /bin/app ./cmd/app"}, run.Arguments)
+		assert.Equal(t, Position{Line: 13, Column: 1}, run.Start)
+		assert.Equal(t, Position{Line: 14, Column: 35}, run.End)
+		assert.False(t, run.JSON)
+	})
+
+	t.Run("builder stage", func(t *testing.T) {
+		builder := dockerfile.Stages[0]
+		assert.Equal(t, "builder", builder.Name)
+		assert.Equal(t, "golang:${GO_VERSION}-alpine", builder.From)
+		assert.Equal(t, "linux/amd64", builder.Platform)
+		assert.Equal(t, "golang", builder.Image)
+		assert.Equal(t, "1.19-alpine", builder.Tag)
+		assert.Equal(t, "", builder.Digest)
+		assert.Equal(t, "", builder.User)
+		assert.Equal(t, "/src", builder.Workdir)
+		assert.Equal(t, map[string]string{"CGO_ENABLED": "0", "GOOS": "linux"}, builder.Env)
+		assert.Equal(t, []string{"go mod download", "go build -o /bin/app ./cmd/app"}, builder.Run)
+		assert.Equal(t, []Copy{
+			{Keyword: "COPY", Sources: []string{"go.mod", "go.sum"}, Destination: "./", Start: Position{Line: 10, Column: 1}},
+			{Keyword: "COPY", Sources: []string{"."}, Destination: ".", Start: Position{Line: 12, Column: 1}},
+		}, builder.Copies)
+		assert.Equal(t, Position{Line: 5, Column: 1}, builder.Start)
+		assert.Equal(t, Position{Line: 14, Column: 35}, builder.End)
+	})
+
+	t.Run("runtime stage", func(t *testing.T) {
+		runtime := dockerfile.Stages[1]
+		assert.Equal(t, "gcr.io/distroless/static", runtime.Image)
+		assert.Equal(t, "", runtime.Tag)
+		assert.Equal(t, "sha256:3d0f463de06b7ddff27684ec3bfd0b54a425149d0f8685308b1fdf297b0265e9", runtime.Digest)
+		assert.Equal(t, map[string]string{
+			"org.opencontainers.image.source": "https://github.com/example/app",
+			"maintainer":                      "ops@example.com",
+		}, runtime.Labels)
+		assert.Equal(t, []string{"8080/tcp", "9090/udp"}, runtime.Expose)
+		assert.Equal(t, "nonroot:nonroot", runtime.User)
+		assert.Equal(t, &Healthcheck{
+			Test:     []string{"CMD", "/app", "healthcheck"},
+			Interval: "30s",
+			Timeout:  "3s",
+			Retries:  3,
+		}, runtime.Healthcheck)
+		assert.Equal(t, []string{"/app"}, runtime.Entrypoint)
+		assert.Equal(t, []string{"serve"}, runtime.Cmd)
+		assert.Equal(t, "builder", runtime.Copies[0].From)
+		assert.Equal(t, "nonroot:nonroot", runtime.Copies[0].Chown)
+	})
+
+	t.Run("stage based on a previous stage", func(t *testing.T) {
+		debug := dockerfile.Stages[2]
+		assert.Equal(t, "runtime", debug.BaseStage)
+		assert.Equal(t, "", debug.Image)
+		assert.Equal(t, "root", debug.User)
+		assert.Equal(t, []string{"/app"}, debug.Entrypoint)
+		assert.Equal(t, []string{"8080/tcp", "9090/udp"}, debug.Expose)
+		assert.Equal(t, "1", debug.Env["DEBUG"])
+		assert.Equal(t, []string{"<<EOF\nset -e\necho debug > /etc/motd\nEOF"}, debug.Run)
+		assert.Equal(t, Position{Line: 33, Column: 10}, debug.End)
+	})
+}
+
+func TestParse_Escape(t *testing.T) {
+	dockerfile, err := Parse(strings.NewReader("# escape=`\n\nFROM mcr.microsoft.com/windows/servercore:ltsc2022\nSHELL [\"powershell\", \"-Command\"]\nRUN Write-Host `\n  hello\nCOPY C:\\app\\bin C:\\app\\\nHEALTHCHECK NONE\n"))
+	require.NoError(t, err)
+	require.Len(t, dockerfile.Stages, 1)
+	stage := dockerfile.Stages[0]
+
+	assert.Equal(t, "ltsc2022", stage.Tag)
+	assert.Equal(t, []string{"powershell", "-Command"}, stage.Instructions[1].Arguments)
+	assert.Equal(t, []string{"Write-Host   hello"}, stage.Run)
+	assert.Equal(t, []string{`C:\app\bin`}, stage.Copies[0].Sources)
+	assert.Equal(t, []string{"NONE"}, stage.Healthcheck.Test)
+}
+
+func TestParseImage(t *testing.T) {
+	tests := []struct {
+		ref    string
+		name   string
+		tag    string
+		digest string
+	}{
+		{"ubuntu", "ubuntu", "", ""},
+		{"ubuntu:22.04", "ubuntu", "22.04", ""},
+		{"localhost:5000/app", "localhost:5000/app", "", ""},
+		{"localhost:5000/app:1.0@sha256:abc", "localhost:5000/app", "1.0", "sha256:abc"},
+	}
+	for _, test := range tests {
+		name, tag, digest := ParseImage(test.ref)
+		assert.Equal(t, test.name, name, test.ref)
+		assert.Equal(t, test.tag, tag, test.ref)
+		assert.Equal(t, test.digest, digest, test.ref)
+	}
+}
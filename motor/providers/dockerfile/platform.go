@@ -0,0 +1,15 @@
+package dockerfile
+
+import (
+	"go.mondoo.com/cnquery/motor/platform"
+	"go.mondoo.com/cnquery/motor/providers"
+)
+
+func (p *Provider) PlatformInfo() *platform.Platform {
+	return &platform.Platform{
+		Name:    "dockerfile",
+		Title:   "Dockerfile",
+		Kind:    providers.Kind_KIND_CODE,
+		Runtime: "dockerfile",
+	}
+}
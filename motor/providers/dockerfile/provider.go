@@ -0,0 +1,102 @@
+package dockerfile
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/motor/providers"
+)
+
+const (
+	OPTION_PATH = "path"
+	// DefaultFilename is used if the path is a directory
+	DefaultFilename = "Dockerfile"
+)
+
+var (
+	_ providers.Instance           = (*Provider)(nil)
+	_ providers.PlatformIdentifier = (*Provider)(nil)
+)
+
+func New(pCfg *providers.Config) (*Provider, error) {
+	if pCfg == nil || len(pCfg.Options[OPTION_PATH]) == 0 {
+		return nil, errors.New("path to the Dockerfile is required")
+	}
+
+	path := pCfg.Options[OPTION_PATH]
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if stat.IsDir() {
+		path = filepath.Join(path, DefaultFilename)
+	}
+
+	log.Debug().Str("path", path).Msg("load Dockerfile")
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	dockerfile, err := Parse(f)
+	if err != nil {
+		return nil, err
+	}
+
+	// the project hash identifies the Dockerfile
+	absPath, _ := filepath.Abs(path)
+	h := sha256.New()
+	h.Write([]byte(absPath))
+	platformID := "//platformid.api.mondoo.app/runtime/dockerfile/hash/" + hex.EncodeToString(h.Sum(nil))
+
+	return &Provider{
+		path:       path,
+		dockerfile: dockerfile,
+		platformID: platformID,
+	}, nil
+}
+
+// Provider is a static analysis provider for Dockerfiles
+type Provider struct {
+	path       string
+	dockerfile *Dockerfile
+	platformID string
+}
+
+func (p *Provider) Close() {}
+
+func (p *Provider) Capabilities() providers.Capabilities {
+	return providers.Capabilities{}
+}
+
+func (p *Provider) Kind() providers.Kind {
+	return providers.Kind_KIND_CODE
+}
+
+func (p *Provider) Runtime() string {
+	return ""
+}
+
+func (p *Provider) PlatformIdDetectors() []providers.PlatformIdDetector {
+	return []providers.PlatformIdDetector{
+		providers.TransportPlatformIdentifierDetector,
+	}
+}
+
+func (p *Provider) Identifier() (string, error) {
+	return p.platformID, nil
+}
+
+// Path is the location of the Dockerfile
+func (p *Provider) Path() string {
+	return p.path
+}
+
+func (p *Provider) Dockerfile() *Dockerfile {
+	return p.dockerfile
+}
@@ -0,0 +1,30 @@
+package dockerfile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/motor/providers"
+)
+
+func TestProvider(t *testing.T) {
+	p, err := New(&providers.Config{
+		Backend: providers.ProviderType_DOCKERFILE,
+		Options: map[string]string{OPTION_PATH: "testdata/app"},
+	})
+	require.NoError(t, err)
+	defer p.Close()
+
+	assert.Equal(t, "testdata/app/Dockerfile", p.Path())
+	assert.Equal(t, providers.Kind_KIND_CODE, p.Kind())
+	assert.Equal(t, "dockerfile", p.PlatformInfo().Name)
+	assert.Len(t, p.Dockerfile().Stages, 3)
+
+	id, err := p.Identifier()
+	require.NoError(t, err)
+	assert.Contains(t, id, "//platformid.api.mondoo.app/runtime/dockerfile/hash/")
+
+	_, err = New(&providers.Config{Options: map[string]string{OPTION_PATH: "testdata/missing"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,17 @@
+//go:build linux
+// +build linux
+
+package fs
+
+import (
+	"path/filepath"
+
+	"go.mondoo.com/cnquery/motor/providers/os"
+	"go.mondoo.com/cnquery/motor/providers/os/fsutil"
+)
+
+var _ os.FileXattrs = (*Provider)(nil)
+
+func (t *Provider) Xattr(path string, name string) ([]byte, error) {
+	return fsutil.LocalXattr(filepath.Join(t.MountedDir, path), name)
+}
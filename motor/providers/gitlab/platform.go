@@ -4,15 +4,57 @@ import (
 	"strconv"
 
 	"github.com/xanzy/go-gitlab"
+	"go.mondoo.com/cnquery/motor/platform"
+	"go.mondoo.com/cnquery/motor/providers"
 )
 
+var (
+	GitLabGroupPlatform = &platform.Platform{
+		Name:    "gitlab",
+		Title:   "GitLab Group",
+		Family:  []string{"gitlab"},
+		Kind:    providers.Kind_KIND_API,
+		Runtime: providers.RUNTIME_GITLAB,
+	}
+	GitLabProjectPlatform = &platform.Platform{
+		Name:    "gitlab-project",
+		Title:   "GitLab Project",
+		Family:  []string{"gitlab"},
+		Kind:    providers.Kind_KIND_API,
+		Runtime: providers.RUNTIME_GITLAB,
+	}
+)
+
+func (t *Provider) PlatformInfo() *platform.Platform {
+	if t.ProjectPath != "" {
+		return GitLabProjectPlatform
+	}
+	return GitLabGroupPlatform
+}
+
+func NewGitLabGroupIdentifier(groupID int) string {
+	return "//platformid.api.mondoo.app/runtime/gitlab/group/" + strconv.Itoa(groupID)
+}
+
+func NewGitLabProjectIdentifier(groupID int, projectID int) string {
+	return NewGitLabGroupIdentifier(groupID) + "/project/" + strconv.Itoa(projectID)
+}
+
 func (t *Provider) Identifier() (string, error) {
 	grp, err := t.Group()
 	if err != nil {
 		return "", err
 	}
 
-	return "//platformid.api.mondoo.app/runtime/gitlab/group/" + strconv.Itoa(grp.ID), nil
+	if t.ProjectPath != "" {
+		prj, err := t.Project()
+		if err != nil {
+			return "", err
+		}
+		return NewGitLabProjectIdentifier(grp.ID, prj.ID), nil
+	}
+
+	return NewGitLabGroupIdentifier(grp.ID), nil
 }
 
 func (t *Provider) Group() (*gitlab.Group, error) {
@@ -22,3 +64,12 @@ func (t *Provider) Group() (*gitlab.Group, error) {
 	}
 	return grp, err
 }
+
+// Project returns the project that is scanned
+func (t *Provider) Project() (*gitlab.Project, error) {
+	prj, _, err := t.Client().Projects.GetProject(t.ProjectPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	return prj, nil
+}
@@ -3,6 +3,7 @@ package gitlab
 import (
 	"errors"
 	"os"
+	"strings"
 
 	"github.com/rs/zerolog/log"
 	"github.com/xanzy/go-gitlab"
@@ -40,7 +41,13 @@ func New(tc *providers.Config) (*Provider, error) {
 		return nil, errors.New("you need to provide GitLab token e.g. via GITLAB_TOKEN env")
 	}
 
-	client, err := gitlab.NewClient(token)
+	// self-managed GitLab instances are reached via their url, e.g. https://gitlab.example.com/api/v4
+	clientOpts := []gitlab.ClientOptionFunc{}
+	if baseURL := tc.Options["url"]; baseURL != "" {
+		clientOpts = append(clientOpts, gitlab.WithBaseURL(baseURL))
+	}
+
+	client, err := gitlab.NewClient(token, clientOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -49,17 +56,30 @@ func New(tc *providers.Config) (*Provider, error) {
 		return nil, errors.New("you need to provide a group for gitlab")
 	}
 
-	return &Provider{
+	p := &Provider{
 		client:    client,
 		opts:      tc.Options,
 		GroupPath: tc.Options["group"],
-	}, nil
+	}
+
+	// the project is either the full path or the path within the group
+	if project := tc.Options["project"]; project != "" {
+		if strings.Contains(project, "/") {
+			p.ProjectPath = project
+		} else {
+			p.ProjectPath = p.GroupPath + "/" + project
+		}
+	}
+
+	return p, nil
 }
 
 type Provider struct {
 	client    *gitlab.Client
 	opts      map[string]string
 	GroupPath string
+	// ProjectPath is the full path of the project if a project is scanned
+	ProjectPath string
 }
 
 func (p *Provider) Close() {}
@@ -39,6 +39,9 @@ const (
 	ProviderID_TERRAFORM          = "terraform"
 	ProviderID_HOST               = "host"
 	ProviderID_TLS                = "tls"
+	ProviderID_DISK_IMAGE         = "disk"
+	ProviderID_DOCKERFILE         = "docker-file"
+	ProviderID_CLOUDFORMATION     = "cloudformation"
 
 	// NOTE: its not mapped directly to a transport, it is transformed into ssh
 	ProviderID_AWS_EC2_INSTANCE_CONNECT = "aws-ec2-connect"
@@ -74,6 +77,9 @@ var ProviderType_id = map[ProviderType]string{
 	ProviderType_GITLAB:                  ProviderID_GITLAB,
 	ProviderType_TERRAFORM:               ProviderID_TERRAFORM,
 	ProviderType_HOST:                    ProviderID_HOST,
+	ProviderType_DISK_IMAGE:              ProviderID_DISK_IMAGE,
+	ProviderType_DOCKERFILE:              ProviderID_DOCKERFILE,
+	ProviderType_CLOUDFORMATION:          ProviderID_CLOUDFORMATION,
 }
 
 var ProviderType_idvalue = map[string]ProviderType{
@@ -104,6 +110,9 @@ var ProviderType_idvalue = map[string]ProviderType{
 	ProviderID_GITLAB:                   ProviderType_GITLAB,
 	ProviderID_TERRAFORM:                ProviderType_TERRAFORM,
 	ProviderID_HOST:                     ProviderType_HOST,
+	ProviderID_DISK_IMAGE:               ProviderType_DISK_IMAGE,
+	ProviderID_DOCKERFILE:               ProviderType_DOCKERFILE,
+	ProviderID_CLOUDFORMATION:           ProviderType_CLOUDFORMATION,
 	ProviderID_AWS_EC2_INSTANCE_CONNECT: ProviderType_SSH,
 	ProviderID_AWS_EC2_SSM_SESSION:      ProviderType_SSH,
 }
@@ -0,0 +1,253 @@
+package helm
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	ChartFile  = "Chart.yaml"
+	ValuesFile = "values.yaml"
+)
+
+// Metadata are the fields of the Chart.yaml file
+type Metadata struct {
+	Name         string            `json:"name"`
+	Version      string            `json:"version"`
+	AppVersion   string            `json:"appVersion,omitempty"`
+	APIVersion   string            `json:"apiVersion,omitempty"`
+	Description  string            `json:"description,omitempty"`
+	Type         string            `json:"type,omitempty"`
+	KubeVersion  string            `json:"kubeVersion,omitempty"`
+	Keywords     []string          `json:"keywords,omitempty"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+	Dependencies []*Dependency     `json:"dependencies,omitempty"`
+}
+
+// Dependency is a chart that is listed in the Chart.yaml
+type Dependency struct {
+	Name       string   `json:"name"`
+	Version    string   `json:"version,omitempty"`
+	Repository string   `json:"repository,omitempty"`
+	Condition  string   `json:"condition,omitempty"`
+	Tags       []string `json:"tags,omitempty"`
+	Alias      string   `json:"alias,omitempty"`
+}
+
+// File is a file of the chart, the name is relative to the chart directory
+type File struct {
+	Name string
+	Data []byte
+}
+
+// Chart is a chart that is loaded from a directory
+type Chart struct {
+	Metadata *Metadata
+	Values   map[string]interface{}
+	// Templates are the files in the templates directory
+	Templates []*File
+	// Files are all other files of the chart, they are available via .Files
+	Files []*File
+	// Dependencies are the charts in the charts directory
+	Dependencies []*Chart
+}
+
+// IsChartDir returns true if the directory has a Chart.yaml
+func IsChartDir(dir string) bool {
+	stat, err := os.Stat(filepath.Join(dir, ChartFile))
+	return err == nil && !stat.IsDir()
+}
+
+// LoadChart loads an unpacked chart with its unpacked dependencies
+func LoadChart(dir string) (*Chart, error) {
+	data, err := os.ReadFile(filepath.Join(dir, ChartFile))
+	if err != nil {
+		return nil, err
+	}
+
+	chart := &Chart{
+		Metadata: &Metadata{},
+		Values:   map[string]interface{}{},
+	}
+	if err := yaml.Unmarshal(data, chart.Metadata); err != nil {
+		return nil, errors.Wrap(err, "could not parse "+ChartFile)
+	}
+	if chart.Metadata.Name == "" {
+		return nil, errors.New("chart name is missing in " + filepath.Join(dir, ChartFile))
+	}
+
+	err = filepath.WalkDir(dir, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if d.IsDir() {
+			// dependencies are loaded as charts on their own
+			if rel == "charts" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		switch {
+		case rel == ChartFile:
+			return nil
+		case rel == ValuesFile:
+			data, err := os.ReadFile(p)
+			if err != nil {
+				return err
+			}
+			if err := yaml.Unmarshal(data, &chart.Values); err != nil {
+				return errors.Wrap(err, "could not parse "+ValuesFile)
+			}
+			if chart.Values == nil {
+				chart.Values = map[string]interface{}{}
+			}
+			return nil
+		}
+
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		file := &File{Name: rel, Data: data}
+		if strings.HasPrefix(rel, "templates/") {
+			chart.Templates = append(chart.Templates, file)
+		} else {
+			chart.Files = append(chart.Files, file)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// only unpacked dependencies are supported, archives need to be extracted first
+	entries, err := os.ReadDir(filepath.Join(dir, "charts"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	for i := range entries {
+		subDir := filepath.Join(dir, "charts", entries[i].Name())
+		if !entries[i].IsDir() || !IsChartDir(subDir) {
+			continue
+		}
+		dependency, err := LoadChart(subDir)
+		if err != nil {
+			return nil, err
+		}
+		chart.Dependencies = append(chart.Dependencies, dependency)
+	}
+
+	sort.Slice(chart.Templates, func(i, j int) bool {
+		return chart.Templates[i].Name < chart.Templates[j].Name
+	})
+	return chart, nil
+}
+
+// LoadValuesFiles reads values files in order, values of later files take precedence
+func LoadValuesFiles(filenames []string) (map[string]interface{}, error) {
+	res := map[string]interface{}{}
+	for _, filename := range filenames {
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		values := map[string]interface{}{}
+		if err := yaml.Unmarshal(data, &values); err != nil {
+			return nil, errors.Wrap(err, "could not parse values file "+filename)
+		}
+		res = mergeValues(res, values)
+	}
+	return res, nil
+}
+
+// mergeValues merges src into dst, maps are merged recursively and all other
+// values of src replace those of dst
+func mergeValues(dst map[string]interface{}, src map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{}, len(dst))
+	for k, v := range dst {
+		res[k] = v
+	}
+	for k, v := range src {
+		srcMap, srcIsMap := v.(map[string]interface{})
+		dstMap, dstIsMap := res[k].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			res[k] = mergeValues(dstMap, srcMap)
+			continue
+		}
+		// null removes a default value like with helm
+		if v == nil {
+			delete(res, k)
+			continue
+		}
+		res[k] = v
+	}
+	return res
+}
+
+// dependencyName is the name of the dependency in the values and templates,
+// the alias of the Chart.yaml takes precedence
+func (c *Chart) dependencyName(dependency *Chart) string {
+	for _, d := range c.Metadata.Dependencies {
+		if d.Name == dependency.Metadata.Name && d.Alias != "" {
+			return d.Alias
+		}
+	}
+	return dependency.Metadata.Name
+}
+
+// dependencyEnabled evaluates the condition and tags of the dependency
+func (c *Chart) dependencyEnabled(dependency *Chart, values map[string]interface{}) bool {
+	for _, d := range c.Metadata.Dependencies {
+		if d.Name != dependency.Metadata.Name {
+			continue
+		}
+		for _, condition := range strings.Split(d.Condition, ",") {
+			condition = strings.TrimSpace(condition)
+			if condition == "" {
+				continue
+			}
+			if v, ok := valuePath(values, condition).(bool); ok {
+				return v
+			}
+		}
+		if len(d.Tags) > 0 {
+			tags, _ := values["tags"].(map[string]interface{})
+			for _, tag := range d.Tags {
+				if v, ok := tags[tag].(bool); ok && v {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	return true
+}
+
+func valuePath(values map[string]interface{}, p string) interface{} {
+	var cur interface{} = values
+	for _, key := range strings.Split(p, ".") {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		cur = m[key]
+	}
+	return cur
+}
+
+func templatePath(parts ...string) string {
+	return path.Join(parts...)
+}
@@ -0,0 +1,65 @@
+package helm
+
+import (
+	"encoding/base64"
+	"path"
+	"strings"
+)
+
+// files is the .Files object of the templates
+type files map[string][]byte
+
+func newFiles(chartFiles []*File) files {
+	res := make(files, len(chartFiles))
+	for i := range chartFiles {
+		res[chartFiles[i].Name] = chartFiles[i].Data
+	}
+	return res
+}
+
+// Get returns the content of a file, it is empty if the file does not exist
+func (f files) Get(name string) string {
+	return string(f[name])
+}
+
+func (f files) GetBytes(name string) []byte {
+	return f[name]
+}
+
+// Lines returns the lines of a file
+func (f files) Lines(name string) []string {
+	data, ok := f[name]
+	if !ok {
+		return []string{}
+	}
+	return strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+}
+
+// Glob returns the files that match the pattern
+func (f files) Glob(pattern string) files {
+	res := files{}
+	for name, data := range f {
+		if ok, _ := path.Match(pattern, name); ok {
+			res[name] = data
+		}
+	}
+	return res
+}
+
+// AsConfig returns the files as data of a config map
+func (f files) AsConfig() string {
+	data := map[string]interface{}{}
+	for name, content := range f {
+		data[path.Base(name)] = string(content)
+	}
+	return toYaml(data)
+}
+
+// AsSecrets returns the files as base64 encoded data of a secret
+func (f files) AsSecrets() string {
+	data := map[string]interface{}{}
+	for name, content := range f {
+		data[path.Base(name)] = base64.StdEncoding.EncodeToString(content)
+	}
+	return toYaml(data)
+}
@@ -0,0 +1,1031 @@
+package helm
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash/adler32"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+	"unicode"
+
+	"github.com/BurntSushi/toml"
+	"github.com/Masterminds/semver"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+// maxIncludeDepth limits the nesting of include and tpl like helm does. Every
+// include starts a new template execution, so that the depth limit of the
+// template package does not stop templates that include themselves.
+const maxIncludeDepth = 1000
+
+// funcMap has the template functions of helm and the commonly used functions
+// of the sprig library. Functions that look up live cluster objects, generate
+// random values or derive secrets return empty or fixed values, so that the
+// rendered manifests are reproducible. All other functions of helm and sprig
+// are defined as well, but fail when they are called.
+func funcMap(root *template.Template) template.FuncMap {
+	depth := 0
+	enter := func(name string) error {
+		if depth >= maxIncludeDepth {
+			return errors.New("rendering template has a nested reference name: " + name)
+		}
+		depth++
+		return nil
+	}
+	leave := func() { depth-- }
+
+	funcs := template.FuncMap{
+		// helm
+		"include": func(name string, data interface{}) (string, error) {
+			if err := enter(name); err != nil {
+				return "", err
+			}
+			defer leave()
+			var buf strings.Builder
+			if err := root.ExecuteTemplate(&buf, name, data); err != nil {
+				return "", err
+			}
+			return buf.String(), nil
+		},
+		"tpl": func(text string, data interface{}) (string, error) {
+			if err := enter("tpl"); err != nil {
+				return "", err
+			}
+			defer leave()
+			t, err := root.Clone()
+			if err != nil {
+				return "", err
+			}
+			t, err = t.New("tpl").Parse(text)
+			if err != nil {
+				return "", err
+			}
+			var buf strings.Builder
+			if err := t.Execute(&buf, data); err != nil {
+				return "", err
+			}
+			return strings.ReplaceAll(buf.String(), "<no value>", ""), nil
+		},
+		"required": func(msg string, v interface{}) (interface{}, error) {
+			if v == nil {
+				return nil, errors.New(msg)
+			}
+			if s, ok := v.(string); ok && s == "" {
+				return nil, errors.New(msg)
+			}
+			return v, nil
+		},
+		"fail": func(msg string) (string, error) {
+			return "", errors.New(msg)
+		},
+		"lookup": func(apiVersion string, kind string, namespace string, name string) map[string]interface{} {
+			return map[string]interface{}{}
+		},
+		"toYaml":        toYaml,
+		"fromYaml":      fromYaml,
+		"fromYamlArray": fromYamlArray,
+		"toToml":        toToml,
+		"toJson":        toJson,
+		"mustToJson":    toJson,
+		"toPrettyJson":  toPrettyJson,
+		"fromJson":      fromJson,
+
+		// defaults and flow control
+		"default":  defaultValue,
+		"empty":    empty,
+		"coalesce": coalesce,
+		"ternary": func(vt interface{}, vf interface{}, cond bool) interface{} {
+			if cond {
+				return vt
+			}
+			return vf
+		},
+		"all": func(v ...interface{}) bool {
+			for i := range v {
+				if empty(v[i]) {
+					return false
+				}
+			}
+			return true
+		},
+		"any": func(v ...interface{}) bool {
+			for i := range v {
+				if !empty(v[i]) {
+					return true
+				}
+			}
+			return false
+		},
+
+		// strings
+		"quote": func(v ...interface{}) string {
+			res := []string{}
+			for i := range v {
+				if v[i] != nil {
+					res = append(res, strconv.Quote(strval(v[i])))
+				}
+			}
+			return strings.Join(res, " ")
+		},
+		"squote": func(v ...interface{}) string {
+			res := []string{}
+			for i := range v {
+				if v[i] != nil {
+					res = append(res, "'"+strval(v[i])+"'")
+				}
+			}
+			return strings.Join(res, " ")
+		},
+		"cat": func(v ...interface{}) string {
+			res := []string{}
+			for i := range v {
+				if v[i] != nil {
+					res = append(res, strval(v[i]))
+				}
+			}
+			return strings.Join(res, " ")
+		},
+		"indent":  indent,
+		"nindent": func(spaces int, v string) string { return "\n" + indent(spaces, v) },
+		"trunc": func(c int, s string) string {
+			if c < 0 && len(s)+c > 0 {
+				return s[len(s)+c:]
+			}
+			if c >= 0 && len(s) > c {
+				return s[:c]
+			}
+			return s
+		},
+		"abbrev": func(width int, s string) string {
+			if width < 4 || len(s) <= width {
+				return s
+			}
+			return s[:width-3] + "..."
+		},
+		"wrap":       func(width int, s string) string { return wrap(width, "\n", s) },
+		"wrapWith":   wrap,
+		"camelcase":  camelcase,
+		"snakecase":  func(s string) string { return strings.ToLower(strings.Join(splitWords(s), "_")) },
+		"kebabcase":  func(s string) string { return strings.ToLower(strings.Join(splitWords(s), "-")) },
+		"replace":    func(old string, new string, src string) string { return strings.ReplaceAll(src, old, new) },
+		"contains":   func(substr string, s string) bool { return strings.Contains(s, substr) },
+		"hasPrefix":  func(prefix string, s string) bool { return strings.HasPrefix(s, prefix) },
+		"hasSuffix":  func(suffix string, s string) bool { return strings.HasSuffix(s, suffix) },
+		"trimPrefix": func(prefix string, s string) string { return strings.TrimPrefix(s, prefix) },
+		"trimSuffix": func(suffix string, s string) string { return strings.TrimSuffix(s, suffix) },
+		"trimAll":    func(cutset string, s string) string { return strings.Trim(s, cutset) },
+		"trim":       strings.TrimSpace,
+		"nospace":    func(s string) string { return strings.Join(strings.Fields(s), "") },
+		"upper":      strings.ToUpper,
+		"lower":      strings.ToLower,
+		"title":      title,
+		"repeat":     func(count int, s string) string { return strings.Repeat(s, count) },
+		"toString":   strval,
+		"toStrings": func(v interface{}) []string {
+			list := toList(v)
+			res := make([]string, len(list))
+			for i := range list {
+				res[i] = strval(list[i])
+			}
+			return res
+		},
+		"split": func(sep string, s string) map[string]interface{} {
+			parts := strings.Split(s, sep)
+			res := make(map[string]interface{}, len(parts))
+			for i := range parts {
+				res["_"+strconv.Itoa(i)] = parts[i]
+			}
+			return res
+		},
+		"splitn": func(sep string, n int, s string) map[string]interface{} {
+			parts := strings.SplitN(s, sep, n)
+			res := make(map[string]interface{}, len(parts))
+			for i := range parts {
+				res["_"+strconv.Itoa(i)] = parts[i]
+			}
+			return res
+		},
+		"splitList": func(sep string, s string) []string { return strings.Split(s, sep) },
+		"join": func(sep string, v interface{}) string {
+			list := toList(v)
+			res := make([]string, 0, len(list))
+			for i := range list {
+				if list[i] != nil {
+					res = append(res, strval(list[i]))
+				}
+			}
+			return strings.Join(res, sep)
+		},
+		"regexMatch": func(regex string, s string) (bool, error) {
+			return regexp.MatchString(regex, s)
+		},
+		"regexFind": func(regex string, s string) (string, error) {
+			r, err := regexp.Compile(regex)
+			if err != nil {
+				return "", err
+			}
+			return r.FindString(s), nil
+		},
+		"regexReplaceAll": func(regex string, s string, repl string) (string, error) {
+			r, err := regexp.Compile(regex)
+			if err != nil {
+				return "", err
+			}
+			return r.ReplaceAllString(s, repl), nil
+		},
+		"regexFindAll": func(regex string, s string, n int) ([]string, error) {
+			r, err := regexp.Compile(regex)
+			if err != nil {
+				return nil, err
+			}
+			return r.FindAllString(s, n), nil
+		},
+		"regexSplit": func(regex string, s string, n int) ([]string, error) {
+			r, err := regexp.Compile(regex)
+			if err != nil {
+				return nil, err
+			}
+			return r.Split(s, n), nil
+		},
+		"regexQuoteMeta": regexp.QuoteMeta,
+		"b64enc":         func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) },
+		"b64dec": func(s string) (string, error) {
+			data, err := base64.StdEncoding.DecodeString(s)
+			return string(data), err
+		},
+		"sha1sum": func(s string) string {
+			h := sha1.Sum([]byte(s))
+			return hex.EncodeToString(h[:])
+		},
+		"sha256sum": func(s string) string {
+			h := sha256.Sum256([]byte(s))
+			return hex.EncodeToString(h[:])
+		},
+		"adler32sum": func(s string) string {
+			return strconv.FormatUint(uint64(adler32.Checksum([]byte(s))), 10)
+		},
+		"randAlphaNum": randString,
+		"randAlpha":    randString,
+		"randNumeric":  func(n int) string { return strings.Repeat("0", n) },
+		"randAscii":    randString,
+		"uuidv4":       func() string { return "00000000-0000-4000-8000-000000000000" },
+
+		// crypto
+		"genPrivateKey": func(typ string) string { return "" },
+		"genCA": func(cn string, days int) certificate {
+			return certificate{}
+		},
+		"genSelfSignedCert": func(cn string, ips []interface{}, alternateDNS []interface{}, days int) certificate {
+			return certificate{}
+		},
+		"genSignedCert": func(cn string, ips []interface{}, alternateDNS []interface{}, days int, ca certificate) certificate {
+			return certificate{}
+		},
+		"htpasswd": func(username string, password string) string {
+			return username + ":"
+		},
+		"derivePassword": func(counter uint32, typ string, password string, user string, site string) string {
+			return ""
+		},
+
+		// numbers
+		"int":     func(v interface{}) int { return int(toInt64(v)) },
+		"int64":   toInt64,
+		"float64": toFloat64,
+		"atoi": func(s string) int {
+			i, _ := strconv.Atoi(s)
+			return i
+		},
+		"add": func(v ...interface{}) int64 {
+			var res int64
+			for i := range v {
+				res += toInt64(v[i])
+			}
+			return res
+		},
+		"add1": func(v interface{}) int64 { return toInt64(v) + 1 },
+		"sub":  func(a interface{}, b interface{}) int64 { return toInt64(a) - toInt64(b) },
+		"mul": func(a interface{}, v ...interface{}) int64 {
+			res := toInt64(a)
+			for i := range v {
+				res *= toInt64(v[i])
+			}
+			return res
+		},
+		"div": func(a interface{}, b interface{}) (int64, error) {
+			if toInt64(b) == 0 {
+				return 0, errors.New("division by zero")
+			}
+			return toInt64(a) / toInt64(b), nil
+		},
+		"mod": func(a interface{}, b interface{}) (int64, error) {
+			if toInt64(b) == 0 {
+				return 0, errors.New("division by zero")
+			}
+			return toInt64(a) % toInt64(b), nil
+		},
+		"max": func(a interface{}, v ...interface{}) int64 {
+			res := toInt64(a)
+			for i := range v {
+				if n := toInt64(v[i]); n > res {
+					res = n
+				}
+			}
+			return res
+		},
+		"min": func(a interface{}, v ...interface{}) int64 {
+			res := toInt64(a)
+			for i := range v {
+				if n := toInt64(v[i]); n < res {
+					res = n
+				}
+			}
+			return res
+		},
+		"until": func(n int) []int {
+			return untilStep(0, n, 1)
+		},
+		"untilStep": untilStep,
+		"semver":    semver.NewVersion,
+		"semverCompare": func(constraint string, version string) (bool, error) {
+			c, err := semver.NewConstraint(constraint)
+			if err != nil {
+				return false, err
+			}
+			v, err := semver.NewVersion(version)
+			if err != nil {
+				return false, err
+			}
+			return c.Check(v), nil
+		},
+
+		// lists
+		"list": func(v ...interface{}) []interface{} { return v },
+		"first": func(v interface{}) interface{} {
+			list := toList(v)
+			if len(list) == 0 {
+				return nil
+			}
+			return list[0]
+		},
+		"last": func(v interface{}) interface{} {
+			list := toList(v)
+			if len(list) == 0 {
+				return nil
+			}
+			return list[len(list)-1]
+		},
+		"rest": func(v interface{}) []interface{} {
+			list := toList(v)
+			if len(list) == 0 {
+				return list
+			}
+			return list[1:]
+		},
+		"initial": func(v interface{}) []interface{} {
+			list := toList(v)
+			if len(list) == 0 {
+				return list
+			}
+			return list[:len(list)-1]
+		},
+		"append":     appendList,
+		"push":       appendList,
+		"mustAppend": appendList,
+		"prepend": func(v interface{}, elem interface{}) []interface{} {
+			return append([]interface{}{elem}, toList(v)...)
+		},
+		"has": func(needle interface{}, haystack interface{}) bool {
+			for _, v := range toList(haystack) {
+				if reflect.DeepEqual(v, needle) {
+					return true
+				}
+			}
+			return false
+		},
+		"uniq": func(v interface{}) []interface{} {
+			res := []interface{}{}
+			for _, elem := range toList(v) {
+				found := false
+				for i := range res {
+					if reflect.DeepEqual(res[i], elem) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					res = append(res, elem)
+				}
+			}
+			return res
+		},
+		"without": func(v interface{}, omit ...interface{}) []interface{} {
+			res := []interface{}{}
+			for _, elem := range toList(v) {
+				skip := false
+				for i := range omit {
+					if reflect.DeepEqual(omit[i], elem) {
+						skip = true
+						break
+					}
+				}
+				if !skip {
+					res = append(res, elem)
+				}
+			}
+			return res
+		},
+		"compact": func(v interface{}) []interface{} {
+			res := []interface{}{}
+			for _, elem := range toList(v) {
+				if !empty(elem) {
+					res = append(res, elem)
+				}
+			}
+			return res
+		},
+		"concat": func(lists ...interface{}) []interface{} {
+			res := []interface{}{}
+			for i := range lists {
+				res = append(res, toList(lists[i])...)
+			}
+			return res
+		},
+		"sortAlpha": func(v interface{}) []string {
+			list := toList(v)
+			res := make([]string, len(list))
+			for i := range list {
+				res[i] = strval(list[i])
+			}
+			sort.Strings(res)
+			return res
+		},
+
+		// dicts
+		"dict": func(v ...interface{}) map[string]interface{} {
+			res := map[string]interface{}{}
+			for i := 0; i < len(v); i += 2 {
+				var value interface{}
+				if i+1 < len(v) {
+					value = v[i+1]
+				}
+				res[strval(v[i])] = value
+			}
+			return res
+		},
+		"get": func(d map[string]interface{}, key string) interface{} {
+			if v, ok := d[key]; ok {
+				return v
+			}
+			return ""
+		},
+		"set": func(d map[string]interface{}, key string, value interface{}) map[string]interface{} {
+			d[key] = value
+			return d
+		},
+		"unset": func(d map[string]interface{}, key string) map[string]interface{} {
+			delete(d, key)
+			return d
+		},
+		"hasKey": func(d map[string]interface{}, key string) bool {
+			_, ok := d[key]
+			return ok
+		},
+		"keys": func(dicts ...map[string]interface{}) []string {
+			res := []string{}
+			for _, d := range dicts {
+				for k := range d {
+					res = append(res, k)
+				}
+			}
+			return res
+		},
+		"values": func(d map[string]interface{}) []interface{} {
+			res := make([]interface{}, 0, len(d))
+			for _, v := range d {
+				res = append(res, v)
+			}
+			return res
+		},
+		"pluck": func(key string, dicts ...map[string]interface{}) []interface{} {
+			res := []interface{}{}
+			for _, d := range dicts {
+				if v, ok := d[key]; ok {
+					res = append(res, v)
+				}
+			}
+			return res
+		},
+		"pick": func(d map[string]interface{}, keys ...string) map[string]interface{} {
+			res := map[string]interface{}{}
+			for _, k := range keys {
+				if v, ok := d[k]; ok {
+					res[k] = v
+				}
+			}
+			return res
+		},
+		"omit": func(d map[string]interface{}, keys ...string) map[string]interface{} {
+			res := map[string]interface{}{}
+			for k, v := range d {
+				res[k] = v
+			}
+			for _, k := range keys {
+				delete(res, k)
+			}
+			return res
+		},
+		"dig": func(v ...interface{}) (interface{}, error) {
+			if len(v) < 3 {
+				return nil, errors.New("dig needs at least three arguments")
+			}
+			d, ok := v[len(v)-1].(map[string]interface{})
+			if !ok {
+				return nil, errors.New("last argument of dig must be a dict")
+			}
+			keys := v[:len(v)-2]
+			def := v[len(v)-2]
+			var cur interface{} = d
+			for _, k := range keys {
+				m, ok := cur.(map[string]interface{})
+				if !ok {
+					return def, nil
+				}
+				if cur, ok = m[strval(k)]; !ok {
+					return def, nil
+				}
+			}
+			return cur, nil
+		},
+		"merge":              mergeDicts(false),
+		"mustMerge":          mergeDicts(false),
+		"mergeOverwrite":     mergeDicts(true),
+		"mustMergeOverwrite": mergeDicts(true),
+		"deepCopy":           deepCopy,
+
+		// types
+		"kindOf": func(v interface{}) string { return kindOf(v) },
+		"kindIs": func(kind string, v interface{}) bool { return kindOf(v) == kind },
+		"typeOf": func(v interface{}) string { return fmt.Sprintf("%T", v) },
+		"typeIs": func(t string, v interface{}) bool { return t == fmt.Sprintf("%T", v) },
+
+		// dates
+		"now": time.Now,
+		"date": func(layout string, date time.Time) string {
+			return date.Format(layout)
+		},
+		"ago": func(date interface{}) string {
+			return time.Since(toTime(date)).Round(time.Second).String()
+		},
+	}
+
+	for name, target := range funcAliases {
+		funcs[name] = funcs[target]
+	}
+
+	// charts only fail if they call a function that is not supported,
+	// undefined functions would already fail when the templates are parsed
+	for _, name := range helmFuncNames {
+		if _, ok := funcs[name]; !ok {
+			funcs[name] = unsupportedFunc(name)
+		}
+	}
+
+	return funcs
+}
+
+// funcAliases maps functions to the function they behave like. The must
+// variants of sprig return errors instead of panics, which is how all
+// functions in this package behave.
+var funcAliases = map[string]string{
+	"tuple":               "list",
+	"mustPush":            "push",
+	"mustPrepend":         "prepend",
+	"mustFirst":           "first",
+	"mustLast":            "last",
+	"mustRest":            "rest",
+	"mustInitial":         "initial",
+	"mustUniq":            "uniq",
+	"mustWithout":         "without",
+	"mustHas":             "has",
+	"mustCompact":         "compact",
+	"mustDeepCopy":        "deepCopy",
+	"mustToPrettyJson":    "toPrettyJson",
+	"mustFromJson":        "fromJson",
+	"mustRegexMatch":      "regexMatch",
+	"mustRegexFind":       "regexFind",
+	"mustRegexFindAll":    "regexFindAll",
+	"mustRegexSplit":      "regexSplit",
+	"mustRegexReplaceAll": "regexReplaceAll",
+	"trimall":             "trimAll",
+}
+
+// helmFuncNames has all functions of helm and sprig
+var helmFuncNames = []string{
+	// helm
+	"include", "tpl", "required", "lookup", "toToml", "toYaml", "mustToYaml", "toYamlPretty",
+	"fromYaml", "fromYamlArray", "toJson", "fromJson", "fromJsonArray",
+	// sprig
+	"hello", "ago", "date", "date_in_zone", "date_modify", "dateInZone", "dateModify",
+	"duration", "durationRound", "htmlDate", "htmlDateInZone", "must_date_modify",
+	"mustDateModify", "mustToDate", "now", "toDate", "unixEpoch",
+	"abbrev", "abbrevboth", "trunc", "trim", "upper", "lower", "title", "untitle", "substr",
+	"repeat", "trimall", "trimAll", "trimSuffix", "trimPrefix", "nospace", "initials",
+	"randAlphaNum", "randAlpha", "randAscii", "randNumeric", "swapcase", "shuffle",
+	"snakecase", "camelcase", "kebabcase", "wrap", "wrapWith", "contains", "hasPrefix",
+	"hasSuffix", "quote", "squote", "cat", "indent", "nindent", "replace", "plural",
+	"sha1sum", "sha256sum", "adler32sum", "toString",
+	"atoi", "int64", "int", "float64", "seq", "toDecimal",
+	"split", "splitList", "splitn", "toStrings",
+	"until", "untilStep",
+	"add1", "add", "sub", "div", "mod", "mul", "randInt", "add1f", "addf", "subf", "divf",
+	"mulf", "biggest", "max", "min", "maxf", "minf", "ceil", "floor", "round",
+	"join", "sortAlpha",
+	"default", "empty", "coalesce", "all", "any", "compact", "mustCompact", "toPrettyJson",
+	"toRawJson", "mustFromJson", "mustToJson", "mustToPrettyJson", "mustToRawJson",
+	"ternary", "deepCopy", "mustDeepCopy",
+	"typeOf", "typeIs", "typeIsLike", "kindOf", "kindIs", "deepEqual",
+	"env", "expandenv", "getHostByName",
+	"base", "dir", "clean", "ext", "isAbs", "osBase", "osClean", "osDir", "osExt", "osIsAbs",
+	"b64enc", "b64dec", "b32enc", "b32dec",
+	"tuple", "list", "dict", "get", "set", "unset", "hasKey", "pluck", "keys", "pick", "omit",
+	"merge", "mergeOverwrite", "mustMerge", "mustMergeOverwrite", "values",
+	"append", "push", "mustAppend", "mustPush", "prepend", "mustPrepend", "first", "mustFirst",
+	"rest", "mustRest", "last", "mustLast", "initial", "mustInitial", "reverse", "mustReverse",
+	"uniq", "mustUniq", "without", "mustWithout", "has", "mustHas", "slice", "mustSlice",
+	"concat", "dig", "chunk", "mustChunk",
+	"bcrypt", "htpasswd", "genPrivateKey", "derivePassword", "buildCustomCert", "genCA",
+	"genCAWithKey", "genSelfSignedCert", "genSelfSignedCertWithKey", "genSignedCert",
+	"genSignedCertWithKey", "encryptAES", "decryptAES", "randBytes",
+	"uuidv4", "semver", "semverCompare", "fail",
+	"regexMatch", "mustRegexMatch", "regexFindAll", "mustRegexFindAll", "regexFind",
+	"mustRegexFind", "regexReplaceAll", "mustRegexReplaceAll", "regexReplaceAllLiteral",
+	"mustRegexReplaceAllLiteral", "regexSplit", "mustRegexSplit", "regexQuoteMeta",
+	"urlParse", "urlJoin",
+}
+
+func unsupportedFunc(name string) func(...interface{}) (interface{}, error) {
+	return func(...interface{}) (interface{}, error) {
+		return nil, errors.New("template function " + name + " is not supported")
+	}
+}
+
+// certificate is returned by the certificate functions, its fields
+// are empty since no keys are generated
+type certificate struct {
+	Cert string
+	Key  string
+}
+
+func toYaml(v interface{}) string {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		// like helm, errors are swallowed to not break the template rendering
+		return ""
+	}
+	return strings.TrimSuffix(string(data), "\n")
+}
+
+func fromYaml(s string) map[string]interface{} {
+	res := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(s), &res); err != nil {
+		res["Error"] = err.Error()
+	}
+	return res
+}
+
+func fromYamlArray(s string) []interface{} {
+	res := []interface{}{}
+	if err := yaml.Unmarshal([]byte(s), &res); err != nil {
+		res = []interface{}{err.Error()}
+	}
+	return res
+}
+
+func toToml(v interface{}) string {
+	var buf strings.Builder
+	if err := toml.NewEncoder(&buf).Encode(v); err != nil {
+		return err.Error()
+	}
+	return buf.String()
+}
+
+func toJson(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+func toPrettyJson(v interface{}) string {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+func fromJson(s string) map[string]interface{} {
+	res := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(s), &res); err != nil {
+		res["Error"] = err.Error()
+	}
+	return res
+}
+
+func defaultValue(d interface{}, given ...interface{}) interface{} {
+	if len(given) == 0 || empty(given[0]) {
+		return d
+	}
+	return given[0]
+}
+
+// empty follows the semantics of sprig, zero values and empty collections are empty
+func empty(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return true
+	}
+
+	switch rv.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return rv.Len() == 0
+	case reflect.Bool:
+		return !rv.Bool()
+	case reflect.Complex64, reflect.Complex128:
+		return rv.Complex() == 0
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return rv.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return rv.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return rv.IsNil()
+	case reflect.Struct:
+		return false
+	default:
+		return rv.IsZero()
+	}
+}
+
+func coalesce(v ...interface{}) interface{} {
+	for i := range v {
+		if !empty(v[i]) {
+			return v[i]
+		}
+	}
+	return nil
+}
+
+func indent(spaces int, v string) string {
+	pad := strings.Repeat(" ", spaces)
+	return pad + strings.ReplaceAll(v, "\n", "\n"+pad)
+}
+
+func title(s string) string {
+	words := strings.Split(s, " ")
+	for i := range words {
+		if words[i] != "" {
+			words[i] = strings.ToUpper(words[i][:1]) + words[i][1:]
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// splitWords splits identifiers like "fooBar", "FooBar", "HTTPServer",
+// "foo_bar" or "foo-bar" into their words
+func splitWords(s string) []string {
+	res := []string{}
+	runes := []rune(s)
+	start := -1
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if start >= 0 {
+				res = append(res, string(runes[start:i]))
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+			continue
+		}
+		prev := runes[i-1]
+		// fooBar or the end of an acronym like HTTPServer
+		if unicode.IsUpper(r) && (unicode.IsLower(prev) ||
+			(unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			res = append(res, string(runes[start:i]))
+			start = i
+		}
+	}
+	if start >= 0 {
+		res = append(res, string(runes[start:]))
+	}
+	return res
+}
+
+// camelcase turns snake case into camel case like sprig, e.g. foo_bar into FooBar
+func camelcase(s string) string {
+	parts := strings.Split(s, "_")
+	for i := range parts {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// wrap breaks the text into lines of the given width. Words that are
+// longer than the width are not broken.
+func wrap(width int, sep string, s string) string {
+	if width < 1 {
+		width = 1
+	}
+	lines := strings.Split(s, "\n")
+	for i := range lines {
+		words := strings.Fields(lines[i])
+		var sb strings.Builder
+		n := 0
+		for _, word := range words {
+			if n > 0 && n+1+len(word) > width {
+				sb.WriteString(sep)
+				n = 0
+			} else if n > 0 {
+				sb.WriteString(" ")
+				n++
+			}
+			sb.WriteString(word)
+			n += len(word)
+		}
+		lines[i] = sb.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// randString returns a fixed string of the requested length instead of a
+// random one, so that rendering the same chart twice has the same result
+func randString(n int) string {
+	return strings.Repeat("x", n)
+}
+
+func strval(v interface{}) string {
+	switch s := v.(type) {
+	case string:
+		return s
+	case []byte:
+		return string(s)
+	case error:
+		return s.Error()
+	case fmt.Stringer:
+		return s.String()
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func toTime(v interface{}) time.Time {
+	switch t := v.(type) {
+	case time.Time:
+		return t
+	case *time.Time:
+		return *t
+	default:
+		return time.Unix(toInt64(v), 0)
+	}
+}
+
+func toInt64(v interface{}) int64 {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return int64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		return int64(rv.Float())
+	case reflect.Bool:
+		if rv.Bool() {
+			return 1
+		}
+		return 0
+	case reflect.String:
+		if i, err := strconv.ParseInt(rv.String(), 10, 64); err == nil {
+			return i
+		}
+		f, _ := strconv.ParseFloat(rv.String(), 64)
+		return int64(f)
+	default:
+		return 0
+	}
+}
+
+func toFloat64(v interface{}) float64 {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		return rv.Float()
+	case reflect.String:
+		f, _ := strconv.ParseFloat(rv.String(), 64)
+		return f
+	default:
+		return 0
+	}
+}
+
+func toList(v interface{}) []interface{} {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return []interface{}{}
+	}
+	res := make([]interface{}, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		res[i] = rv.Index(i).Interface()
+	}
+	return res
+}
+
+func appendList(v interface{}, elem interface{}) []interface{} {
+	return append(toList(v), elem)
+}
+
+func untilStep(start int, stop int, step int) []int {
+	res := []int{}
+	if step == 0 || (step > 0 && start >= stop) || (step < 0 && start <= stop) {
+		return res
+	}
+	for i := start; (step > 0 && i < stop) || (step < 0 && i > stop); i += step {
+		res = append(res, i)
+	}
+	return res
+}
+
+func kindOf(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return "invalid"
+	}
+	return rv.Kind().String()
+}
+
+// mergeDicts merges the sources into dst, without overwrite only missing keys are set
+func mergeDicts(overwrite bool) func(dst map[string]interface{}, srcs ...map[string]interface{}) map[string]interface{} {
+	var merge func(dst map[string]interface{}, src map[string]interface{})
+	merge = func(dst map[string]interface{}, src map[string]interface{}) {
+		for k, v := range src {
+			srcMap, srcIsMap := v.(map[string]interface{})
+			dstMap, dstIsMap := dst[k].(map[string]interface{})
+			switch {
+			case srcIsMap && dstIsMap:
+				merge(dstMap, srcMap)
+			case overwrite:
+				dst[k] = v
+			default:
+				if _, ok := dst[k]; !ok {
+					dst[k] = v
+				}
+			}
+		}
+	}
+
+	return func(dst map[string]interface{}, srcs ...map[string]interface{}) map[string]interface{} {
+		for i := range srcs {
+			merge(dst, srcs[i])
+		}
+		return dst
+	}
+}
+
+func deepCopy(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(t))
+		for k := range t {
+			res[k] = deepCopy(t[k])
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(t))
+		for i := range t {
+			res[i] = deepCopy(t[i])
+		}
+		return res
+	default:
+		return v
+	}
+}
@@ -0,0 +1,250 @@
+package helm
+
+import (
+	"bytes"
+	"path"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultReleaseName = "release-name"
+	DefaultNamespace   = "default"
+	DefaultKubeVersion = "v1.25.0"
+)
+
+// defaultAPIVersions are the api versions reported by .Capabilities.APIVersions
+var defaultAPIVersions = []string{
+	"v1",
+	"admissionregistration.k8s.io/v1",
+	"apiextensions.k8s.io/v1",
+	"apps/v1",
+	"authentication.k8s.io/v1",
+	"authorization.k8s.io/v1",
+	"autoscaling/v1",
+	"autoscaling/v2",
+	"batch/v1",
+	"certificates.k8s.io/v1",
+	"coordination.k8s.io/v1",
+	"discovery.k8s.io/v1",
+	"events.k8s.io/v1",
+	"networking.k8s.io/v1",
+	"node.k8s.io/v1",
+	"policy/v1",
+	"rbac.authorization.k8s.io/v1",
+	"scheduling.k8s.io/v1",
+	"storage.k8s.io/v1",
+}
+
+// Options describe the release the chart is rendered for
+type Options struct {
+	ReleaseName string
+	Namespace   string
+	// Values overwrite the values of the chart
+	Values      map[string]interface{}
+	KubeVersion string
+}
+
+// Render renders the templates of the chart and its enabled dependencies like
+// `helm template` does. It returns the manifests by template name, e.g.
+// mychart/templates/deployment.yaml. Partials and NOTES.txt are not part of
+// the result.
+func Render(chart *Chart, opts Options) (map[string]string, error) {
+	if opts.ReleaseName == "" {
+		opts.ReleaseName = DefaultReleaseName
+	}
+	if opts.Namespace == "" {
+		opts.Namespace = DefaultNamespace
+	}
+	if opts.KubeVersion == "" {
+		opts.KubeVersion = DefaultKubeVersion
+	}
+
+	var charts []renderChart
+	collectCharts(chart, mergeValues(chart.Values, opts.Values), chart.Metadata.Name, &charts)
+
+	root := template.New("helm")
+	root.Option("missingkey=zero")
+	root.Funcs(funcMap(root))
+	for _, rc := range charts {
+		for _, file := range rc.chart.Templates {
+			name := templatePath(rc.path, file.Name)
+			if _, err := root.New(name).Parse(string(file.Data)); err != nil {
+				return nil, errors.Wrap(err, "could not parse template "+name)
+			}
+		}
+	}
+
+	caps := newCapabilities(opts.KubeVersion)
+	res := map[string]string{}
+	for _, rc := range charts {
+		for _, file := range rc.chart.Templates {
+			base := path.Base(file.Name)
+			if strings.HasPrefix(base, "_") || base == "NOTES.txt" {
+				continue
+			}
+
+			name := templatePath(rc.path, file.Name)
+			data := map[string]interface{}{
+				"Values": rc.values,
+				"Release": map[string]interface{}{
+					"Name":      opts.ReleaseName,
+					"Namespace": opts.Namespace,
+					"Service":   "Helm",
+					"IsInstall": true,
+					"IsUpgrade": false,
+					"Revision":  1,
+				},
+				"Chart":        chartObject(rc.chart.Metadata),
+				"Capabilities": caps,
+				"Template": map[string]interface{}{
+					"Name":     name,
+					"BasePath": templatePath(rc.path, "templates"),
+				},
+				"Files": newFiles(rc.chart.Files),
+			}
+
+			var buf bytes.Buffer
+			if err := root.ExecuteTemplate(&buf, name, data); err != nil {
+				return nil, errors.Wrap(err, "could not render template "+name)
+			}
+			res[name] = strings.ReplaceAll(buf.String(), "<no value>", "")
+		}
+	}
+	return res, nil
+}
+
+// Manifest joins the rendered templates into one multi-document yaml, sorted by
+// template name. Documents without content are dropped.
+func Manifest(rendered map[string]string) []byte {
+	names := make([]string, 0, len(rendered))
+	for name := range rendered {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	for _, name := range names {
+		for _, doc := range splitDocuments(rendered[name]) {
+			buf.WriteString("---\n# Source: " + name + "\n")
+			buf.WriteString(doc)
+			buf.WriteString("\n")
+		}
+	}
+	return buf.Bytes()
+}
+
+// splitDocuments splits a yaml stream and drops documents that only have comments
+func splitDocuments(s string) []string {
+	var res []string
+	for _, doc := range strings.Split("\n"+s, "\n---") {
+		doc = strings.Trim(doc, "\n")
+		if hasContent(doc) {
+			res = append(res, doc)
+		}
+	}
+	return res
+}
+
+func hasContent(doc string) bool {
+	for _, line := range strings.Split(doc, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			return true
+		}
+	}
+	return false
+}
+
+type renderChart struct {
+	chart  *Chart
+	values map[string]interface{}
+	path   string
+}
+
+// collectCharts determines the values of the chart and its enabled dependencies.
+// The values of a dependency are the ones under its name in the parent values,
+// merged over the defaults of the dependency. Global values are passed down.
+func collectCharts(chart *Chart, values map[string]interface{}, p string, res *[]renderChart) {
+	*res = append(*res, renderChart{chart: chart, values: values, path: p})
+
+	for _, dependency := range chart.Dependencies {
+		if !chart.dependencyEnabled(dependency, values) {
+			continue
+		}
+		name := chart.dependencyName(dependency)
+
+		parentValues, _ := values[name].(map[string]interface{})
+		dependencyValues := mergeValues(dependency.Values, parentValues)
+		if global, ok := values["global"].(map[string]interface{}); ok {
+			dependencyGlobal, _ := dependencyValues["global"].(map[string]interface{})
+			dependencyValues["global"] = mergeValues(dependencyGlobal, global)
+		}
+		values[name] = dependencyValues
+
+		collectCharts(dependency, dependencyValues, templatePath(p, "charts", name), res)
+	}
+}
+
+// chartObject is the .Chart object, helm exposes the fields of the Chart.yaml
+// with capitalized names
+func chartObject(m *Metadata) map[string]interface{} {
+	return map[string]interface{}{
+		"Name":        m.Name,
+		"Version":     m.Version,
+		"AppVersion":  m.AppVersion,
+		"APIVersion":  m.APIVersion,
+		"Description": m.Description,
+		"Type":        m.Type,
+		"KubeVersion": m.KubeVersion,
+		"Keywords":    m.Keywords,
+		"Annotations": m.Annotations,
+	}
+}
+
+type kubeVersion struct {
+	Version    string
+	Major      string
+	Minor      string
+	GitVersion string
+}
+
+func (k kubeVersion) String() string {
+	return k.Version
+}
+
+type versionSet []string
+
+// Has returns true if the api version, e.g. apps/v1, is available
+func (v versionSet) Has(apiVersion string) bool {
+	for i := range v {
+		if v[i] == apiVersion {
+			return true
+		}
+	}
+	return false
+}
+
+type capabilities struct {
+	KubeVersion kubeVersion
+	APIVersions versionSet
+}
+
+func newCapabilities(version string) *capabilities {
+	if !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+	parts := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3)
+	kv := kubeVersion{Version: version, GitVersion: version}
+	if len(parts) > 1 {
+		kv.Major = parts[0]
+		kv.Minor = parts[1]
+	}
+	return &capabilities{
+		KubeVersion: kv,
+		APIVersions: versionSet(defaultAPIVersions),
+	}
+}
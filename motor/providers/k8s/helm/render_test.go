@@ -0,0 +1,188 @@
+package helm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+func TestLoadChart(t *testing.T) {
+	chart, err := LoadChart("./testdata/webapp")
+	require.NoError(t, err)
+
+	assert.Equal(t, "webapp", chart.Metadata.Name)
+	assert.Equal(t, "1.16.0", chart.Metadata.AppVersion)
+	assert.Len(t, chart.Templates, 6)
+	require.Len(t, chart.Files, 1)
+	assert.Equal(t, "config/app.conf", chart.Files[0].Name)
+	require.Len(t, chart.Dependencies, 1)
+	assert.Equal(t, "cache", chart.Dependencies[0].Metadata.Name)
+}
+
+func TestRender(t *testing.T) {
+	chart, err := LoadChart("./testdata/webapp")
+	require.NoError(t, err)
+
+	rendered, err := Render(chart, Options{
+		ReleaseName: "prod",
+		Namespace:   "web",
+		Values: map[string]interface{}{
+			"replicaCount": 3,
+			"image":        map[string]interface{}{"tag": "1.23"},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.NotContains(t, rendered, "webapp/templates/_helpers.tpl")
+	assert.NotContains(t, rendered, "webapp/templates/NOTES.txt")
+	assert.Empty(t, splitDocuments(rendered["webapp/templates/ingress.yaml"]))
+
+	deployment := map[string]interface{}{}
+	require.NoError(t, yaml.Unmarshal([]byte(rendered["webapp/templates/deployment.yaml"]), &deployment))
+	assert.Equal(t, "prod-webapp", valuePath(deployment, "metadata.name"))
+	assert.Equal(t, "web", valuePath(deployment, "metadata.namespace"))
+	labels := valuePath(deployment, "metadata.labels").(map[string]interface{})
+	assert.Equal(t, "1.16.0", labels["app.kubernetes.io/version"])
+	assert.Equal(t, float64(3), valuePath(deployment, "spec.replicas"))
+
+	containers := valuePath(deployment, "spec.template.spec.containers").([]interface{})
+	require.Len(t, containers, 1)
+	container := containers[0].(map[string]interface{})
+	assert.Equal(t, "nginx:1.23", container["image"])
+	assert.Equal(t, map[string]interface{}{"runAsNonRoot": true}, container["securityContext"])
+
+	configMap := map[string]interface{}{}
+	require.NoError(t, yaml.Unmarshal([]byte(rendered["webapp/templates/configmap.yaml"]), &configMap))
+	assert.Equal(t, map[string]interface{}{"app.conf": "listen 8080\n"}, configMap["data"])
+
+	t.Run("dependencies", func(t *testing.T) {
+		pod := map[string]interface{}{}
+		require.NoError(t, yaml.Unmarshal([]byte(rendered["webapp/charts/cache/templates/pod.yaml"]), &pod))
+		assert.Equal(t, "prod-cache", valuePath(pod, "metadata.name"))
+		assert.Equal(t, "platform", valuePath(pod, "metadata.labels.team"))
+
+		rendered, err := Render(chart, Options{
+			Values: map[string]interface{}{
+				"cache": map[string]interface{}{"enabled": false},
+			},
+		})
+		require.NoError(t, err)
+		assert.NotContains(t, rendered, "webapp/charts/cache/templates/pod.yaml")
+	})
+
+	t.Run("required values", func(t *testing.T) {
+		_, err := Render(chart, Options{
+			Values: map[string]interface{}{
+				"ingress": map[string]interface{}{"enabled": true},
+			},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ingress.host is required")
+	})
+}
+
+func TestManifest(t *testing.T) {
+	manifest := Manifest(map[string]string{
+		"app/templates/b.yaml": "# only a comment\n",
+		"app/templates/a.yaml": "kind: Service\n---\nkind: Deployment\n",
+	})
+	assert.Equal(t, "---\n# Source: app/templates/a.yaml\nkind: Service\n"+
+		"---\n# Source: app/templates/a.yaml\nkind: Deployment\n", string(manifest))
+}
+
+func TestRender_RecursiveInclude(t *testing.T) {
+	chart := &Chart{
+		Metadata: &Metadata{Name: "loop", Version: "0.1.0"},
+		Values:   map[string]interface{}{},
+		Templates: []*File{
+			{Name: "templates/_helpers.tpl", Data: []byte(`{{- define "loop.name" -}}{{ include "loop.name" . }}{{- end -}}`)},
+			{Name: "templates/configmap.yaml", Data: []byte(`name: {{ include "loop.name" . }}`)},
+		},
+	}
+	_, err := Render(chart, Options{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "nested reference name: loop.name")
+
+	// tpl that renders itself
+	chart.Values["text"] = `{{ tpl .Values.text . }}`
+	chart.Templates = []*File{{Name: "templates/configmap.yaml", Data: []byte(`name: {{ tpl .Values.text . }}`)}}
+	_, err = Render(chart, Options{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "nested reference name: tpl")
+}
+
+func TestRender_SprigFuncs(t *testing.T) {
+	chart := &Chart{
+		Metadata: &Metadata{Name: "funcs", Version: "0.1.0"},
+		Values: map[string]interface{}{
+			"appName": "my_web_app",
+			"desc":    "a web application that serves the public website",
+			"config":  map[string]interface{}{"port": 8080},
+		},
+		Templates: []*File{
+			{Name: "templates/secret.yaml", Data: []byte(`{{- $ca := genCA "funcs-ca" 365 -}}
+{{- $cert := genSelfSignedCert "funcs" nil (list "funcs.local") 365 -}}
+{{- $tuple := tuple "a" "b" -}}
+{{- $version := semver "1.16.3" -}}
+{{- $parts := splitn ":" 2 "host:8080:extra" -}}
+apiVersion: v1
+kind: Secret
+metadata:
+  name: {{ .Values.appName | kebabcase }}
+  labels:
+    snake: {{ "HTTPServerName" | snakecase }}
+    camel: {{ .Values.appName | camelcase }}
+    id: {{ uuidv4 | quote }}
+    major: {{ $version.Major | quote }}
+    first: {{ index $tuple 0 }}
+    host: {{ $parts._0 }}
+    port: {{ $parts._1 | quote }}
+    version: {{ mustRegexReplaceAll "^v" "v1.2" "" | quote }}
+  annotations:
+    short: {{ abbrev 8 .Values.desc | quote }}
+    wrapped: {{ wrap 20 .Values.desc | quote }}
+    derived: {{ derivePassword 1 "long" "secret" "user" "example.com" | quote }}
+    age: {{ ago now | quote }}
+data:
+  ca.crt: {{ $ca.Cert | b64enc | quote }}
+  tls.key: {{ $cert.Key | b64enc | quote }}
+  key: {{ genPrivateKey "rsa" | b64enc | quote }}
+  auth: {{ htpasswd "admin" "secret" | b64enc | quote }}
+  config: {{ toToml .Values.config | b64enc | quote }}
+`)},
+			{Name: "templates/unsupported.yaml", Data: []byte(`{{ if .Values.encrypt }}key: {{ encryptAES "secret" "text" }}{{ end }}`)},
+		},
+	}
+
+	rendered, err := Render(chart, Options{ReleaseName: "prod"})
+	require.NoError(t, err)
+
+	secret := map[string]interface{}{}
+	require.NoError(t, yaml.Unmarshal([]byte(rendered["funcs/templates/secret.yaml"]), &secret))
+	assert.Equal(t, "my-web-app", valuePath(secret, "metadata.name"))
+	assert.Equal(t, map[string]interface{}{
+		"snake":   "http_server_name",
+		"camel":   "MyWebApp",
+		"id":      "00000000-0000-4000-8000-000000000000",
+		"major":   "1",
+		"first":   "a",
+		"host":    "host",
+		"port":    "8080:extra",
+		"version": "1.2",
+	}, valuePath(secret, "metadata.labels"))
+	assert.Equal(t, map[string]interface{}{
+		"short":   "a web...",
+		"wrapped": "a web application\nthat serves the\npublic website",
+		"derived": "",
+		"age":     "0s",
+	}, valuePath(secret, "metadata.annotations"))
+	assert.Equal(t, "cG9ydCA9IDgwODAK", valuePath(secret, "data.config"))
+
+	// functions that are not supported only fail if they are called
+	chart.Values["encrypt"] = true
+	_, err = Render(chart, Options{ReleaseName: "prod"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "template function encryptAES is not supported")
+}
@@ -15,6 +15,7 @@ import (
 	"go.mondoo.com/cnquery/motor/discovery/common"
 	"go.mondoo.com/cnquery/motor/platform"
 	"go.mondoo.com/cnquery/motor/providers"
+	"go.mondoo.com/cnquery/motor/providers/k8s/helm"
 	"go.mondoo.com/cnquery/motor/providers/k8s/resources"
 	os_provider "go.mondoo.com/cnquery/motor/providers/os"
 	"go.mondoo.com/cnquery/motor/providers/os/fsutil"
@@ -37,6 +38,20 @@ func WithManifestFile(filename string) Option {
 	}
 }
 
+// WithHelmValues sets the values files that are used to render a helm chart
+func WithHelmValues(filenames []string) Option {
+	return func(t *manifestProvider) {
+		t.helmValues = filenames
+	}
+}
+
+// WithHelmReleaseName sets the release name that is used to render a helm chart
+func WithHelmReleaseName(name string) Option {
+	return func(t *manifestProvider) {
+		t.helmReleaseName = name
+	}
+}
+
 func newManifestProvider(selectedResourceID string, objectKind string, opts ...Option) (KubernetesProvider, error) {
 	t := &manifestProvider{
 		objectKind: objectKind,
@@ -46,7 +61,14 @@ func newManifestProvider(selectedResourceID string, objectKind string, opts ...O
 		option(t)
 	}
 
-	manifest, err := loadManifestFile(t.manifestFile)
+	var manifest []byte
+	var err error
+	if t.manifestFile != "-" && helm.IsChartDir(t.manifestFile) {
+		t.helmChart = true
+		manifest, err = renderHelmChart(t.manifestFile, t.helmReleaseName, t.namespace, t.helmValues)
+	} else {
+		manifest, err = loadManifestFile(t.manifestFile)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -65,6 +87,9 @@ type manifestProvider struct {
 	namespace          string
 	selectedResourceID string
 	objectKind         string
+	helmChart          bool
+	helmValues         []string
+	helmReleaseName    string
 }
 
 func (t *manifestProvider) RunCommand(command string) (*os_provider.Command, error) {
@@ -173,6 +198,9 @@ func (t *manifestProvider) Identifier() (string, error) {
 func (t *manifestProvider) Name() (string, error) {
 	// manifest parent directory name
 	clusterName := common.ProjectNameFromPath(t.manifestFile)
+	if t.helmChart {
+		return "K8s Helm Chart " + clusterName, nil
+	}
 	clusterName = "K8s Manifest " + clusterName
 	return clusterName, nil
 }
@@ -232,3 +260,28 @@ func loadManifestFile(manifestFile string) ([]byte, error) {
 
 	return ioutil.ReadAll(input)
 }
+
+// renderHelmChart renders a local chart directory with its values files into
+// a manifest, the same way `helm template` does
+func renderHelmChart(chartDir string, releaseName string, namespace string, valuesFiles []string) ([]byte, error) {
+	chart, err := helm.LoadChart(chartDir)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load helm chart "+chartDir)
+	}
+
+	values, err := helm.LoadValuesFiles(valuesFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug().Str("chart", chart.Metadata.Name).Strs("values", valuesFiles).Msg("render helm chart")
+	rendered, err := helm.Render(chart, helm.Options{
+		ReleaseName: releaseName,
+		Namespace:   namespace,
+		Values:      values,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not render helm chart "+chartDir)
+	}
+	return helm.Manifest(rendered), nil
+}
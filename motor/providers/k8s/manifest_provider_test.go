@@ -60,3 +60,29 @@ func TestManifestFileProvider(t *testing.T) {
 		assert.Contains(t, transport.PlatformInfo().Family, "k8s")
 	})
 }
+
+func TestHelmChartProvider(t *testing.T) {
+	transport, err := newManifestProvider("", "",
+		WithManifestFile("./helm/testdata/webapp"),
+		WithHelmValues([]string{"./helm/testdata/prod-values.yaml"}),
+		WithHelmReleaseName("prod"),
+	)
+	require.NoError(t, err)
+
+	name, err := transport.Name()
+	require.NoError(t, err)
+	assert.Equal(t, "K8s Helm Chart webapp", name)
+
+	res, err := transport.Resources("deployment", "prod-webapp", "default")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Resources))
+	containers, err := resources.GetContainers(res.Resources[0])
+	require.NoError(t, err)
+	require.Equal(t, 1, len(containers))
+	assert.Equal(t, "nginx:1.23", containers[0].Image)
+
+	// the subchart is disabled in the values file
+	res, err = transport.Resources("pod", "", "")
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Resources))
+}
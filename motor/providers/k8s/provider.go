@@ -5,6 +5,7 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	platform "go.mondoo.com/cnquery/motor/platform"
 	"go.mondoo.com/cnquery/motor/providers"
@@ -18,10 +19,12 @@ import (
 )
 
 const (
-	OPTION_MANIFEST    = "path"
-	OPTION_NAMESPACE   = "namespace"
-	OPTION_ADMISSION   = "k8s-admission-review"
-	OPTION_OBJECT_KIND = "object-kind"
+	OPTION_MANIFEST          = "path"
+	OPTION_NAMESPACE         = "namespace"
+	OPTION_ADMISSION         = "k8s-admission-review"
+	OPTION_OBJECT_KIND       = "object-kind"
+	OPTION_HELM_VALUES       = "helm-values"
+	OPTION_HELM_RELEASE_NAME = "helm-release-name"
 )
 
 var (
@@ -83,14 +86,24 @@ type ResourceResult struct {
 // New initializes the k8s provider and loads a configuration.
 // Supported options are:
 // - namespace: limits the resources to a specific namespace
-// - path: use a manifest file instead of live API
+// - path: use a manifest file instead of live API, a directory with a Chart.yaml is rendered as helm chart
+// - helm-values: comma-separated list of values files for the helm chart
+// - helm-release-name: release name for the helm chart
 func New(ctx context.Context, tc *providers.Config) (KubernetesProvider, error) {
 	if tc.Backend != providers.ProviderType_K8S {
 		return nil, providers.ErrProviderTypeDoesNotMatch
 	}
 
 	if manifestFile, manifestDefined := tc.Options[OPTION_MANIFEST]; manifestDefined {
-		return newManifestProvider(tc.PlatformId, tc.Options[OPTION_OBJECT_KIND], WithManifestFile(manifestFile), WithNamespace(tc.Options[OPTION_NAMESPACE]))
+		opts := []Option{
+			WithManifestFile(manifestFile),
+			WithNamespace(tc.Options[OPTION_NAMESPACE]),
+			WithHelmReleaseName(tc.Options[OPTION_HELM_RELEASE_NAME]),
+		}
+		if values := tc.Options[OPTION_HELM_VALUES]; values != "" {
+			opts = append(opts, WithHelmValues(strings.Split(values, ",")))
+		}
+		return newManifestProvider(tc.PlatformId, tc.Options[OPTION_OBJECT_KIND], opts...)
 	}
 
 	if data, admissionDefined := tc.Options[OPTION_ADMISSION]; admissionDefined {
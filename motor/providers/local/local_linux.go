@@ -0,0 +1,15 @@
+//go:build linux
+// +build linux
+
+package local
+
+import (
+	"go.mondoo.com/cnquery/motor/providers/os"
+	"go.mondoo.com/cnquery/motor/providers/os/fsutil"
+)
+
+var _ os.FileXattrs = (*Provider)(nil)
+
+func (p *Provider) Xattr(path string, name string) ([]byte, error) {
+	return fsutil.LocalXattr(path, name)
+}
@@ -44,9 +44,13 @@ func NewWithConfig(pCfg *providers.Config) (*Provider, error) {
 		// just check for the explicit positive case, otherwise just activate sudo
 		// we check sudo in VerifyConnection
 		if string(stdout) != "0" {
-			// configure sudo
-			log.Debug().Msg("activated sudo for local connection")
-			s = cmd.NewSudo()
+			// configure privilege escalation
+			var err error
+			s, err = cmd.NewEscalation(pCfg.Sudo)
+			if err != nil {
+				return nil, err
+			}
+			log.Debug().Str("method", pCfg.Sudo.Method).Msg("activated privilege escalation for local connection")
 		}
 	}
 	p.Sudo = s
@@ -64,10 +68,11 @@ type Provider struct {
 
 func (p *Provider) RunCommand(command string) (*os.Command, error) {
 	log.Debug().Msgf("local> run command %s", command)
+	c := &cmd.CommandRunner{Shell: p.shell}
 	if p.Sudo != nil {
 		command = p.Sudo.Build(command)
+		c.Stdin = cmd.PasswordInput(p.Sudo)
 	}
-	c := &cmd.CommandRunner{Shell: p.shell}
 	args := []string{}
 
 	res, err := c.Exec(command, args)
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"bytes"
+	"io"
 	"os/exec"
 	"strings"
 	"syscall"
@@ -14,6 +15,8 @@ type CommandRunner struct {
 	os.Command
 	cmdExecutor *exec.Cmd
 	Shell       []string
+	// Stdin is passed to the command, e.g. the password for sudo
+	Stdin io.Reader
 }
 
 func (c *CommandRunner) Exec(usercmd string, args []string) (*os.Command, error) {
@@ -43,6 +46,7 @@ func (c *CommandRunner) Exec(usercmd string, args []string) (*os.Command, error)
 	c.Command.Stdout = &stdoutBuffer
 	c.Command.Stderr = &stderrBuffer
 
+	c.cmdExecutor.Stdin = c.Stdin
 	c.cmdExecutor.Stdout = c.Command.Stdout
 	c.cmdExecutor.Stderr = c.Command.Stderr
 
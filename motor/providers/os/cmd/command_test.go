@@ -2,9 +2,11 @@ package cmd
 
 import (
 	"io/ioutil"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCommandResource(t *testing.T) {
@@ -21,3 +23,12 @@ func TestCommandResource(t *testing.T) {
 		assert.Equal(t, "", string(stderrContent), "stderr output should be correct")
 	}
 }
+
+func TestCommandStdin(t *testing.T) {
+	c := &CommandRunner{Shell: []string{"sh", "-c"}, Stdin: strings.NewReader("secret\n")}
+	cmd, err := c.Exec("read pw && echo $pw", []string{})
+	require.NoError(t, err)
+
+	stdoutContent, _ := ioutil.ReadAll(cmd.Stdout)
+	assert.Equal(t, "secret\n", string(stdoutContent))
+}
@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"go.mondoo.com/cnquery/motor/providers"
+)
+
+// privilege escalation methods that can be configured per connection
+const (
+	EscalationSudo   = "sudo"
+	EscalationDoas   = "doas"
+	EscalationSu     = "su"
+	EscalationPbrun  = "pbrun"
+	EscalationDzdo   = "dzdo"
+	EscalationCustom = "custom"
+)
+
+// NewEscalation returns the wrapper for the privilege escalation of the connection.
+// Sudo is used by default.
+func NewEscalation(cfg *providers.Sudo) (Wrapper, error) {
+	if cfg == nil {
+		return NewSudo(), nil
+	}
+
+	password := ""
+	if cfg.Credential != nil {
+		password = string(cfg.Credential.Secret)
+		if password == "" {
+			password = cfg.Credential.Password
+		}
+	}
+
+	method := strings.ToLower(cfg.Method)
+	if password != "" && method != "" && method != EscalationSudo && method != EscalationDzdo {
+		return nil, errors.New("privilege escalation via " + method + " does not support passwords, configure password-less escalation")
+	}
+
+	switch method {
+	case "", EscalationSudo, EscalationDzdo:
+		if method == "" {
+			method = EscalationSudo
+		}
+		return &Sudo{
+			user:       cfg.User,
+			executable: method,
+			shell:      cfg.Shell,
+			password:   password,
+		}, nil
+	case EscalationDoas, EscalationPbrun:
+		return &Prefix{
+			executable: method,
+			user:       cfg.User,
+			shell:      cfg.Shell,
+		}, nil
+	case EscalationSu:
+		return &Su{
+			user:  cfg.User,
+			shell: cfg.Shell,
+		}, nil
+	case EscalationCustom:
+		if !strings.Contains(cfg.Template, templateCmd) {
+			return nil, errors.New("custom privilege escalation requires a template with " + templateCmd)
+		}
+		return &Template{
+			template: cfg.Template,
+			user:     cfg.User,
+		}, nil
+	default:
+		return nil, errors.New("unsupported privilege escalation method: " + cfg.Method)
+	}
+}
+
+// Prefix runs the command with tools that are called like sudo without any
+// password support, e.g. doas or pbrun
+type Prefix struct {
+	executable string
+	user       string
+	shell      string
+}
+
+func (p *Prefix) Build(cmd string) string {
+	var sb strings.Builder
+	sb.WriteString(p.executable)
+
+	if len(p.user) > 0 {
+		sb.WriteString(" -u ")
+		sb.WriteString(p.user)
+	}
+
+	sb.WriteString(" ")
+	if len(p.shell) > 0 {
+		sb.WriteString(p.shell)
+		sb.WriteString(" -c ")
+		sb.WriteString(shellQuote(cmd))
+	} else {
+		sb.WriteString(cmd)
+	}
+	return sb.String()
+}
+
+// Su runs the command via su, which uses root if no user is set. It cannot
+// read a password without terminal, therefore it only works if su does not ask for one.
+type Su struct {
+	user  string
+	shell string
+}
+
+func (su *Su) Build(cmd string) string {
+	var sb strings.Builder
+	sb.WriteString("su")
+
+	if len(su.user) > 0 {
+		sb.WriteString(" ")
+		sb.WriteString(su.user)
+	}
+
+	if len(su.shell) > 0 {
+		sb.WriteString(" -s ")
+		sb.WriteString(su.shell)
+	}
+
+	sb.WriteString(" -c ")
+	sb.WriteString(shellQuote(cmd))
+	return sb.String()
+}
+
+const (
+	templateCmd  = "{{cmd}}"
+	templateUser = "{{user}}"
+)
+
+// Template runs the command via a user-provided template. {{cmd}} is replaced with
+// the quoted command and {{user}} with the quoted user.
+type Template struct {
+	template string
+	user     string
+}
+
+func (t *Template) Build(cmd string) string {
+	return strings.NewReplacer(
+		templateCmd, shellQuote(cmd),
+		templateUser, shellQuote(t.user),
+	).Replace(t.template)
+}
+
+// PasswordInput returns the stdin for commands wrapped by the wrapper, it is nil
+// if the wrapper does not need a password
+func PasswordInput(w Wrapper) io.Reader {
+	pw, ok := w.(PasswordWrapper)
+	if !ok || pw.Password() == "" {
+		return nil
+	}
+	return strings.NewReader(pw.Password() + "\n")
+}
+
+// shellQuote quotes the string so that the shell passes it as one argument
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'"'"'`) + "'"
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/motor/providers"
+	"go.mondoo.com/cnquery/motor/vault"
+)
+
+func TestEscalation(t *testing.T) {
+	tests := []struct {
+		cfg      *providers.Sudo
+		expected string
+	}{
+		{nil, "sudo cat /etc/shadow"},
+		{&providers.Sudo{Active: true}, "sudo cat /etc/shadow"},
+		{&providers.Sudo{User: "postgres", Shell: "bash"}, "sudo -u postgres bash -c 'cat /etc/shadow'"},
+		{&providers.Sudo{Method: "dzdo"}, "dzdo cat /etc/shadow"},
+		{&providers.Sudo{Method: "doas", User: "admin"}, "doas -u admin cat /etc/shadow"},
+		{&providers.Sudo{Method: "pbrun", Shell: "sh"}, "pbrun sh -c 'cat /etc/shadow'"},
+		{&providers.Sudo{Method: "su"}, "su -c 'cat /etc/shadow'"},
+		{&providers.Sudo{Method: "su", User: "postgres", Shell: "/bin/bash"}, "su postgres -s /bin/bash -c 'cat /etc/shadow'"},
+		{&providers.Sudo{Method: "custom", User: "root", Template: "ksu {{user}} -e /bin/sh -c {{cmd}}"}, "ksu 'root' -e /bin/sh -c 'cat /etc/shadow'"},
+	}
+
+	for i := range tests {
+		w, err := NewEscalation(tests[i].cfg)
+		require.NoError(t, err)
+		assert.Equal(t, tests[i].expected, w.Build("cat /etc/shadow"))
+		assert.Nil(t, PasswordInput(w))
+	}
+}
+
+func TestEscalationQuoting(t *testing.T) {
+	w, err := NewEscalation(&providers.Sudo{Method: "su"})
+	require.NoError(t, err)
+	assert.Equal(t, `su -c 'echo '"'"'hi'"'"''`, w.Build("echo 'hi'"))
+}
+
+func TestEscalationTemplateQuoting(t *testing.T) {
+	w, err := NewEscalation(&providers.Sudo{Method: "custom", User: "root; rm -rf /", Template: "ksu {{user}} -e /bin/sh -c {{cmd}}"})
+	require.NoError(t, err)
+	assert.Equal(t, `ksu 'root; rm -rf /' -e /bin/sh -c 'id'`, w.Build("id"))
+
+	w, err = NewEscalation(&providers.Sudo{Method: "custom", User: "o'brien", Template: "ksu {{user}} -c {{cmd}}"})
+	require.NoError(t, err)
+	assert.Equal(t, `ksu 'o'"'"'brien' -c 'id'`, w.Build("id"))
+}
+
+func TestEscalationPassword(t *testing.T) {
+	w, err := NewEscalation(&providers.Sudo{
+		User:       "root",
+		Credential: &vault.Credential{Type: vault.CredentialType_password, Secret: []byte("secret")},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "sudo -k -S -p '' -u root id", w.Build("id"))
+
+	stdin, err := io.ReadAll(PasswordInput(w))
+	require.NoError(t, err)
+	assert.Equal(t, "secret\n", string(stdin))
+
+	// the password is not part of the command
+	assert.NotContains(t, w.Build("id"), "secret")
+}
+
+func TestEscalationErrors(t *testing.T) {
+	_, err := NewEscalation(&providers.Sudo{Method: "runas"})
+	assert.EqualError(t, err, "unsupported privilege escalation method: runas")
+
+	_, err = NewEscalation(&providers.Sudo{Method: "custom", Template: "ksu -e"})
+	assert.EqualError(t, err, "custom privilege escalation requires a template with {{cmd}}")
+
+	_, err = NewEscalation(&providers.Sudo{Method: "doas", Credential: &vault.Credential{Password: "secret"}})
+	assert.EqualError(t, err, "privilege escalation via doas does not support passwords, configure password-less escalation")
+}
@@ -9,6 +9,13 @@ type Wrapper interface {
 	Build(cmd string) string
 }
 
+// PasswordWrapper is implemented by wrappers that read the password from stdin,
+// the caller needs to pass the password as stdin of the wrapped command
+type PasswordWrapper interface {
+	Wrapper
+	Password() string
+}
+
 func NewSudo() *Sudo {
 	return &Sudo{
 		user:       "",
@@ -21,6 +28,7 @@ type Sudo struct {
 	user       string
 	executable string
 	shell      string
+	password   string
 }
 
 func (sudo *Sudo) Build(cmd string) string {
@@ -28,13 +36,19 @@ func (sudo *Sudo) Build(cmd string) string {
 
 	sb.WriteString(sudo.executable)
 
+	if len(sudo.password) > 0 {
+		// read the password from stdin without prompt and ignore cached credentials,
+		// so that the password is always consumed by sudo and not by the command
+		sb.WriteString(" -k -S -p ''")
+	}
+
 	if len(sudo.user) > 0 {
 		user := fmt.Sprintf(" -u %s", sudo.user)
 		sb.WriteString(user)
 	}
 
 	if len(sudo.shell) > 0 {
-		cmd = fmt.Sprintf(" %s -c %s", sudo.shell, cmd)
+		cmd = fmt.Sprintf(" %s -c %s", sudo.shell, shellQuote(cmd))
 		sb.WriteString(cmd)
 	} else {
 		sb.WriteString(" ")
@@ -43,3 +57,7 @@ func (sudo *Sudo) Build(cmd string) string {
 
 	return sb.String()
 }
+
+func (sudo *Sudo) Password() string {
+	return sudo.password
+}
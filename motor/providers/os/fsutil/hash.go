@@ -2,13 +2,44 @@ package fsutil
 
 import (
 	"crypto/md5"
+	"crypto/sha1"
 	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
+	"hash"
 	"io"
 
 	"github.com/spf13/afero"
 )
 
+// supported hash algorithms for file content
+const (
+	HashMd5    = "md5"
+	HashSha1   = "sha1"
+	HashSha256 = "sha256"
+)
+
+// Hash streams the reader into the hash algorithm and returns the hex encoded
+// hashsum, the content is never fully loaded into memory
+func Hash(r io.Reader, algorithm string) (string, error) {
+	var h hash.Hash
+	switch algorithm {
+	case HashMd5:
+		h = md5.New()
+	case HashSha1:
+		h = sha1.New()
+	case HashSha256:
+		h = sha256.New()
+	default:
+		return "", fmt.Errorf("unsupported hash algorithm '%s'", algorithm)
+	}
+
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
 func Md5(f afero.File) (string, error) {
 	h := md5.New()
 	if _, err := io.Copy(h, f); err != nil {
@@ -18,6 +49,10 @@ func Md5(f afero.File) (string, error) {
 	return hex.EncodeToString(h.Sum(nil)), nil
 }
 
+func Sha1(f afero.File) (string, error) {
+	return Hash(f, HashSha1)
+}
+
 func Sha256(f afero.File) (string, error) {
 	h := sha256.New()
 	if _, err := io.Copy(h, f); err != nil {
@@ -1,10 +1,12 @@
 package fsutil_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.mondoo.com/cnquery/motor/providers/local"
 	"go.mondoo.com/cnquery/motor/providers/os/fsutil"
 )
@@ -36,3 +38,22 @@ func TestFileResource(t *testing.T) {
 		assert.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", sha256)
 	}
 }
+
+func TestHash(t *testing.T) {
+	tests := []struct {
+		algorithm string
+		expected  string
+	}{
+		{fsutil.HashMd5, "5eb63bbbe01eeed093cb22bb8f5acdc3"},
+		{fsutil.HashSha1, "2aae6c35c94fcfb415dbe95f408b9ce91ee846ed"},
+		{fsutil.HashSha256, "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"},
+	}
+	for i := range tests {
+		hash, err := fsutil.Hash(strings.NewReader("hello world"), tests[i].algorithm)
+		require.NoError(t, err)
+		assert.Equal(t, tests[i].expected, hash, tests[i].algorithm)
+	}
+
+	_, err := fsutil.Hash(strings.NewReader("hello world"), "crc32")
+	assert.EqualError(t, err, "unsupported hash algorithm 'crc32'")
+}
@@ -0,0 +1,34 @@
+//go:build linux
+// +build linux
+
+package fsutil
+
+import (
+	"errors"
+
+	"go.mondoo.com/cnquery/motor/providers/os"
+	"golang.org/x/sys/unix"
+)
+
+// LocalXattr reads the extended attribute of a local file, symlinks are not followed
+func LocalXattr(path string, name string) ([]byte, error) {
+	buf := make([]byte, 256)
+	for {
+		n, err := unix.Lgetxattr(path, name, buf)
+		switch {
+		case errors.Is(err, unix.ERANGE):
+			// the value does not fit into the buffer, query the required size
+			size, err := unix.Lgetxattr(path, name, nil)
+			if err != nil {
+				return nil, err
+			}
+			buf = make([]byte, size)
+			continue
+		case errors.Is(err, unix.ENODATA):
+			return nil, os.ErrXattrNotFound
+		case err != nil:
+			return nil, err
+		}
+		return buf[:n], nil
+	}
+}
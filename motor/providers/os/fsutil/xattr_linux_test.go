@@ -0,0 +1,38 @@
+//go:build linux
+// +build linux
+
+package fsutil_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	os_provider "go.mondoo.com/cnquery/motor/providers/os"
+	"go.mondoo.com/cnquery/motor/providers/os/fsutil"
+	"golang.org/x/sys/unix"
+)
+
+func TestLocalXattr(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(path, []byte("hello world"), 0o644))
+
+	// user attributes are not supported on all filesystems, e.g. tmpfs on older kernels
+	value := strings.Repeat("x", 1000)
+	err := unix.Lsetxattr(path, "user.test", []byte(value), 0)
+	if errors.Is(err, unix.ENOTSUP) {
+		t.Skip("filesystem does not support user xattrs")
+	}
+	require.NoError(t, err)
+
+	data, err := fsutil.LocalXattr(path, "user.test")
+	require.NoError(t, err)
+	assert.Equal(t, value, string(data))
+
+	_, err = fsutil.LocalXattr(path, "user.missing")
+	assert.Equal(t, os_provider.ErrXattrNotFound, err)
+}
@@ -0,0 +1,102 @@
+package hashutil
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	os_provider "go.mondoo.com/cnquery/motor/providers/os"
+	"go.mondoo.com/cnquery/motor/providers/os/fsutil"
+	"go.mondoo.com/cnquery/motor/providers/os/powershell"
+	"go.mondoo.com/cnquery/motor/providers/os/statutil"
+)
+
+type CommandRunner interface {
+	RunCommand(command string) (*os_provider.Command, error)
+}
+
+// unixCommands lists the hash commands for each algorithm, the coreutils
+// variant is tried first and perl's shasum is used on macOS
+var unixCommands = map[string][]string{
+	fsutil.HashMd5:    {"md5sum"},
+	fsutil.HashSha1:   {"sha1sum", "shasum -a 1"},
+	fsutil.HashSha256: {"sha256sum", "shasum -a 256"},
+}
+
+var windowsAlgorithms = map[string]string{
+	fsutil.HashMd5:    "MD5",
+	fsutil.HashSha1:   "SHA1",
+	fsutil.HashSha256: "SHA256",
+}
+
+var hashLength = map[string]int{
+	fsutil.HashMd5:    32,
+	fsutil.HashSha1:   40,
+	fsutil.HashSha256: 64,
+}
+
+var hexRegex = regexp.MustCompile(`^[0-9a-f]+$`)
+
+// UnixFileHash calculates the hashsum of a file on the target system with
+// the coreutils hash commands
+func UnixFileHash(cmdRunner CommandRunner, path string, algorithm string) (string, error) {
+	commands, ok := unixCommands[algorithm]
+	if !ok {
+		return "", fmt.Errorf("unsupported hash algorithm '%s'", algorithm)
+	}
+
+	calls := make([]string, len(commands))
+	for i := range commands {
+		// stderr is dropped so that a missing command does not end up in the output
+		calls[i] = commands[i] + " " + statutil.ShellEscape(path) + " 2>/dev/null"
+	}
+	// the fallbacks run in one shell, so that privilege escalation applies
+	// to all of them and its own errors are not dropped
+	command := "sh -c " + statutil.ShellEscape(strings.Join(calls, " || "))
+	return runHash(cmdRunner, command, algorithm)
+}
+
+// WindowsFileHash calculates the hashsum of a file on the target system with
+// Get-FileHash, which is available since PowerShell 4
+func WindowsFileHash(cmdRunner CommandRunner, path string, algorithm string) (string, error) {
+	psAlgorithm, ok := windowsAlgorithms[algorithm]
+	if !ok {
+		return "", fmt.Errorf("unsupported hash algorithm '%s'", algorithm)
+	}
+
+	script := fmt.Sprintf("(Get-FileHash -LiteralPath '%s' -Algorithm %s).Hash", strings.ReplaceAll(path, "'", "''"), psAlgorithm)
+	return runHash(cmdRunner, powershell.Wrap(script), algorithm)
+}
+
+func runHash(cmdRunner CommandRunner, command string, algorithm string) (string, error) {
+	cmd, err := cmdRunner.RunCommand(command)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := io.ReadAll(cmd.Stdout)
+	if err != nil {
+		return "", err
+	}
+	if cmd.ExitStatus != 0 {
+		stderr, _ := io.ReadAll(cmd.Stderr)
+		if msg := strings.TrimSpace(string(stderr)); msg != "" {
+			return "", fmt.Errorf("could not calculate %s hash, exit status %d: %s", algorithm, cmd.ExitStatus, msg)
+		}
+		return "", fmt.Errorf("could not calculate %s hash, exit status %d", algorithm, cmd.ExitStatus)
+	}
+
+	// the output is either "<hash>  <path>" or just the upper-case hash on windows
+	fields := strings.Fields(string(data))
+	if len(fields) == 0 {
+		return "", fmt.Errorf("could not calculate %s hash, command returned no output", algorithm)
+	}
+	hash := strings.ToLower(fields[0])
+	// a leading backslash marks escaped file names in coreutils
+	hash = strings.TrimPrefix(hash, "\\")
+	if len(hash) != hashLength[algorithm] || !hexRegex.MatchString(hash) {
+		return "", fmt.Errorf("could not parse %s hash: %s", algorithm, fields[0])
+	}
+	return hash, nil
+}
@@ -0,0 +1,73 @@
+package hashutil
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/motor/providers/mock"
+	os_provider "go.mondoo.com/cnquery/motor/providers/os"
+	"go.mondoo.com/cnquery/motor/providers/os/cmd"
+	"go.mondoo.com/cnquery/motor/providers/os/fsutil"
+)
+
+// sudoRunner wraps all commands like the ssh provider does with sudo
+type sudoRunner struct {
+	runner CommandRunner
+	sudo   cmd.Wrapper
+}
+
+func (r *sudoRunner) RunCommand(command string) (*os_provider.Command, error) {
+	return r.runner.RunCommand(r.sudo.Build(command))
+}
+
+func TestUnixFileHash(t *testing.T) {
+	filepath, _ := filepath.Abs("./testdata/hash.toml")
+	p, err := mock.NewFromTomlFile(filepath)
+	require.NoError(t, err)
+
+	hash, err := UnixFileHash(p, "/etc/ssh/sshd_config", fsutil.HashSha256)
+	require.NoError(t, err)
+	assert.Equal(t, "e0b0f7e6bd7b7a8e9c7fbb3b5f24f02b8f6b0e0f1ac2fd8b0e4fb2e63dfb1a12", hash)
+
+	// file names with special characters are escaped in the output
+	hash, err = UnixFileHash(p, "/tmp/file with spaces", fsutil.HashMd5)
+	require.NoError(t, err)
+	assert.Equal(t, "5eb63bbbe01eeed093cb22bb8f5acdc3", hash)
+
+	_, err = UnixFileHash(p, "/etc/missing", fsutil.HashSha1)
+	assert.EqualError(t, err, "could not calculate sha1 hash, exit status 1")
+
+	_, err = UnixFileHash(p, "/etc/ssh/sshd_config", "crc32")
+	assert.EqualError(t, err, "unsupported hash algorithm 'crc32'")
+}
+
+func TestUnixFileHash_Sudo(t *testing.T) {
+	filepath, _ := filepath.Abs("./testdata/hash.toml")
+	p, err := mock.NewFromTomlFile(filepath)
+	require.NoError(t, err)
+	runner := &sudoRunner{runner: p, sudo: cmd.NewSudo()}
+
+	// all fallbacks are elevated, not just the first command
+	hash, err := UnixFileHash(runner, "/etc/shadow", fsutil.HashSha256)
+	require.NoError(t, err)
+	assert.Equal(t, "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", hash)
+
+	// errors of sudo are not dropped
+	_, err = UnixFileHash(runner, "/etc/shadow", fsutil.HashSha1)
+	assert.EqualError(t, err, "could not calculate sha1 hash, exit status 1: sudo: a password is required")
+}
+
+func TestWindowsFileHash(t *testing.T) {
+	filepath, _ := filepath.Abs("./testdata/hash.toml")
+	p, err := mock.NewFromTomlFile(filepath)
+	require.NoError(t, err)
+
+	hash, err := WindowsFileHash(p, `C:\Users\o'neil\notes.txt`, fsutil.HashSha1)
+	require.NoError(t, err)
+	assert.Equal(t, "2aae6c35c94fcfb415dbe95f408b9ce91ee846ed", hash)
+
+	_, err = WindowsFileHash(p, `C:\Windows\notepad.exe`, fsutil.HashSha256)
+	assert.Error(t, err)
+}
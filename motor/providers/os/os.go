@@ -1,6 +1,7 @@
 package os
 
 import (
+	"errors"
 	"io"
 	"io/fs"
 	"os"
@@ -152,3 +153,19 @@ type FileInfoDetails struct {
 type FileSearch interface {
 	Find(from string, r *regexp.Regexp, typ string) ([]string, error)
 }
+
+// FileHasher is implemented by providers that can calculate the hashsum of a
+// file on the target system, which avoids the transfer of the file content
+type FileHasher interface {
+	FileHash(path string, algorithm string) (string, error)
+}
+
+// ErrXattrNotFound is returned if the file has no extended attribute with the name
+var ErrXattrNotFound = errors.New("extended attribute not found")
+
+// FileXattrs is implemented by providers that can read extended attributes of
+// files, e.g. security.selinux with the SELinux file context
+type FileXattrs interface {
+	// Xattr returns the value of the extended attribute, symlinks are not followed
+	Xattr(path string, name string) ([]byte, error)
+}
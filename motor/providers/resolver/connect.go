@@ -15,7 +15,9 @@ func EstablishConnection(ctx context.Context, tc *providers.Config, credentialFn
 	log.Debug().Str("connection", tc.ToUrl()).Bool("insecure", insecure).Msg("establish connection to asset")
 	// overwrite connection specific insecure with global insecure
 	if insecure {
-		tc.Insecure = insecure
+		for cfg := tc; cfg != nil; cfg = cfg.Bastion {
+			cfg.Insecure = insecure
+		}
 	}
 
 	if record {
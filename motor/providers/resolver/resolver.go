@@ -11,7 +11,10 @@ import (
 	aws_provider "go.mondoo.com/cnquery/motor/providers/aws"
 	"go.mondoo.com/cnquery/motor/providers/awsec2ebs"
 	"go.mondoo.com/cnquery/motor/providers/azure"
+	"go.mondoo.com/cnquery/motor/providers/cloudformation"
 	"go.mondoo.com/cnquery/motor/providers/container"
+	"go.mondoo.com/cnquery/motor/providers/diskimage"
+	"go.mondoo.com/cnquery/motor/providers/dockerfile"
 	"go.mondoo.com/cnquery/motor/providers/equinix"
 	"go.mondoo.com/cnquery/motor/providers/fs"
 	"go.mondoo.com/cnquery/motor/providers/gcp"
@@ -55,20 +58,12 @@ func NewMotorConnection(ctx context.Context, tc *providers.Config, credentialFn
 	// we clone the config here, and replace all credential references with the real references
 	// the clone is important so that credentials are not leaked outside of the function
 	resolvedConfig := proto.Clone(tc).(*providers.Config)
-	resolvedCredentials := []*vault.Credential{}
-	for i := range resolvedConfig.Credentials {
-		credential := resolvedConfig.Credentials[i]
-		if credential.SecretId != "" && credentialFn != nil {
-			resolvedCredential, err := credentialFn(credential)
-			if err != nil {
-				log.Debug().Str("secret-id", credential.SecretId).Err(err).Msg("could not fetch secret for motor connection")
-				return nil, err
-			}
-			credential = resolvedCredential
+	// jump hosts have their own credentials
+	for cfg := resolvedConfig; cfg != nil; cfg = cfg.Bastion {
+		if err := resolveCredentials(cfg, credentialFn); err != nil {
+			return nil, err
 		}
-		resolvedCredentials = append(resolvedCredentials, credential)
 	}
-	resolvedConfig.Credentials = resolvedCredentials
 
 	// establish connection
 	switch resolvedConfig.Backend {
@@ -101,6 +96,17 @@ func NewMotorConnection(ctx context.Context, tc *providers.Config, credentialFn
 			return nil, err
 		}
 
+		m, err = motor.New(p, motor.WithRecoding(resolvedConfig.Record))
+		if err != nil {
+			return nil, err
+		}
+	case providers.ProviderType_DISK_IMAGE:
+		log.Debug().Msg("connection> load disk image provider")
+		p, err := diskimage.New(resolvedConfig)
+		if err != nil {
+			return nil, err
+		}
+
 		m, err = motor.New(p, motor.WithRecoding(resolvedConfig.Record))
 		if err != nil {
 			return nil, err
@@ -302,6 +308,24 @@ func NewMotorConnection(ctx context.Context, tc *providers.Config, credentialFn
 		if err != nil {
 			return nil, err
 		}
+	case providers.ProviderType_DOCKERFILE:
+		p, err := dockerfile.New(tc)
+		if err != nil {
+			return nil, err
+		}
+		m, err = motor.New(p)
+		if err != nil {
+			return nil, err
+		}
+	case providers.ProviderType_CLOUDFORMATION:
+		p, err := cloudformation.New(tc)
+		if err != nil {
+			return nil, err
+		}
+		m, err = motor.New(p)
+		if err != nil {
+			return nil, err
+		}
 	case providers.ProviderType_HOST:
 		p, err := network.New(tc)
 		if err != nil {
@@ -317,3 +341,32 @@ func NewMotorConnection(ctx context.Context, tc *providers.Config, credentialFn
 
 	return m, nil
 }
+
+// resolveCredentials replaces all credential references of the config with the credentials from vault
+func resolveCredentials(cfg *providers.Config, credentialFn func(cred *vault.Credential) (*vault.Credential, error)) error {
+	resolvedCredentials := []*vault.Credential{}
+	for i := range cfg.Credentials {
+		credential := cfg.Credentials[i]
+		if credential.SecretId != "" && credentialFn != nil {
+			resolvedCredential, err := credentialFn(credential)
+			if err != nil {
+				log.Debug().Str("secret-id", credential.SecretId).Err(err).Msg("could not fetch secret for motor connection")
+				return err
+			}
+			credential = resolvedCredential
+		}
+		resolvedCredentials = append(resolvedCredentials, credential)
+	}
+	cfg.Credentials = resolvedCredentials
+
+	// the privilege escalation may use its own password
+	if cfg.Sudo != nil && cfg.Sudo.Credential != nil && cfg.Sudo.Credential.SecretId != "" && credentialFn != nil {
+		credential, err := credentialFn(cfg.Sudo.Credential)
+		if err != nil {
+			log.Debug().Str("secret-id", cfg.Sudo.Credential.SecretId).Err(err).Msg("could not fetch sudo secret for motor connection")
+			return err
+		}
+		cfg.Sudo.Credential = credential
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package ssh_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/motor/providers"
+	"go.mondoo.com/cnquery/motor/providers/ssh"
+	cryptossh "golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+func TestBastion(t *testing.T) {
+	ca := newSigner(t)
+	target := newTestServer(t, newHostCertSigner(t, ca))
+	bastion := newTestServer(t, newHostCertSigner(t, ca))
+	jump := newTestServer(t, newHostCertSigner(t, ca))
+	withHome(t, ca, target, bastion, jump)
+
+	cfg := target.config(false)
+	cfg.Bastion = bastion.config(false)
+	cfg.Bastion.Bastion = jump.config(false)
+
+	p, err := ssh.New(cfg)
+	require.NoError(t, err)
+
+	cmd, err := p.RunCommand("uname -s")
+	require.NoError(t, err)
+	assert.Equal(t, 0, cmd.ExitStatus)
+	p.Close()
+
+	// the jump host is the first hop and reaches the bastion, which reaches the target
+	assert.Equal(t, []string{bastion.addr()}, jump.forwarded)
+	assert.Equal(t, []string{target.addr()}, bastion.forwarded)
+	assert.Empty(t, bastion.commands)
+	assert.Contains(t, target.commands, "uname -s")
+}
+
+func TestBastionHostKeyVerification(t *testing.T) {
+	ca := newSigner(t)
+	// the host certificate of the bastion is not signed by a trusted ca
+	target := newTestServer(t, newHostCertSigner(t, ca))
+	bastion := newTestServer(t, newHostCertSigner(t, newSigner(t)))
+	withHome(t, ca, target, bastion)
+
+	t.Run("untrusted bastion", func(t *testing.T) {
+		cfg := target.config(false)
+		cfg.Bastion = bastion.config(false)
+		_, err := ssh.New(cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "could not connect to bastion")
+		assert.Empty(t, bastion.forwarded)
+	})
+
+	t.Run("insecure bastion", func(t *testing.T) {
+		cfg := target.config(false)
+		cfg.Bastion = bastion.config(true)
+		p, err := ssh.New(cfg)
+		require.NoError(t, err)
+		p.Close()
+		assert.Equal(t, []string{target.addr()}, bastion.forwarded)
+	})
+}
+
+func TestBastionDefaultIdentities(t *testing.T) {
+	ca := newSigner(t)
+	target := newTestServer(t, newHostCertSigner(t, ca))
+	bastion := newTestServer(t, newHostCertSigner(t, ca))
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signer, err := cryptossh.NewSignerFromKey(key)
+	require.NoError(t, err)
+	// the bastion only accepts the key, the user is passed via proxy jump like in the ssh config
+	bastion.requireKey(signer.PublicKey())
+
+	t.Run("default identity file", func(t *testing.T) {
+		withHome(t, ca, target, bastion)
+		t.Setenv("SSH_AUTH_SOCK", "")
+		home, err := os.UserHomeDir()
+		require.NoError(t, err)
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		require.NoError(t, err)
+		keyFile := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+		require.NoError(t, os.WriteFile(filepath.Join(home, ".ssh", "id_ed25519"), keyFile, 0o600))
+
+		cfg := target.config(false)
+		cfg.Bastion, err = ssh.ParseProxyJump("test@" + bastion.addr())
+		require.NoError(t, err)
+		p, err := ssh.New(cfg)
+		require.NoError(t, err)
+		p.Close()
+		assert.Contains(t, bastion.forwarded, target.addr())
+	})
+
+	t.Run("ssh agent", func(t *testing.T) {
+		withHome(t, ca, target, bastion)
+		keyring := agent.NewKeyring()
+		require.NoError(t, keyring.Add(agent.AddedKey{PrivateKey: key}))
+		socket := filepath.Join(t.TempDir(), "agent.sock")
+		listener, err := net.Listen("unix", socket)
+		require.NoError(t, err)
+		t.Cleanup(func() { listener.Close() })
+		go func() {
+			for {
+				conn, err := listener.Accept()
+				if err != nil {
+					return
+				}
+				go agent.ServeAgent(keyring, conn)
+			}
+		}()
+		t.Setenv("SSH_AUTH_SOCK", socket)
+
+		cfg := target.config(false)
+		cfg.Bastion, err = ssh.ParseProxyJump("test@" + bastion.addr())
+		require.NoError(t, err)
+		p, err := ssh.New(cfg)
+		require.NoError(t, err)
+		p.Close()
+		assert.Contains(t, bastion.forwarded, target.addr())
+	})
+}
+
+func TestParseProxyJump(t *testing.T) {
+	cfg, err := ssh.ParseProxyJump("none")
+	require.NoError(t, err)
+	assert.Nil(t, cfg)
+
+	cfg, err = ssh.ParseProxyJump("admin@bastion.example.com:2222, internal-bastion")
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	// the last jump host is connected to the target
+	assert.Equal(t, "internal-bastion", cfg.Host)
+	assert.Equal(t, int32(0), cfg.Port)
+	assert.Empty(t, cfg.Credentials)
+
+	require.NotNil(t, cfg.Bastion)
+	assert.Equal(t, providers.ProviderType_SSH, cfg.Bastion.Backend)
+	assert.Equal(t, "bastion.example.com", cfg.Bastion.Host)
+	assert.Equal(t, int32(2222), cfg.Bastion.Port)
+	require.Len(t, cfg.Bastion.Credentials, 1)
+	assert.Equal(t, "admin", cfg.Bastion.Credentials[0].User)
+	assert.Nil(t, cfg.Bastion.Bastion)
+
+	cfg, err = ssh.ParseProxyJump("ssh://[::1]:22")
+	require.NoError(t, err)
+	assert.Equal(t, "::1", cfg.Host)
+	assert.Equal(t, int32(22), cfg.Port)
+
+	_, err = ssh.ParseProxyJump("bastion:port")
+	assert.Error(t, err)
+}
@@ -3,6 +3,7 @@ package ssh
 import (
 	"bytes"
 	"errors"
+	"io"
 	"time"
 
 	"github.com/rs/zerolog/log"
@@ -13,6 +14,8 @@ import (
 type Command struct {
 	os.Command
 	SSHProvider *Provider
+	// Stdin is passed to the command, e.g. the password for sudo
+	Stdin io.Reader
 }
 
 func (c *Command) Exec(command string) (*os.Command, error) {
@@ -45,6 +48,7 @@ func (c *Command) Exec(command string) (*os.Command, error) {
 	defer session.Close()
 
 	// start ssh call
+	session.Stdin = c.Stdin
 	session.Stdout = stdoutBuffer
 	session.Stderr = stderrBuffer
 	err = session.Run(c.Command.Command)
@@ -1,6 +1,8 @@
 package ssh
 
 import (
+	"errors"
+	"net"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -80,13 +82,92 @@ func ReadSSHConfig(cc *providers.Config) *providers.Config {
 	if err == nil && strings.ToLower(entry) == "no" {
 		cc.Insecure = true
 	}
+
+	// jump hosts configured in the inventory take precedence
+	if cc.Bastion == nil {
+		entry, err = cfg.Get(host, "ProxyJump")
+		if err == nil {
+			bastion, err := ParseProxyJump(entry)
+			if err != nil {
+				log.Debug().Err(err).Str("file", sshUserConfigPath).Str("proxyjump", entry).Msg("could not parse ssh proxy jump")
+			} else if bastion != nil && bastion.Host != host {
+				cc.Bastion = bastion
+			}
+		}
+	}
 	return cc
 }
 
+// ParseProxyJump parses the ssh_config ProxyJump value, e.g. user@bastion:2222,internal-bastion.
+// Jump hosts are connected in the given order, therefore the last one becomes the bastion of the
+// target and every jump host uses its predecessor as bastion.
+func ParseProxyJump(value string) (*providers.Config, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || strings.ToLower(value) == "none" {
+		return nil, nil
+	}
+
+	var bastion *providers.Config
+	for _, hop := range strings.Split(value, ",") {
+		hop = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(hop), "ssh://"))
+		if hop == "" {
+			return nil, errors.New("empty jump host in proxy jump " + value)
+		}
+
+		cfg := &providers.Config{
+			Backend: providers.ProviderType_SSH,
+			Bastion: bastion,
+		}
+
+		if i := strings.LastIndex(hop, "@"); i >= 0 {
+			// the user is passed without password, the default identities and the ssh agent
+			// are added when the connection is established
+			cfg.AddCredential(&vault.Credential{
+				Type: vault.CredentialType_password,
+				User: hop[:i],
+			})
+			hop = hop[i+1:]
+		}
+
+		host, port, err := net.SplitHostPort(hop)
+		if err != nil {
+			// no port was provided
+			host = strings.Trim(hop, "[]")
+		} else {
+			portNum, err := strconv.Atoi(port)
+			if err != nil {
+				return nil, errors.New("invalid port in jump host " + hop)
+			}
+			cfg.Port = int32(portNum)
+		}
+		if host == "" {
+			return nil, errors.New("missing host in jump host " + hop)
+		}
+		cfg.Host = host
+
+		bastion = cfg
+	}
+	return bastion, nil
+}
+
+// maxBastionHops limits the jump hosts of a connection, e.g. to detect loops in the ssh config
+const maxBastionHops = 10
+
 func VerifyConfig(pCfg *providers.Config) error {
 	if pCfg.Backend != providers.ProviderType_SSH {
 		return providers.ErrProviderTypeDoesNotMatch
 	}
 
+	hops := 0
+	for bastion := pCfg.Bastion; bastion != nil; bastion = bastion.Bastion {
+		hops++
+		if hops > maxBastionHops {
+			return errors.New("too many jump hosts, check the bastion configuration for loops")
+		}
+		if bastion.Host == "" {
+			return errors.New("bastion host is not defined")
+		}
+	}
+
 	return nil
 }
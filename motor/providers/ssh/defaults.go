@@ -51,6 +51,34 @@ func ApplyDefaults(cc *providers.Config, username string, identityFile string, p
 	return nil
 }
 
+// applyBastionDefaults applies the ssh defaults to a jump host. Jump hosts are mostly
+// configured with a user only, e.g. via ProxyJump, so they authenticate with the default
+// identities and the ssh agent like the ssh command does.
+func applyBastionDefaults(cc *providers.Config) {
+	if cc.Port == 0 {
+		cc.Port = 22
+	}
+
+	username := ""
+	for i := range cc.Credentials {
+		if cc.Credentials[i].User != "" {
+			username = cc.Credentials[i].User
+			break
+		}
+	}
+	// fallback to current user if no username was provided
+	if username == "" {
+		usr, err := user.Current()
+		if err != nil {
+			log.Warn().Err(err).Str("bastion", cc.Host).Msg("could not fallback do current user")
+		} else {
+			username = usr.Username
+		}
+	}
+
+	ApplyDefaultIdentities(cc, username, "")
+}
+
 // ApplyDefaultIdentities loads user's ssh identifies from ~/.ssh/
 func ApplyDefaultIdentities(cc *providers.Config, username string, password string) *providers.Config {
 	// ssh config overwrite like: IdentityFile ~/.foo/identity is done in ReadSSHConfig()
@@ -2,7 +2,6 @@ package ssh
 
 import (
 	"io"
-	"net"
 	"os"
 	"strings"
 
@@ -13,6 +12,7 @@ import (
 	"go.mondoo.com/cnquery/motor/providers"
 	os_provider "go.mondoo.com/cnquery/motor/providers/os"
 	"go.mondoo.com/cnquery/motor/providers/os/cmd"
+	"go.mondoo.com/cnquery/motor/providers/os/hashutil"
 	"go.mondoo.com/cnquery/motor/providers/ssh/cat"
 	"go.mondoo.com/cnquery/motor/providers/ssh/scp"
 	"go.mondoo.com/cnquery/motor/providers/ssh/sftp"
@@ -23,10 +23,16 @@ var (
 	_ providers.Instance                  = (*Provider)(nil)
 	_ providers.PlatformIdentifier        = (*Provider)(nil)
 	_ os_provider.OperatingSystemProvider = (*Provider)(nil)
+	_ os_provider.FileHasher              = (*Provider)(nil)
 )
 
 func New(pCfg *providers.Config) (*Provider, error) {
 	pCfg = ReadSSHConfig(pCfg)
+	// jump hosts may have their own ssh config, loops are detected by VerifyConfig
+	for i, bastion := 0, pCfg.Bastion; bastion != nil && i <= maxBastionHops; i, bastion = i+1, bastion.Bastion {
+		ReadSSHConfig(bastion)
+		applyBastionDefaults(bastion)
+	}
 
 	// ensure all required configs are set
 	err := VerifyConfig(pCfg)
@@ -63,9 +69,13 @@ func New(pCfg *providers.Config) (*Provider, error) {
 		// just check for the explicit positive case, otherwise just activate sudo
 		// we check sudo in VerifyConnection
 		if string(stdout) != "0" {
-			// configure sudo
-			log.Debug().Msg("activated sudo for ssh connection")
-			s = cmd.NewSudo()
+			// configure privilege escalation
+			s, err = cmd.NewEscalation(pCfg.Sudo)
+			if err != nil {
+				t.Close()
+				return nil, err
+			}
+			log.Debug().Str("method", pCfg.Sudo.Method).Msg("activated privilege escalation for ssh connection")
 		}
 	}
 	t.Sudo = s
@@ -91,6 +101,8 @@ type Provider struct {
 	kind             providers.Kind
 	runtime          string
 	serverVersion    string
+	// closer holds everything the connection depends on, e.g. bastion connections
+	closer []io.Closer
 }
 
 func (p *Provider) Connect() error {
@@ -108,43 +120,19 @@ func (p *Provider) Connect() error {
 	}
 
 	var hostkey ssh.PublicKey
-	hostkeyCallback := func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+	hostkeyCallback := verifyHostKey(cc.Insecure, knownHostsCallback, func(key ssh.PublicKey) {
 		// store the hostkey for later identification
 		hostkey = key
-
-		// ignore hostkey check if the user provided an insecure flag
-		if cc.Insecure {
-			return nil
-		}
-
-		// knownhost.New returns a ssh.CertChecker which does not work with all ssh.HostKey types
-		// especially the newer edcsa keys (ssh.curve25519sha256) are not well supported.
-		// https://github.com/golang/crypto/blob/master/ssh/knownhosts/knownhosts.go#L417-L436
-		// creates the CertChecker which requires an instance of Certificate
-		// https://github.com/golang/crypto/blob/master/ssh/certs.go#L326-L348
-		// https://github.com/golang/crypto/blob/master/ssh/keys.go#L271-L283
-		// therefore it is best to skip the checking for now since it forces users to set the insecure flag otherwise
-		// TODO: implement custom host-key checking for normal public keys as well
-		_, ok := key.(*ssh.Certificate)
-		if !ok {
-			log.Debug().Msg("skip hostkey check the hostkey since the algo is not supported yet")
-			return nil
-		}
-
-		err := knownHostsCallback(hostname, remote, key)
-		if err != nil {
-			log.Debug().Err(err).Str("hostname", hostname).Str("ip", remote.String()).Msg("check known host")
-		}
-		return err
-	}
+	})
 
 	// establish connection
-	conn, _, err := establishClientConnection(cc, hostkeyCallback)
+	conn, closer, err := establishClientConnection(cc, hostkeyCallback, knownHostsCallback)
 	if err != nil {
 		log.Debug().Err(err).Str("provider", "ssh").Str("host", cc.Host).Int32("port", cc.Port).Bool("insecure", cc.Insecure).Msg("could not establish ssh session")
 		return err
 	}
 	p.SSHClient = conn
+	p.closer = closer
 	p.HostKey = hostkey
 	p.serverVersion = string(conn.ServerVersion())
 	log.Debug().Str("provider", "ssh").Str("host", cc.Host).Int32("port", cc.Port).Str("server", p.serverVersion).Msg("ssh session established")
@@ -157,10 +145,18 @@ func (p *Provider) VerifyConnection() error {
 
 	if p.Sudo != nil {
 		// Wrap sudo command, to see proper error messages. We set /dev/null to disable stdin
-		command := "sh -c '" + p.Sudo.Build("echo 'hi'") + " < /dev/null'"
-		out, err = p.runRawCommand(command)
+		// unless the password is passed via stdin
+		command := p.Sudo.Build("echo 'hi'")
+		stdin := cmd.PasswordInput(p.Sudo)
+		if stdin == nil {
+			command += " < /dev/null"
+		}
+		out, err = p.runRawCommand(command, stdin)
+		if err != nil {
+			return err
+		}
 	} else {
-		out, err = p.runRawCommand("echo 'hi'")
+		out, err = p.runRawCommand("echo 'hi'", nil)
 		if err != nil {
 			return err
 		}
@@ -180,7 +176,9 @@ func (p *Provider) VerifyConnection() error {
 	case strings.Contains(errMsg, "not found"):
 		return errors.New("sudo command is missing on target")
 	case strings.Contains(errMsg, "a password is required"):
-		return errors.New("could not establish connection: sudo password is not supported yet, configure password-less sudo")
+		return errors.New("could not establish connection: sudo requires a password, configure the sudo credential or password-less sudo")
+	case strings.Contains(errMsg, "incorrect password"):
+		return errors.New("could not establish connection: sudo password is incorrect")
 	default:
 		return errors.New("could not establish connection: " + errMsg)
 	}
@@ -192,17 +190,19 @@ func (p *Provider) Reconnect() error {
 	return p.Connect()
 }
 
-func (p *Provider) runRawCommand(command string) (*os_provider.Command, error) {
+func (p *Provider) runRawCommand(command string, stdin io.Reader) (*os_provider.Command, error) {
 	log.Debug().Str("command", command).Str("provider", "ssh").Msg("run command")
-	c := &Command{SSHProvider: p}
+	c := &Command{SSHProvider: p, Stdin: stdin}
 	return c.Exec(command)
 }
 
 func (p *Provider) RunCommand(command string) (*os_provider.Command, error) {
+	var stdin io.Reader
 	if p.Sudo != nil {
 		command = p.Sudo.Build(command)
+		stdin = cmd.PasswordInput(p.Sudo)
 	}
-	return p.runRawCommand(command)
+	return p.runRawCommand(command, stdin)
 }
 
 func (p *Provider) FS() afero.Fs {
@@ -286,10 +286,18 @@ func (p *Provider) FileInfo(path string) (os_provider.FileInfoDetails, error) {
 	}, nil
 }
 
+// FileHash calculates the hashsum on the target system, so that the file does
+// not need to be transferred
+func (p *Provider) FileHash(path string, algorithm string) (string, error) {
+	return hashutil.UnixFileHash(p, path, algorithm)
+}
+
 func (p *Provider) Close() {
 	if p.SSHClient != nil {
 		p.SSHClient.Close()
 	}
+	closeAll(p.closer)
+	p.closer = nil
 }
 
 func (p *Provider) Capabilities() providers.Capabilities {
@@ -4,8 +4,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.mondoo.com/cnquery/motor/providers"
 	"go.mondoo.com/cnquery/motor/providers/ssh"
+	"go.mondoo.com/cnquery/motor/vault"
 )
 
 func TestSSHProviderError(t *testing.T) {
@@ -22,3 +24,60 @@ func TestSSHAuthError(t *testing.T) {
 			// local testing without ssh agent
 			err.Error() == "no authentication method defined")
 }
+
+func TestSSHSudoPassword(t *testing.T) {
+	ca := newSigner(t)
+	target := newTestServer(t, newHostCertSigner(t, ca))
+	withHome(t, ca, target)
+
+	cfg := target.config(false)
+	cfg.Sudo = &providers.Sudo{
+		Active:     true,
+		Credential: &vault.Credential{Type: vault.CredentialType_password, Secret: []byte("sudo-secret")},
+	}
+	p, err := ssh.New(cfg)
+	require.NoError(t, err)
+	defer p.Close()
+
+	_, err = p.RunCommand("cat /etc/shadow")
+	require.NoError(t, err)
+
+	// the password is only passed via stdin, never as part of the command
+	target.lock.Lock()
+	defer target.lock.Unlock()
+	require.Equal(t, "sudo -k -S -p '' cat /etc/shadow", target.commands[len(target.commands)-1])
+	assert.Equal(t, "sudo-secret\n", target.stdin[len(target.stdin)-1])
+	for i := range target.commands {
+		assert.NotContains(t, target.commands[i], "sudo-secret")
+	}
+}
+
+func TestSSHEscalationMethod(t *testing.T) {
+	ca := newSigner(t)
+	target := newTestServer(t, newHostCertSigner(t, ca))
+	withHome(t, ca, target)
+
+	cfg := target.config(false)
+	cfg.Sudo = &providers.Sudo{Active: true, Method: "doas"}
+	p, err := ssh.New(cfg)
+	require.NoError(t, err)
+	defer p.Close()
+
+	// root-only files are read via the elevated shell, the test server cannot answer the stat call
+	_, err = p.FS().Open("/etc/shadow")
+	require.Error(t, err)
+
+	target.lock.Lock()
+	assert.Contains(t, target.commands, "doas test -e /etc/shadow")
+	assert.Empty(t, target.stdin[len(target.stdin)-1])
+	target.lock.Unlock()
+
+	cfg = target.config(false)
+	cfg.Sudo = &providers.Sudo{
+		Active:     true,
+		Method:     "su",
+		Credential: &vault.Credential{Type: vault.CredentialType_password, Secret: []byte("secret")},
+	}
+	_, err = ssh.New(cfg)
+	assert.Error(t, err)
+}
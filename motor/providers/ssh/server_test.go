@@ -0,0 +1,230 @@
+package ssh_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	homedir "github.com/mitchellh/go-homedir"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/motor/providers"
+	"go.mondoo.com/cnquery/motor/vault"
+	cryptossh "golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// testServer is an in-process ssh server that runs every command successfully
+// and forwards direct-tcpip channels, so that it can act as bastion as well
+type testServer struct {
+	host     string
+	port     int32
+	listener net.Listener
+
+	lock          sync.Mutex
+	authorizedKey cryptossh.PublicKey
+	forwarded     []string
+	commands      []string
+	stdin         []string
+}
+
+func newTestServer(t *testing.T, hostKey cryptossh.Signer) *testServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	addr := listener.Addr().(*net.TCPAddr)
+	s := &testServer{
+		host:     addr.IP.String(),
+		port:     int32(addr.Port),
+		listener: listener,
+	}
+
+	config := &cryptossh.ServerConfig{
+		PasswordCallback: func(conn cryptossh.ConnMetadata, password []byte) (*cryptossh.Permissions, error) {
+			if s.requiredKey() == nil && conn.User() == "test" && string(password) == "secret" {
+				return nil, nil
+			}
+			return nil, io.EOF
+		},
+		PublicKeyCallback: func(conn cryptossh.ConnMetadata, key cryptossh.PublicKey) (*cryptossh.Permissions, error) {
+			authorized := s.requiredKey()
+			if authorized != nil && conn.User() == "test" && bytes.Equal(key.Marshal(), authorized.Marshal()) {
+				return nil, nil
+			}
+			return nil, io.EOF
+		},
+	}
+	config.AddHostKey(hostKey)
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn, config)
+		}
+	}()
+	return s
+}
+
+// requireKey disables password authentication, only the key is accepted for the user test
+func (s *testServer) requireKey(key cryptossh.PublicKey) {
+	s.lock.Lock()
+	s.authorizedKey = key
+	s.lock.Unlock()
+}
+
+func (s *testServer) requiredKey() cryptossh.PublicKey {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.authorizedKey
+}
+
+func (s *testServer) serve(conn net.Conn, config *cryptossh.ServerConfig) {
+	_, chans, reqs, err := cryptossh.NewServerConn(conn, config)
+	if err != nil {
+		conn.Close()
+		return
+	}
+	go cryptossh.DiscardRequests(reqs)
+
+	for newChannel := range chans {
+		switch newChannel.ChannelType() {
+		case "session":
+			go s.session(newChannel)
+		case "direct-tcpip":
+			go s.forward(newChannel)
+		default:
+			newChannel.Reject(cryptossh.UnknownChannelType, "unsupported channel type")
+		}
+	}
+}
+
+func (s *testServer) session(newChannel cryptossh.NewChannel) {
+	channel, requests, err := newChannel.Accept()
+	if err != nil {
+		return
+	}
+	defer channel.Close()
+
+	for req := range requests {
+		if req.Type != "exec" {
+			req.Reply(false, nil)
+			continue
+		}
+		var payload struct{ Command string }
+		cryptossh.Unmarshal(req.Payload, &payload)
+		req.Reply(true, nil)
+		stdin, _ := io.ReadAll(channel)
+		s.lock.Lock()
+		s.commands = append(s.commands, payload.Command)
+		s.stdin = append(s.stdin, string(stdin))
+		s.lock.Unlock()
+
+		channel.Write([]byte("hi\n"))
+		channel.SendRequest("exit-status", false, cryptossh.Marshal(struct{ Status uint32 }{0}))
+		return
+	}
+}
+
+func (s *testServer) forward(newChannel cryptossh.NewChannel) {
+	var payload struct {
+		Host     string
+		Port     uint32
+		OrigHost string
+		OrigPort uint32
+	}
+	if err := cryptossh.Unmarshal(newChannel.ExtraData(), &payload); err != nil {
+		newChannel.Reject(cryptossh.ConnectionFailed, err.Error())
+		return
+	}
+	addr := net.JoinHostPort(payload.Host, strconv.Itoa(int(payload.Port)))
+	target, err := net.Dial("tcp", addr)
+	if err != nil {
+		newChannel.Reject(cryptossh.ConnectionFailed, err.Error())
+		return
+	}
+	s.lock.Lock()
+	s.forwarded = append(s.forwarded, addr)
+	s.lock.Unlock()
+
+	channel, requests, err := newChannel.Accept()
+	if err != nil {
+		target.Close()
+		return
+	}
+	go cryptossh.DiscardRequests(requests)
+
+	go func() {
+		io.Copy(channel, target)
+		channel.CloseWrite()
+	}()
+	io.Copy(target, channel)
+	target.Close()
+	channel.Close()
+}
+
+func (s *testServer) addr() string {
+	return net.JoinHostPort(s.host, strconv.Itoa(int(s.port)))
+}
+
+func (s *testServer) config(insecure bool) *providers.Config {
+	return &providers.Config{
+		Backend:  providers.ProviderType_SSH,
+		Host:     s.host,
+		Port:     s.port,
+		Insecure: insecure,
+		Credentials: []*vault.Credential{{
+			Type:   vault.CredentialType_password,
+			User:   "test",
+			Secret: []byte("secret"),
+		}},
+	}
+}
+
+func newSigner(t *testing.T) cryptossh.Signer {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signer, err := cryptossh.NewSignerFromKey(key)
+	require.NoError(t, err)
+	return signer
+}
+
+// newHostCertSigner creates a host key with a certificate signed by the ca
+func newHostCertSigner(t *testing.T, ca cryptossh.Signer) cryptossh.Signer {
+	key := newSigner(t)
+	cert := &cryptossh.Certificate{
+		Key:         key.PublicKey(),
+		CertType:    cryptossh.HostCert,
+		ValidBefore: cryptossh.CertTimeInfinity,
+	}
+	require.NoError(t, cert.SignCert(rand.Reader, ca))
+	signer, err := cryptossh.NewCertSigner(cert, key)
+	require.NoError(t, err)
+	return signer
+}
+
+// withHome uses a temporary home directory that trusts host certificates of the ca for the servers
+func withHome(t *testing.T, ca cryptossh.Signer, servers ...*testServer) {
+	home := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(home, ".ssh"), 0o700))
+	hosts := make([]string, len(servers))
+	for i := range servers {
+		hosts[i] = knownhosts.Normalize(servers[i].addr())
+	}
+	knownHosts := "@cert-authority " + strings.Join(hosts, ",") + " " + string(cryptossh.MarshalAuthorizedKey(ca.PublicKey()))
+	require.NoError(t, os.WriteFile(filepath.Join(home, ".ssh", "known_hosts"), []byte(knownHosts), 0o600))
+
+	t.Setenv("HOME", home)
+	homedir.DisableCache = true
+	t.Cleanup(func() { homedir.DisableCache = false })
+}
@@ -3,7 +3,6 @@ package ssh
 import (
 	"context"
 	"encoding/pem"
-	"fmt"
 	"io"
 	"net"
 	"os"
@@ -21,13 +20,16 @@ import (
 	"golang.org/x/crypto/ssh/agent"
 )
 
-func establishClientConnection(pCfg *providers.Config, hostKeyCallback ssh.HostKeyCallback) (*ssh.Client, []io.Closer, error) {
+// establishClientConnection connects to the configured host. If a bastion is configured, the
+// connection is tunneled through it. The returned closers include the bastion connections.
+func establishClientConnection(pCfg *providers.Config, hostKeyCallback ssh.HostKeyCallback, knownHostsCallback ssh.HostKeyCallback) (*ssh.Client, []io.Closer, error) {
 	authMethods, closer, err := prepareConnection(pCfg)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	if len(authMethods) == 0 {
+		closeAll(closer)
 		return nil, nil, errors.New("no authentication method defined")
 	}
 
@@ -39,13 +41,57 @@ func establishClientConnection(pCfg *providers.Config, hostKeyCallback ssh.HostK
 		}
 	}
 
-	log.Debug().Int("methods", len(authMethods)).Str("user", user).Msg("connect to remote ssh")
-	conn, err := ssh.Dial("tcp", fmt.Sprintf("%s:%d", pCfg.Host, pCfg.Port), &ssh.ClientConfig{
+	clientConfig := &ssh.ClientConfig{
 		User:            user,
 		Auth:            authMethods,
 		HostKeyCallback: hostKeyCallback,
-	})
-	return conn, closer, err
+	}
+	addr := net.JoinHostPort(pCfg.Host, strconv.Itoa(int(pCfg.Port)))
+
+	if pCfg.Bastion == nil {
+		log.Debug().Int("methods", len(authMethods)).Str("user", user).Msg("connect to remote ssh")
+		conn, err := ssh.Dial("tcp", addr, clientConfig)
+		if err != nil {
+			closeAll(closer)
+			return nil, nil, err
+		}
+		return conn, closer, nil
+	}
+
+	// every hop verifies its host key on its own
+	bastionCfg := pCfg.Bastion
+	if bastionCfg.Port == 0 {
+		bastionCfg.Port = 22
+	}
+	bastion, bastionCloser, err := establishClientConnection(bastionCfg, verifyHostKey(bastionCfg.Insecure, knownHostsCallback, nil), knownHostsCallback)
+	if err != nil {
+		closeAll(closer)
+		return nil, nil, errors.Wrap(err, "could not connect to bastion "+bastionCfg.Host)
+	}
+	closer = append(closer, bastionCloser...)
+	closer = append(closer, bastion)
+
+	log.Debug().Int("methods", len(authMethods)).Str("user", user).Str("bastion", bastionCfg.Host).Msg("connect to remote ssh via bastion")
+	tunnel, err := bastion.Dial("tcp", addr)
+	if err != nil {
+		closeAll(closer)
+		return nil, nil, errors.Wrap(err, "could not reach "+addr+" via bastion "+bastionCfg.Host)
+	}
+
+	c, chans, reqs, err := ssh.NewClientConn(tunnel, addr, clientConfig)
+	if err != nil {
+		tunnel.Close()
+		closeAll(closer)
+		return nil, nil, err
+	}
+	return ssh.NewClient(c, chans, reqs), closer, nil
+}
+
+// closeAll closes the connections in reverse order, so that bastions are closed last
+func closeAll(closer []io.Closer) {
+	for i := len(closer) - 1; i >= 0; i-- {
+		closer[i].Close()
+	}
 }
 
 func authPrivateKeyWithPassphrase(pemBytes []byte, passphrase []byte) (ssh.Signer, error) {
@@ -2,8 +2,8 @@ package tar
 
 import (
 	"archive/tar"
-	"bufio"
 	"errors"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -13,7 +13,7 @@ type File struct {
 	path   string
 	header *tar.Header
 	Fs     *FS
-	reader *bufio.Reader
+	reader *io.SectionReader
 }
 
 func (f *File) Name() string {
@@ -25,7 +25,7 @@ func (f *File) Close() error {
 }
 
 func (f *File) Stat() (os.FileInfo, error) {
-	return f.Fs.stat(f.header)
+	return f.header.FileInfo(), nil
 }
 
 func (f *File) Sync() error {
@@ -44,30 +44,40 @@ func (f *File) Read(b []byte) (n int, err error) {
 }
 
 func (f *File) ReadAt(b []byte, off int64) (n int, err error) {
-	return 0, errors.New("not implemented yet")
+	if f.reader == nil {
+		return 0, errors.New("no tar data available")
+	}
+	return f.reader.ReadAt(b, off)
 }
 
 func (f *File) Readdir(n int) ([]os.FileInfo, error) {
+	index, err := f.Fs.loadIndex()
+	if err != nil {
+		return nil, err
+	}
+
 	fi := []os.FileInfo{}
 	// search all child items
-	for k := range f.Fs.FileMap {
-		entry := f.Fs.FileMap[k].Name
-		if strings.HasPrefix(Abs(entry), f.path) {
-			fi = append(fi, f.Fs.FileMap[k].FileInfo())
+	for k := range index {
+		if strings.HasPrefix(k, f.path) {
+			fi = append(fi, index[k].header.FileInfo())
 		}
 	}
 	return fi, nil
 }
 
 func (f *File) Readdirnames(n int) ([]string, error) {
+	index, err := f.Fs.loadIndex()
+	if err != nil {
+		return nil, err
+	}
+
 	fi := []string{}
 	// search all child items
-	for k := range f.Fs.FileMap {
-		entry := f.Fs.FileMap[k].Name
-
-		if strings.HasPrefix(Abs(entry), f.path) {
+	for k := range index {
+		if strings.HasPrefix(k, f.path) {
 			// extract file name
-			rel, err := filepath.Rel(f.path, Abs(entry))
+			rel, err := filepath.Rel(f.path, k)
 			if err != nil {
 				return nil, err
 			}
@@ -85,7 +95,10 @@ func (f *File) Readdirnames(n int) ([]string, error) {
 }
 
 func (f *File) Seek(offset int64, whence int) (int64, error) {
-	return 0, errors.New("seek not implemented")
+	if f.reader == nil {
+		return 0, errors.New("no tar data available")
+	}
+	return f.reader.Seek(offset, whence)
 }
 
 func (f *File) Write(b []byte) (n int, err error) {
@@ -2,37 +2,71 @@ package tar
 
 import (
 	"archive/tar"
-	"bufio"
 	"errors"
 	"io"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/afero"
+	os_provider "go.mondoo.com/cnquery/motor/providers/os"
 	"go.mondoo.com/cnquery/motor/providers/os/fsutil"
 )
 
+const (
+	// maxSymlinks limits the symlinks that are followed for a path, same as linux
+	maxSymlinks = 40
+	// paxXattrPrefix is used by gnu tar and docker to store extended attributes
+	paxXattrPrefix = "SCHILY.xattr."
+)
+
 func NewFs(source string) *FS {
+	return NewLayeredFs(source)
+}
+
+// NewLayeredFs creates a filesystem from uncompressed layer tars, ordered from
+// the lowest to the upper most layer. Whiteouts of upper layers remove files
+// of lower layers.
+func NewLayeredFs(layers ...string) *FS {
 	return &FS{
-		Source:  source,
-		FileMap: make(map[string]*tar.Header),
+		Layers: layers,
 	}
 }
 
+// FS is a read-only filesystem of tar files. The file index is built on
+// first access and only keeps the offsets of the file contents.
 type FS struct {
-	Source  string
-	FileMap map[string]*tar.Header
+	Layers []string
+
+	indexOnce sync.Once
+	index     map[string]*entry
+	indexErr  error
+
+	// layer files are shared by all open files, they are read via ReadAt
+	filesLock sync.Mutex
+	files     map[int]*os.File
 }
 
 func (fs *FS) Name() string {
 	return "tarfs"
 }
 
+// loadIndex returns all indexed files, the index is built on first use
+func (fs *FS) loadIndex() (map[string]*entry, error) {
+	fs.indexOnce.Do(func() {
+		start := time.Now()
+		fs.index, fs.indexErr = buildIndex(fs.Layers)
+		if fs.indexErr == nil {
+			log.Debug().Int("files", len(fs.index)).Int("layers", len(fs.Layers)).Dur("duration", time.Since(start)).Msg("tar> successfully indexed")
+		}
+	})
+	return fs.index, fs.indexErr
+}
+
 func (fs *FS) Create(name string) (afero.File, error) {
 	return nil, errors.New("create not implemented")
 }
@@ -46,18 +80,9 @@ func (fs *FS) MkdirAll(path string, perm os.FileMode) error {
 }
 
 func (fs *FS) Open(path string) (afero.File, error) {
-	h, ok := fs.FileMap[path]
-	if !ok {
-		return nil, os.ErrNotExist
-	}
-
-	if h.Typeflag == tar.TypeSymlink {
-		resolvedPath := fs.resolveSymlink(h)
-		log.Debug().Str("path", path).Str("resolved", Abs(resolvedPath)).Msg("file is a symlink, resolved it")
-		h, ok = fs.FileMap[Abs(resolvedPath)]
-		if !ok {
-			return nil, os.ErrNotExist
-		}
+	h, err := fs.lookup(path, true)
+	if err != nil {
+		return nil, err
 	}
 
 	reader, err := fs.open(h)
@@ -67,7 +92,7 @@ func (fs *FS) Open(path string) (afero.File, error) {
 
 	return &File{
 		path:   path,
-		header: h,
+		header: h.header,
 		Fs:     fs,
 		reader: reader,
 	}, nil
@@ -90,11 +115,11 @@ func (fs *FS) Rename(oldname, newname string) error {
 }
 
 func (fs *FS) Stat(name string) (os.FileInfo, error) {
-	h, ok := fs.FileMap[name]
-	if !ok {
-		return nil, os.ErrNotExist
+	h, err := fs.lookup(name, true)
+	if err != nil {
+		return nil, err
 	}
-	return fs.stat(h)
+	return h.header.FileInfo(), nil
 }
 
 func (fs *FS) Chmod(name string, mode os.FileMode) error {
@@ -109,17 +134,49 @@ func (fs *FS) Chown(name string, uid, gid int) error {
 	return errors.New("chown not implemented")
 }
 
-func (fs *FS) stat(header *tar.Header) (os.FileInfo, error) {
-	statHeader := header
-	if header.Typeflag == tar.TypeSymlink {
-		path := fs.resolveSymlink(header)
-		h, ok := fs.FileMap[Abs(path)]
+// lookup finds the entry for the path, symlinks of parent directories are
+// always resolved, the symlink of the path itself only if follow is set
+func (fs *FS) lookup(path string, follow bool) (*entry, error) {
+	index, err := fs.loadIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	path = Abs(path)
+	for i := 0; i < maxSymlinks; i++ {
+		e, ok := index[path]
+		if ok {
+			if follow && e.header.Typeflag == tar.TypeSymlink {
+				resolved := Abs(fs.resolveSymlink(e.header))
+				log.Debug().Str("path", path).Str("resolved", resolved).Msg("file is a symlink, resolved it")
+				path = resolved
+				continue
+			}
+			return e, nil
+		}
+
+		// a parent directory may be a symlink, e.g. /lib -> usr/lib
+		resolved, ok := fs.resolveParent(index, path)
 		if !ok {
-			return nil, errors.New("could not find " + path)
+			return nil, os.ErrNotExist
+		}
+		path = resolved
+	}
+	return nil, errors.New("too many levels of symbolic links: " + path)
+}
+
+// resolveParent replaces the first parent directory of the path that is a symlink
+func (fs *FS) resolveParent(index map[string]*entry, path string) (string, bool) {
+	for i := 1; i < len(path); i++ {
+		if path[i] != Separator {
+			continue
+		}
+		e, ok := index[path[:i]]
+		if ok && e.header.Typeflag == tar.TypeSymlink {
+			return join(Abs(fs.resolveSymlink(e.header)), path[i+1:]), true
 		}
-		statHeader = h
 	}
-	return statHeader.FileInfo(), nil
+	return "", false
 }
 
 // resolve symlink file
@@ -143,46 +200,83 @@ func (fs *FS) resolveSymlink(header *tar.Header) string {
 	return path
 }
 
-func (fs *FS) open(header *tar.Header) (*bufio.Reader, error) {
-	log.Debug().Str("file", header.Name).Msg("tar> load file content")
+// open returns a reader for the file content, that is directly read from the tar
+func (fs *FS) open(e *entry) (*io.SectionReader, error) {
+	log.Debug().Str("file", e.header.Name).Msg("tar> load file content")
+
+	// hard links share the content of the linked file
+	if e.header.Typeflag == tar.TypeLink {
+		linked, err := fs.lookup(e.header.Linkname, true)
+		if err != nil {
+			return nil, err
+		}
+		e = linked
+	}
 
-	// open tar file
-	f, err := os.Open(fs.Source)
+	if e.header.Typeflag != tar.TypeReg && e.header.Typeflag != tar.TypeRegA {
+		return nil, nil
+	}
+
+	f, err := fs.layerFile(e.layer)
 	if err != nil {
 		return nil, err
 	}
-	defer f.Close()
+	return io.NewSectionReader(f, e.offset, e.header.Size), nil
+}
+
+func (fs *FS) layerFile(layer int) (*os.File, error) {
+	fs.filesLock.Lock()
+	defer fs.filesLock.Unlock()
 
-	path := header.Name
-	if header.Typeflag == tar.TypeSymlink {
-		path = fs.resolveSymlink(header)
+	if f, ok := fs.files[layer]; ok {
+		return f, nil
 	}
 
-	// extract file from tar stream
-	reader, err := fsutil.ExtractFileFromTarStream(path, f)
+	f, err := os.Open(fs.Layers[layer])
 	if err != nil {
 		return nil, err
 	}
-	return reader, nil
+	if fs.files == nil {
+		fs.files = map[int]*os.File{}
+	}
+	fs.files[layer] = f
+	return f, nil
+}
+
+// Close closes all open layer files
+func (fs *FS) Close() error {
+	fs.filesLock.Lock()
+	defer fs.filesLock.Unlock()
+
+	var err error
+	for i := range fs.files {
+		if cErr := fs.files[i].Close(); cErr != nil {
+			err = cErr
+		}
+	}
+	fs.files = nil
+	return err
 }
 
 func (fs *FS) tar(path string, header *tar.Header) (io.ReadCloser, error) {
-	fReader, err := fs.open(header)
+	e, err := fs.lookup(path, true)
 	if err != nil {
 		return nil, err
 	}
 
-	// create a pipe
-	tarReader, tarWriter := io.Pipe()
-
-	// get file info, header my just include symlink fileinfo
-	fi, err := fs.stat(header)
+	fReader, err := fs.open(e)
 	if err != nil {
 		return nil, err
 	}
+	if fReader == nil {
+		return nil, errors.New("cannot stream " + path + ", it is not a file")
+	}
+
+	// create a pipe
+	tarReader, tarWriter := io.Pipe()
 
 	// convert raw stream to tar stream
-	go fsutil.StreamFileAsTar(header.Name, fi, ioutil.NopCloser(fReader), tarWriter)
+	go fsutil.StreamFileAsTar(header.Name, e.header.FileInfo(), io.NopCloser(fReader), tarWriter)
 
 	// return the reader
 	return tarReader, nil
@@ -190,9 +284,27 @@ func (fs *FS) tar(path string, header *tar.Header) (io.ReadCloser, error) {
 
 // searches for files and returns the file info
 // regex can be nil
+// Xattr returns the extended attribute of the file, tar stores them as pax records
+func (fs *FS) Xattr(path string, name string) ([]byte, error) {
+	e, err := fs.lookup(path, false)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := e.header.PAXRecords[paxXattrPrefix+name]
+	if !ok {
+		return nil, os_provider.ErrXattrNotFound
+	}
+	return []byte(value), nil
+}
+
 func (fs *FS) Find(from string, r *regexp.Regexp, typ string) ([]string, error) {
+	index, err := fs.loadIndex()
+	if err != nil {
+		return nil, err
+	}
+
 	list := []string{}
-	for k := range fs.FileMap {
+	for k := range index {
 		p := strings.HasPrefix(k, from)
 		m := true
 		if r != nil {
@@ -200,7 +312,7 @@ func (fs *FS) Find(from string, r *regexp.Regexp, typ string) ([]string, error)
 		}
 		log.Trace().Str("path", k).Str("from", from).Str("prefix", from).Bool("prefix", p).Bool("m", m).Msg("check if matches")
 		if p && m {
-			entry := fs.FileMap[k]
+			entry := index[k].header
 			if (typ == "directory" && entry.Typeflag == tar.TypeDir) || (typ == "file" && entry.Typeflag == tar.TypeReg) {
 				list = append(list, k)
 				log.Debug().Msg("matches")
@@ -0,0 +1,254 @@
+package tar_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/motor/providers"
+	"go.mondoo.com/cnquery/motor/providers/container/cache"
+	os_provider "go.mondoo.com/cnquery/motor/providers/os"
+	motortar "go.mondoo.com/cnquery/motor/providers/tar"
+)
+
+type tarEntry struct {
+	name     string
+	typ      byte
+	content  string
+	linkname string
+	xattrs   map[string]string
+}
+
+func writeTar(t *testing.T, entries []tarEntry) []byte {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, e := range entries {
+		h := &tar.Header{
+			Name:     e.name,
+			Typeflag: e.typ,
+			Linkname: e.linkname,
+			Mode:     0o644,
+		}
+		if e.typ == tar.TypeDir {
+			h.Mode = 0o755
+		}
+		for k, v := range e.xattrs {
+			if h.PAXRecords == nil {
+				h.PAXRecords = map[string]string{}
+			}
+			h.PAXRecords["SCHILY.xattr."+k] = v
+		}
+		if e.typ == tar.TypeReg {
+			h.Size = int64(len(e.content))
+		}
+		require.NoError(t, tw.WriteHeader(h))
+		if e.typ == tar.TypeReg {
+			_, err := tw.Write([]byte(e.content))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, tw.Close())
+	return buf.Bytes()
+}
+
+var (
+	baseLayer = []tarEntry{
+		{name: "etc/", typ: tar.TypeDir},
+		{name: "etc/os-release", typ: tar.TypeReg, content: "ID=alpine\n"},
+		{name: "etc/passwd", typ: tar.TypeReg, content: "root:x:0:0:root:/root:/bin/ash\n"},
+		{name: "usr/", typ: tar.TypeDir},
+		{name: "usr/lib/", typ: tar.TypeDir},
+		{name: "usr/lib/libc.so", typ: tar.TypeReg, content: "libc"},
+		{name: "lib", typ: tar.TypeSymlink, linkname: "usr/lib"},
+		{name: "bin/", typ: tar.TypeDir},
+		{name: "bin/busybox", typ: tar.TypeReg, content: "#!busybox"},
+		{name: "bin/ash", typ: tar.TypeLink, linkname: "bin/busybox"},
+		{name: "bin/sh", typ: tar.TypeSymlink, linkname: "/bin/ash"},
+		{name: "var/cache/", typ: tar.TypeDir},
+		{name: "var/cache/a", typ: tar.TypeReg, content: "a"},
+		{name: "var/cache/b/c", typ: tar.TypeReg, content: "c"},
+	}
+	upperLayer = []tarEntry{
+		{name: "etc/.wh.passwd", typ: tar.TypeReg},
+		{name: "etc/hostname", typ: tar.TypeReg, content: "alpine\n"},
+		{name: "etc/os-release", typ: tar.TypeReg, content: "ID=alpine\nVERSION_ID=3.17\n"},
+		{name: "var/cache/.wh..wh..opq", typ: tar.TypeReg},
+		{name: "var/cache/d", typ: tar.TypeReg, content: "d"},
+	}
+)
+
+func readFile(t *testing.T, fs afero.Fs, path string) string {
+	data, err := afero.ReadFile(fs, path)
+	require.NoError(t, err, path)
+	return string(data)
+}
+
+func TestXattr(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rootfs.tar")
+	require.NoError(t, os.WriteFile(path, writeTar(t, []tarEntry{
+		{name: "etc/", typ: tar.TypeDir},
+		{name: "etc/shadow", typ: tar.TypeReg, content: "root:*:19000::::::\n", xattrs: map[string]string{
+			"security.selinux": "system_u:object_r:shadow_t:s0\x00",
+		}},
+		{name: "etc/passwd", typ: tar.TypeReg, content: "root:x:0:0:root:/root:/bin/sh\n"},
+	}), 0o644))
+
+	fs := motortar.NewFs(path)
+	defer fs.Close()
+
+	value, err := fs.Xattr("/etc/shadow", "security.selinux")
+	require.NoError(t, err)
+	assert.Equal(t, "system_u:object_r:shadow_t:s0\x00", string(value))
+
+	_, err = fs.Xattr("/etc/passwd", "security.selinux")
+	assert.Equal(t, os_provider.ErrXattrNotFound, err)
+	_, err = fs.Xattr("/etc/group", "security.selinux")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestLayeredFs(t *testing.T) {
+	dir := t.TempDir()
+	base := filepath.Join(dir, "base.tar")
+	upper := filepath.Join(dir, "upper.tar")
+	require.NoError(t, os.WriteFile(base, writeTar(t, baseLayer), 0o644))
+	require.NoError(t, os.WriteFile(upper, writeTar(t, upperLayer), 0o644))
+
+	fs := motortar.NewLayeredFs(base, upper)
+	defer fs.Close()
+
+	// upper layers overwrite files
+	assert.Equal(t, "ID=alpine\nVERSION_ID=3.17\n", readFile(t, fs, "/etc/os-release"))
+	assert.Equal(t, "alpine\n", readFile(t, fs, "/etc/hostname"))
+
+	// symlinks of files and parent directories as well as hard links are resolved
+	assert.Equal(t, "libc", readFile(t, fs, "/lib/libc.so"))
+	assert.Equal(t, "#!busybox", readFile(t, fs, "/bin/ash"))
+	assert.Equal(t, "#!busybox", readFile(t, fs, "/bin/sh"))
+
+	// whiteouts remove files, opaque directories hide all lower files
+	_, err := fs.Stat("/etc/passwd")
+	assert.True(t, os.IsNotExist(err))
+	_, err = fs.Stat("/var/cache/a")
+	assert.True(t, os.IsNotExist(err))
+	_, err = fs.Stat("/var/cache/b/c")
+	assert.True(t, os.IsNotExist(err))
+	assert.Equal(t, "d", readFile(t, fs, "/var/cache/d"))
+	_, err = fs.Stat("/etc/.wh.passwd")
+	assert.True(t, os.IsNotExist(err))
+
+	files, err := fs.Find("/etc", regexp.MustCompile(".*"), "file")
+	require.NoError(t, err)
+	sort.Strings(files)
+	assert.Equal(t, []string{"/etc/hostname", "/etc/os-release"}, files)
+
+	stat, err := fs.Stat("/usr/lib")
+	require.NoError(t, err)
+	assert.True(t, stat.IsDir())
+}
+
+func TestLayeredFs_Seek(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "base.tar")
+	require.NoError(t, os.WriteFile(path, writeTar(t, baseLayer), 0o644))
+	fs := motortar.NewFs(path)
+	defer fs.Close()
+
+	f, err := fs.Open("/etc/passwd")
+	require.NoError(t, err)
+	defer f.Close()
+
+	buf := make([]byte, 4)
+	_, err = f.ReadAt(buf, 5)
+	require.NoError(t, err)
+	assert.Equal(t, "x:0:", string(buf))
+
+	_, err = f.Seek(-10, io.SeekEnd)
+	require.NoError(t, err)
+	rest, err := io.ReadAll(f)
+	require.NoError(t, err)
+	assert.Equal(t, "/bin/ash\n", string(rest[1:]))
+}
+
+func TestLayeredFs_LazyIndex(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "broken.tar")
+	require.NoError(t, os.WriteFile(path, []byte("no tar"), 0o644))
+
+	// the index is only built on first access
+	p, err := motortar.New(&providers.Config{
+		Backend: providers.ProviderType_TAR,
+		Options: map[string]string{motortar.OPTION_FILE: path},
+	})
+	require.NoError(t, err)
+	defer p.Close()
+
+	_, err = p.FS().Stat("/etc/os-release")
+	assert.Error(t, err)
+}
+
+func testImage(t *testing.T) v1.Image {
+	var layers []v1.Layer
+	for _, entries := range [][]tarEntry{baseLayer, upperLayer} {
+		data := writeTar(t, entries)
+		layer, err := tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(data)), nil
+		})
+		require.NoError(t, err)
+		layers = append(layers, layer)
+	}
+	img, err := mutate.AppendLayers(empty.Image, layers...)
+	require.NoError(t, err)
+	cfg, err := img.ConfigFile()
+	require.NoError(t, err)
+	cfg = cfg.DeepCopy()
+	cfg.Architecture = "arm64"
+	cfg.OS = "linux"
+	img, err = mutate.ConfigFile(img, cfg)
+	require.NoError(t, err)
+	return img
+}
+
+func TestImageTarball(t *testing.T) {
+	layerCache := t.TempDir()
+	t.Setenv(cache.LayerCacheEnv, layerCache)
+
+	img := testImage(t)
+	path := filepath.Join(t.TempDir(), "image.tar")
+	ref, err := name.ParseReference("alpine:test")
+	require.NoError(t, err)
+	require.NoError(t, tarball.WriteToFile(path, ref, img))
+
+	p, err := motortar.New(&providers.Config{
+		Backend: providers.ProviderType_TAR,
+		Options: map[string]string{motortar.OPTION_FILE: path},
+	})
+	require.NoError(t, err)
+	defer p.Close()
+
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	assert.Equal(t, "//platformid.api.mondoo.app/runtime/docker/images/"+digest.Hex, p.PlatformIdentifier)
+	assert.Equal(t, "arm64", p.PlatformArchitecture)
+	assert.Equal(t, providers.Kind_KIND_CONTAINER_IMAGE, p.Kind())
+
+	// files are read from the layers without flattening the image
+	assert.Equal(t, "ID=alpine\nVERSION_ID=3.17\n", readFile(t, p.FS(), "/etc/os-release"))
+	_, err = p.FS().Stat("/etc/passwd")
+	assert.True(t, os.IsNotExist(err))
+
+	// all layers are kept in the cache
+	cached, err := os.ReadDir(layerCache)
+	require.NoError(t, err)
+	assert.Len(t, cached, 2)
+}
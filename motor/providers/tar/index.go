@@ -0,0 +1,154 @@
+package tar
+
+import (
+	"archive/tar"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// files in a layer that hide files of lower layers, see
+	// https://github.com/opencontainers/image-spec/blob/main/layer.md#whiteouts
+	whiteoutPrefix = ".wh."
+	whiteoutOpaque = ".wh..wh..opq"
+)
+
+// entry is an indexed file of a tar, the offset points to the file content
+type entry struct {
+	header *tar.Header
+	layer  int
+	offset int64
+}
+
+// countingReader tracks the position in the tar, it keeps the tar reader
+// able to seek over file content instead of reading it
+type countingReader struct {
+	r   io.ReadSeeker
+	pos int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.pos += int64(n)
+	return n, err
+}
+
+func (c *countingReader) Seek(offset int64, whence int) (int64, error) {
+	pos, err := c.r.Seek(offset, whence)
+	if err == nil {
+		c.pos = pos
+	}
+	return pos, err
+}
+
+// layerIndex holds the entries of one layer and the paths it removes from lower layers
+type layerIndex struct {
+	entries  map[string]*entry
+	removed  map[string]struct{}
+	opaque   map[string]struct{}
+	hasWhite bool
+}
+
+// indexLayer reads all headers of the tar and keeps the offset of their content
+func indexLayer(layer int, path string) (*layerIndex, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	res := &layerIndex{
+		entries: map[string]*entry{},
+		removed: map[string]struct{}{},
+		opaque:  map[string]struct{}{},
+	}
+
+	cr := &countingReader{r: f}
+	tr := tar.NewReader(cr)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Error().Err(err).Str("tar", path).Msg("tar> error reading tar stream")
+			return nil, err
+		}
+
+		name := Abs(h.Name)
+		dir, base := split(name)
+		switch {
+		case base == whiteoutOpaque:
+			res.opaque[dir] = struct{}{}
+			res.hasWhite = true
+			continue
+		case strings.HasPrefix(base, whiteoutPrefix):
+			res.removed[join(dir, strings.TrimPrefix(base, whiteoutPrefix))] = struct{}{}
+			res.hasWhite = true
+			continue
+		}
+
+		// the tar reader is positioned at the content of the file
+		res.entries[name] = &entry{
+			header: h,
+			layer:  layer,
+			offset: cr.pos,
+		}
+	}
+	return res, nil
+}
+
+// buildIndex merges all layers, upper layers overwrite and remove files of lower layers
+func buildIndex(layers []string) (map[string]*entry, error) {
+	index := map[string]*entry{}
+	for i := range layers {
+		li, err := indexLayer(i, layers[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if li.hasWhite {
+			for path := range index {
+				if li.hides(path) {
+					delete(index, path)
+				}
+			}
+		}
+
+		for path, e := range li.entries {
+			index[path] = e
+		}
+	}
+	return index, nil
+}
+
+// hides checks if the path of a lower layer is removed by this layer, either
+// by a whiteout of the path or one of its parents or an opaque parent directory
+func (li *layerIndex) hides(path string) bool {
+	if _, ok := li.removed[path]; ok {
+		return true
+	}
+	for dir, _ := split(path); ; dir, _ = split(dir) {
+		if _, ok := li.removed[dir]; ok {
+			return true
+		}
+		if _, ok := li.opaque[dir]; ok {
+			return true
+		}
+		if dir == "/" {
+			return false
+		}
+	}
+}
+
+// split returns the directory and file name of an absolute path
+func split(path string) (string, string) {
+	i := strings.LastIndexByte(path, Separator)
+	if i <= 0 {
+		return "/", path[i+1:]
+	}
+	return path[:i], path[i+1:]
+}
@@ -7,29 +7,37 @@ import (
 	"io"
 	"os"
 
-	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/tarball"
-	"github.com/rs/zerolog/log"
 	"github.com/spf13/afero"
 	"go.mondoo.com/cnquery/motor/motorid/containerid"
 	"go.mondoo.com/cnquery/motor/providers"
 	"go.mondoo.com/cnquery/motor/providers/container/cache"
+	"go.mondoo.com/cnquery/motor/providers/container/image"
 	os_provider "go.mondoo.com/cnquery/motor/providers/os"
 	"go.mondoo.com/cnquery/motor/providers/os/fsutil"
 )
 
-const OPTION_FILE = "file"
+const (
+	OPTION_FILE = "file"
+	// OPTION_DIGEST selects the image manifest of an OCI image layout directory
+	OPTION_DIGEST = "digest"
+	// OPTION_TAG selects the image of a docker archive with multiple images
+	OPTION_TAG = "tag"
+)
 
 var (
 	_ providers.Instance           = (*Provider)(nil)
 	_ providers.PlatformIdentifier = (*Provider)(nil)
+	_ os_provider.FileXattrs       = (*Provider)(nil)
 )
 
 func New(endpoint *providers.Config) (*Provider, error) {
 	return NewWithClose(endpoint, nil)
 }
 
-// NewWithReader provides a tar provider from a container image stream
+// NewWithReader provides a tar provider from a tar stream, the stream is cached in a
+// temporary file. Container images should use NewWithImage to avoid flattening them.
 func NewWithReader(rc io.ReadCloser, close func()) (*Provider, error) {
 	// we cache the flattened image locally
 	f, err := cache.RandomFile()
@@ -64,46 +72,99 @@ func NewWithClose(pCfg *providers.Config, closeFn func()) (*Provider, error) {
 	}
 
 	filename := pCfg.Options[OPTION_FILE]
-	var identifier string
 
-	// try to determine if the tar is a container image
-	img, iErr := tarball.ImageFromPath(filename, nil)
-	if iErr == nil {
-		hash, err := img.Digest()
-		if err != nil {
-			return nil, err
-		}
-		identifier = containerid.MondooContainerImageID(hash.String())
-		// if it is a container image, we need to transform the tar first, so that all layers are flattened
-		p, err := NewWithReader(mutate.Extract(img), closeFn)
-		if err != nil {
-			return nil, err
-		}
-		p.PlatformIdentifier = identifier
-		return p, nil
-	} else {
-		hash, err := fsutil.LocalFileSha256(filename)
+	// OCI image layouts are directories with an index of all images
+	stat, err := os.Stat(filename)
+	if err != nil {
+		return nil, err
+	}
+	if stat.IsDir() {
+		img, err := image.LoadImageFromOCILayout(filename, pCfg.Options[OPTION_DIGEST])
 		if err != nil {
 			return nil, err
 		}
-		identifier = "//platformid.api.mondoo.app/runtime/tar/hash/" + hash
+		return NewWithImage(img, closeFn)
+	}
 
-		p := &Provider{
-			Fs:              NewFs(filename),
-			CloseFN:         closeFn,
-			PlatformKind:    pCfg.Kind,
-			PlatformRuntime: pCfg.Runtime,
-		}
+	// try to determine if the tar is a container image
+	img, iErr := image.LoadImageFromDockerArchive(filename, pCfg.Options[OPTION_TAG])
+	if iErr == nil {
+		// container images are accessed layer by layer
+		return NewWithImage(img, closeFn)
+	}
+	if pCfg.Options[OPTION_TAG] != "" {
+		return nil, iErr
+	}
 
-		err = p.LoadFile(filename)
+	hash, err := fsutil.LocalFileSha256(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Provider{
+		Fs:                 NewFs(filename),
+		CloseFN:            closeFn,
+		PlatformKind:       pCfg.Kind,
+		PlatformRuntime:    pCfg.Runtime,
+		PlatformIdentifier: "//platformid.api.mondoo.app/runtime/tar/hash/" + hash,
+	}, nil
+}
+
+// NewWithImage provides a tar provider for a container image. The uncompressed layers
+// are stored in the layer cache and files are read from the layer they belong to,
+// therefore the image is never flattened.
+func NewWithImage(img v1.Image, closeFn func()) (*Provider, error) {
+	layerCache, err := cache.DefaultLayerCache()
+	if err != nil {
+		return nil, err
+	}
+	p, err := newWithImage(img, layerCache, closeFn)
+	if err != nil {
+		layerCache.Close()
+		return nil, err
+	}
+	return p, nil
+}
+
+func newWithImage(img v1.Image, layerCache *cache.LayerCache, closeFn func()) (*Provider, error) {
+	hash, err := img.Digest()
+	if err != nil {
+		return nil, err
+	}
+
+	layers, err := img.Layers()
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, len(layers))
+	for i := range layers {
+		paths[i], err = layerCache.Layer(layers[i])
 		if err != nil {
-			log.Error().Err(err).Str("tar", filename).Msg("tar> could not load tar file")
 			return nil, err
 		}
+	}
 
-		p.PlatformIdentifier = identifier
-		return p, nil
+	p := &Provider{
+		Fs: NewLayeredFs(paths...),
+		CloseFN: func() {
+			layerCache.Close()
+			if closeFn != nil {
+				closeFn()
+			}
+		},
+		PlatformKind:       providers.Kind_KIND_CONTAINER_IMAGE,
+		PlatformRuntime:    providers.RUNTIME_DOCKER_IMAGE,
+		PlatformIdentifier: containerid.MondooContainerImageID(hash.String()),
 	}
+
+	// set the platform architecture using the image configuration
+	imgConfig, err := img.ConfigFile()
+	if err == nil {
+		p.PlatformArchitecture = imgConfig.Architecture
+	}
+
+	return p, nil
 }
 
 func PlatformID(filename string) (string, error) {
@@ -164,6 +225,10 @@ func (p *Provider) FS() afero.Fs {
 	return p.Fs
 }
 
+func (p *Provider) Xattr(path string, name string) ([]byte, error) {
+	return p.Fs.Xattr(path, name)
+}
+
 func (p *Provider) FileInfo(path string) (os_provider.FileInfoDetails, error) {
 	fs := p.FS()
 	afs := &afero.Afero{Fs: fs}
@@ -189,6 +254,7 @@ func (p *Provider) FileInfo(path string) (os_provider.FileInfoDetails, error) {
 }
 
 func (p *Provider) Close() {
+	p.Fs.Close()
 	if p.CloseFN != nil {
 		p.CloseFN()
 	}
@@ -201,36 +267,6 @@ func (p *Provider) Capabilities() providers.Capabilities {
 	}
 }
 
-func (p *Provider) Load(stream io.Reader) error {
-	tr := tar.NewReader(stream)
-	for {
-		h, err := tr.Next()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			log.Error().Err(err).Msg("tar> error reading tar stream")
-			return err
-		}
-
-		path := Abs(h.Name)
-		p.Fs.FileMap[path] = h
-	}
-	log.Debug().Int("files", len(p.Fs.FileMap)).Msg("tar> successfully loaded")
-	return nil
-}
-
-func (p *Provider) LoadFile(path string) error {
-	log.Debug().Str("path", path).Msg("tar> load tar file into backend")
-
-	f, err := os.Open(path)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
-	return p.Load(f)
-}
-
 func (p *Provider) Kind() providers.Kind {
 	return p.PlatformKind
 }
@@ -0,0 +1,665 @@
+package terraform
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/ext/typeexpr"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/rs/zerolog/log"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	"github.com/zclconf/go-cty/cty/function"
+)
+
+// VarOptionPrefix marks the provider options that set input variables of the
+// root module like -var does, e.g. var.region=eu-west-1
+const VarOptionPrefix = "var."
+
+// maxModuleDepth limits nested module calls, e.g. for modules that call themselves
+const maxModuleDepth = 32
+
+// meta-arguments are not part of the evaluated arguments
+var (
+	resourceMetaArguments = map[string]struct{}{
+		"count": {}, "for_each": {}, "depends_on": {}, "provider": {},
+		"lifecycle": {}, "provisioner": {}, "connection": {},
+	}
+	moduleMetaArguments = map[string]struct{}{
+		"source": {}, "version": {}, "count": {}, "for_each": {}, "providers": {}, "depends_on": {},
+	}
+)
+
+var variableSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{Name: "type"},
+		{Name: "default"},
+	},
+}
+
+// EvaluatedModule is a module instance with resolved variables, locals and
+// outputs. Values that are only known after apply, e.g. resource ids, are unknown.
+type EvaluatedModule struct {
+	// Address is empty for the root module, e.g. module.network or
+	// module.network["a"] for module calls
+	Address string
+	// Source of the module call
+	Source string
+	// Dir is the directory of the module, it is empty if the module is not
+	// available locally
+	Dir string
+	// Key is the count index or the for_each key of the module call
+	Key cty.Value
+	// Block is the module call, it is nil for the root module
+	Block     *hcl.Block
+	File      *hcl.File
+	Variables map[string]cty.Value
+	Locals    map[string]cty.Value
+	Outputs   map[string]cty.Value
+	Resources []*EvaluatedResource
+	Modules   []*EvaluatedModule
+}
+
+// EvaluatedResource is an instance of a resource or data source block
+type EvaluatedResource struct {
+	// Address includes the module and the instance key, e.g.
+	// module.network.aws_subnet.private[0]
+	Address string
+	// Module is the address of the module
+	Module string
+	// Mode is managed for resources and data for data sources
+	Mode  string
+	Type  string
+	Name  string
+	Key   cty.Value
+	Block *hcl.Block
+	File  *hcl.File
+	// Arguments are the evaluated attributes and nested blocks, nested blocks
+	// are lists of objects with dynamic blocks expanded
+	Arguments map[string]cty.Value
+}
+
+type configBlock struct {
+	block *hcl.Block
+	file  *hcl.File
+}
+
+// moduleConfig are the blocks of all configuration files of a module
+type moduleConfig struct {
+	variables []configBlock
+	locals    []*hcl.Attribute
+	modules   []configBlock
+	resources []configBlock
+	outputs   []configBlock
+}
+
+func newModuleConfig(files map[string]*hcl.File) *moduleConfig {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	cfg := &moduleConfig{}
+	for _, name := range names {
+		file := files[name]
+		content, _, _ := file.Body.PartialContent(TerraformSchema_0_12)
+		for _, block := range content.Blocks {
+			b := configBlock{block: block, file: file}
+			switch block.Type {
+			case "variable":
+				cfg.variables = append(cfg.variables, b)
+			case "locals":
+				attrs, _ := block.Body.JustAttributes()
+				for _, attr := range attrs {
+					cfg.locals = append(cfg.locals, attr)
+				}
+			case "module":
+				cfg.modules = append(cfg.modules, b)
+			case "resource", "data":
+				cfg.resources = append(cfg.resources, b)
+			case "output":
+				cfg.outputs = append(cfg.outputs, b)
+			}
+		}
+	}
+	return cfg
+}
+
+type evaluator struct {
+	rootDir   string
+	manifest  *ModuleManifest
+	functions map[string]function.Function
+}
+
+// Evaluate resolves the configuration of the root module in rootDir. Input
+// variables are set from their defaults, the tfvars and the overrides in this
+// order. Modules are loaded from local paths or the module manifest of
+// terraform init.
+func Evaluate(rootDir string, files map[string]*hcl.File, tfVars map[string]*hcl.Attribute, overrides map[string]string, manifest *ModuleManifest) *EvaluatedModule {
+	e := &evaluator{
+		rootDir:   rootDir,
+		manifest:  manifest,
+		functions: evalFunctions(),
+	}
+
+	inputs := map[string]cty.Value{}
+	for name := range tfVars {
+		v, diags := tfVars[name].Expr.Value(nil)
+		if !diags.HasErrors() {
+			inputs[name] = v
+		}
+	}
+
+	root := &EvaluatedModule{Dir: rootDir}
+	e.evalModule(root, newModuleConfig(files), inputs, overrides, "", 0)
+	return root
+}
+
+func (e *evaluator) evalModule(mod *EvaluatedModule, cfg *moduleConfig, inputs map[string]cty.Value, overrides map[string]string, manifestKey string, depth int) {
+	mod.Variables = evalVariables(cfg, inputs, overrides)
+
+	// locals may reference module outputs and module inputs may reference
+	// locals, therefore locals are evaluated again once the modules are known
+	modules := map[string]cty.Value{}
+	for _, call := range cfg.modules {
+		modules[call.block.Labels[0]] = cty.DynamicVal
+	}
+	e.evalLocals(mod, cfg, modules)
+	modules = e.evalModuleCalls(mod, cfg, modules, manifestKey, depth)
+	e.evalLocals(mod, cfg, modules)
+
+	ctx := e.evalContext(mod, cfg, modules)
+	e.evalResources(mod, cfg, ctx)
+
+	mod.Outputs = map[string]cty.Value{}
+	for _, output := range cfg.outputs {
+		attrs, _ := output.block.Body.JustAttributes()
+		value := cty.NullVal(cty.DynamicPseudoType)
+		if attr, ok := attrs["value"]; ok {
+			value = evalExpr(attr.Expr, ctx)
+		}
+		mod.Outputs[output.block.Labels[0]] = value
+	}
+}
+
+func evalVariables(cfg *moduleConfig, inputs map[string]cty.Value, overrides map[string]string) map[string]cty.Value {
+	res := map[string]cty.Value{}
+	for _, variable := range cfg.variables {
+		name := variable.block.Labels[0]
+		content, _, _ := variable.block.Body.PartialContent(variableSchema)
+
+		ty := cty.DynamicPseudoType
+		if attr, ok := content.Attributes["type"]; ok {
+			if t, diags := typeexpr.TypeConstraint(attr.Expr); !diags.HasErrors() {
+				ty = t
+			}
+		}
+
+		// variables without a value would be prompted for by terraform
+		value := cty.UnknownVal(ty)
+		if attr, ok := content.Attributes["default"]; ok {
+			if v, diags := attr.Expr.Value(nil); !diags.HasErrors() {
+				value = v
+			}
+		}
+		if v, ok := inputs[name]; ok {
+			value = v
+		}
+		if raw, ok := overrides[name]; ok {
+			value = parseVarOverride(name, raw, ty)
+		}
+
+		if v, err := convert.Convert(value, ty); err == nil {
+			value = v
+		}
+		res[name] = value
+	}
+	return res
+}
+
+// parseVarOverride parses the value of an override like -var does, values of
+// primitive types are strings while complex types use the HCL syntax
+func parseVarOverride(name string, raw string, ty cty.Type) cty.Value {
+	if ty.IsPrimitiveType() || ty == cty.DynamicPseudoType {
+		return cty.StringVal(raw)
+	}
+
+	expr, diags := hclsyntax.ParseExpression([]byte(raw), "<value for var."+name+">", hcl.InitialPos)
+	if diags.HasErrors() {
+		return cty.StringVal(raw)
+	}
+	v, diags := expr.Value(nil)
+	if diags.HasErrors() {
+		return cty.StringVal(raw)
+	}
+	return v
+}
+
+// evalLocals evaluates the locals in the order of their references to other
+// locals, locals with cyclic references are unknown
+func (e *evaluator) evalLocals(mod *EvaluatedModule, cfg *moduleConfig, modules map[string]cty.Value) {
+	mod.Locals = map[string]cty.Value{}
+	pending := map[string]*hcl.Attribute{}
+	for _, attr := range cfg.locals {
+		pending[attr.Name] = attr
+	}
+
+	ctx := e.evalContext(mod, cfg, modules)
+	for len(pending) > 0 {
+		names := make([]string, 0, len(pending))
+		for name := range pending {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		progress := false
+		for _, name := range names {
+			attr := pending[name]
+			if referencesLocals(attr.Expr, pending) {
+				continue
+			}
+			mod.Locals[name] = evalExpr(attr.Expr, ctx)
+			ctx.Variables["local"] = cty.ObjectVal(mod.Locals)
+			delete(pending, name)
+			progress = true
+		}
+
+		if !progress {
+			for name := range pending {
+				mod.Locals[name] = cty.DynamicVal
+			}
+			ctx.Variables["local"] = cty.ObjectVal(mod.Locals)
+			break
+		}
+	}
+}
+
+func referencesLocals(expr hcl.Expression, locals map[string]*hcl.Attribute) bool {
+	for _, traversal := range expr.Variables() {
+		if traversal.RootName() != "local" || len(traversal) < 2 {
+			continue
+		}
+		if attr, ok := traversal[1].(hcl.TraverseAttr); ok {
+			if _, ok := locals[attr.Name]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// evalModuleCalls evaluates all instances of the module calls and returns
+// their outputs for module.<name> references
+func (e *evaluator) evalModuleCalls(mod *EvaluatedModule, cfg *moduleConfig, modules map[string]cty.Value, manifestKey string, depth int) map[string]cty.Value {
+	ctx := e.evalContext(mod, cfg, modules)
+	res := map[string]cty.Value{}
+
+	for _, call := range cfg.modules {
+		name := call.block.Labels[0]
+		attrs, _ := call.block.Body.JustAttributes()
+
+		source := ""
+		if attr, ok := attrs["source"]; ok {
+			if v, diags := attr.Expr.Value(nil); !diags.HasErrors() && v.Type() == cty.String && v.IsKnown() && !v.IsNull() {
+				source = v.AsString()
+			}
+		}
+
+		key := name
+		if manifestKey != "" {
+			key = manifestKey + "." + name
+		}
+
+		var childCfg *moduleConfig
+		dir := e.moduleDir(mod.Dir, source, key)
+		if dir != "" && depth < maxModuleDepth {
+			files, err := parseModuleDir(dir)
+			if err != nil {
+				log.Warn().Err(err).Str("module", name).Str("dir", dir).Msg("could not load terraform module")
+				dir = ""
+			} else {
+				childCfg = newModuleConfig(files)
+			}
+		}
+
+		instances := expandInstances(attrs, ctx)
+		outputs := map[string]cty.Value{}
+		outputList := []cty.Value{}
+		for _, instance := range instances {
+			child := &EvaluatedModule{
+				Address: "module." + name + instance.suffix,
+				Source:  source,
+				Dir:     dir,
+				Key:     instance.key,
+				Block:   call.block,
+				File:    call.file,
+			}
+			if mod.Address != "" {
+				child.Address = mod.Address + "." + child.Address
+			}
+
+			inputs := map[string]cty.Value{}
+			for argName, attr := range attrs {
+				if _, ok := moduleMetaArguments[argName]; ok {
+					continue
+				}
+				inputs[argName] = evalExpr(attr.Expr, instance.ctx)
+			}
+
+			value := cty.DynamicVal
+			if childCfg != nil {
+				e.evalModule(child, childCfg, inputs, nil, key, depth+1)
+				value = cty.ObjectVal(child.Outputs)
+			} else {
+				child.Variables = inputs
+				child.Locals = map[string]cty.Value{}
+				child.Outputs = map[string]cty.Value{}
+			}
+			mod.Modules = append(mod.Modules, child)
+
+			if instance.key.Type() == cty.String && instance.key.IsKnown() {
+				outputs[instance.key.AsString()] = value
+			} else {
+				outputList = append(outputList, value)
+			}
+		}
+
+		switch {
+		case hasAttribute(attrs, "for_each"):
+			res[name] = cty.ObjectVal(outputs)
+		case hasAttribute(attrs, "count"):
+			res[name] = cty.TupleVal(outputList)
+		case len(outputList) == 1:
+			res[name] = outputList[0]
+		default:
+			res[name] = cty.DynamicVal
+		}
+	}
+	return res
+}
+
+// moduleDir returns the directory of local modules and modules that are
+// installed by terraform init
+func (e *evaluator) moduleDir(parentDir string, source string, key string) string {
+	dir := ""
+	switch {
+	case strings.HasPrefix(source, "./") || strings.HasPrefix(source, "../"):
+		dir = filepath.Join(parentDir, source)
+	case e.manifest != nil:
+		for _, record := range e.manifest.Records {
+			if record.Key == key {
+				dir = record.Dir
+				if !filepath.IsAbs(dir) {
+					dir = filepath.Join(e.rootDir, dir)
+				}
+				break
+			}
+		}
+	}
+
+	if dir == "" {
+		return ""
+	}
+	if stat, err := os.Stat(dir); err != nil || !stat.IsDir() {
+		return ""
+	}
+	return dir
+}
+
+func parseModuleDir(dir string) (map[string]*hcl.File, error) {
+	fileList, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	loader := NewHCLFileLoader()
+	if err := loader.ParseHclDirectory(dir, fileList); err != nil {
+		return nil, err
+	}
+	return loader.GetParser().Files(), nil
+}
+
+func (e *evaluator) evalResources(mod *EvaluatedModule, cfg *moduleConfig, ctx *hcl.EvalContext) {
+	for _, r := range cfg.resources {
+		if len(r.block.Labels) < 2 {
+			continue
+		}
+		mode := "managed"
+		address := r.block.Labels[0] + "." + r.block.Labels[1]
+		if r.block.Type == "data" {
+			mode = "data"
+			address = "data." + address
+		}
+		if mod.Address != "" {
+			address = mod.Address + "." + address
+		}
+
+		attrs, _ := r.block.Body.JustAttributes()
+		for _, instance := range expandInstances(attrs, ctx) {
+			mod.Resources = append(mod.Resources, &EvaluatedResource{
+				Address:   address + instance.suffix,
+				Module:    mod.Address,
+				Mode:      mode,
+				Type:      r.block.Labels[0],
+				Name:      r.block.Labels[1],
+				Key:       instance.key,
+				Block:     r.block,
+				File:      r.file,
+				Arguments: evalBody(r.block.Body, instance.ctx, resourceMetaArguments),
+			})
+		}
+	}
+}
+
+func (e *evaluator) evalContext(mod *EvaluatedModule, cfg *moduleConfig, modules map[string]cty.Value) *hcl.EvalContext {
+	modulePath := "."
+	if rel, err := filepath.Rel(e.rootDir, mod.Dir); err == nil {
+		modulePath = rel
+	}
+
+	variables := map[string]cty.Value{
+		"var":    cty.ObjectVal(mod.Variables),
+		"local":  cty.ObjectVal(mod.Locals),
+		"module": cty.ObjectVal(modules),
+		"path": cty.ObjectVal(map[string]cty.Value{
+			"module": cty.StringVal(modulePath),
+			"root":   cty.StringVal("."),
+			"cwd":    cty.StringVal(e.rootDir),
+		}),
+		"terraform": cty.ObjectVal(map[string]cty.Value{
+			"workspace": cty.StringVal("default"),
+		}),
+		// attributes of resources and data sources are only known after apply
+		"data": cty.DynamicVal,
+		"self": cty.DynamicVal,
+	}
+	for _, r := range cfg.resources {
+		if r.block.Type == "resource" && len(r.block.Labels) > 0 {
+			variables[r.block.Labels[0]] = cty.DynamicVal
+		}
+	}
+
+	return &hcl.EvalContext{
+		Variables: variables,
+		Functions: e.functions,
+	}
+}
+
+type instance struct {
+	// key is the count index or for_each key, it is cty.NilVal for blocks
+	// without count and for_each
+	key    cty.Value
+	suffix string
+	ctx    *hcl.EvalContext
+}
+
+// maxCountInstances limits the instances of a resource or module with count, larger
+// counts are treated like unknown counts
+const maxCountInstances = 10000
+
+// expandInstances returns the instances of a block with count or for_each,
+// if these are unknown a single instance with an unknown key is used
+func expandInstances(attrs map[string]*hcl.Attribute, ctx *hcl.EvalContext) []instance {
+	if attr, ok := attrs["count"]; ok {
+		count := evalExpr(attr.Expr, ctx)
+		if v, err := convert.Convert(count, cty.Number); err == nil {
+			count = v
+		}
+		unknown := []instance{newInstance(ctx, "count", cty.UnknownVal(cty.Number), cty.UnknownVal(cty.Number), "")}
+		if !count.IsKnown() || count.IsNull() || count.Type() != cty.Number {
+			return unknown
+		}
+
+		// invalid counts fail the plan in terraform, the resource is evaluated like an unknown count
+		f := count.AsBigFloat()
+		n, _ := f.Int64()
+		if !f.IsInt() || n < 0 || n > maxCountInstances {
+			return unknown
+		}
+		res := make([]instance, 0, n)
+		for i := int64(0); i < n; i++ {
+			key := cty.NumberIntVal(i)
+			res = append(res, newInstance(ctx, "count", key, key, fmt.Sprintf("[%d]", i)))
+		}
+		return res
+	}
+
+	if attr, ok := attrs["for_each"]; ok {
+		forEach := evalExpr(attr.Expr, ctx)
+		ty := forEach.Type()
+		if !forEach.IsWhollyKnown() || forEach.IsNull() || !(ty.IsMapType() || ty.IsObjectType() || ty.IsSetType()) {
+			return []instance{newInstance(ctx, "each", cty.UnknownVal(cty.String), cty.DynamicVal, "")}
+		}
+
+		res := []instance{}
+		it := forEach.ElementIterator()
+		for it.Next() {
+			key, value := it.Element()
+			if k, err := convert.Convert(key, cty.String); err == nil {
+				key = k
+			}
+			if key.IsNull() {
+				continue
+			}
+			res = append(res, newInstance(ctx, "each", key, value, fmt.Sprintf("[%q]", key.AsString())))
+		}
+		return res
+	}
+
+	return []instance{{key: cty.NilVal, ctx: ctx}}
+}
+
+func newInstance(ctx *hcl.EvalContext, kind string, key cty.Value, value cty.Value, suffix string) instance {
+	child := ctx.NewChild()
+	if kind == "count" {
+		child.Variables = map[string]cty.Value{
+			"count": cty.ObjectVal(map[string]cty.Value{"index": key}),
+		}
+	} else {
+		child.Variables = map[string]cty.Value{
+			"each": cty.ObjectVal(map[string]cty.Value{"key": key, "value": value}),
+		}
+	}
+	return instance{key: key, suffix: suffix, ctx: child}
+}
+
+// evalBody evaluates all attributes and nested blocks of a body, blocks of the
+// same type are collected in a list
+func evalBody(body hcl.Body, ctx *hcl.EvalContext, skip map[string]struct{}) map[string]cty.Value {
+	res := map[string]cty.Value{}
+
+	syntaxBody, ok := body.(*hclsyntax.Body)
+	if !ok {
+		// JSON bodies do not distinguish between attributes and blocks
+		attrs, _ := body.JustAttributes()
+		for name, attr := range attrs {
+			if _, ok := skip[name]; ok {
+				continue
+			}
+			res[name] = evalExpr(attr.Expr, ctx)
+		}
+		return res
+	}
+
+	for name, attr := range syntaxBody.Attributes {
+		if _, ok := skip[name]; ok {
+			continue
+		}
+		res[name] = evalExpr(attr.Expr, ctx)
+	}
+
+	blocks := map[string][]cty.Value{}
+	for _, block := range syntaxBody.Blocks {
+		if _, ok := skip[block.Type]; ok {
+			continue
+		}
+		if block.Type == "dynamic" && len(block.Labels) == 1 {
+			name := block.Labels[0]
+			blocks[name] = append(blocks[name], evalDynamicBlock(block, ctx)...)
+			continue
+		}
+		blocks[block.Type] = append(blocks[block.Type], cty.ObjectVal(evalBody(block.Body, ctx, nil)))
+	}
+	for name, values := range blocks {
+		res[name] = cty.TupleVal(values)
+	}
+
+	return res
+}
+
+// evalDynamicBlock expands the content of a dynamic block for each element of
+// its for_each argument
+func evalDynamicBlock(block *hclsyntax.Block, ctx *hcl.EvalContext) []cty.Value {
+	iterator := block.Labels[0]
+	if attr, ok := block.Body.Attributes["iterator"]; ok {
+		if name := hcl.ExprAsKeyword(attr.Expr); name != "" {
+			iterator = name
+		}
+	}
+
+	attr, ok := block.Body.Attributes["for_each"]
+	if !ok {
+		return nil
+	}
+	forEach := evalExpr(attr.Expr, ctx)
+	if !forEach.IsWhollyKnown() || forEach.IsNull() || !forEach.CanIterateElements() {
+		return nil
+	}
+
+	res := []cty.Value{}
+	for _, content := range block.Body.Blocks {
+		if content.Type != "content" {
+			continue
+		}
+		it := forEach.ElementIterator()
+		for it.Next() {
+			key, value := it.Element()
+			child := ctx.NewChild()
+			child.Variables = map[string]cty.Value{
+				iterator: cty.ObjectVal(map[string]cty.Value{"key": key, "value": value}),
+			}
+			res = append(res, cty.ObjectVal(evalBody(content.Body, child, nil)))
+		}
+	}
+	return res
+}
+
+// evalExpr evaluates an expression, expressions that cannot be evaluated,
+// e.g. because they use unsupported functions, are unknown
+func evalExpr(expr hcl.Expression, ctx *hcl.EvalContext) cty.Value {
+	v, diags := expr.Value(ctx)
+	if diags.HasErrors() || v == cty.NilVal {
+		return cty.DynamicVal
+	}
+	return v
+}
+
+func hasAttribute(attrs map[string]*hcl.Attribute, name string) bool {
+	_, ok := attrs[name]
+	return ok
+}
@@ -0,0 +1,149 @@
+package terraform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+	"go.mondoo.com/cnquery/motor/providers"
+)
+
+func findResource(resources []*EvaluatedResource, address string) *EvaluatedResource {
+	for i := range resources {
+		if resources[i].Address == address {
+			return resources[i]
+		}
+	}
+	return nil
+}
+
+// assertCtyEqual compares values independently of the precision of numbers
+func assertCtyEqual(t *testing.T, expected cty.Value, actual cty.Value) {
+	assert.True(t, expected.Equals(actual).True(), "expected %#v, got %#v", expected, actual)
+}
+
+func TestEvaluate(t *testing.T) {
+	p, err := New(&providers.Config{
+		Options: map[string]string{
+			"path": "./testdata/modules",
+		},
+	})
+	require.NoError(t, err)
+
+	root, err := p.Evaluated()
+	require.NoError(t, err)
+
+	t.Run("variables from tfvars", func(t *testing.T) {
+		assert.Equal(t, cty.StringVal("prod"), root.Variables["environment"])
+		assert.Equal(t, cty.ListVal([]cty.Value{cty.StringVal("a"), cty.StringVal("b")}), root.Variables["zones"])
+		assertCtyEqual(t, cty.ListVal([]cty.Value{cty.NumberIntVal(22)}), root.Variables["ingress_ports"])
+	})
+
+	t.Run("locals", func(t *testing.T) {
+		assert.Equal(t, cty.StringVal("prod-app"), root.Locals["prefix"])
+		assert.Equal(t, cty.StringVal("prod-app-vpc"), root.Locals["name"])
+	})
+
+	t.Run("resources", func(t *testing.T) {
+		require.Len(t, root.Resources, 4)
+
+		vpc := findResource(root.Resources, "aws_vpc.main")
+		require.NotNil(t, vpc)
+		assert.Equal(t, "managed", vpc.Mode)
+		assert.Equal(t, cty.NilVal, vpc.Key)
+		assert.Equal(t, cty.ObjectVal(map[string]cty.Value{
+			"Environment": cty.StringVal("prod"),
+			"Name":        cty.StringVal("prod-app-vpc"),
+		}), vpc.Arguments["tags"])
+
+		sg := findResource(root.Resources, `aws_security_group.web["b"]`)
+		require.NotNil(t, sg)
+		assert.Equal(t, cty.StringVal("b"), sg.Key)
+		assert.Equal(t, cty.StringVal("prod-app-b"), sg.Arguments["name"])
+		assert.False(t, sg.Arguments["vpc_id"].IsKnown())
+		assertCtyEqual(t, cty.TupleVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"from_port": cty.NumberIntVal(22),
+				"to_port":   cty.NumberIntVal(22),
+			}),
+		}), sg.Arguments["ingress"])
+		_, ok := sg.Arguments["lifecycle"]
+		assert.False(t, ok)
+
+		ami := findResource(root.Resources, "data.aws_ami.ubuntu")
+		require.NotNil(t, ami)
+		assert.Equal(t, "data", ami.Mode)
+	})
+
+	t.Run("modules", func(t *testing.T) {
+		require.Len(t, root.Modules, 2)
+		subnet := root.Modules[1]
+		assert.Equal(t, "module.subnet[1]", subnet.Address)
+		assert.Equal(t, "./modules/subnet", subnet.Source)
+		assert.Equal(t, cty.StringVal("b"), subnet.Variables["zone"])
+		assert.Equal(t, cty.False, subnet.Variables["public"])
+
+		require.Len(t, subnet.Resources, 1)
+		assert.Equal(t, "module.subnet[1].aws_subnet.this", subnet.Resources[0].Address)
+		assert.Equal(t, "module.subnet[1]", subnet.Resources[0].Module)
+		assert.Equal(t, cty.StringVal("us-east-1b"), subnet.Resources[0].Arguments["availability_zone"])
+
+		assert.Equal(t, cty.TupleVal([]cty.Value{cty.StringVal("prod-app-a"), cty.StringVal("prod-app-b")}), root.Outputs["subnet_names"])
+	})
+}
+
+func TestEvaluate_Overrides(t *testing.T) {
+	p, err := New(&providers.Config{
+		Options: map[string]string{
+			"path":              "./testdata/modules",
+			"var.environment":   "staging",
+			"var.zones":         `["c"]`,
+			"var.ingress_ports": "[80, 443]",
+		},
+	})
+	require.NoError(t, err)
+
+	root, err := p.Evaluated()
+	require.NoError(t, err)
+
+	assert.Equal(t, cty.StringVal("staging-app-vpc"), root.Locals["name"])
+	require.Len(t, root.Modules, 1)
+	assert.Equal(t, cty.StringVal("c"), root.Modules[0].Variables["zone"])
+
+	sg := findResource(root.Resources, `aws_security_group.web["c"]`)
+	require.NotNil(t, sg)
+	assert.Equal(t, 2, sg.Arguments["ingress"].LengthInt())
+}
+
+func TestExpandInstances_Count(t *testing.T) {
+	for expr, expected := range map[string]int{
+		"2":       2,
+		"0":       0,
+		"-1":      -1,
+		"1.5":     -1,
+		"1e12":    -1,
+		"var.foo": -1,
+	} {
+		t.Run(expr, func(t *testing.T) {
+			file, diags := hclsyntax.ParseConfig([]byte("count = "+expr), "main.tf", hcl.InitialPos)
+			require.False(t, diags.HasErrors())
+			attrs, diags := file.Body.JustAttributes()
+			require.False(t, diags.HasErrors())
+
+			instances := expandInstances(attrs, &hcl.EvalContext{})
+			if expected < 0 {
+				// invalid counts are handled like unknown counts
+				require.Len(t, instances, 1)
+				assert.False(t, instances[0].key.IsKnown())
+				return
+			}
+			require.Len(t, instances, expected)
+			for i := range instances {
+				assertCtyEqual(t, cty.NumberIntVal(int64(i)), instances[i].key)
+			}
+		})
+	}
+}
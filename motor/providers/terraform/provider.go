@@ -7,6 +7,8 @@ import (
 	"github.com/rs/zerolog/log"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 
 	"github.com/cockroachdb/errors"
 	"github.com/hashicorp/hcl/v2"
@@ -33,6 +35,7 @@ func New(tc *providers.Config) (*Provider, error) {
 	}
 
 	projectPath := ""
+	rootDir := ""
 	// NOTE: right now we are only supporting to load either state, plan or hcl files but not at the same time
 
 	var assetType terraformAssetType
@@ -95,6 +98,7 @@ func New(tc *providers.Config) (*Provider, error) {
 			}
 
 			modulesManifest, err = ParseTerraformModuleManifest(path)
+			rootDir = path
 		} else {
 			err = loader.ParseHclFile(path)
 			if err != nil {
@@ -105,6 +109,15 @@ func New(tc *providers.Config) (*Provider, error) {
 			if err != nil {
 				return nil, errors.Wrap(err, "could not parse tfvars file")
 			}
+			rootDir = filepath.Dir(path)
+		}
+	}
+
+	// input variables that are set like with -var
+	vars := map[string]string{}
+	for k, v := range tc.Options {
+		if strings.HasPrefix(k, VarOptionPrefix) {
+			vars[strings.TrimPrefix(k, VarOptionPrefix)] = v
 		}
 	}
 
@@ -119,8 +132,10 @@ func New(tc *providers.Config) (*Provider, error) {
 		platformID: platformID,
 		assetType:  assetType,
 
+		rootDir:         rootDir,
 		parsed:          loader.GetParser(),
 		tfVars:          tfVars,
+		vars:            vars,
 		modulesManifest: modulesManifest,
 
 		state: &state,
@@ -134,11 +149,16 @@ func New(tc *providers.Config) (*Provider, error) {
 type Provider struct {
 	platformID      string
 	assetType       terraformAssetType
+	rootDir         string
 	parsed          *hclparse.Parser
 	tfVars          map[string]*hcl.Attribute
+	vars            map[string]string
 	modulesManifest *ModuleManifest
 	state           *State
 	plan            *Plan
+
+	evaluateOnce sync.Once
+	evaluated    *EvaluatedModule
 }
 
 func (t *Provider) Close() {}
@@ -173,6 +193,19 @@ func (t *Provider) ModulesManifest() *ModuleManifest {
 	return t.modulesManifest
 }
 
+// Evaluated returns the configuration with resolved variables, locals and
+// modules, the raw configuration files remain available via Parser
+func (t *Provider) Evaluated() (*EvaluatedModule, error) {
+	if t.assetType != configurationfiles {
+		return nil, errors.New("evaluation is only supported for terraform configuration files")
+	}
+
+	t.evaluateOnce.Do(func() {
+		t.evaluated = Evaluate(t.rootDir, t.parsed.Files(), t.tfVars, t.vars, t.modulesManifest)
+	})
+	return t.evaluated, nil
+}
+
 func (t *Provider) Identifier() (string, error) {
 	return t.platformID, nil
 }
@@ -0,0 +1,63 @@
+variable "environment" {
+  type    = string
+  default = "dev"
+}
+
+variable "zones" {
+  type    = list(string)
+  default = ["a"]
+}
+
+variable "ingress_ports" {
+  type    = list(number)
+  default = [22]
+}
+
+locals {
+  name   = "${local.prefix}-vpc"
+  prefix = "${var.environment}-app"
+  tags = {
+    Environment = var.environment
+  }
+}
+
+resource "aws_vpc" "main" {
+  cidr_block = "10.0.0.0/16"
+  tags       = merge(local.tags, { Name = local.name })
+}
+
+resource "aws_security_group" "web" {
+  for_each = toset(var.zones)
+  name     = "${local.prefix}-${each.key}"
+  vpc_id   = aws_vpc.main.id
+
+  dynamic "ingress" {
+    for_each = var.ingress_ports
+    iterator = port
+    content {
+      from_port = port.value
+      to_port   = port.value
+    }
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+data "aws_ami" "ubuntu" {
+  most_recent = true
+  owners      = ["099720109477"]
+}
+
+module "subnet" {
+  source = "./modules/subnet"
+  count  = length(var.zones)
+  vpc_id = aws_vpc.main.id
+  zone   = var.zones[count.index]
+  name   = local.prefix
+}
+
+output "subnet_names" {
+  value = module.subnet[*].name
+}
@@ -0,0 +1,26 @@
+variable "vpc_id" {
+  type = string
+}
+
+variable "zone" {
+  type = string
+}
+
+variable "name" {
+  type = string
+}
+
+variable "public" {
+  type    = bool
+  default = false
+}
+
+resource "aws_subnet" "this" {
+  vpc_id                  = var.vpc_id
+  availability_zone       = "us-east-1${var.zone}"
+  map_public_ip_on_launch = var.public
+}
+
+output "name" {
+  value = "${var.name}-${var.zone}"
+}
@@ -11,11 +11,15 @@ import (
 	"github.com/spf13/afero"
 	"go.mondoo.com/cnquery/motor/providers"
 	os_provider "go.mondoo.com/cnquery/motor/providers/os"
+	"go.mondoo.com/cnquery/motor/providers/os/hashutil"
 	"go.mondoo.com/cnquery/motor/providers/winrm/cat"
 	"go.mondoo.com/cnquery/motor/vault"
 )
 
-var _ providers.Instance = (*Provider)(nil)
+var (
+	_ providers.Instance     = (*Provider)(nil)
+	_ os_provider.FileHasher = (*Provider)(nil)
+)
 
 func VerifyConfig(pCfg *providers.Config) (*winrm.Endpoint, error) {
 	if pCfg.Backend != providers.ProviderType_WINRM {
@@ -156,6 +160,12 @@ func (p *Provider) FS() afero.Fs {
 	return p.fs
 }
 
+// FileHash calculates the hashsum on the target system via Get-FileHash, so
+// that the file does not need to be transferred
+func (p *Provider) FileHash(path string, algorithm string) (string, error) {
+	return hashutil.WindowsFileHash(p, path, algorithm)
+}
+
 func (p *Provider) Close() {
 	// nothing to do yet
 }
@@ -38,3 +38,24 @@ func TestVault(t *testing.T) {
 	assert.Equal(t, cred.Label, newCred.Label)
 	assert.DeepEqual(t, cred.Data, newCred.Data)
 }
+
+func TestVault_ListDelete(t *testing.T) {
+	v := New()
+	ctx := context.Background()
+
+	for _, key := range []string{"b", "a"} {
+		_, err := v.Set(ctx, &vault.Secret{Key: key, Data: []byte(key)})
+		require.NoError(t, err)
+	}
+
+	ids, err := v.ListSecrets(ctx)
+	require.NoError(t, err)
+	require.Len(t, ids, 2)
+	assert.Equal(t, "a", ids[0].Key)
+	assert.Equal(t, "b", ids[1].Key)
+
+	require.NoError(t, v.DeleteSecret(ctx, &vault.SecretID{Key: "a"}))
+	_, err = v.Get(ctx, &vault.SecretID{Key: "a"})
+	assert.Equal(t, vault.NotFoundError, err)
+	assert.Equal(t, vault.NotFoundError, v.DeleteSecret(ctx, &vault.SecretID{Key: "a"}))
+}
@@ -3,7 +3,10 @@ package keyring
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
 
+	"github.com/mitchellh/go-homedir"
 	"github.com/rs/zerolog/log"
 
 	"github.com/99designs/keyring"
@@ -125,3 +128,128 @@ func (v *Vault) Get(ctx context.Context, id *vault.SecretID) (*vault.Secret, err
 		Encoding: vault.SecretEncoding_encoding_json,
 	}, nil
 }
+
+func (v *Vault) ListSecrets(ctx context.Context) ([]*vault.SecretID, error) {
+	ring, err := v.open()
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := ring.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*vault.SecretID, len(keys))
+	for i := range keys {
+		res[i] = &vault.SecretID{Key: keys[i]}
+	}
+	return res, nil
+}
+
+func (v *Vault) DeleteSecret(ctx context.Context, id *vault.SecretID) error {
+	if id == nil {
+		return errors.New("id cannot be nil")
+	}
+	ring, err := v.open()
+	if err != nil {
+		return err
+	}
+
+	if err := ring.Remove(id.Key); err != nil {
+		log.Debug().Err(err).Msg("could not remove secret from keyring")
+		return vault.NotFoundError
+	}
+	return nil
+}
+
+// RotatePassword re-encrypts all secrets of an encrypted file vault with
+// the new password. All secrets are written to a new directory first, which
+// replaces the vault once all of them are stored, so that a failure never
+// leaves secrets with different passwords behind.
+func (v *Vault) RotatePassword(ctx context.Context, password string) error {
+	if len(v.allowedBackends) != 1 || v.allowedBackends[0] != keyring.FileBackend {
+		return errors.New("only encrypted file vaults support password rotation")
+	}
+
+	ring, err := v.open()
+	if err != nil {
+		return err
+	}
+
+	keys, err := ring.Keys()
+	if err != nil {
+		return err
+	}
+
+	items := make([]keyring.Item, len(keys))
+	for i := range keys {
+		items[i], err = ring.Get(keys[i])
+		if err != nil {
+			return errors.New("cannot decrypt secret '" + keys[i] + "' with the current password")
+		}
+	}
+
+	dir, err := homedir.Expand(v.fileDir)
+	if err != nil {
+		return err
+	}
+	dir = filepath.Clean(dir)
+
+	tmpDir, err := os.MkdirTemp(filepath.Dir(dir), "."+filepath.Base(dir)+"-rotate-")
+	if err != nil {
+		return err
+	}
+	// only cleans up after failures, otherwise the directory was moved
+	defer os.RemoveAll(tmpDir)
+
+	rotated := &Vault{
+		ServiceName:     v.ServiceName,
+		allowedBackends: v.allowedBackends,
+		fileDir:         tmpDir,
+		filePasswordFunc: func(s string) (string, error) {
+			return password, nil
+		},
+	}
+	ring, err = rotated.open()
+	if err != nil {
+		return err
+	}
+	for i := range items {
+		if err := setItem(ring, items[i]); err != nil {
+			return err
+		}
+	}
+
+	if err := swapDir(dir, tmpDir); err != nil {
+		return err
+	}
+
+	v.filePasswordFunc = rotated.filePasswordFunc
+	return nil
+}
+
+// setItem stores a single item, it is replaced in tests to simulate failures
+var setItem = func(ring keyring.Keyring, item keyring.Item) error {
+	return ring.Set(item)
+}
+
+// swapDir replaces dir with newDir. The old directory is moved aside first
+// and restored if newDir cannot be moved in its place.
+func swapDir(dir string, newDir string) error {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return os.Rename(newDir, dir)
+	}
+
+	oldDir := newDir + "-old"
+	if err := os.Rename(dir, oldDir); err != nil {
+		return err
+	}
+	if err := os.Rename(newDir, dir); err != nil {
+		if rerr := os.Rename(oldDir, dir); rerr != nil {
+			log.Error().Err(rerr).Str("path", oldDir).Msg("could not restore vault directory")
+		}
+		return err
+	}
+	return os.RemoveAll(oldDir)
+}
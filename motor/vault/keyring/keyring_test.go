@@ -3,8 +3,13 @@ package keyring
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/99designs/keyring"
 	"github.com/stretchr/testify/require"
 	"go.mondoo.com/cnquery/motor/vault"
 	"gotest.tools/assert"
@@ -40,3 +45,83 @@ func TestEncryptedFile(t *testing.T) {
 	assert.Equal(t, cred.Label, newCred.Label)
 	assert.DeepEqual(t, cred.Data, newCred.Data)
 }
+
+func TestEncryptedFileManagement(t *testing.T) {
+	dir := t.TempDir()
+	v := NewEncryptedFile(dir, "mondoo", "superpassword")
+	ctx := context.Background()
+
+	for _, key := range []string{"secret-a", "secret-b"} {
+		_, err := v.Set(ctx, &vault.Secret{Key: key, Data: []byte(`{"user":"` + key + `"}`)})
+		require.NoError(t, err)
+	}
+
+	ids, err := v.ListSecrets(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(ids))
+
+	require.NoError(t, v.DeleteSecret(ctx, &vault.SecretID{Key: "secret-a"}))
+	ids, err = v.ListSecrets(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ids))
+	assert.Equal(t, "secret-b", ids[0].Key)
+
+	require.NoError(t, v.RotatePassword(ctx, "newpassword"))
+
+	old := NewEncryptedFile(dir, "mondoo", "superpassword")
+	_, err = old.Get(ctx, &vault.SecretID{Key: "secret-b"})
+	require.Error(t, err)
+
+	rotated := NewEncryptedFile(dir, "mondoo", "newpassword")
+	secret, err := rotated.Get(ctx, &vault.SecretID{Key: "secret-b"})
+	require.NoError(t, err)
+	assert.Equal(t, `{"user":"secret-b"}`, string(secret.Data))
+
+	require.Error(t, NewLinuxKernelKeyring("mondoo").RotatePassword(ctx, "pwd"))
+}
+
+func TestEncryptedFileRotatePasswordFailure(t *testing.T) {
+	dir := t.TempDir()
+	v := NewEncryptedFile(dir, "mondoo", "superpassword")
+	ctx := context.Background()
+
+	keys := []string{"secret-a", "secret-b", "secret-c"}
+	for _, key := range keys {
+		_, err := v.Set(ctx, &vault.Secret{Key: key, Data: []byte(`{"user":"` + key + `"}`)})
+		require.NoError(t, err)
+	}
+
+	// fail after the first secret was written with the new password
+	written := 0
+	setItem = func(ring keyring.Keyring, item keyring.Item) error {
+		if written == 1 {
+			return errors.New("disk full")
+		}
+		written++
+		return ring.Set(item)
+	}
+	defer func() {
+		setItem = func(ring keyring.Keyring, item keyring.Item) error {
+			return ring.Set(item)
+		}
+	}()
+
+	require.Error(t, v.RotatePassword(ctx, "newpassword"))
+
+	// all secrets still use the old password
+	current := NewEncryptedFile(dir, "mondoo", "superpassword")
+	for _, key := range keys {
+		secret, err := current.Get(ctx, &vault.SecretID{Key: key})
+		require.NoError(t, err)
+		assert.Equal(t, `{"user":"`+key+`"}`, string(secret.Data))
+	}
+	_, err := v.Get(ctx, &vault.SecretID{Key: "secret-a"})
+	require.NoError(t, err)
+
+	// no temporary directories are left behind
+	entries, err := os.ReadDir(filepath.Dir(dir))
+	require.NoError(t, err)
+	for i := range entries {
+		assert.Assert(t, !strings.Contains(entries[i].Name(), "-rotate-"), entries[i].Name())
+	}
+}
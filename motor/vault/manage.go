@@ -0,0 +1,21 @@
+package vault
+
+import (
+	"context"
+)
+
+// SecretLister is implemented by vaults that can enumerate their secrets
+type SecretLister interface {
+	ListSecrets(ctx context.Context) ([]*SecretID, error)
+}
+
+// SecretDeleter is implemented by vaults that can remove secrets
+type SecretDeleter interface {
+	DeleteSecret(ctx context.Context, id *SecretID) error
+}
+
+// PasswordRotator is implemented by vaults that are protected by a password,
+// eg. encrypted files. It re-encrypts all secrets with the new password.
+type PasswordRotator interface {
+	RotatePassword(ctx context.Context, password string) error
+}
@@ -148,3 +148,57 @@ func TestMqlIfAndProps(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, nil, value.Value)
 }
+
+func TestMqlArrayBuiltins(t *testing.T) {
+	tests := []struct {
+		query     string
+		assertion interface{}
+	}{
+		{"[3,1,2].sort", []interface{}{int64(1), int64(2), int64(3)}},
+		{"['b','c','a'].sort()", []interface{}{"a", "b", "c"}},
+		{"users.sortBy(uid).map(name)", []interface{}{"root", "bin", "chris", "christopher", "chris"}},
+		{"users.sortBy(name).map(uid)", []interface{}{int64(1), int64(1000), int64(1002), int64(1000), int64(0)}},
+		{"users.list.sortBy(uid).take(2).map(name)", []interface{}{"root", "bin"}},
+		{"[[1,2],[3]].flat", []interface{}{int64(1), int64(2), int64(3)}},
+		{"users.groupBy(name)['chris'].length", int64(2)},
+		{"users.groupBy(uid).keys.sort", []interface{}{"0", "1", "1000", "1002"}},
+		{"[1,2,3].sum", int64(6)},
+		{"[1.5,2.5].sum", float64(4)},
+		{"[1,2,3,4].avg", float64(2.5)},
+		{"[3,1,2].min", int64(1)},
+		{"['a','c','b'].max", "c"},
+		{"users.map(uid).max", int64(1002)},
+		{"[1,2,3,4].skip(1).take(2)", []interface{}{int64(2), int64(3)}},
+		{"[1,2].take(5)", []interface{}{int64(1), int64(2)}},
+	}
+
+	for i := range tests {
+		one := tests[i]
+		t.Run(one.query, func(t *testing.T) {
+			runtime := initRuntime()
+			res, err := Exec(one.query, runtime, features, nil)
+			require.NoError(t, err)
+			require.NoError(t, res.Error)
+			assert.Equal(t, one.assertion, res.Value)
+		})
+	}
+}
+
+func TestMqlArrayBuiltins_Errors(t *testing.T) {
+	tests := []string{
+		"users.list.sort",
+		"[1,2].flat",
+		"['a'].sum",
+		"users.list.sortBy(sshkeys)",
+		"[1,2].take('a')",
+	}
+
+	for i := range tests {
+		query := tests[i]
+		t.Run(query, func(t *testing.T) {
+			runtime := initRuntime()
+			_, err := Exec(query, runtime, features, nil)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -17,11 +17,13 @@ type compileHandler struct {
 
 var (
 	childType       = func(t types.Type) types.Type { return t.Child() }
+	sameType        = func(t types.Type) types.Type { return t }
 	arrayBlockType  = func(t types.Type) types.Type { return types.Array(types.Map(types.Int, types.Block)) }
 	boolType        = func(t types.Type) types.Type { return types.Bool }
 	intType         = func(t types.Type) types.Type { return types.Int }
 	stringType      = func(t types.Type) types.Type { return types.String }
 	stringArrayType = func(t types.Type) types.Type { return types.Array(types.String) }
+	stringMapType   = func(t types.Type) types.Type { return types.Map(types.String, types.String) }
 	dictType        = func(t types.Type) types.Type { return types.Dict }
 	blockType       = func(t types.Type) types.Type { return types.Block }
 	dictArrayType   = func(t types.Type) types.Type { return types.Array(types.Dict) }
@@ -32,15 +34,26 @@ var builtinFunctions map[types.Type]map[string]compileHandler
 func init() {
 	builtinFunctions = map[types.Type]map[string]compileHandler{
 		types.String: {
-			"contains":  {compile: compileStringContains, typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
-			"find":      {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
-			"length":    {typ: intType, signature: FunctionSignature{}},
-			"camelcase": {typ: stringType, signature: FunctionSignature{}},
-			"downcase":  {typ: stringType, signature: FunctionSignature{}},
-			"upcase":    {typ: stringType, signature: FunctionSignature{}},
-			"lines":     {typ: stringArrayType, signature: FunctionSignature{}},
-			"split":     {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
-			"trim":      {typ: stringType, signature: FunctionSignature{Required: 0, Args: []types.Type{types.String}}},
+			"contains":     {compile: compileStringContains, typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"find":         {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
+			"length":       {typ: intType, signature: FunctionSignature{}},
+			"camelcase":    {typ: stringType, signature: FunctionSignature{}},
+			"downcase":     {typ: stringType, signature: FunctionSignature{}},
+			"upcase":       {typ: stringType, signature: FunctionSignature{}},
+			"lines":        {typ: stringArrayType, signature: FunctionSignature{}},
+			"split":        {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"trim":         {typ: stringType, signature: FunctionSignature{Required: 0, Args: []types.Type{types.String}}},
+			"replace":      {compile: compileStringReplace, signature: FunctionSignature{Required: 2, Args: []types.Type{types.Any, types.String}}},
+			"startsWith":   {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"endsWith":     {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"trimPrefix":   {typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"trimSuffix":   {typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"substring":    {typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int, types.Int}}},
+			"capture":      {typ: stringMapType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
+			"format":       {compile: compileStringFormat, signature: FunctionSignature{}},
+			"decodeBase64": {typ: stringType, signature: FunctionSignature{}},
+			"decodeHex":    {typ: stringType, signature: FunctionSignature{}},
+			"sha256":       {typ: stringType, signature: FunctionSignature{}},
 		},
 		types.Time: {
 			"seconds": {typ: intType, signature: FunctionSignature{}},
@@ -53,15 +66,26 @@ func init() {
 			"[]": {typ: dictType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Any}}},
 			"{}": {typ: blockType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			// string-ish
-			"contains":  {compile: compileStringContains, typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
-			"find":      {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
-			"length":    {typ: intType, signature: FunctionSignature{}},
-			"camelcase": {typ: stringType, signature: FunctionSignature{}},
-			"downcase":  {typ: stringType, signature: FunctionSignature{}},
-			"upcase":    {typ: stringType, signature: FunctionSignature{}},
-			"lines":     {typ: stringArrayType, signature: FunctionSignature{}},
-			"split":     {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
-			"trim":      {typ: stringType, signature: FunctionSignature{Required: 0, Args: []types.Type{types.String}}},
+			"contains":     {compile: compileStringContains, typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"find":         {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
+			"length":       {typ: intType, signature: FunctionSignature{}},
+			"camelcase":    {typ: stringType, signature: FunctionSignature{}},
+			"downcase":     {typ: stringType, signature: FunctionSignature{}},
+			"upcase":       {typ: stringType, signature: FunctionSignature{}},
+			"lines":        {typ: stringArrayType, signature: FunctionSignature{}},
+			"split":        {typ: stringArrayType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"trim":         {typ: stringType, signature: FunctionSignature{Required: 0, Args: []types.Type{types.String}}},
+			"replace":      {compile: compileStringReplace, signature: FunctionSignature{Required: 2, Args: []types.Type{types.Any, types.String}}},
+			"startsWith":   {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"endsWith":     {typ: boolType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"trimPrefix":   {typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"trimSuffix":   {typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
+			"substring":    {typ: stringType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int, types.Int}}},
+			"capture":      {typ: stringMapType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Regex}}},
+			"format":       {compile: compileStringFormat, signature: FunctionSignature{}},
+			"decodeBase64": {typ: stringType, signature: FunctionSignature{}},
+			"decodeHex":    {typ: stringType, signature: FunctionSignature{}},
+			"sha256":       {typ: stringType, signature: FunctionSignature{}},
 			// array-ish
 			"where": {compile: compileWhere, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			"all":   {compile: compileArrayAll, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
@@ -90,6 +114,16 @@ func init() {
 			"one":          {compile: compileArrayOne, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			"none":         {compile: compileArrayNone, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
 			"map":          {compile: compileArrayMap, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
+			"sort":         {compile: compileArraySort, signature: FunctionSignature{}},
+			"sortBy":       {compile: compileArraySortBy, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
+			"flat":         {compile: compileArrayFlat, signature: FunctionSignature{}},
+			"groupBy":      {compile: compileArrayGroupBy, signature: FunctionSignature{Required: 1, Args: []types.Type{types.FunctionLike}}},
+			"sum":          {compile: compileArraySum, signature: FunctionSignature{}},
+			"min":          {compile: compileArrayMinMax, signature: FunctionSignature{}},
+			"max":          {compile: compileArrayMinMax, signature: FunctionSignature{}},
+			"avg":          {compile: compileArrayAvg, signature: FunctionSignature{}},
+			"take":         {typ: sameType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int}}},
+			"skip":         {typ: sameType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int}}},
 		},
 		types.MapLike: {
 			"[]":     {typ: childType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.String}}},
@@ -488,3 +488,185 @@ func compileArrayMap(c *compiler, typ types.Type, ref uint64, id string, call *p
 	})
 	return types.Array(mappedType), nil
 }
+
+// compileArrayBlockCall compiles a function on an array that takes one function
+// block, e.g. sortBy(size). It returns the arguments of the call, the binding
+// and the type of the value that is returned by the function block.
+func compileArrayBlockCall(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) ([]*llx.Primitive, uint64, types.Type, error) {
+	if call == nil || len(call.Function) == 0 {
+		return nil, 0, types.Nil, errors.New("missing argument for calling '" + id + "'")
+	}
+	if len(call.Function) > 1 {
+		return nil, 0, types.Nil, errors.New("too many arguments when calling '" + id + "', only 1 is supported")
+	}
+
+	arg := call.Function[0]
+	if arg.Name != "" {
+		return nil, 0, types.Nil, errors.New("called '" + id + "' with a named parameter, which is not supported")
+	}
+
+	refs, err := c.blockExpressions([]*parser.Expression{arg.Value}, typ, ref)
+	if err != nil {
+		return nil, 0, types.Nil, err
+	}
+	if refs.block == 0 {
+		return nil, 0, types.Nil, errors.New("called '" + id + "' without a function block")
+	}
+
+	block := c.Result.CodeV2.Block(refs.block)
+	if len(block.Entrypoints) != 1 {
+		return nil, 0, types.Nil, errors.New("called '" + id + "' with a bad function block, you can only return 1 value")
+	}
+	blockType := c.Result.CodeV2.DereferencedBlockType(block)
+
+	args := []*llx.Primitive{
+		llx.RefPrimitiveV2(refs.binding),
+		llx.FunctionPrimitive(refs.block),
+	}
+	for _, v := range refs.deps {
+		if c.isInMyBlock(v) {
+			args = append(args, llx.RefPrimitiveV2(v))
+		}
+	}
+	c.blockDeps = append(c.blockDeps, refs.deps...)
+
+	return args, refs.binding, blockType, nil
+}
+
+func compileArraySort(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call != nil && len(call.Function) > 0 {
+		return types.Nil, errors.New("too many arguments when calling '" + id + "', use sortBy to sort by a field")
+	}
+
+	ct := typ.Child()
+	if _, ok := types.Less[ct]; !ok {
+		return types.Nil, errors.New("cannot sort array of " + ct.Label() + ", try using sortBy")
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(typ),
+			Binding: ref,
+		},
+	})
+	return typ, nil
+}
+
+func compileArraySortBy(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	args, binding, keyType, err := compileArrayBlockCall(c, typ, ref, id, call)
+	if err != nil {
+		return types.Nil, err
+	}
+	if _, ok := types.Less[keyType]; !ok {
+		return types.Nil, errors.New("cannot sort by values of type " + keyType.Label())
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(typ),
+			Binding: binding,
+			Args:    args,
+		},
+	})
+	return typ, nil
+}
+
+func compileArrayGroupBy(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	args, binding, keyType, err := compileArrayBlockCall(c, typ, ref, id, call)
+	if err != nil {
+		return types.Nil, err
+	}
+	switch keyType {
+	case types.Bool, types.Int, types.Float, types.String, types.Dict:
+	default:
+		return types.Nil, errors.New("cannot group by values of type " + keyType.Label())
+	}
+
+	resType := types.Map(types.String, typ)
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(resType),
+			Binding: binding,
+			Args:    args,
+		},
+	})
+	return resType, nil
+}
+
+func compileArrayFlat(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call != nil && len(call.Function) > 0 {
+		return types.Nil, errors.New("too many arguments when calling '" + id + "'")
+	}
+
+	var resType types.Type
+	ct := typ.Child()
+	switch {
+	case ct.IsArray():
+		resType = ct
+	case ct == types.Dict:
+		resType = typ
+	default:
+		return types.Nil, errors.New("cannot flatten array of " + ct.Label() + ", it needs to contain lists")
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(resType),
+			Binding: ref,
+		},
+	})
+	return resType, nil
+}
+
+// compileArrayAggregate compiles functions that reduce an array to one value,
+// like sum, min, max and avg
+func compileArrayAggregate(resTypes map[types.Type]types.Type) func(*compiler, types.Type, uint64, string, *parser.Call) (types.Type, error) {
+	return func(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+		if call != nil && len(call.Function) > 0 {
+			return types.Nil, errors.New("too many arguments when calling '" + id + "'")
+		}
+
+		resType, ok := resTypes[typ.Child()]
+		if !ok {
+			return types.Nil, errors.New("cannot call '" + id + "' on array of " + typ.Child().Label())
+		}
+
+		c.addChunk(&llx.Chunk{
+			Call: llx.Chunk_FUNCTION,
+			Id:   id,
+			Function: &llx.Function{
+				Type:    string(resType),
+				Binding: ref,
+			},
+		})
+		return resType, nil
+	}
+}
+
+var (
+	compileArraySum = compileArrayAggregate(map[types.Type]types.Type{
+		types.Int:   types.Int,
+		types.Float: types.Float,
+		types.Dict:  types.Float,
+	})
+	compileArrayAvg = compileArrayAggregate(map[types.Type]types.Type{
+		types.Int:   types.Float,
+		types.Float: types.Float,
+		types.Dict:  types.Float,
+	})
+	compileArrayMinMax = compileArrayAggregate(map[types.Type]types.Type{
+		types.Int:    types.Int,
+		types.Float:  types.Float,
+		types.String: types.String,
+		types.Time:   types.Time,
+		types.Dict:   types.Dict,
+	})
+)
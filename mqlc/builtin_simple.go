@@ -77,3 +77,71 @@ func compileStringContains(c *compiler, typ types.Type, ref uint64, id string, c
 		return types.Nil, errors.New("cannot find #string.contains with this type " + types.Type(val.Type).Label())
 	}
 }
+
+func compileStringReplace(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	if call == nil || len(call.Function) != 2 {
+		return types.Nil, errors.New("function " + id + " needs two arguments")
+	}
+
+	args := make([]*llx.Primitive, len(call.Function))
+	for i := range call.Function {
+		arg, err := c.compileExpression(call.Function[i].Value)
+		if err != nil {
+			return types.Nil, err
+		}
+		args[i] = arg
+	}
+
+	searchType, err := c.dereferenceType(args[0])
+	if err != nil {
+		return types.Nil, err
+	}
+	if searchType != types.String && searchType != types.Regex && searchType != types.Dict {
+		return types.Nil, errors.New("function " + id + " can only replace strings or regular expressions, got " + searchType.Label())
+	}
+
+	replaceType, err := c.dereferenceType(args[1])
+	if err != nil {
+		return types.Nil, err
+	}
+	if replaceType != types.String && replaceType != types.Dict {
+		return types.Nil, errors.New("function " + id + " needs a string replacement, got " + replaceType.Label())
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(types.String),
+			Binding: ref,
+			Args:    args,
+		},
+	})
+	return types.String, nil
+}
+
+// compileStringFormat accepts any number of arguments, which are passed
+// to the format string in the given order
+func compileStringFormat(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	var args []*llx.Primitive
+	if call != nil {
+		for i := range call.Function {
+			arg, err := c.compileExpression(call.Function[i].Value)
+			if err != nil {
+				return types.Nil, err
+			}
+			args = append(args, arg)
+		}
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(types.String),
+			Binding: ref,
+			Args:    args,
+		},
+	})
+	return types.String, nil
+}
@@ -1002,6 +1002,18 @@ func (c *compiler) compileBoundIdentifierWithMqlCtx(id string, binding *variable
 	}
 
 	h, _ := builtinFunction(typ, id)
+	if h == nil {
+		// list resources can use all array builtins on their list of items
+		var bind *variable
+		var err error
+		h, bind, err = c.compileImplicitBuiltin(typ, id)
+		if err != nil {
+			return true, types.Nil, err
+		}
+		if bind != nil {
+			binding = bind
+		}
+	}
 	if h != nil {
 		call = filterTrailingNullArgs(call)
 		typ, err := c.compileBuiltinFunction(h, id, binding, call)
@@ -1067,6 +1079,18 @@ func (c *compiler) compileBoundIdentifierWithoutMqlCtx(id string, binding *varia
 	}
 
 	h, _ := builtinFunction(typ, id)
+	if h == nil {
+		// list resources can use all array builtins on their list of items
+		var bind *variable
+		var err error
+		h, bind, err = c.compileImplicitBuiltin(typ, id)
+		if err != nil {
+			return true, types.Nil, err
+		}
+		if bind != nil {
+			binding = bind
+		}
+	}
 	if h != nil {
 		call = filterTrailingNullArgs(call)
 		typ, err := c.compileBuiltinFunction(h, id, binding, call)
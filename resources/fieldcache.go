@@ -0,0 +1,257 @@
+package resources
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// FieldCache persists computed resource fields beyond the lifetime of a
+// runtime, e.g. to speed up repeated runs against slow API providers.
+// Implementations decide how long entries stay valid.
+type FieldCache interface {
+	Load(key string) ([]byte, bool)
+	Store(key string, data []byte)
+}
+
+// cachedValue is the serialized form of a field value. Resources are
+// stored with their primitive fields, which are used to recreate them.
+type cachedValue struct {
+	Kind     string                  `json:"k"`
+	String   string                  `json:"s,omitempty"`
+	Int      int64                   `json:"i,omitempty"`
+	Float    float64                 `json:"f,omitempty"`
+	Bool     bool                    `json:"b,omitempty"`
+	Array    []*cachedValue          `json:"a,omitempty"`
+	Map      map[string]*cachedValue `json:"m,omitempty"`
+	Resource *cachedResource         `json:"r,omitempty"`
+}
+
+type cachedResource struct {
+	Name   string                  `json:"name"`
+	Id     string                  `json:"id"`
+	Fields map[string]*cachedValue `json:"fields"`
+}
+
+const (
+	kindNil      = "nil"
+	kindString   = "string"
+	kindInt      = "int"
+	kindFloat    = "float"
+	kindBool     = "bool"
+	kindTime     = "time"
+	kindArray    = "array"
+	kindMap      = "map"
+	kindResource = "resource"
+)
+
+var errNotCacheable = errors.New("value cannot be cached")
+
+func encodeCachedValue(v interface{}, withResources bool) (*cachedValue, error) {
+	switch x := v.(type) {
+	case nil:
+		return &cachedValue{Kind: kindNil}, nil
+	case string:
+		return &cachedValue{Kind: kindString, String: x}, nil
+	case int64:
+		return &cachedValue{Kind: kindInt, Int: x}, nil
+	case float64:
+		return &cachedValue{Kind: kindFloat, Float: x}, nil
+	case bool:
+		return &cachedValue{Kind: kindBool, Bool: x}, nil
+	case *time.Time:
+		if x == nil {
+			return &cachedValue{Kind: kindNil}, nil
+		}
+		return &cachedValue{Kind: kindTime, String: x.Format(time.RFC3339Nano)}, nil
+	case []interface{}:
+		res := &cachedValue{Kind: kindArray, Array: make([]*cachedValue, len(x))}
+		for i := range x {
+			child, err := encodeCachedValue(x[i], withResources)
+			if err != nil {
+				return nil, err
+			}
+			res.Array[i] = child
+		}
+		return res, nil
+	case map[string]interface{}:
+		res := &cachedValue{Kind: kindMap, Map: make(map[string]*cachedValue, len(x))}
+		for k := range x {
+			child, err := encodeCachedValue(x[k], withResources)
+			if err != nil {
+				return nil, err
+			}
+			res.Map[k] = child
+		}
+		return res, nil
+	case ResourceType:
+		if !withResources {
+			return nil, errNotCacheable
+		}
+		return encodeCachedResource(x.MqlResource())
+	default:
+		return nil, errNotCacheable
+	}
+}
+
+// encodeCachedResource stores all computed fields of a resource, that don't
+// reference other resources. This avoids cycles between resources.
+func encodeCachedResource(r *Resource) (*cachedValue, error) {
+	res := &cachedResource{
+		Name:   r.Name,
+		Id:     r.Id,
+		Fields: map[string]*cachedValue{},
+	}
+
+	r.Cache.Range(func(key, value interface{}) bool {
+		entry, ok := value.(*CacheEntry)
+		if !ok || !entry.Valid || entry.Error != nil {
+			return true
+		}
+		field, err := encodeCachedValue(entry.Data, false)
+		if err != nil {
+			return true
+		}
+		res.Fields[key.(string)] = field
+		return true
+	})
+
+	return &cachedValue{Kind: kindResource, Resource: res}, nil
+}
+
+func (ctx *Runtime) decodeCachedValue(v *cachedValue) (interface{}, error) {
+	switch v.Kind {
+	case kindNil:
+		return nil, nil
+	case kindString:
+		return v.String, nil
+	case kindInt:
+		return v.Int, nil
+	case kindFloat:
+		return v.Float, nil
+	case kindBool:
+		return v.Bool, nil
+	case kindTime:
+		t, err := time.Parse(time.RFC3339Nano, v.String)
+		if err != nil {
+			return nil, err
+		}
+		return &t, nil
+	case kindArray:
+		res := make([]interface{}, len(v.Array))
+		for i := range v.Array {
+			child, err := ctx.decodeCachedValue(v.Array[i])
+			if err != nil {
+				return nil, err
+			}
+			res[i] = child
+		}
+		return res, nil
+	case kindMap:
+		res := make(map[string]interface{}, len(v.Map))
+		for k := range v.Map {
+			child, err := ctx.decodeCachedValue(v.Map[k])
+			if err != nil {
+				return nil, err
+			}
+			res[k] = child
+		}
+		return res, nil
+	case kindResource:
+		if v.Resource == nil {
+			return nil, errors.New("cached resource is missing")
+		}
+		args := make([]interface{}, 0, len(v.Resource.Fields)*2)
+		for field, value := range v.Resource.Fields {
+			data, err := ctx.decodeCachedValue(value)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, field, data)
+		}
+		return ctx.CreateResourceWithID(v.Resource.Name, v.Resource.Id, args...)
+	default:
+		return nil, errors.New("unknown cached value kind '" + v.Kind + "'")
+	}
+}
+
+// fieldCacheKey identifies a field across runs. Assets without a platform ID
+// cannot be identified and are never cached.
+func (ctx *Runtime) fieldCacheKey(r *Resource, field string) (string, bool) {
+	if ctx.FieldCache == nil {
+		return "", false
+	}
+	asset := ctx.Motor.GetAsset()
+	if asset == nil || len(asset.PlatformIds) == 0 {
+		return "", false
+	}
+	return asset.PlatformIds[0] + "\x00" + r.Name + "\x00" + r.Id + "\x00" + field, true
+}
+
+// loadFromFieldCache fills the field of the resource from the field cache.
+// It returns true if the field was found.
+func (ctx *Runtime) loadFromFieldCache(r *Resource, field string) bool {
+	key, ok := ctx.fieldCacheKey(r, field)
+	if !ok {
+		return false
+	}
+
+	if entry, ok := r.Cache.Load(field); ok && (entry.Valid || entry.Error != nil) {
+		return false
+	}
+
+	raw, ok := ctx.FieldCache.Load(key)
+	if !ok {
+		return false
+	}
+
+	var value cachedValue
+	if err := json.Unmarshal(raw, &value); err != nil {
+		log.Debug().Err(err).Str("resource", r.UID()).Str("field", field).Msg("field cache> failed to read cached field")
+		return false
+	}
+	data, err := ctx.decodeCachedValue(&value)
+	if err != nil {
+		log.Debug().Err(err).Str("resource", r.UID()).Str("field", field).Msg("field cache> failed to restore cached field")
+		return false
+	}
+
+	log.Trace().Str("resource", r.UID()).Str("field", field).Msg("field cache> use cached field")
+	r.Cache.Store(field, &CacheEntry{Data: data, Valid: true, Timestamp: time.Now().Unix()})
+	return true
+}
+
+// storeInFieldCache persists the computed value of the field. Errors are
+// never cached, so that they are retried on the next run.
+func (ctx *Runtime) storeInFieldCache(r *Resource, field string) {
+	key, ok := ctx.fieldCacheKey(r, field)
+	if !ok {
+		return
+	}
+
+	entry, ok := r.Cache.Load(field)
+	if !ok || !entry.Valid || entry.Error != nil {
+		return
+	}
+
+	value, err := encodeCachedValue(entry.Data, true)
+	if err != nil {
+		return
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+	ctx.FieldCache.Store(key, raw)
+}
+
+// compute a field and persist its value in the field cache
+func (ctx *Runtime) compute(r ResourceType, field string) error {
+	err := r.Compute(field)
+	if err == nil {
+		ctx.storeInFieldCache(r.MqlResource(), field)
+	}
+	return err
+}
@@ -4,6 +4,7 @@ import (
 	"go.mondoo.com/cnquery/resources/packs/all/info"
 	"go.mondoo.com/cnquery/resources/packs/aws"
 	"go.mondoo.com/cnquery/resources/packs/azure"
+	"go.mondoo.com/cnquery/resources/packs/cloudformation"
 	"go.mondoo.com/cnquery/resources/packs/core"
 	"go.mondoo.com/cnquery/resources/packs/gcp"
 	"go.mondoo.com/cnquery/resources/packs/github"
@@ -33,6 +34,7 @@ func init() {
 	Registry.Add(github.Registry)
 	Registry.Add(gitlab.Registry)
 	Registry.Add(terraform.Registry)
+	Registry.Add(cloudformation.Registry)
 	Registry.Add(k8s.Registry)
 	Registry.Add(vsphere.Registry)
 }
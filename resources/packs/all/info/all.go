@@ -15,6 +15,7 @@ import (
 	"go.mondoo.com/cnquery/resources/lr/docs"
 	awsInfo "go.mondoo.com/cnquery/resources/packs/aws/info"
 	azureInfo "go.mondoo.com/cnquery/resources/packs/azure/info"
+	cloudformationInfo "go.mondoo.com/cnquery/resources/packs/cloudformation/info"
 	coreInfo "go.mondoo.com/cnquery/resources/packs/core/info"
 	gcpInfo "go.mondoo.com/cnquery/resources/packs/gcp/info"
 	githubInfo "go.mondoo.com/cnquery/resources/packs/github/info"
@@ -52,6 +53,7 @@ func init() {
 		githubInfo.ResourceDocs,
 		gitlabInfo.ResourceDocs,
 		terraformInfo.ResourceDocs,
+		cloudformationInfo.ResourceDocs,
 		k8sInfo.ResourceDocs,
 		vsphereInfo.ResourceDocs,
 	)
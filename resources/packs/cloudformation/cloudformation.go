@@ -0,0 +1,198 @@
+package cloudformation
+
+import (
+	"errors"
+
+	"go.mondoo.com/cnquery/motor/providers"
+	"go.mondoo.com/cnquery/motor/providers/cloudformation"
+	"go.mondoo.com/cnquery/resources/packs/cloudformation/info"
+	"go.mondoo.com/cnquery/resources/packs/core"
+)
+
+var Registry = info.Registry
+
+func init() {
+	Init(Registry)
+}
+
+func cloudformationProvider(t providers.Instance) (*cloudformation.Provider, error) {
+	p, ok := t.(*cloudformation.Provider)
+	if !ok {
+		return nil, errors.New("cloudformation resource is not supported on this transport")
+	}
+	return p, nil
+}
+
+func (t *mqlCloudformationTemplate) id() (string, error) {
+	return "cloudformation.template", nil
+}
+
+func (t *mqlCloudformationTemplate) template() (*cloudformation.Template, error) {
+	p, err := cloudformationProvider(t.MotorRuntime.Motor.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return p.Template(), nil
+}
+
+func (t *mqlCloudformationTemplate) GetVersion() (string, error) {
+	tpl, err := t.template()
+	if err != nil {
+		return "", err
+	}
+	return tpl.Version, nil
+}
+
+func (t *mqlCloudformationTemplate) GetDescription() (string, error) {
+	tpl, err := t.template()
+	if err != nil {
+		return "", err
+	}
+	return tpl.Description, nil
+}
+
+func (t *mqlCloudformationTemplate) GetTransform() ([]interface{}, error) {
+	tpl, err := t.template()
+	if err != nil {
+		return nil, err
+	}
+	return core.StrSliceToInterface(tpl.Transform), nil
+}
+
+func (t *mqlCloudformationTemplate) GetMetadata() (interface{}, error) {
+	tpl, err := t.template()
+	if err != nil {
+		return nil, err
+	}
+	return tpl.Metadata, nil
+}
+
+func (t *mqlCloudformationTemplate) GetMappings() (interface{}, error) {
+	tpl, err := t.template()
+	if err != nil {
+		return nil, err
+	}
+	return tpl.Mappings, nil
+}
+
+func (t *mqlCloudformationTemplate) GetConditions() (interface{}, error) {
+	tpl, err := t.template()
+	if err != nil {
+		return nil, err
+	}
+	return tpl.Conditions, nil
+}
+
+func (t *mqlCloudformationTemplate) GetContent() (interface{}, error) {
+	tpl, err := t.template()
+	if err != nil {
+		return nil, err
+	}
+	return tpl.Raw, nil
+}
+
+func (t *mqlCloudformationTemplate) GetParameters() ([]interface{}, error) {
+	tpl, err := t.template()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(tpl.Parameters))
+	for i := range tpl.Parameters {
+		p := tpl.Parameters[i]
+		allowedValues := p.AllowedValues
+		if allowedValues == nil {
+			allowedValues = []interface{}{}
+		}
+		mqlParameter, err := t.MotorRuntime.CreateResource("cloudformation.parameter",
+			"name", p.Name,
+			"type", p.Type,
+			"description", p.Description,
+			"default", p.Default,
+			"allowedValues", allowedValues,
+			"allowedPattern", p.AllowedPattern,
+			"noEcho", p.NoEcho,
+			"properties", p.Properties,
+		)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = mqlParameter
+	}
+	return res, nil
+}
+
+func (t *mqlCloudformationTemplate) GetResources() ([]interface{}, error) {
+	tpl, err := t.template()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(tpl.Resources))
+	for i := range tpl.Resources {
+		r := tpl.Resources[i]
+		mqlResource, err := t.MotorRuntime.CreateResource("cloudformation.resource",
+			"name", r.Name,
+			"type", r.Type,
+			"condition", r.Condition,
+			"dependsOn", core.StrSliceToInterface(r.DependsOn),
+			"deletionPolicy", r.DeletionPolicy,
+			"updateReplacePolicy", r.UpdateReplacePolicy,
+			"metadata", r.Metadata,
+			"properties", r.Properties,
+		)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = mqlResource
+	}
+	return res, nil
+}
+
+func (t *mqlCloudformationTemplate) GetOutputs() ([]interface{}, error) {
+	tpl, err := t.template()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(tpl.Outputs))
+	for i := range tpl.Outputs {
+		o := tpl.Outputs[i]
+		mqlOutput, err := t.MotorRuntime.CreateResource("cloudformation.output",
+			"name", o.Name,
+			"description", o.Description,
+			"value", o.Value,
+			"exportName", o.ExportName,
+			"condition", o.Condition,
+		)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = mqlOutput
+	}
+	return res, nil
+}
+
+func (p *mqlCloudformationParameter) id() (string, error) {
+	name, err := p.Name()
+	if err != nil {
+		return "", err
+	}
+	return "cloudformation.parameter/" + name, nil
+}
+
+func (r *mqlCloudformationResource) id() (string, error) {
+	name, err := r.Name()
+	if err != nil {
+		return "", err
+	}
+	return "cloudformation.resource/" + name, nil
+}
+
+func (o *mqlCloudformationOutput) id() (string, error) {
+	name, err := o.Name()
+	if err != nil {
+		return "", err
+	}
+	return "cloudformation.output/" + name, nil
+}
@@ -0,0 +1,1503 @@
+// Code generated by resources. DO NOT EDIT.
+package cloudformation
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mondoo.com/cnquery/resources"
+	"github.com/rs/zerolog/log"
+)
+
+// Init all resources into the registry
+func Init(registry *resources.Registry) {
+	registry.AddFactory("cloudformation.template", newCloudformationTemplate)
+	registry.AddFactory("cloudformation.parameter", newCloudformationParameter)
+	registry.AddFactory("cloudformation.resource", newCloudformationResource)
+	registry.AddFactory("cloudformation.output", newCloudformationOutput)
+}
+
+// CloudformationTemplate resource interface
+type CloudformationTemplate interface {
+	MqlResource() (*resources.Resource)
+	Compute(string) error
+	Field(string) (interface{}, error)
+	Register(string) error
+	Validate() error
+	Version() (string, error)
+	Description() (string, error)
+	Transform() ([]interface{}, error)
+	Metadata() (interface{}, error)
+	Mappings() (interface{}, error)
+	Conditions() (interface{}, error)
+	Parameters() ([]interface{}, error)
+	Resources() ([]interface{}, error)
+	Outputs() ([]interface{}, error)
+	Content() (interface{}, error)
+}
+
+// mqlCloudformationTemplate for the cloudformation.template resource
+type mqlCloudformationTemplate struct {
+	*resources.Resource
+}
+
+// MqlResource to retrieve the underlying resource info
+func (s *mqlCloudformationTemplate) MqlResource() *resources.Resource {
+	return s.Resource
+}
+
+// create a new instance of the cloudformation.template resource
+func newCloudformationTemplate(runtime *resources.Runtime, args *resources.Args) (interface{}, error) {
+	// User hooks
+	var err error
+	res := mqlCloudformationTemplate{runtime.NewResource("cloudformation.template")}
+	// assign all named fields
+	var id string
+
+	now := time.Now().Unix()
+	for name, val := range *args {
+		if val == nil {
+			res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+			continue
+		}
+
+		switch name {
+		case "version":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.template\", its \"version\" argument has the wrong type (expected type \"string\")")
+			}
+		case "description":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.template\", its \"description\" argument has the wrong type (expected type \"string\")")
+			}
+		case "transform":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.template\", its \"transform\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
+		case "metadata":
+			if _, ok := val.(interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.template\", its \"metadata\" argument has the wrong type (expected type \"interface{}\")")
+			}
+		case "mappings":
+			if _, ok := val.(interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.template\", its \"mappings\" argument has the wrong type (expected type \"interface{}\")")
+			}
+		case "conditions":
+			if _, ok := val.(interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.template\", its \"conditions\" argument has the wrong type (expected type \"interface{}\")")
+			}
+		case "parameters":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.template\", its \"parameters\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
+		case "resources":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.template\", its \"resources\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
+		case "outputs":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.template\", its \"outputs\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
+		case "content":
+			if _, ok := val.(interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.template\", its \"content\" argument has the wrong type (expected type \"interface{}\")")
+			}
+		case "__id":
+			idVal, ok := val.(string)
+			if !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.template\", its \"__id\" argument has the wrong type (expected type \"string\")")
+			}
+			id = idVal
+		default:
+			return nil, errors.New("Initialized cloudformation.template with unknown argument " + name)
+		}
+		res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+	}
+
+	// Get the ID
+	if id == "" {
+		res.Resource.Id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res.Resource.Id = id
+	}
+
+	return &res, nil
+}
+
+func (s *mqlCloudformationTemplate) Validate() error {
+	// required arguments
+	// no required fields found
+
+	return nil
+}
+
+// Register accessor autogenerated
+func (s *mqlCloudformationTemplate) Register(name string) error {
+	log.Trace().Str("field", name).Msg("[cloudformation.template].Register")
+	switch name {
+	case "version":
+		return nil
+	case "description":
+		return nil
+	case "transform":
+		return nil
+	case "metadata":
+		return nil
+	case "mappings":
+		return nil
+	case "conditions":
+		return nil
+	case "parameters":
+		return nil
+	case "resources":
+		return nil
+	case "outputs":
+		return nil
+	case "content":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"cloudformation.template\" resource")
+	}
+}
+
+// Field accessor autogenerated
+func (s *mqlCloudformationTemplate) Field(name string) (interface{}, error) {
+	log.Trace().Str("field", name).Msg("[cloudformation.template].Field")
+	switch name {
+	case "version":
+		return s.Version()
+	case "description":
+		return s.Description()
+	case "transform":
+		return s.Transform()
+	case "metadata":
+		return s.Metadata()
+	case "mappings":
+		return s.Mappings()
+	case "conditions":
+		return s.Conditions()
+	case "parameters":
+		return s.Parameters()
+	case "resources":
+		return s.Resources()
+	case "outputs":
+		return s.Outputs()
+	case "content":
+		return s.Content()
+	default:
+		return nil, fmt.Errorf("Cannot find field '" + name + "' in \"cloudformation.template\" resource")
+	}
+}
+
+// Version accessor autogenerated
+func (s *mqlCloudformationTemplate) Version() (string, error) {
+	res, ok := s.Cache.Load("version")
+	if !ok || !res.Valid {
+		if err := s.ComputeVersion(); err != nil {
+			return "", err
+		}
+		res, ok = s.Cache.Load("version")
+		if !ok {
+			return "", errors.New("\"cloudformation.template\" calculated \"version\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "version")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"cloudformation.template\" failed to cast field \"version\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Description accessor autogenerated
+func (s *mqlCloudformationTemplate) Description() (string, error) {
+	res, ok := s.Cache.Load("description")
+	if !ok || !res.Valid {
+		if err := s.ComputeDescription(); err != nil {
+			return "", err
+		}
+		res, ok = s.Cache.Load("description")
+		if !ok {
+			return "", errors.New("\"cloudformation.template\" calculated \"description\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "description")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"cloudformation.template\" failed to cast field \"description\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Transform accessor autogenerated
+func (s *mqlCloudformationTemplate) Transform() ([]interface{}, error) {
+	res, ok := s.Cache.Load("transform")
+	if !ok || !res.Valid {
+		if err := s.ComputeTransform(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("transform")
+		if !ok {
+			return nil, errors.New("\"cloudformation.template\" calculated \"transform\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "transform")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"cloudformation.template\" failed to cast field \"transform\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Metadata accessor autogenerated
+func (s *mqlCloudformationTemplate) Metadata() (interface{}, error) {
+	res, ok := s.Cache.Load("metadata")
+	if !ok || !res.Valid {
+		if err := s.ComputeMetadata(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("metadata")
+		if !ok {
+			return nil, errors.New("\"cloudformation.template\" calculated \"metadata\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "metadata")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"cloudformation.template\" failed to cast field \"metadata\" to the right type (interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Mappings accessor autogenerated
+func (s *mqlCloudformationTemplate) Mappings() (interface{}, error) {
+	res, ok := s.Cache.Load("mappings")
+	if !ok || !res.Valid {
+		if err := s.ComputeMappings(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("mappings")
+		if !ok {
+			return nil, errors.New("\"cloudformation.template\" calculated \"mappings\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "mappings")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"cloudformation.template\" failed to cast field \"mappings\" to the right type (interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Conditions accessor autogenerated
+func (s *mqlCloudformationTemplate) Conditions() (interface{}, error) {
+	res, ok := s.Cache.Load("conditions")
+	if !ok || !res.Valid {
+		if err := s.ComputeConditions(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("conditions")
+		if !ok {
+			return nil, errors.New("\"cloudformation.template\" calculated \"conditions\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "conditions")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"cloudformation.template\" failed to cast field \"conditions\" to the right type (interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Parameters accessor autogenerated
+func (s *mqlCloudformationTemplate) Parameters() ([]interface{}, error) {
+	res, ok := s.Cache.Load("parameters")
+	if !ok || !res.Valid {
+		if err := s.ComputeParameters(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("parameters")
+		if !ok {
+			return nil, errors.New("\"cloudformation.template\" calculated \"parameters\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "parameters")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"cloudformation.template\" failed to cast field \"parameters\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Resources accessor autogenerated
+func (s *mqlCloudformationTemplate) Resources() ([]interface{}, error) {
+	res, ok := s.Cache.Load("resources")
+	if !ok || !res.Valid {
+		if err := s.ComputeResources(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("resources")
+		if !ok {
+			return nil, errors.New("\"cloudformation.template\" calculated \"resources\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "resources")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"cloudformation.template\" failed to cast field \"resources\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Outputs accessor autogenerated
+func (s *mqlCloudformationTemplate) Outputs() ([]interface{}, error) {
+	res, ok := s.Cache.Load("outputs")
+	if !ok || !res.Valid {
+		if err := s.ComputeOutputs(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("outputs")
+		if !ok {
+			return nil, errors.New("\"cloudformation.template\" calculated \"outputs\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "outputs")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"cloudformation.template\" failed to cast field \"outputs\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Content accessor autogenerated
+func (s *mqlCloudformationTemplate) Content() (interface{}, error) {
+	res, ok := s.Cache.Load("content")
+	if !ok || !res.Valid {
+		if err := s.ComputeContent(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("content")
+		if !ok {
+			return nil, errors.New("\"cloudformation.template\" calculated \"content\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "content")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"cloudformation.template\" failed to cast field \"content\" to the right type (interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Compute accessor autogenerated
+func (s *mqlCloudformationTemplate) Compute(name string) error {
+	log.Trace().Str("field", name).Msg("[cloudformation.template].Compute")
+	switch name {
+	case "version":
+		return s.ComputeVersion()
+	case "description":
+		return s.ComputeDescription()
+	case "transform":
+		return s.ComputeTransform()
+	case "metadata":
+		return s.ComputeMetadata()
+	case "mappings":
+		return s.ComputeMappings()
+	case "conditions":
+		return s.ComputeConditions()
+	case "parameters":
+		return s.ComputeParameters()
+	case "resources":
+		return s.ComputeResources()
+	case "outputs":
+		return s.ComputeOutputs()
+	case "content":
+		return s.ComputeContent()
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"cloudformation.template\" resource")
+	}
+}
+
+// ComputeVersion computer autogenerated
+func (s *mqlCloudformationTemplate) ComputeVersion() error {
+	var err error
+	if _, ok := s.Cache.Load("version"); ok {
+		return nil
+	}
+	vres, err := s.GetVersion()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("version", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeDescription computer autogenerated
+func (s *mqlCloudformationTemplate) ComputeDescription() error {
+	var err error
+	if _, ok := s.Cache.Load("description"); ok {
+		return nil
+	}
+	vres, err := s.GetDescription()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("description", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeTransform computer autogenerated
+func (s *mqlCloudformationTemplate) ComputeTransform() error {
+	var err error
+	if _, ok := s.Cache.Load("transform"); ok {
+		return nil
+	}
+	vres, err := s.GetTransform()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("transform", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeMetadata computer autogenerated
+func (s *mqlCloudformationTemplate) ComputeMetadata() error {
+	var err error
+	if _, ok := s.Cache.Load("metadata"); ok {
+		return nil
+	}
+	vres, err := s.GetMetadata()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("metadata", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeMappings computer autogenerated
+func (s *mqlCloudformationTemplate) ComputeMappings() error {
+	var err error
+	if _, ok := s.Cache.Load("mappings"); ok {
+		return nil
+	}
+	vres, err := s.GetMappings()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("mappings", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeConditions computer autogenerated
+func (s *mqlCloudformationTemplate) ComputeConditions() error {
+	var err error
+	if _, ok := s.Cache.Load("conditions"); ok {
+		return nil
+	}
+	vres, err := s.GetConditions()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("conditions", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeParameters computer autogenerated
+func (s *mqlCloudformationTemplate) ComputeParameters() error {
+	var err error
+	if _, ok := s.Cache.Load("parameters"); ok {
+		return nil
+	}
+	vres, err := s.GetParameters()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("parameters", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeResources computer autogenerated
+func (s *mqlCloudformationTemplate) ComputeResources() error {
+	var err error
+	if _, ok := s.Cache.Load("resources"); ok {
+		return nil
+	}
+	vres, err := s.GetResources()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("resources", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeOutputs computer autogenerated
+func (s *mqlCloudformationTemplate) ComputeOutputs() error {
+	var err error
+	if _, ok := s.Cache.Load("outputs"); ok {
+		return nil
+	}
+	vres, err := s.GetOutputs()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("outputs", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeContent computer autogenerated
+func (s *mqlCloudformationTemplate) ComputeContent() error {
+	var err error
+	if _, ok := s.Cache.Load("content"); ok {
+		return nil
+	}
+	vres, err := s.GetContent()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("content", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// CloudformationParameter resource interface
+type CloudformationParameter interface {
+	MqlResource() (*resources.Resource)
+	Compute(string) error
+	Field(string) (interface{}, error)
+	Register(string) error
+	Validate() error
+	Name() (string, error)
+	Type() (string, error)
+	Description() (string, error)
+	Default() (interface{}, error)
+	AllowedValues() ([]interface{}, error)
+	AllowedPattern() (string, error)
+	NoEcho() (bool, error)
+	Properties() (interface{}, error)
+}
+
+// mqlCloudformationParameter for the cloudformation.parameter resource
+type mqlCloudformationParameter struct {
+	*resources.Resource
+}
+
+// MqlResource to retrieve the underlying resource info
+func (s *mqlCloudformationParameter) MqlResource() *resources.Resource {
+	return s.Resource
+}
+
+// create a new instance of the cloudformation.parameter resource
+func newCloudformationParameter(runtime *resources.Runtime, args *resources.Args) (interface{}, error) {
+	// User hooks
+	var err error
+	res := mqlCloudformationParameter{runtime.NewResource("cloudformation.parameter")}
+	// assign all named fields
+	var id string
+
+	now := time.Now().Unix()
+	for name, val := range *args {
+		if val == nil {
+			res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+			continue
+		}
+
+		switch name {
+		case "name":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.parameter\", its \"name\" argument has the wrong type (expected type \"string\")")
+			}
+		case "type":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.parameter\", its \"type\" argument has the wrong type (expected type \"string\")")
+			}
+		case "description":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.parameter\", its \"description\" argument has the wrong type (expected type \"string\")")
+			}
+		case "default":
+			if _, ok := val.(interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.parameter\", its \"default\" argument has the wrong type (expected type \"interface{}\")")
+			}
+		case "allowedValues":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.parameter\", its \"allowedValues\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
+		case "allowedPattern":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.parameter\", its \"allowedPattern\" argument has the wrong type (expected type \"string\")")
+			}
+		case "noEcho":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.parameter\", its \"noEcho\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "properties":
+			if _, ok := val.(interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.parameter\", its \"properties\" argument has the wrong type (expected type \"interface{}\")")
+			}
+		case "__id":
+			idVal, ok := val.(string)
+			if !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.parameter\", its \"__id\" argument has the wrong type (expected type \"string\")")
+			}
+			id = idVal
+		default:
+			return nil, errors.New("Initialized cloudformation.parameter with unknown argument " + name)
+		}
+		res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+	}
+
+	// Get the ID
+	if id == "" {
+		res.Resource.Id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res.Resource.Id = id
+	}
+
+	return &res, nil
+}
+
+func (s *mqlCloudformationParameter) Validate() error {
+	// required arguments
+	if _, ok := s.Cache.Load("name"); !ok {
+		return errors.New("Initialized \"cloudformation.parameter\" resource without a \"name\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("type"); !ok {
+		return errors.New("Initialized \"cloudformation.parameter\" resource without a \"type\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("description"); !ok {
+		return errors.New("Initialized \"cloudformation.parameter\" resource without a \"description\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("default"); !ok {
+		return errors.New("Initialized \"cloudformation.parameter\" resource without a \"default\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("allowedValues"); !ok {
+		return errors.New("Initialized \"cloudformation.parameter\" resource without a \"allowedValues\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("allowedPattern"); !ok {
+		return errors.New("Initialized \"cloudformation.parameter\" resource without a \"allowedPattern\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("noEcho"); !ok {
+		return errors.New("Initialized \"cloudformation.parameter\" resource without a \"noEcho\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("properties"); !ok {
+		return errors.New("Initialized \"cloudformation.parameter\" resource without a \"properties\". This field is required.")
+	}
+
+	return nil
+}
+
+// Register accessor autogenerated
+func (s *mqlCloudformationParameter) Register(name string) error {
+	log.Trace().Str("field", name).Msg("[cloudformation.parameter].Register")
+	switch name {
+	case "name":
+		return nil
+	case "type":
+		return nil
+	case "description":
+		return nil
+	case "default":
+		return nil
+	case "allowedValues":
+		return nil
+	case "allowedPattern":
+		return nil
+	case "noEcho":
+		return nil
+	case "properties":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"cloudformation.parameter\" resource")
+	}
+}
+
+// Field accessor autogenerated
+func (s *mqlCloudformationParameter) Field(name string) (interface{}, error) {
+	log.Trace().Str("field", name).Msg("[cloudformation.parameter].Field")
+	switch name {
+	case "name":
+		return s.Name()
+	case "type":
+		return s.Type()
+	case "description":
+		return s.Description()
+	case "default":
+		return s.Default()
+	case "allowedValues":
+		return s.AllowedValues()
+	case "allowedPattern":
+		return s.AllowedPattern()
+	case "noEcho":
+		return s.NoEcho()
+	case "properties":
+		return s.Properties()
+	default:
+		return nil, fmt.Errorf("Cannot find field '" + name + "' in \"cloudformation.parameter\" resource")
+	}
+}
+
+// Name accessor autogenerated
+func (s *mqlCloudformationParameter) Name() (string, error) {
+	res, ok := s.Cache.Load("name")
+	if !ok || !res.Valid {
+		return "", errors.New("\"cloudformation.parameter\" failed: no value provided for static field \"name\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"cloudformation.parameter\" failed to cast field \"name\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Type accessor autogenerated
+func (s *mqlCloudformationParameter) Type() (string, error) {
+	res, ok := s.Cache.Load("type")
+	if !ok || !res.Valid {
+		return "", errors.New("\"cloudformation.parameter\" failed: no value provided for static field \"type\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"cloudformation.parameter\" failed to cast field \"type\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Description accessor autogenerated
+func (s *mqlCloudformationParameter) Description() (string, error) {
+	res, ok := s.Cache.Load("description")
+	if !ok || !res.Valid {
+		return "", errors.New("\"cloudformation.parameter\" failed: no value provided for static field \"description\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"cloudformation.parameter\" failed to cast field \"description\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Default accessor autogenerated
+func (s *mqlCloudformationParameter) Default() (interface{}, error) {
+	res, ok := s.Cache.Load("default")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"cloudformation.parameter\" failed: no value provided for static field \"default\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"cloudformation.parameter\" failed to cast field \"default\" to the right type (interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// AllowedValues accessor autogenerated
+func (s *mqlCloudformationParameter) AllowedValues() ([]interface{}, error) {
+	res, ok := s.Cache.Load("allowedValues")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"cloudformation.parameter\" failed: no value provided for static field \"allowedValues\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"cloudformation.parameter\" failed to cast field \"allowedValues\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// AllowedPattern accessor autogenerated
+func (s *mqlCloudformationParameter) AllowedPattern() (string, error) {
+	res, ok := s.Cache.Load("allowedPattern")
+	if !ok || !res.Valid {
+		return "", errors.New("\"cloudformation.parameter\" failed: no value provided for static field \"allowedPattern\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"cloudformation.parameter\" failed to cast field \"allowedPattern\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// NoEcho accessor autogenerated
+func (s *mqlCloudformationParameter) NoEcho() (bool, error) {
+	res, ok := s.Cache.Load("noEcho")
+	if !ok || !res.Valid {
+		return false, errors.New("\"cloudformation.parameter\" failed: no value provided for static field \"noEcho\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"cloudformation.parameter\" failed to cast field \"noEcho\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// Properties accessor autogenerated
+func (s *mqlCloudformationParameter) Properties() (interface{}, error) {
+	res, ok := s.Cache.Load("properties")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"cloudformation.parameter\" failed: no value provided for static field \"properties\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"cloudformation.parameter\" failed to cast field \"properties\" to the right type (interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Compute accessor autogenerated
+func (s *mqlCloudformationParameter) Compute(name string) error {
+	log.Trace().Str("field", name).Msg("[cloudformation.parameter].Compute")
+	switch name {
+	case "name":
+		return nil
+	case "type":
+		return nil
+	case "description":
+		return nil
+	case "default":
+		return nil
+	case "allowedValues":
+		return nil
+	case "allowedPattern":
+		return nil
+	case "noEcho":
+		return nil
+	case "properties":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"cloudformation.parameter\" resource")
+	}
+}
+
+// CloudformationResource resource interface
+type CloudformationResource interface {
+	MqlResource() (*resources.Resource)
+	Compute(string) error
+	Field(string) (interface{}, error)
+	Register(string) error
+	Validate() error
+	Name() (string, error)
+	Type() (string, error)
+	Condition() (string, error)
+	DependsOn() ([]interface{}, error)
+	DeletionPolicy() (string, error)
+	UpdateReplacePolicy() (string, error)
+	Metadata() (interface{}, error)
+	Properties() (interface{}, error)
+}
+
+// mqlCloudformationResource for the cloudformation.resource resource
+type mqlCloudformationResource struct {
+	*resources.Resource
+}
+
+// MqlResource to retrieve the underlying resource info
+func (s *mqlCloudformationResource) MqlResource() *resources.Resource {
+	return s.Resource
+}
+
+// create a new instance of the cloudformation.resource resource
+func newCloudformationResource(runtime *resources.Runtime, args *resources.Args) (interface{}, error) {
+	// User hooks
+	var err error
+	res := mqlCloudformationResource{runtime.NewResource("cloudformation.resource")}
+	// assign all named fields
+	var id string
+
+	now := time.Now().Unix()
+	for name, val := range *args {
+		if val == nil {
+			res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+			continue
+		}
+
+		switch name {
+		case "name":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.resource\", its \"name\" argument has the wrong type (expected type \"string\")")
+			}
+		case "type":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.resource\", its \"type\" argument has the wrong type (expected type \"string\")")
+			}
+		case "condition":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.resource\", its \"condition\" argument has the wrong type (expected type \"string\")")
+			}
+		case "dependsOn":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.resource\", its \"dependsOn\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
+		case "deletionPolicy":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.resource\", its \"deletionPolicy\" argument has the wrong type (expected type \"string\")")
+			}
+		case "updateReplacePolicy":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.resource\", its \"updateReplacePolicy\" argument has the wrong type (expected type \"string\")")
+			}
+		case "metadata":
+			if _, ok := val.(interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.resource\", its \"metadata\" argument has the wrong type (expected type \"interface{}\")")
+			}
+		case "properties":
+			if _, ok := val.(interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.resource\", its \"properties\" argument has the wrong type (expected type \"interface{}\")")
+			}
+		case "__id":
+			idVal, ok := val.(string)
+			if !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.resource\", its \"__id\" argument has the wrong type (expected type \"string\")")
+			}
+			id = idVal
+		default:
+			return nil, errors.New("Initialized cloudformation.resource with unknown argument " + name)
+		}
+		res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+	}
+
+	// Get the ID
+	if id == "" {
+		res.Resource.Id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res.Resource.Id = id
+	}
+
+	return &res, nil
+}
+
+func (s *mqlCloudformationResource) Validate() error {
+	// required arguments
+	if _, ok := s.Cache.Load("name"); !ok {
+		return errors.New("Initialized \"cloudformation.resource\" resource without a \"name\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("type"); !ok {
+		return errors.New("Initialized \"cloudformation.resource\" resource without a \"type\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("condition"); !ok {
+		return errors.New("Initialized \"cloudformation.resource\" resource without a \"condition\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("dependsOn"); !ok {
+		return errors.New("Initialized \"cloudformation.resource\" resource without a \"dependsOn\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("deletionPolicy"); !ok {
+		return errors.New("Initialized \"cloudformation.resource\" resource without a \"deletionPolicy\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("updateReplacePolicy"); !ok {
+		return errors.New("Initialized \"cloudformation.resource\" resource without a \"updateReplacePolicy\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("metadata"); !ok {
+		return errors.New("Initialized \"cloudformation.resource\" resource without a \"metadata\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("properties"); !ok {
+		return errors.New("Initialized \"cloudformation.resource\" resource without a \"properties\". This field is required.")
+	}
+
+	return nil
+}
+
+// Register accessor autogenerated
+func (s *mqlCloudformationResource) Register(name string) error {
+	log.Trace().Str("field", name).Msg("[cloudformation.resource].Register")
+	switch name {
+	case "name":
+		return nil
+	case "type":
+		return nil
+	case "condition":
+		return nil
+	case "dependsOn":
+		return nil
+	case "deletionPolicy":
+		return nil
+	case "updateReplacePolicy":
+		return nil
+	case "metadata":
+		return nil
+	case "properties":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"cloudformation.resource\" resource")
+	}
+}
+
+// Field accessor autogenerated
+func (s *mqlCloudformationResource) Field(name string) (interface{}, error) {
+	log.Trace().Str("field", name).Msg("[cloudformation.resource].Field")
+	switch name {
+	case "name":
+		return s.Name()
+	case "type":
+		return s.Type()
+	case "condition":
+		return s.Condition()
+	case "dependsOn":
+		return s.DependsOn()
+	case "deletionPolicy":
+		return s.DeletionPolicy()
+	case "updateReplacePolicy":
+		return s.UpdateReplacePolicy()
+	case "metadata":
+		return s.Metadata()
+	case "properties":
+		return s.Properties()
+	default:
+		return nil, fmt.Errorf("Cannot find field '" + name + "' in \"cloudformation.resource\" resource")
+	}
+}
+
+// Name accessor autogenerated
+func (s *mqlCloudformationResource) Name() (string, error) {
+	res, ok := s.Cache.Load("name")
+	if !ok || !res.Valid {
+		return "", errors.New("\"cloudformation.resource\" failed: no value provided for static field \"name\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"cloudformation.resource\" failed to cast field \"name\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Type accessor autogenerated
+func (s *mqlCloudformationResource) Type() (string, error) {
+	res, ok := s.Cache.Load("type")
+	if !ok || !res.Valid {
+		return "", errors.New("\"cloudformation.resource\" failed: no value provided for static field \"type\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"cloudformation.resource\" failed to cast field \"type\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Condition accessor autogenerated
+func (s *mqlCloudformationResource) Condition() (string, error) {
+	res, ok := s.Cache.Load("condition")
+	if !ok || !res.Valid {
+		return "", errors.New("\"cloudformation.resource\" failed: no value provided for static field \"condition\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"cloudformation.resource\" failed to cast field \"condition\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// DependsOn accessor autogenerated
+func (s *mqlCloudformationResource) DependsOn() ([]interface{}, error) {
+	res, ok := s.Cache.Load("dependsOn")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"cloudformation.resource\" failed: no value provided for static field \"dependsOn\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"cloudformation.resource\" failed to cast field \"dependsOn\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// DeletionPolicy accessor autogenerated
+func (s *mqlCloudformationResource) DeletionPolicy() (string, error) {
+	res, ok := s.Cache.Load("deletionPolicy")
+	if !ok || !res.Valid {
+		return "", errors.New("\"cloudformation.resource\" failed: no value provided for static field \"deletionPolicy\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"cloudformation.resource\" failed to cast field \"deletionPolicy\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// UpdateReplacePolicy accessor autogenerated
+func (s *mqlCloudformationResource) UpdateReplacePolicy() (string, error) {
+	res, ok := s.Cache.Load("updateReplacePolicy")
+	if !ok || !res.Valid {
+		return "", errors.New("\"cloudformation.resource\" failed: no value provided for static field \"updateReplacePolicy\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"cloudformation.resource\" failed to cast field \"updateReplacePolicy\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Metadata accessor autogenerated
+func (s *mqlCloudformationResource) Metadata() (interface{}, error) {
+	res, ok := s.Cache.Load("metadata")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"cloudformation.resource\" failed: no value provided for static field \"metadata\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"cloudformation.resource\" failed to cast field \"metadata\" to the right type (interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Properties accessor autogenerated
+func (s *mqlCloudformationResource) Properties() (interface{}, error) {
+	res, ok := s.Cache.Load("properties")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"cloudformation.resource\" failed: no value provided for static field \"properties\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"cloudformation.resource\" failed to cast field \"properties\" to the right type (interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Compute accessor autogenerated
+func (s *mqlCloudformationResource) Compute(name string) error {
+	log.Trace().Str("field", name).Msg("[cloudformation.resource].Compute")
+	switch name {
+	case "name":
+		return nil
+	case "type":
+		return nil
+	case "condition":
+		return nil
+	case "dependsOn":
+		return nil
+	case "deletionPolicy":
+		return nil
+	case "updateReplacePolicy":
+		return nil
+	case "metadata":
+		return nil
+	case "properties":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"cloudformation.resource\" resource")
+	}
+}
+
+// CloudformationOutput resource interface
+type CloudformationOutput interface {
+	MqlResource() (*resources.Resource)
+	Compute(string) error
+	Field(string) (interface{}, error)
+	Register(string) error
+	Validate() error
+	Name() (string, error)
+	Description() (string, error)
+	Value() (interface{}, error)
+	ExportName() (interface{}, error)
+	Condition() (string, error)
+}
+
+// mqlCloudformationOutput for the cloudformation.output resource
+type mqlCloudformationOutput struct {
+	*resources.Resource
+}
+
+// MqlResource to retrieve the underlying resource info
+func (s *mqlCloudformationOutput) MqlResource() *resources.Resource {
+	return s.Resource
+}
+
+// create a new instance of the cloudformation.output resource
+func newCloudformationOutput(runtime *resources.Runtime, args *resources.Args) (interface{}, error) {
+	// User hooks
+	var err error
+	res := mqlCloudformationOutput{runtime.NewResource("cloudformation.output")}
+	// assign all named fields
+	var id string
+
+	now := time.Now().Unix()
+	for name, val := range *args {
+		if val == nil {
+			res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+			continue
+		}
+
+		switch name {
+		case "name":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.output\", its \"name\" argument has the wrong type (expected type \"string\")")
+			}
+		case "description":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.output\", its \"description\" argument has the wrong type (expected type \"string\")")
+			}
+		case "value":
+			if _, ok := val.(interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.output\", its \"value\" argument has the wrong type (expected type \"interface{}\")")
+			}
+		case "exportName":
+			if _, ok := val.(interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.output\", its \"exportName\" argument has the wrong type (expected type \"interface{}\")")
+			}
+		case "condition":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.output\", its \"condition\" argument has the wrong type (expected type \"string\")")
+			}
+		case "__id":
+			idVal, ok := val.(string)
+			if !ok {
+				return nil, errors.New("Failed to initialize \"cloudformation.output\", its \"__id\" argument has the wrong type (expected type \"string\")")
+			}
+			id = idVal
+		default:
+			return nil, errors.New("Initialized cloudformation.output with unknown argument " + name)
+		}
+		res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+	}
+
+	// Get the ID
+	if id == "" {
+		res.Resource.Id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res.Resource.Id = id
+	}
+
+	return &res, nil
+}
+
+func (s *mqlCloudformationOutput) Validate() error {
+	// required arguments
+	if _, ok := s.Cache.Load("name"); !ok {
+		return errors.New("Initialized \"cloudformation.output\" resource without a \"name\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("description"); !ok {
+		return errors.New("Initialized \"cloudformation.output\" resource without a \"description\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("value"); !ok {
+		return errors.New("Initialized \"cloudformation.output\" resource without a \"value\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("exportName"); !ok {
+		return errors.New("Initialized \"cloudformation.output\" resource without a \"exportName\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("condition"); !ok {
+		return errors.New("Initialized \"cloudformation.output\" resource without a \"condition\". This field is required.")
+	}
+
+	return nil
+}
+
+// Register accessor autogenerated
+func (s *mqlCloudformationOutput) Register(name string) error {
+	log.Trace().Str("field", name).Msg("[cloudformation.output].Register")
+	switch name {
+	case "name":
+		return nil
+	case "description":
+		return nil
+	case "value":
+		return nil
+	case "exportName":
+		return nil
+	case "condition":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"cloudformation.output\" resource")
+	}
+}
+
+// Field accessor autogenerated
+func (s *mqlCloudformationOutput) Field(name string) (interface{}, error) {
+	log.Trace().Str("field", name).Msg("[cloudformation.output].Field")
+	switch name {
+	case "name":
+		return s.Name()
+	case "description":
+		return s.Description()
+	case "value":
+		return s.Value()
+	case "exportName":
+		return s.ExportName()
+	case "condition":
+		return s.Condition()
+	default:
+		return nil, fmt.Errorf("Cannot find field '" + name + "' in \"cloudformation.output\" resource")
+	}
+}
+
+// Name accessor autogenerated
+func (s *mqlCloudformationOutput) Name() (string, error) {
+	res, ok := s.Cache.Load("name")
+	if !ok || !res.Valid {
+		return "", errors.New("\"cloudformation.output\" failed: no value provided for static field \"name\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"cloudformation.output\" failed to cast field \"name\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Description accessor autogenerated
+func (s *mqlCloudformationOutput) Description() (string, error) {
+	res, ok := s.Cache.Load("description")
+	if !ok || !res.Valid {
+		return "", errors.New("\"cloudformation.output\" failed: no value provided for static field \"description\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"cloudformation.output\" failed to cast field \"description\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Value accessor autogenerated
+func (s *mqlCloudformationOutput) Value() (interface{}, error) {
+	res, ok := s.Cache.Load("value")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"cloudformation.output\" failed: no value provided for static field \"value\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"cloudformation.output\" failed to cast field \"value\" to the right type (interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// ExportName accessor autogenerated
+func (s *mqlCloudformationOutput) ExportName() (interface{}, error) {
+	res, ok := s.Cache.Load("exportName")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"cloudformation.output\" failed: no value provided for static field \"exportName\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"cloudformation.output\" failed to cast field \"exportName\" to the right type (interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Condition accessor autogenerated
+func (s *mqlCloudformationOutput) Condition() (string, error) {
+	res, ok := s.Cache.Load("condition")
+	if !ok || !res.Valid {
+		return "", errors.New("\"cloudformation.output\" failed: no value provided for static field \"condition\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"cloudformation.output\" failed to cast field \"condition\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Compute accessor autogenerated
+func (s *mqlCloudformationOutput) Compute(name string) error {
+	log.Trace().Str("field", name).Msg("[cloudformation.output].Compute")
+	switch name {
+	case "name":
+		return nil
+	case "description":
+		return nil
+	case "value":
+		return nil
+	case "exportName":
+		return nil
+	case "condition":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"cloudformation.output\" resource")
+	}
+}
+
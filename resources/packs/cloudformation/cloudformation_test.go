@@ -0,0 +1,77 @@
+package cloudformation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/motor"
+	"go.mondoo.com/cnquery/motor/providers"
+	provider "go.mondoo.com/cnquery/motor/providers/cloudformation"
+	"go.mondoo.com/cnquery/resources/packs/cloudformation"
+	"go.mondoo.com/cnquery/resources/packs/testutils"
+)
+
+func testCloudformationQuery(t *testing.T, query string) []*llx.RawResult {
+	p, err := provider.New(&providers.Config{
+		Backend: providers.ProviderType_CLOUDFORMATION,
+		Options: map[string]string{
+			"path": "./testdata/template.yaml",
+		},
+	})
+	require.NoError(t, err)
+
+	m, err := motor.New(p)
+	require.NoError(t, err)
+
+	x := testutils.InitTester(m, cloudformation.Registry)
+	return x.TestQuery(t, query)
+}
+
+func TestCloudformationTemplate(t *testing.T) {
+	t.Run("template", func(t *testing.T) {
+		res := testCloudformationQuery(t, "cloudformation.template.version")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, "2010-09-09", res[0].Data.Value)
+
+		res = testCloudformationQuery(t, "cloudformation.template.transform")
+		require.NotEmpty(t, res)
+		assert.Equal(t, []interface{}{"AWS::Serverless-2016-10-31"}, res[0].Data.Value)
+	})
+
+	t.Run("parameters", func(t *testing.T) {
+		res := testCloudformationQuery(t, "cloudformation.template.parameters.where(noEcho == true).map(name)")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, []interface{}{"DatabasePassword"}, res[0].Data.Value)
+
+		res = testCloudformationQuery(t, "cloudformation.template.parameters.where(name == 'Environment').first.allowedValues")
+		require.NotEmpty(t, res)
+		assert.Equal(t, []interface{}{"dev", "prod"}, res[0].Data.Value)
+	})
+
+	t.Run("resources", func(t *testing.T) {
+		res := testCloudformationQuery(t, "cloudformation.template.resources.where(type == 'AWS::S3::Bucket').length")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, int64(2), res[0].Data.Value)
+
+		res = testCloudformationQuery(t, "cloudformation.template.resources.where(name == 'Bucket').first.properties['LoggingConfiguration']['DestinationBucketName']['Ref']")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, "LogBucket", res[0].Data.Value)
+
+		res = testCloudformationQuery(t, "cloudformation.template.resources.where(name == 'Bucket').first.dependsOn")
+		require.NotEmpty(t, res)
+		assert.Equal(t, []interface{}{"LogBucket"}, res[0].Data.Value)
+	})
+
+	t.Run("outputs", func(t *testing.T) {
+		res := testCloudformationQuery(t, "cloudformation.template.outputs.first.value['Fn::GetAtt']")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, []interface{}{"Bucket", "Arn"}, res[0].Data.Value)
+	})
+}
@@ -1336,6 +1336,9 @@ type File interface {
 	User() (User, error)
 	Group() (Group, error)
 	Empty() (bool, error)
+	Sha256() (string, error)
+	Sha1() (string, error)
+	Md5() (string, error)
 }
 
 // mqlFile for the file resource
@@ -1404,6 +1407,18 @@ func newFile(runtime *resources.Runtime, args *resources.Args) (interface{}, err
 			if _, ok := val.(bool); !ok {
 				return nil, errors.New("Failed to initialize \"file\", its \"empty\" argument has the wrong type (expected type \"bool\")")
 			}
+		case "sha256":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"file\", its \"sha256\" argument has the wrong type (expected type \"string\")")
+			}
+		case "sha1":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"file\", its \"sha1\" argument has the wrong type (expected type \"string\")")
+			}
+		case "md5":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"file\", its \"md5\" argument has the wrong type (expected type \"string\")")
+			}
 		case "__id":
 			idVal, ok := val.(string)
 			if !ok {
@@ -1478,6 +1493,12 @@ func (s *mqlFile) Register(name string) error {
 		return nil
 	case "empty":
 		return nil
+	case "sha256":
+		return nil
+	case "sha1":
+		return nil
+	case "md5":
+		return nil
 	default:
 		return errors.New("Cannot find field '" + name + "' in \"file\" resource")
 	}
@@ -1507,6 +1528,12 @@ func (s *mqlFile) Field(name string) (interface{}, error) {
 		return s.Group()
 	case "empty":
 		return s.Empty()
+	case "sha256":
+		return s.Sha256()
+	case "sha1":
+		return s.Sha1()
+	case "md5":
+		return s.Md5()
 	default:
 		return nil, fmt.Errorf("Cannot find field '" + name + "' in \"file\" resource")
 	}
@@ -1714,6 +1741,75 @@ func (s *mqlFile) Empty() (bool, error) {
 	return tres, nil
 }
 
+// Sha256 accessor autogenerated
+func (s *mqlFile) Sha256() (string, error) {
+	res, ok := s.Cache.Load("sha256")
+	if !ok || !res.Valid {
+		if err := s.ComputeSha256(); err != nil {
+			return "", err
+		}
+		res, ok = s.Cache.Load("sha256")
+		if !ok {
+			return "", errors.New("\"file\" calculated \"sha256\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "sha256")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"file\" failed to cast field \"sha256\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Sha1 accessor autogenerated
+func (s *mqlFile) Sha1() (string, error) {
+	res, ok := s.Cache.Load("sha1")
+	if !ok || !res.Valid {
+		if err := s.ComputeSha1(); err != nil {
+			return "", err
+		}
+		res, ok = s.Cache.Load("sha1")
+		if !ok {
+			return "", errors.New("\"file\" calculated \"sha1\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "sha1")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"file\" failed to cast field \"sha1\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Md5 accessor autogenerated
+func (s *mqlFile) Md5() (string, error) {
+	res, ok := s.Cache.Load("md5")
+	if !ok || !res.Valid {
+		if err := s.ComputeMd5(); err != nil {
+			return "", err
+		}
+		res, ok = s.Cache.Load("md5")
+		if !ok {
+			return "", errors.New("\"file\" calculated \"md5\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "md5")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"file\" failed to cast field \"md5\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
 // Compute accessor autogenerated
 func (s *mqlFile) Compute(name string) error {
 	log.Trace().Str("field", name).Msg("[file].Compute")
@@ -1738,6 +1834,12 @@ func (s *mqlFile) Compute(name string) error {
 		return s.ComputeGroup()
 	case "empty":
 		return s.ComputeEmpty()
+	case "sha256":
+		return s.ComputeSha256()
+	case "sha1":
+		return s.ComputeSha1()
+	case "md5":
+		return s.ComputeMd5()
 	default:
 		return errors.New("Cannot find field '" + name + "' in \"file\" resource")
 	}
@@ -1892,6 +1994,48 @@ func (s *mqlFile) ComputeEmpty() error {
 	return nil
 }
 
+// ComputeSha256 computer autogenerated
+func (s *mqlFile) ComputeSha256() error {
+	var err error
+	if _, ok := s.Cache.Load("sha256"); ok {
+		return nil
+	}
+	vres, err := s.GetSha256()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("sha256", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeSha1 computer autogenerated
+func (s *mqlFile) ComputeSha1() error {
+	var err error
+	if _, ok := s.Cache.Load("sha1"); ok {
+		return nil
+	}
+	vres, err := s.GetSha1()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("sha1", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeMd5 computer autogenerated
+func (s *mqlFile) ComputeMd5() error {
+	var err error
+	if _, ok := s.Cache.Load("md5"); ok {
+		return nil
+	}
+	vres, err := s.GetMd5()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("md5", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
 // FilePermissions resource interface
 type FilePermissions interface {
 	MqlResource() (*resources.Resource)
@@ -8379,6 +8523,8 @@ type Process interface {
 	Executable() (string, error)
 	Command() (string, error)
 	Flags() (map[string]interface{}, error)
+	Seccomp() (string, error)
+	NoNewPrivs() (bool, error)
 }
 
 // mqlProcess for the process resource
@@ -8436,6 +8582,14 @@ func newProcess(runtime *resources.Runtime, args *resources.Args) (interface{},
 			if _, ok := val.(map[string]interface{}); !ok {
 				return nil, errors.New("Failed to initialize \"process\", its \"flags\" argument has the wrong type (expected type \"map[string]interface{}\")")
 			}
+		case "seccomp":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"process\", its \"seccomp\" argument has the wrong type (expected type \"string\")")
+			}
+		case "noNewPrivs":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"process\", its \"noNewPrivs\" argument has the wrong type (expected type \"bool\")")
+			}
 		case "__id":
 			idVal, ok := val.(string)
 			if !ok {
@@ -8484,6 +8638,10 @@ func (s *mqlProcess) Register(name string) error {
 		return nil
 	case "flags":
 		return nil
+	case "seccomp":
+		return nil
+	case "noNewPrivs":
+		return nil
 	default:
 		return errors.New("Cannot find field '" + name + "' in \"process\" resource")
 	}
@@ -8503,6 +8661,10 @@ func (s *mqlProcess) Field(name string) (interface{}, error) {
 		return s.Command()
 	case "flags":
 		return s.Flags()
+	case "seccomp":
+		return s.Seccomp()
+	case "noNewPrivs":
+		return s.NoNewPrivs()
 	default:
 		return nil, fmt.Errorf("Cannot find field '" + name + "' in \"process\" resource")
 	}
@@ -8616,6 +8778,52 @@ func (s *mqlProcess) Flags() (map[string]interface{}, error) {
 	return tres, nil
 }
 
+// Seccomp accessor autogenerated
+func (s *mqlProcess) Seccomp() (string, error) {
+	res, ok := s.Cache.Load("seccomp")
+	if !ok || !res.Valid {
+		if err := s.ComputeSeccomp(); err != nil {
+			return "", err
+		}
+		res, ok = s.Cache.Load("seccomp")
+		if !ok {
+			return "", errors.New("\"process\" calculated \"seccomp\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "seccomp")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"process\" failed to cast field \"seccomp\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// NoNewPrivs accessor autogenerated
+func (s *mqlProcess) NoNewPrivs() (bool, error) {
+	res, ok := s.Cache.Load("noNewPrivs")
+	if !ok || !res.Valid {
+		if err := s.ComputeNoNewPrivs(); err != nil {
+			return false, err
+		}
+		res, ok = s.Cache.Load("noNewPrivs")
+		if !ok {
+			return false, errors.New("\"process\" calculated \"noNewPrivs\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "noNewPrivs")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"process\" failed to cast field \"noNewPrivs\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
 // Compute accessor autogenerated
 func (s *mqlProcess) Compute(name string) error {
 	log.Trace().Str("field", name).Msg("[process].Compute")
@@ -8630,6 +8838,10 @@ func (s *mqlProcess) Compute(name string) error {
 		return s.ComputeCommand()
 	case "flags":
 		return s.ComputeFlags()
+	case "seccomp":
+		return s.ComputeSeccomp()
+	case "noNewPrivs":
+		return s.ComputeNoNewPrivs()
 	default:
 		return errors.New("Cannot find field '" + name + "' in \"process\" resource")
 	}
@@ -8691,6 +8903,34 @@ func (s *mqlProcess) ComputeFlags() error {
 	return nil
 }
 
+// ComputeSeccomp computer autogenerated
+func (s *mqlProcess) ComputeSeccomp() error {
+	var err error
+	if _, ok := s.Cache.Load("seccomp"); ok {
+		return nil
+	}
+	vres, err := s.GetSeccomp()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("seccomp", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeNoNewPrivs computer autogenerated
+func (s *mqlProcess) ComputeNoNewPrivs() error {
+	var err error
+	if _, ok := s.Cache.Load("noNewPrivs"); ok {
+		return nil
+	}
+	vres, err := s.GetNoNewPrivs()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("noNewPrivs", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
 // Processes resource interface
 type Processes interface {
 	MqlResource() (*resources.Resource)
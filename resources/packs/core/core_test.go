@@ -535,9 +535,108 @@ func TestString_Methods(t *testing.T) {
 			"'hello ' + 'world'",
 			0, "hello world",
 		},
+		{
+			"'a-b-c'.replace('-', '+')",
+			0, "a+b+c",
+		},
+		{
+			"'PermitRootLogin   no'.replace(/\\s+/, ' ')",
+			0, "PermitRootLogin no",
+		},
+		{
+			"'key=value'.replace(/(\\w+)=(\\w+)/, '$2=$1')",
+			0, "value=key",
+		},
+		{
+			"'hello'.startsWith('he')",
+			0, true,
+		},
+		{
+			"'hello'.startsWith('lo')",
+			0, false,
+		},
+		{
+			"'hello'.endsWith('lo')",
+			0, true,
+		},
+		{
+			"'/etc/ssh/sshd_config'.trimPrefix('/etc/')",
+			0, "ssh/sshd_config",
+		},
+		{
+			"'sshd_config.d'.trimSuffix('.d')",
+			0, "sshd_config",
+		},
+		{
+			"'hello world'.substring(6)",
+			0, "world",
+		},
+		{
+			"'hello world'.substring(0, 5)",
+			0, "hello",
+		},
+		{
+			"'hello'.substring(1, 100)",
+			0, "ello",
+		},
+		{
+			"'PASS_MAX_DAYS 90'.capture(/(?P<key>\\w+)\\s+(?P<value>\\d+)/)",
+			0, map[string]interface{}{"key": "PASS_MAX_DAYS", "value": "90"},
+		},
+		{
+			"'PASS_MAX_DAYS 90'.capture(/(?P<value>[a-z]+)/)",
+			0, map[string]interface{}{},
+		},
+		{
+			"'port %d on %s'.format(22, 'localhost')",
+			0, "port 22 on localhost",
+		},
+		{
+			"'aGVsbG8='.decodeBase64",
+			0, "hello",
+		},
+		{
+			"'aGVsbG8'.decodeBase64",
+			0, "hello",
+		},
+		{
+			"'68656c6c6f'.decodeHex",
+			0, "hello",
+		},
+		{
+			"'hello'.sha256",
+			0, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+		},
 	})
 }
 
+func TestString_Methods_Errors(t *testing.T) {
+	x := testutils.InitTester(testutils.LinuxMock(), core.Registry)
+	for _, query := range []string{
+		"'hello'.substring(-1)",
+		"'hello'.substring(3, 2)",
+		"'not base64!'.decodeBase64",
+		"'xyz'.decodeHex",
+	} {
+		t.Run(query, func(t *testing.T) {
+			res := x.TestQuery(t, query)
+			require.NotEmpty(t, res)
+			assert.Error(t, res[0].Data.Error)
+		})
+	}
+
+	for _, query := range []string{
+		"'hello'.replace(1, 'a')",
+		"'hello'.capture('a')",
+		"'hello'.substring('a')",
+	} {
+		t.Run(query, func(t *testing.T) {
+			_, err := x.Compile(query)
+			assert.Error(t, err)
+		})
+	}
+}
+
 func TestScore_Methods(t *testing.T) {
 	x := testutils.InitTester(testutils.LinuxMock(), core.Registry)
 	x.TestSimple(t, []testutils.SimpleTest{
@@ -1194,6 +1293,26 @@ func TestDict_Methods_OtherJson(t *testing.T) {
 			"parse.json('/dummy.string.json').params",
 			0, "hi",
 		},
+		{
+			"parse.json('/dummy.string.json').params.replace('i', 'ello')",
+			0, "hello",
+		},
+		{
+			"parse.json('/dummy.string.json').params.startsWith('h')",
+			0, true,
+		},
+		{
+			"parse.json('/dummy.string.json').params.capture(/(?P<first>.)/)",
+			0, map[string]interface{}{"first": "h"},
+		},
+		{
+			"parse.json('/dummy.string.json').params.format()",
+			0, "hi",
+		},
+		{
+			"parse.json('/dummy.string.json').params.sha256",
+			0, "8f434346648f6b96df89dda901c5176b10a6d83961dd3c1ac88b59b2dc327aa4",
+		},
 		{
 			"parse.json('/dummy.true.json').params",
 			0, true,
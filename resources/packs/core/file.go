@@ -16,7 +16,9 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/afero"
 	"go.mondoo.com/cnquery/motor/providers"
+	os_provider "go.mondoo.com/cnquery/motor/providers/os"
 	"go.mondoo.com/cnquery/motor/providers/os/events"
+	"go.mondoo.com/cnquery/motor/providers/os/fsutil"
 	"go.mondoo.com/cnquery/resources"
 )
 
@@ -241,6 +243,48 @@ func (s *mqlFile) GetGroup() (interface{}, error) {
 	return mqlUser.(Group), nil
 }
 
+func (s *mqlFile) GetSha256() (string, error) {
+	return s.hash(fsutil.HashSha256)
+}
+
+func (s *mqlFile) GetSha1() (string, error) {
+	return s.hash(fsutil.HashSha1)
+}
+
+func (s *mqlFile) GetMd5() (string, error) {
+	return s.hash(fsutil.HashMd5)
+}
+
+// hash calculates the hashsum on the target system if the provider supports it,
+// otherwise the file content is streamed through the hash
+func (s *mqlFile) hash(algorithm string) (string, error) {
+	path, err := s.Path()
+	if err != nil {
+		return "", err
+	}
+
+	osProvider, err := osProvider(s.MotorRuntime.Motor)
+	if err != nil {
+		return "", err
+	}
+
+	if hasher, ok := osProvider.(os_provider.FileHasher); ok {
+		sum, err := hasher.FileHash(path, algorithm)
+		if err == nil {
+			return sum, nil
+		}
+		log.Debug().Err(err).Str("file", path).Msg("[file]> could not hash file on target, fallback to file transfer")
+	}
+
+	f, err := osProvider.FS().Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	return fsutil.Hash(f, algorithm)
+}
+
 func (s *mqlFile) stat() (FilePermissions, int64, error) {
 	osProvider, err := osProvider(s.MotorRuntime.Motor)
 	if err != nil {
@@ -43,6 +43,18 @@ func TestResource_File(t *testing.T) {
 			"file(\"/etc/passwd\").content",
 			0, passwdContent,
 		},
+		{
+			"file(\"/etc/passwd\").sha256",
+			0, "4374ecfc360d25cb633d12eb886466f854b8b86d525753530a0fd8ccaa362306",
+		},
+		{
+			"file(\"/etc/passwd\").sha1",
+			0, "4cf4bd60d287126a90dafcd1bfa753401f803b0d",
+		},
+		{
+			"file(\"/etc/passwd\").md5",
+			0, "8db01757ef00e55f0912bfd1233b532a",
+		},
 	})
 }
 
@@ -13,6 +13,7 @@ import (
 
 var (
 	Specline     = regexp.MustCompile(`^\s*(.*)\s\((.*)\)\s*$`)
+	Specfile     = regexp.MustCompile(`^(.+?)-(\d[^-]*)(-.+)?\.gemspec$`)
 	GIT          = "GIT"
 	PATH         = "PATH"
 	GEM          = "GEM"
@@ -107,3 +108,19 @@ func LeadingSpaces(line string) int {
 	}
 	return i
 }
+
+// ParseGemspecFilename extracts the package of an installed gem from its
+// specification file name, e.g. nokogiri-1.13.8-x86_64-linux.gemspec
+func ParseGemspecFilename(filename string) (*mvd.Package, error) {
+	m := Specfile.FindStringSubmatch(filename)
+	if len(m) < 3 {
+		return nil, errors.New("cannot parse " + filename)
+	}
+
+	return &mvd.Package{
+		Name:      m[1],
+		Version:   m[2],
+		Format:    "gem",
+		Namespace: "gem",
+	}, nil
+}
@@ -54,3 +54,18 @@ func TestParsePackagename(t *testing.T) {
 	assert.Equal(t, "aws-sdk-kms", name)
 	assert.Equal(t, "1.11.0", version)
 }
+
+func TestParseGemspecFilename(t *testing.T) {
+	pkg, err := gem.ParseGemspecFilename("aws-sdk-s3-1.114.0.gemspec")
+	assert.Nil(t, err)
+	assert.Equal(t, "aws-sdk-s3", pkg.Name)
+	assert.Equal(t, "1.114.0", pkg.Version)
+
+	pkg, err = gem.ParseGemspecFilename("nokogiri-1.13.8-x86_64-linux.gemspec")
+	assert.Nil(t, err)
+	assert.Equal(t, "nokogiri", pkg.Name)
+	assert.Equal(t, "1.13.8", pkg.Version)
+
+	_, err = gem.ParseGemspecFilename("rake.gemspec")
+	assert.NotNil(t, err)
+}
@@ -47,3 +47,37 @@ func ParseComposerLock(r io.Reader) ([]*mvd.Package, error) {
 
 	return entries, nil
 }
+
+// ComposerInstalled is the struct to represent vendor/composer/installed.json
+// of composer 2. Composer 1 only stores the list of packages.
+type ComposerInstalled struct {
+	Packages []ComposerPackage `json:"packages"`
+}
+
+func ParseComposerInstalled(r io.Reader) ([]*mvd.Package, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var installed ComposerInstalled
+	if err := json.Unmarshal(data, &installed); err != nil {
+		// fallback to composer 1
+		if err := json.Unmarshal(data, &installed.Packages); err != nil {
+			return nil, err
+		}
+	}
+
+	entries := []*mvd.Package{}
+	for i := range installed.Packages {
+		pkg := installed.Packages[i]
+		entries = append(entries, &mvd.Package{
+			Name:      pkg.Name,
+			Version:   pkg.Version,
+			Format:    "php",
+			Namespace: "php",
+		})
+	}
+
+	return entries, nil
+}
@@ -33,3 +33,31 @@ func TestComposerLockParser(t *testing.T) {
 		Namespace: "php",
 	})
 }
+
+func TestComposerInstalledParser(t *testing.T) {
+	data, err := os.Open("./testdata/installed.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pkgs, err := php.ParseComposerInstalled(data)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(pkgs))
+
+	assert.Contains(t, pkgs, &mvd.Package{
+		Name:      "monolog/monolog",
+		Version:   "2.8.0",
+		Format:    "php",
+		Namespace: "php",
+	})
+
+	data, err = os.Open("./testdata/installed-v1.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pkgs, err = php.ParseComposerInstalled(data)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(pkgs))
+	assert.Equal(t, "psr/log", pkgs[0].Name)
+}
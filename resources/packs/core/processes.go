@@ -9,6 +9,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"go.mondoo.com/cnquery/resources"
 	"go.mondoo.com/cnquery/resources/packs/core/processes"
+	"go.mondoo.com/cnquery/resources/packs/os/procfs"
 )
 
 func (p *mqlProcess) init(args *resources.Args) (*resources.Args, Process, error) {
@@ -122,6 +123,54 @@ func (p *mqlProcess) GetFlags() (map[string]interface{}, error) {
 	return res, nil
 }
 
+func (p *mqlProcess) GetSeccomp() (string, error) {
+	status, err := p.procStatus()
+	if err != nil {
+		return "", err
+	}
+	p.Cache.Store("noNewPrivs", &resources.CacheEntry{Data: status.NoNewPrivs, Valid: true, Timestamp: time.Now().Unix()})
+	return status.Seccomp, nil
+}
+
+func (p *mqlProcess) GetNoNewPrivs() (bool, error) {
+	status, err := p.procStatus()
+	if err != nil {
+		return false, err
+	}
+	p.Cache.Store("seccomp", &resources.CacheEntry{Data: status.Seccomp, Valid: true, Timestamp: time.Now().Unix()})
+	return status.NoNewPrivs, nil
+}
+
+// procStatus reads the process status from procfs, it is read independent of
+// the process manager since ps does not expose the security settings
+func (p *mqlProcess) procStatus() (*procfs.LinuxProcessStatus, error) {
+	pid, err := p.Pid()
+	if err != nil {
+		return nil, err
+	}
+
+	platform, err := p.MotorRuntime.Motor.Platform()
+	if err != nil {
+		return nil, err
+	}
+	if !platform.IsFamily("linux") {
+		return nil, errors.New("process security settings are only supported on linux")
+	}
+
+	osProvider, err := osProvider(p.MotorRuntime.Motor)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := osProvider.FS().Open("/proc/" + strconv.FormatInt(pid, 10) + "/status")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return procfs.ParseProcessStatus(f)
+}
+
 type ProcessCallbackTrigger func()
 
 func (p *mqlProcess) gatherProcessInfo(fn ProcessCallbackTrigger) error {
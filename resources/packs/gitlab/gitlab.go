@@ -3,10 +3,13 @@ package gitlab
 import (
 	"errors"
 	"strconv"
+	"time"
 
+	"github.com/xanzy/go-gitlab"
 	"go.mondoo.com/cnquery/motor/providers"
 	provider "go.mondoo.com/cnquery/motor/providers/gitlab"
 	"go.mondoo.com/cnquery/resources"
+	"go.mondoo.com/cnquery/resources/packs/core"
 	"go.mondoo.com/cnquery/resources/packs/gitlab/info"
 )
 
@@ -16,6 +19,8 @@ func init() {
 	Init(Registry)
 }
 
+const perPage = 100
+
 func gitlabProvider(t providers.Instance) (*provider.Provider, error) {
 	gt, ok := t.(*provider.Provider)
 	if !ok {
@@ -24,6 +29,36 @@ func gitlabProvider(t providers.Instance) (*provider.Provider, error) {
 	return gt, nil
 }
 
+// accessLevelRole returns the name of the role for the access level
+// see https://docs.gitlab.com/ee/api/members.html#valid-access-levels
+func accessLevelRole(level gitlab.AccessLevelValue) string {
+	switch level {
+	case gitlab.NoPermissions:
+		return "none"
+	case gitlab.MinimalAccessPermissions:
+		return "minimal"
+	case gitlab.GuestPermissions:
+		return "guest"
+	case gitlab.ReporterPermissions:
+		return "reporter"
+	case gitlab.DeveloperPermissions:
+		return "developer"
+	case gitlab.MaintainerPermissions:
+		return "maintainer"
+	case gitlab.OwnerPermissions:
+		return "owner"
+	default:
+		return "unknown"
+	}
+}
+
+func isoTime(t *gitlab.ISOTime) *time.Time {
+	if t == nil {
+		return nil
+	}
+	return core.MqlTime(time.Time(*t))
+}
+
 func (g *mqlGitlabGroup) id() (string, error) {
 	id, _ := g.Id()
 	return "gitlab.group/" + strconv.FormatInt(id, 10), nil
@@ -41,7 +76,7 @@ func (g *mqlGitlabGroup) init(args *resources.Args) (*resources.Args, GitlabGrou
 		return nil, nil, err
 	}
 
-	grp, _, err := gt.Client().Groups.GetGroup(gt.GroupPath, nil)
+	grp, err := gt.Group()
 	if err != nil {
 		return nil, nil, err
 	}
@@ -49,52 +84,702 @@ func (g *mqlGitlabGroup) init(args *resources.Args) (*resources.Args, GitlabGrou
 	(*args)["id"] = int64(grp.ID)
 	(*args)["name"] = grp.Name
 	(*args)["path"] = grp.Path
+	(*args)["fullPath"] = grp.FullPath
 	(*args)["description"] = grp.Description
 	(*args)["visibility"] = string(grp.Visibility)
+	(*args)["webURL"] = grp.WebURL
 	(*args)["requireTwoFactorAuthentication"] = grp.RequireTwoFactorAuth
+	(*args)["preventForkingOutsideGroup"] = grp.PreventForkingOutsideGroup
+	(*args)["createdAt"] = grp.CreatedAt
 
 	return args, nil, nil
 }
 
-// GetProjects list all projects that belong to a group
-// see https://docs.gitlab.com/ee/api/projects.html
+// GetProjects list all projects that belong to a group, including the ones of subgroups
+// see https://docs.gitlab.com/ee/api/groups.html#list-a-groups-projects
 func (g *mqlGitlabGroup) GetProjects() ([]interface{}, error) {
 	gt, err := gitlabProvider(g.MotorRuntime.Motor.Provider)
 	if err != nil {
 		return nil, err
 	}
 
-	path, err := g.Path()
+	gid, err := g.Id()
+	if err != nil {
+		return nil, err
+	}
+
+	var mqlProjects []interface{}
+	opts := &gitlab.ListGroupProjectsOptions{
+		ListOptions:      gitlab.ListOptions{PerPage: perPage},
+		IncludeSubGroups: gitlab.Bool(true),
+	}
+	for {
+		projects, resp, err := gt.Client().Groups.ListGroupProjects(int(gid), opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range projects {
+			mqlProject, err := g.MotorRuntime.CreateResource("gitlab.project", projectArgs(projects[i])...)
+			if err != nil {
+				return nil, err
+			}
+			mqlProjects = append(mqlProjects, mqlProject)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return mqlProjects, nil
+}
+
+// GetMembers lists the members of the group, including inherited members
+// see https://docs.gitlab.com/ee/api/members.html#list-all-members-of-a-group-or-project-including-inherited-and-invited-members
+func (g *mqlGitlabGroup) GetMembers() ([]interface{}, error) {
+	gt, err := gitlabProvider(g.MotorRuntime.Motor.Provider)
 	if err != nil {
 		return nil, err
 	}
 
-	grp, _, err := gt.Client().Groups.GetGroup(path, nil)
+	gid, err := g.Id()
 	if err != nil {
 		return nil, err
 	}
+	groupID, _ := g.id()
 
-	var mqlProjects []interface{}
-	for i := range grp.Projects {
-		prj := grp.Projects[i]
-
-		mqlProject, err := g.MotorRuntime.CreateResource("gitlab.project",
-			"id", int64(prj.ID),
-			"name", prj.Name,
-			"path", prj.Path,
-			"description", prj.Description,
-			"visibility", string(prj.Visibility),
-		)
+	res := []interface{}{}
+	opts := &gitlab.ListGroupMembersOptions{ListOptions: gitlab.ListOptions{PerPage: perPage}}
+	for {
+		members, resp, err := gt.Client().Groups.ListAllGroupMembers(int(gid), opts)
 		if err != nil {
 			return nil, err
 		}
-		mqlProjects = append(mqlProjects, mqlProject)
+
+		for i := range members {
+			m := members[i]
+			mqlMember, err := g.MotorRuntime.CreateResource("gitlab.member",
+				"__id", groupID+"/member/"+strconv.Itoa(m.ID),
+				"id", int64(m.ID),
+				"username", m.Username,
+				"name", m.Name,
+				"state", m.State,
+				"accessLevel", int64(m.AccessLevel),
+				"role", accessLevelRole(m.AccessLevel),
+				"expiresAt", isoTime(m.ExpiresAt),
+				"createdAt", m.CreatedAt,
+			)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, mqlMember)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
 	}
 
-	return mqlProjects, nil
+	return res, nil
+}
+
+// GetVariables lists the CI/CD variables of the group. The values are not part of
+// the resource since they may contain secrets.
+// see https://docs.gitlab.com/ee/api/group_level_variables.html
+func (g *mqlGitlabGroup) GetVariables() ([]interface{}, error) {
+	gt, err := gitlabProvider(g.MotorRuntime.Motor.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	gid, err := g.Id()
+	if err != nil {
+		return nil, err
+	}
+	groupID, _ := g.id()
+
+	res := []interface{}{}
+	opts := &gitlab.ListGroupVariablesOptions{PerPage: perPage}
+	for {
+		variables, resp, err := gt.Client().GroupVariables.ListVariables(int(gid), opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range variables {
+			v := variables[i]
+			mqlVariable, err := newMqlVariable(g.MotorRuntime, groupID, v.Key, string(v.VariableType), v.Protected, v.Masked, v.EnvironmentScope)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, mqlVariable)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return res, nil
+}
+
+// GetRunners lists the runners available to the group
+// see https://docs.gitlab.com/ee/api/runners.html#list-groups-runners
+func (g *mqlGitlabGroup) GetRunners() ([]interface{}, error) {
+	gt, err := gitlabProvider(g.MotorRuntime.Motor.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	gid, err := g.Id()
+	if err != nil {
+		return nil, err
+	}
+
+	res := []interface{}{}
+	opts := &gitlab.ListGroupsRunnersOptions{ListOptions: gitlab.ListOptions{PerPage: perPage}}
+	for {
+		runners, resp, err := gt.Client().Runners.ListGroupsRunners(int(gid), opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range runners {
+			mqlRunner, err := newMqlRunner(g.MotorRuntime, runners[i])
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, mqlRunner)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return res, nil
+}
+
+// projectArgs returns the resource arguments of a gitlab.project
+func projectArgs(prj *gitlab.Project) []interface{} {
+	return []interface{}{
+		"id", int64(prj.ID),
+		"name", prj.Name,
+		"path", prj.Path,
+		"fullPath", prj.PathWithNamespace,
+		"description", prj.Description,
+		"visibility", string(prj.Visibility),
+		"webURL", prj.WebURL,
+		"defaultBranch", prj.DefaultBranch,
+		"archived", prj.Archived,
+		"createdAt", prj.CreatedAt,
+		"onlyAllowMergeIfPipelineSucceeds", prj.OnlyAllowMergeIfPipelineSucceeds,
+		"onlyAllowMergeIfAllDiscussionsAreResolved", prj.OnlyAllowMergeIfAllDiscussionsAreResolved,
+		"allowMergeOnSkippedPipeline", prj.AllowMergeOnSkippedPipeline,
+		"removeSourceBranchAfterMerge", prj.RemoveSourceBranchAfterMerge,
+		"mergeMethod", string(prj.MergeMethod),
+		"squashOption", string(prj.SquashOption),
+		"requestAccessEnabled", prj.RequestAccessEnabled,
+		"issuesEnabled", prj.IssuesEnabled,
+		"mergeRequestsEnabled", prj.MergeRequestsEnabled,
+		"wikiEnabled", prj.WikiEnabled,
+		"snippetsEnabled", prj.SnippetsEnabled,
+		"containerRegistryEnabled", prj.ContainerRegistryEnabled,
+		"packagesEnabled", prj.PackagesEnabled,
+		"jobsEnabled", prj.JobsEnabled,
+		"publicJobs", prj.PublicBuilds,
+		"sharedRunnersEnabled", prj.SharedRunnersEnabled,
+		"autoDevopsEnabled", prj.AutoDevopsEnabled,
+		"forkingAccessLevel", string(prj.ForkingAccessLevel),
+		"restrictUserDefinedVariables", prj.RestrictUserDefinedVariables,
+		"ciConfigPath", prj.CIConfigPath,
+	}
 }
 
 func (g *mqlGitlabProject) id() (string, error) {
 	id, _ := g.Id()
 	return "gitlab.project/" + strconv.FormatInt(id, 10), nil
 }
+
+// init initializes the gitlab project that is scanned, or the one with the given full path
+// see https://docs.gitlab.com/ee/api/projects.html#get-single-project
+func (g *mqlGitlabProject) init(args *resources.Args) (*resources.Args, GitlabProject, error) {
+	if len(*args) > 2 {
+		return args, nil, nil
+	}
+
+	gt, err := gitlabProvider(g.MotorRuntime.Motor.Provider)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path := gt.ProjectPath
+	if x, ok := (*args)["fullPath"]; ok {
+		path, _ = x.(string)
+	}
+	if path == "" {
+		return nil, nil, errors.New("gitlab.project needs a project, either pass its fullPath or scan a gitlab project")
+	}
+
+	prj, _, err := gt.Client().Projects.GetProject(path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	projectArgs := projectArgs(prj)
+	for i := 0; i < len(projectArgs); i += 2 {
+		(*args)[projectArgs[i].(string)] = projectArgs[i+1]
+	}
+
+	return args, nil, nil
+}
+
+// GetMembers lists the members of the project, including inherited members
+// see https://docs.gitlab.com/ee/api/members.html#list-all-members-of-a-group-or-project-including-inherited-and-invited-members
+func (g *mqlGitlabProject) GetMembers() ([]interface{}, error) {
+	gt, err := gitlabProvider(g.MotorRuntime.Motor.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	pid, err := g.Id()
+	if err != nil {
+		return nil, err
+	}
+	projectID, _ := g.id()
+
+	res := []interface{}{}
+	opts := &gitlab.ListProjectMembersOptions{ListOptions: gitlab.ListOptions{PerPage: perPage}}
+	for {
+		members, resp, err := gt.Client().ProjectMembers.ListAllProjectMembers(int(pid), opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range members {
+			m := members[i]
+			mqlMember, err := g.MotorRuntime.CreateResource("gitlab.member",
+				"__id", projectID+"/member/"+strconv.Itoa(m.ID),
+				"id", int64(m.ID),
+				"username", m.Username,
+				"name", m.Name,
+				"state", m.State,
+				"accessLevel", int64(m.AccessLevel),
+				"role", accessLevelRole(m.AccessLevel),
+				"expiresAt", isoTime(m.ExpiresAt),
+				"createdAt", m.CreatedAt,
+			)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, mqlMember)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return res, nil
+}
+
+// GetProtectedBranches lists the protected branches of the project
+// see https://docs.gitlab.com/ee/api/protected_branches.html#list-protected-branches
+func (g *mqlGitlabProject) GetProtectedBranches() ([]interface{}, error) {
+	gt, err := gitlabProvider(g.MotorRuntime.Motor.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	pid, err := g.Id()
+	if err != nil {
+		return nil, err
+	}
+
+	res := []interface{}{}
+	opts := &gitlab.ListProtectedBranchesOptions{PerPage: perPage}
+	for {
+		branches, resp, err := gt.Client().ProtectedBranches.ListProtectedBranches(int(pid), opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range branches {
+			b := branches[i]
+			pushAccessLevels, err := core.JsonToDictSlice(b.PushAccessLevels)
+			if err != nil {
+				return nil, err
+			}
+			mergeAccessLevels, err := core.JsonToDictSlice(b.MergeAccessLevels)
+			if err != nil {
+				return nil, err
+			}
+			unprotectAccessLevels, err := core.JsonToDictSlice(b.UnprotectAccessLevels)
+			if err != nil {
+				return nil, err
+			}
+
+			mqlBranch, err := g.MotorRuntime.CreateResource("gitlab.project.protectedBranch",
+				"id", int64(b.ID),
+				"name", b.Name,
+				"allowForcePush", b.AllowForcePush,
+				"codeOwnerApprovalRequired", b.CodeOwnerApprovalRequired,
+				"pushAccessLevels", pushAccessLevels,
+				"mergeAccessLevels", mergeAccessLevels,
+				"unprotectAccessLevels", unprotectAccessLevels,
+			)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, mqlBranch)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return res, nil
+}
+
+// GetApprovalSettings returns the merge request approval settings of the project
+// see https://docs.gitlab.com/ee/api/merge_request_approvals.html#get-configuration
+func (g *mqlGitlabProject) GetApprovalSettings() (interface{}, error) {
+	gt, err := gitlabProvider(g.MotorRuntime.Motor.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	pid, err := g.Id()
+	if err != nil {
+		return nil, err
+	}
+	projectID, _ := g.id()
+
+	approvals, _, err := gt.Client().Projects.GetApprovalConfiguration(int(pid))
+	if err != nil {
+		return nil, err
+	}
+
+	return g.MotorRuntime.CreateResource("gitlab.project.approvalSetting",
+		"__id", projectID+"/approvalSettings",
+		"approvalsBeforeMerge", int64(approvals.ApprovalsBeforeMerge),
+		"resetApprovalsOnPush", approvals.ResetApprovalsOnPush,
+		"disableOverridingApproversPerMergeRequest", approvals.DisableOverridingApproversPerMergeRequest,
+		"mergeRequestsAuthorApproval", approvals.MergeRequestsAuthorApproval,
+		"mergeRequestsDisableCommittersApproval", approvals.MergeRequestsDisableCommittersApproval,
+		"requirePasswordToApprove", approvals.RequirePasswordToApprove,
+	)
+}
+
+// GetApprovalRules lists the merge request approval rules of the project
+// see https://docs.gitlab.com/ee/api/merge_request_approvals.html#get-project-level-rules
+func (g *mqlGitlabProject) GetApprovalRules() ([]interface{}, error) {
+	gt, err := gitlabProvider(g.MotorRuntime.Motor.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	pid, err := g.Id()
+	if err != nil {
+		return nil, err
+	}
+
+	rules, _, err := gt.Client().Projects.GetProjectApprovalRules(int(pid))
+	if err != nil {
+		return nil, err
+	}
+
+	res := []interface{}{}
+	for i := range rules {
+		r := rules[i]
+
+		users := []interface{}{}
+		for j := range r.Users {
+			users = append(users, r.Users[j].Username)
+		}
+		groups := []interface{}{}
+		for j := range r.Groups {
+			groups = append(groups, r.Groups[j].FullPath)
+		}
+		branches := []interface{}{}
+		for j := range r.ProtectedBranches {
+			branches = append(branches, r.ProtectedBranches[j].Name)
+		}
+
+		mqlRule, err := g.MotorRuntime.CreateResource("gitlab.project.approvalRule",
+			"id", int64(r.ID),
+			"name", r.Name,
+			"ruleType", r.RuleType,
+			"approvalsRequired", int64(r.ApprovalsRequired),
+			"users", users,
+			"groups", groups,
+			"protectedBranches", branches,
+		)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, mqlRule)
+	}
+
+	return res, nil
+}
+
+// GetVariables lists the CI/CD variables of the project. The values are not part of
+// the resource since they may contain secrets.
+// see https://docs.gitlab.com/ee/api/project_level_variables.html
+func (g *mqlGitlabProject) GetVariables() ([]interface{}, error) {
+	gt, err := gitlabProvider(g.MotorRuntime.Motor.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	pid, err := g.Id()
+	if err != nil {
+		return nil, err
+	}
+	projectID, _ := g.id()
+
+	res := []interface{}{}
+	opts := &gitlab.ListProjectVariablesOptions{PerPage: perPage}
+	for {
+		variables, resp, err := gt.Client().ProjectVariables.ListVariables(int(pid), opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range variables {
+			v := variables[i]
+			mqlVariable, err := newMqlVariable(g.MotorRuntime, projectID, v.Key, string(v.VariableType), v.Protected, v.Masked, v.EnvironmentScope)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, mqlVariable)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return res, nil
+}
+
+// GetDeployKeys lists the deploy keys of the project
+// see https://docs.gitlab.com/ee/api/deploy_keys.html#list-deploy-keys-for-project
+func (g *mqlGitlabProject) GetDeployKeys() ([]interface{}, error) {
+	gt, err := gitlabProvider(g.MotorRuntime.Motor.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	pid, err := g.Id()
+	if err != nil {
+		return nil, err
+	}
+	projectID, _ := g.id()
+
+	res := []interface{}{}
+	opts := &gitlab.ListProjectDeployKeysOptions{PerPage: perPage}
+	for {
+		keys, resp, err := gt.Client().DeployKeys.ListProjectDeployKeys(int(pid), opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range keys {
+			k := keys[i]
+			// deploy keys can be shared between projects, write access is set per project
+			mqlKey, err := g.MotorRuntime.CreateResource("gitlab.project.deployKey",
+				"__id", projectID+"/deployKey/"+strconv.Itoa(k.ID),
+				"id", int64(k.ID),
+				"title", k.Title,
+				"key", k.Key,
+				"canPush", k.CanPush,
+				"createdAt", k.CreatedAt,
+			)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, mqlKey)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return res, nil
+}
+
+// GetWebhooks lists the webhooks of the project
+// see https://docs.gitlab.com/ee/api/projects.html#list-project-hooks
+func (g *mqlGitlabProject) GetWebhooks() ([]interface{}, error) {
+	gt, err := gitlabProvider(g.MotorRuntime.Motor.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	pid, err := g.Id()
+	if err != nil {
+		return nil, err
+	}
+
+	res := []interface{}{}
+	opts := &gitlab.ListProjectHooksOptions{PerPage: perPage}
+	for {
+		hooks, resp, err := gt.Client().Projects.ListProjectHooks(int(pid), opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range hooks {
+			h := hooks[i]
+			mqlHook, err := g.MotorRuntime.CreateResource("gitlab.project.webhook",
+				"id", int64(h.ID),
+				"url", h.URL,
+				"pushEvents", h.PushEvents,
+				"pushEventsBranchFilter", h.PushEventsBranchFilter,
+				"tagPushEvents", h.TagPushEvents,
+				"mergeRequestsEvents", h.MergeRequestsEvents,
+				"issuesEvents", h.IssuesEvents,
+				"confidentialIssuesEvents", h.ConfidentialIssuesEvents,
+				"noteEvents", h.NoteEvents,
+				"confidentialNoteEvents", h.ConfidentialNoteEvents,
+				"jobEvents", h.JobEvents,
+				"pipelineEvents", h.PipelineEvents,
+				"wikiPageEvents", h.WikiPageEvents,
+				"deploymentEvents", h.DeploymentEvents,
+				"releasesEvents", h.ReleasesEvents,
+				"enableSslVerification", h.EnableSSLVerification,
+				"createdAt", h.CreatedAt,
+			)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, mqlHook)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return res, nil
+}
+
+// GetRunners lists the runners available to the project
+// see https://docs.gitlab.com/ee/api/runners.html#list-projects-runners
+func (g *mqlGitlabProject) GetRunners() ([]interface{}, error) {
+	gt, err := gitlabProvider(g.MotorRuntime.Motor.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	pid, err := g.Id()
+	if err != nil {
+		return nil, err
+	}
+
+	res := []interface{}{}
+	opts := &gitlab.ListProjectRunnersOptions{ListOptions: gitlab.ListOptions{PerPage: perPage}}
+	for {
+		runners, resp, err := gt.Client().Runners.ListProjectRunners(int(pid), opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range runners {
+			mqlRunner, err := newMqlRunner(g.MotorRuntime, runners[i])
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, mqlRunner)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return res, nil
+}
+
+func (g *mqlGitlabMember) id() (string, error) {
+	id, _ := g.Id()
+	return "gitlab.member/" + strconv.FormatInt(id, 10), nil
+}
+
+func (g *mqlGitlabProjectProtectedBranch) id() (string, error) {
+	id, _ := g.Id()
+	return "gitlab.project.protectedBranch/" + strconv.FormatInt(id, 10), nil
+}
+
+func (g *mqlGitlabProjectApprovalSetting) id() (string, error) {
+	return "gitlab.project.approvalSetting", nil
+}
+
+func (g *mqlGitlabProjectApprovalRule) id() (string, error) {
+	id, _ := g.Id()
+	return "gitlab.project.approvalRule/" + strconv.FormatInt(id, 10), nil
+}
+
+// newMqlVariable creates a gitlab.variable, variables are scoped by their group or project
+func newMqlVariable(runtime *resources.Runtime, scope string, key string, typ string, protected bool, masked bool, environmentScope string) (resources.ResourceType, error) {
+	return runtime.CreateResource("gitlab.variable",
+		"__id", scope+"/variable/"+key+"/"+environmentScope,
+		"key", key,
+		"type", typ,
+		"protected", protected,
+		"masked", masked,
+		"environmentScope", environmentScope,
+	)
+}
+
+func (g *mqlGitlabVariable) id() (string, error) {
+	key, _ := g.Key()
+	scope, _ := g.EnvironmentScope()
+	return "gitlab.variable/" + key + "/" + scope, nil
+}
+
+func (g *mqlGitlabProjectDeployKey) id() (string, error) {
+	id, _ := g.Id()
+	return "gitlab.project.deployKey/" + strconv.FormatInt(id, 10), nil
+}
+
+func (g *mqlGitlabProjectWebhook) id() (string, error) {
+	id, _ := g.Id()
+	return "gitlab.project.webhook/" + strconv.FormatInt(id, 10), nil
+}
+
+// newMqlRunner creates a gitlab.runner, the runner token is not part of the resource
+func newMqlRunner(runtime *resources.Runtime, r *gitlab.Runner) (resources.ResourceType, error) {
+	return runtime.CreateResource("gitlab.runner",
+		"id", int64(r.ID),
+		"name", r.Name,
+		"description", r.Description,
+		"runnerType", r.RunnerType,
+		"isShared", r.IsShared,
+		"active", r.Active,
+		"paused", r.Paused,
+		"online", r.Online,
+		"status", r.Status,
+		"ipAddress", r.IPAddress,
+	)
+}
+
+func (g *mqlGitlabRunner) id() (string, error) {
+	id, _ := g.Id()
+	return "gitlab.runner/" + strconv.FormatInt(id, 10), nil
+}
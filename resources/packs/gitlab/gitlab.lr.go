@@ -14,6 +14,14 @@ import (
 func Init(registry *resources.Registry) {
 	registry.AddFactory("gitlab.group", newGitlabGroup)
 	registry.AddFactory("gitlab.project", newGitlabProject)
+	registry.AddFactory("gitlab.member", newGitlabMember)
+	registry.AddFactory("gitlab.project.protectedBranch", newGitlabProjectProtectedBranch)
+	registry.AddFactory("gitlab.project.approvalSetting", newGitlabProjectApprovalSetting)
+	registry.AddFactory("gitlab.project.approvalRule", newGitlabProjectApprovalRule)
+	registry.AddFactory("gitlab.variable", newGitlabVariable)
+	registry.AddFactory("gitlab.project.deployKey", newGitlabProjectDeployKey)
+	registry.AddFactory("gitlab.project.webhook", newGitlabProjectWebhook)
+	registry.AddFactory("gitlab.runner", newGitlabRunner)
 }
 
 // GitlabGroup resource interface
@@ -26,10 +34,17 @@ type GitlabGroup interface {
 	Id() (int64, error)
 	Name() (string, error)
 	Path() (string, error)
+	FullPath() (string, error)
 	Description() (string, error)
 	Visibility() (string, error)
+	WebURL() (string, error)
 	RequireTwoFactorAuthentication() (bool, error)
+	PreventForkingOutsideGroup() (bool, error)
+	CreatedAt() (*time.Time, error)
 	Projects() ([]interface{}, error)
+	Members() ([]interface{}, error)
+	Variables() ([]interface{}, error)
+	Runners() ([]interface{}, error)
 }
 
 // mqlGitlabGroup for the gitlab.group resource
@@ -79,6 +94,10 @@ func newGitlabGroup(runtime *resources.Runtime, args *resources.Args) (interface
 			if _, ok := val.(string); !ok {
 				return nil, errors.New("Failed to initialize \"gitlab.group\", its \"path\" argument has the wrong type (expected type \"string\")")
 			}
+		case "fullPath":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.group\", its \"fullPath\" argument has the wrong type (expected type \"string\")")
+			}
 		case "description":
 			if _, ok := val.(string); !ok {
 				return nil, errors.New("Failed to initialize \"gitlab.group\", its \"description\" argument has the wrong type (expected type \"string\")")
@@ -87,14 +106,38 @@ func newGitlabGroup(runtime *resources.Runtime, args *resources.Args) (interface
 			if _, ok := val.(string); !ok {
 				return nil, errors.New("Failed to initialize \"gitlab.group\", its \"visibility\" argument has the wrong type (expected type \"string\")")
 			}
+		case "webURL":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.group\", its \"webURL\" argument has the wrong type (expected type \"string\")")
+			}
 		case "requireTwoFactorAuthentication":
 			if _, ok := val.(bool); !ok {
 				return nil, errors.New("Failed to initialize \"gitlab.group\", its \"requireTwoFactorAuthentication\" argument has the wrong type (expected type \"bool\")")
 			}
+		case "preventForkingOutsideGroup":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.group\", its \"preventForkingOutsideGroup\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "createdAt":
+			if _, ok := val.(*time.Time); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.group\", its \"createdAt\" argument has the wrong type (expected type \"*time.Time\")")
+			}
 		case "projects":
 			if _, ok := val.([]interface{}); !ok {
 				return nil, errors.New("Failed to initialize \"gitlab.group\", its \"projects\" argument has the wrong type (expected type \"[]interface{}\")")
 			}
+		case "members":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.group\", its \"members\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
+		case "variables":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.group\", its \"variables\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
+		case "runners":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.group\", its \"runners\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
 		case "__id":
 			idVal, ok := val.(string)
 			if !ok {
@@ -131,15 +174,27 @@ func (s *mqlGitlabGroup) Validate() error {
 	if _, ok := s.Cache.Load("path"); !ok {
 		return errors.New("Initialized \"gitlab.group\" resource without a \"path\". This field is required.")
 	}
+	if _, ok := s.Cache.Load("fullPath"); !ok {
+		return errors.New("Initialized \"gitlab.group\" resource without a \"fullPath\". This field is required.")
+	}
 	if _, ok := s.Cache.Load("description"); !ok {
 		return errors.New("Initialized \"gitlab.group\" resource without a \"description\". This field is required.")
 	}
 	if _, ok := s.Cache.Load("visibility"); !ok {
 		return errors.New("Initialized \"gitlab.group\" resource without a \"visibility\". This field is required.")
 	}
+	if _, ok := s.Cache.Load("webURL"); !ok {
+		return errors.New("Initialized \"gitlab.group\" resource without a \"webURL\". This field is required.")
+	}
 	if _, ok := s.Cache.Load("requireTwoFactorAuthentication"); !ok {
 		return errors.New("Initialized \"gitlab.group\" resource without a \"requireTwoFactorAuthentication\". This field is required.")
 	}
+	if _, ok := s.Cache.Load("preventForkingOutsideGroup"); !ok {
+		return errors.New("Initialized \"gitlab.group\" resource without a \"preventForkingOutsideGroup\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("createdAt"); !ok {
+		return errors.New("Initialized \"gitlab.group\" resource without a \"createdAt\". This field is required.")
+	}
 
 	return nil
 }
@@ -154,14 +209,28 @@ func (s *mqlGitlabGroup) Register(name string) error {
 		return nil
 	case "path":
 		return nil
+	case "fullPath":
+		return nil
 	case "description":
 		return nil
 	case "visibility":
 		return nil
+	case "webURL":
+		return nil
 	case "requireTwoFactorAuthentication":
 		return nil
+	case "preventForkingOutsideGroup":
+		return nil
+	case "createdAt":
+		return nil
 	case "projects":
 		return nil
+	case "members":
+		return nil
+	case "variables":
+		return nil
+	case "runners":
+		return nil
 	default:
 		return errors.New("Cannot find field '" + name + "' in \"gitlab.group\" resource")
 	}
@@ -177,14 +246,28 @@ func (s *mqlGitlabGroup) Field(name string) (interface{}, error) {
 		return s.Name()
 	case "path":
 		return s.Path()
+	case "fullPath":
+		return s.FullPath()
 	case "description":
 		return s.Description()
 	case "visibility":
 		return s.Visibility()
+	case "webURL":
+		return s.WebURL()
 	case "requireTwoFactorAuthentication":
 		return s.RequireTwoFactorAuthentication()
+	case "preventForkingOutsideGroup":
+		return s.PreventForkingOutsideGroup()
+	case "createdAt":
+		return s.CreatedAt()
 	case "projects":
 		return s.Projects()
+	case "members":
+		return s.Members()
+	case "variables":
+		return s.Variables()
+	case "runners":
+		return s.Runners()
 	default:
 		return nil, fmt.Errorf("Cannot find field '" + name + "' in \"gitlab.group\" resource")
 	}
@@ -238,6 +321,22 @@ func (s *mqlGitlabGroup) Path() (string, error) {
 	return tres, nil
 }
 
+// FullPath accessor autogenerated
+func (s *mqlGitlabGroup) FullPath() (string, error) {
+	res, ok := s.Cache.Load("fullPath")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.group\" failed: no value provided for static field \"fullPath\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.group\" failed to cast field \"fullPath\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
 // Description accessor autogenerated
 func (s *mqlGitlabGroup) Description() (string, error) {
 	res, ok := s.Cache.Load("description")
@@ -270,6 +369,22 @@ func (s *mqlGitlabGroup) Visibility() (string, error) {
 	return tres, nil
 }
 
+// WebURL accessor autogenerated
+func (s *mqlGitlabGroup) WebURL() (string, error) {
+	res, ok := s.Cache.Load("webURL")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.group\" failed: no value provided for static field \"webURL\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.group\" failed to cast field \"webURL\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
 // RequireTwoFactorAuthentication accessor autogenerated
 func (s *mqlGitlabGroup) RequireTwoFactorAuthentication() (bool, error) {
 	res, ok := s.Cache.Load("requireTwoFactorAuthentication")
@@ -286,6 +401,38 @@ func (s *mqlGitlabGroup) RequireTwoFactorAuthentication() (bool, error) {
 	return tres, nil
 }
 
+// PreventForkingOutsideGroup accessor autogenerated
+func (s *mqlGitlabGroup) PreventForkingOutsideGroup() (bool, error) {
+	res, ok := s.Cache.Load("preventForkingOutsideGroup")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.group\" failed: no value provided for static field \"preventForkingOutsideGroup\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.group\" failed to cast field \"preventForkingOutsideGroup\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// CreatedAt accessor autogenerated
+func (s *mqlGitlabGroup) CreatedAt() (*time.Time, error) {
+	res, ok := s.Cache.Load("createdAt")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"gitlab.group\" failed: no value provided for static field \"createdAt\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(*time.Time)
+	if !ok {
+		return nil, fmt.Errorf("\"gitlab.group\" failed to cast field \"createdAt\" to the right type (*time.Time): %#v", res)
+	}
+	return tres, nil
+}
+
 // Projects accessor autogenerated
 func (s *mqlGitlabGroup) Projects() ([]interface{}, error) {
 	res, ok := s.Cache.Load("projects")
@@ -309,6 +456,75 @@ func (s *mqlGitlabGroup) Projects() ([]interface{}, error) {
 	return tres, nil
 }
 
+// Members accessor autogenerated
+func (s *mqlGitlabGroup) Members() ([]interface{}, error) {
+	res, ok := s.Cache.Load("members")
+	if !ok || !res.Valid {
+		if err := s.ComputeMembers(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("members")
+		if !ok {
+			return nil, errors.New("\"gitlab.group\" calculated \"members\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "members")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"gitlab.group\" failed to cast field \"members\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Variables accessor autogenerated
+func (s *mqlGitlabGroup) Variables() ([]interface{}, error) {
+	res, ok := s.Cache.Load("variables")
+	if !ok || !res.Valid {
+		if err := s.ComputeVariables(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("variables")
+		if !ok {
+			return nil, errors.New("\"gitlab.group\" calculated \"variables\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "variables")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"gitlab.group\" failed to cast field \"variables\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Runners accessor autogenerated
+func (s *mqlGitlabGroup) Runners() ([]interface{}, error) {
+	res, ok := s.Cache.Load("runners")
+	if !ok || !res.Valid {
+		if err := s.ComputeRunners(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("runners")
+		if !ok {
+			return nil, errors.New("\"gitlab.group\" calculated \"runners\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "runners")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"gitlab.group\" failed to cast field \"runners\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
 // Compute accessor autogenerated
 func (s *mqlGitlabGroup) Compute(name string) error {
 	log.Trace().Str("field", name).Msg("[gitlab.group].Compute")
@@ -319,14 +535,28 @@ func (s *mqlGitlabGroup) Compute(name string) error {
 		return nil
 	case "path":
 		return nil
+	case "fullPath":
+		return nil
 	case "description":
 		return nil
 	case "visibility":
 		return nil
+	case "webURL":
+		return nil
 	case "requireTwoFactorAuthentication":
 		return nil
+	case "preventForkingOutsideGroup":
+		return nil
+	case "createdAt":
+		return nil
 	case "projects":
 		return s.ComputeProjects()
+	case "members":
+		return s.ComputeMembers()
+	case "variables":
+		return s.ComputeVariables()
+	case "runners":
+		return s.ComputeRunners()
 	default:
 		return errors.New("Cannot find field '" + name + "' in \"gitlab.group\" resource")
 	}
@@ -346,6 +576,48 @@ func (s *mqlGitlabGroup) ComputeProjects() error {
 	return nil
 }
 
+// ComputeMembers computer autogenerated
+func (s *mqlGitlabGroup) ComputeMembers() error {
+	var err error
+	if _, ok := s.Cache.Load("members"); ok {
+		return nil
+	}
+	vres, err := s.GetMembers()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("members", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeVariables computer autogenerated
+func (s *mqlGitlabGroup) ComputeVariables() error {
+	var err error
+	if _, ok := s.Cache.Load("variables"); ok {
+		return nil
+	}
+	vres, err := s.GetVariables()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("variables", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeRunners computer autogenerated
+func (s *mqlGitlabGroup) ComputeRunners() error {
+	var err error
+	if _, ok := s.Cache.Load("runners"); ok {
+		return nil
+	}
+	vres, err := s.GetRunners()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("runners", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
 // GitlabProject resource interface
 type GitlabProject interface {
 	MqlResource() (*resources.Resource)
@@ -356,8 +628,41 @@ type GitlabProject interface {
 	Id() (int64, error)
 	Name() (string, error)
 	Path() (string, error)
+	FullPath() (string, error)
 	Description() (string, error)
 	Visibility() (string, error)
+	WebURL() (string, error)
+	DefaultBranch() (string, error)
+	Archived() (bool, error)
+	CreatedAt() (*time.Time, error)
+	OnlyAllowMergeIfPipelineSucceeds() (bool, error)
+	OnlyAllowMergeIfAllDiscussionsAreResolved() (bool, error)
+	AllowMergeOnSkippedPipeline() (bool, error)
+	RemoveSourceBranchAfterMerge() (bool, error)
+	MergeMethod() (string, error)
+	SquashOption() (string, error)
+	RequestAccessEnabled() (bool, error)
+	IssuesEnabled() (bool, error)
+	MergeRequestsEnabled() (bool, error)
+	WikiEnabled() (bool, error)
+	SnippetsEnabled() (bool, error)
+	ContainerRegistryEnabled() (bool, error)
+	PackagesEnabled() (bool, error)
+	JobsEnabled() (bool, error)
+	PublicJobs() (bool, error)
+	SharedRunnersEnabled() (bool, error)
+	AutoDevopsEnabled() (bool, error)
+	ForkingAccessLevel() (string, error)
+	RestrictUserDefinedVariables() (bool, error)
+	CiConfigPath() (string, error)
+	Members() ([]interface{}, error)
+	ProtectedBranches() ([]interface{}, error)
+	ApprovalSettings() (GitlabProjectApprovalSetting, error)
+	ApprovalRules() ([]interface{}, error)
+	Variables() ([]interface{}, error)
+	DeployKeys() ([]interface{}, error)
+	Webhooks() ([]interface{}, error)
+	Runners() ([]interface{}, error)
 }
 
 // mqlGitlabProject for the gitlab.project resource
@@ -375,6 +680,15 @@ func newGitlabProject(runtime *resources.Runtime, args *resources.Args) (interfa
 	// User hooks
 	var err error
 	res := mqlGitlabProject{runtime.NewResource("gitlab.project")}
+	var existing GitlabProject
+	args, existing, err = res.init(args)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
 	// assign all named fields
 	var id string
 
@@ -398,6 +712,10 @@ func newGitlabProject(runtime *resources.Runtime, args *resources.Args) (interfa
 			if _, ok := val.(string); !ok {
 				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"path\" argument has the wrong type (expected type \"string\")")
 			}
+		case "fullPath":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"fullPath\" argument has the wrong type (expected type \"string\")")
+			}
 		case "description":
 			if _, ok := val.(string); !ok {
 				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"description\" argument has the wrong type (expected type \"string\")")
@@ -406,6 +724,134 @@ func newGitlabProject(runtime *resources.Runtime, args *resources.Args) (interfa
 			if _, ok := val.(string); !ok {
 				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"visibility\" argument has the wrong type (expected type \"string\")")
 			}
+		case "webURL":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"webURL\" argument has the wrong type (expected type \"string\")")
+			}
+		case "defaultBranch":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"defaultBranch\" argument has the wrong type (expected type \"string\")")
+			}
+		case "archived":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"archived\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "createdAt":
+			if _, ok := val.(*time.Time); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"createdAt\" argument has the wrong type (expected type \"*time.Time\")")
+			}
+		case "onlyAllowMergeIfPipelineSucceeds":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"onlyAllowMergeIfPipelineSucceeds\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "onlyAllowMergeIfAllDiscussionsAreResolved":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"onlyAllowMergeIfAllDiscussionsAreResolved\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "allowMergeOnSkippedPipeline":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"allowMergeOnSkippedPipeline\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "removeSourceBranchAfterMerge":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"removeSourceBranchAfterMerge\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "mergeMethod":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"mergeMethod\" argument has the wrong type (expected type \"string\")")
+			}
+		case "squashOption":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"squashOption\" argument has the wrong type (expected type \"string\")")
+			}
+		case "requestAccessEnabled":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"requestAccessEnabled\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "issuesEnabled":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"issuesEnabled\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "mergeRequestsEnabled":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"mergeRequestsEnabled\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "wikiEnabled":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"wikiEnabled\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "snippetsEnabled":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"snippetsEnabled\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "containerRegistryEnabled":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"containerRegistryEnabled\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "packagesEnabled":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"packagesEnabled\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "jobsEnabled":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"jobsEnabled\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "publicJobs":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"publicJobs\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "sharedRunnersEnabled":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"sharedRunnersEnabled\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "autoDevopsEnabled":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"autoDevopsEnabled\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "forkingAccessLevel":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"forkingAccessLevel\" argument has the wrong type (expected type \"string\")")
+			}
+		case "restrictUserDefinedVariables":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"restrictUserDefinedVariables\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "ciConfigPath":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"ciConfigPath\" argument has the wrong type (expected type \"string\")")
+			}
+		case "members":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"members\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
+		case "protectedBranches":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"protectedBranches\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
+		case "approvalSettings":
+			if _, ok := val.(GitlabProjectApprovalSetting); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"approvalSettings\" argument has the wrong type (expected type \"GitlabProjectApprovalSetting\")")
+			}
+		case "approvalRules":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"approvalRules\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
+		case "variables":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"variables\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
+		case "deployKeys":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"deployKeys\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
+		case "webhooks":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"webhooks\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
+		case "runners":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project\", its \"runners\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
 		case "__id":
 			idVal, ok := val.(string)
 			if !ok {
@@ -442,12 +888,87 @@ func (s *mqlGitlabProject) Validate() error {
 	if _, ok := s.Cache.Load("path"); !ok {
 		return errors.New("Initialized \"gitlab.project\" resource without a \"path\". This field is required.")
 	}
+	if _, ok := s.Cache.Load("fullPath"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"fullPath\". This field is required.")
+	}
 	if _, ok := s.Cache.Load("description"); !ok {
 		return errors.New("Initialized \"gitlab.project\" resource without a \"description\". This field is required.")
 	}
 	if _, ok := s.Cache.Load("visibility"); !ok {
 		return errors.New("Initialized \"gitlab.project\" resource without a \"visibility\". This field is required.")
 	}
+	if _, ok := s.Cache.Load("webURL"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"webURL\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("defaultBranch"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"defaultBranch\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("archived"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"archived\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("createdAt"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"createdAt\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("onlyAllowMergeIfPipelineSucceeds"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"onlyAllowMergeIfPipelineSucceeds\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("onlyAllowMergeIfAllDiscussionsAreResolved"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"onlyAllowMergeIfAllDiscussionsAreResolved\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("allowMergeOnSkippedPipeline"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"allowMergeOnSkippedPipeline\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("removeSourceBranchAfterMerge"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"removeSourceBranchAfterMerge\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("mergeMethod"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"mergeMethod\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("squashOption"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"squashOption\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("requestAccessEnabled"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"requestAccessEnabled\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("issuesEnabled"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"issuesEnabled\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("mergeRequestsEnabled"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"mergeRequestsEnabled\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("wikiEnabled"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"wikiEnabled\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("snippetsEnabled"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"snippetsEnabled\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("containerRegistryEnabled"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"containerRegistryEnabled\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("packagesEnabled"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"packagesEnabled\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("jobsEnabled"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"jobsEnabled\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("publicJobs"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"publicJobs\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("sharedRunnersEnabled"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"sharedRunnersEnabled\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("autoDevopsEnabled"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"autoDevopsEnabled\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("forkingAccessLevel"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"forkingAccessLevel\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("restrictUserDefinedVariables"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"restrictUserDefinedVariables\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("ciConfigPath"); !ok {
+		return errors.New("Initialized \"gitlab.project\" resource without a \"ciConfigPath\". This field is required.")
+	}
 
 	return nil
 }
@@ -462,29 +983,161 @@ func (s *mqlGitlabProject) Register(name string) error {
 		return nil
 	case "path":
 		return nil
+	case "fullPath":
+		return nil
 	case "description":
 		return nil
 	case "visibility":
 		return nil
-	default:
-		return errors.New("Cannot find field '" + name + "' in \"gitlab.project\" resource")
-	}
-}
-
-// Field accessor autogenerated
-func (s *mqlGitlabProject) Field(name string) (interface{}, error) {
-	log.Trace().Str("field", name).Msg("[gitlab.project].Field")
-	switch name {
-	case "id":
-		return s.Id()
-	case "name":
-		return s.Name()
-	case "path":
-		return s.Path()
+	case "webURL":
+		return nil
+	case "defaultBranch":
+		return nil
+	case "archived":
+		return nil
+	case "createdAt":
+		return nil
+	case "onlyAllowMergeIfPipelineSucceeds":
+		return nil
+	case "onlyAllowMergeIfAllDiscussionsAreResolved":
+		return nil
+	case "allowMergeOnSkippedPipeline":
+		return nil
+	case "removeSourceBranchAfterMerge":
+		return nil
+	case "mergeMethod":
+		return nil
+	case "squashOption":
+		return nil
+	case "requestAccessEnabled":
+		return nil
+	case "issuesEnabled":
+		return nil
+	case "mergeRequestsEnabled":
+		return nil
+	case "wikiEnabled":
+		return nil
+	case "snippetsEnabled":
+		return nil
+	case "containerRegistryEnabled":
+		return nil
+	case "packagesEnabled":
+		return nil
+	case "jobsEnabled":
+		return nil
+	case "publicJobs":
+		return nil
+	case "sharedRunnersEnabled":
+		return nil
+	case "autoDevopsEnabled":
+		return nil
+	case "forkingAccessLevel":
+		return nil
+	case "restrictUserDefinedVariables":
+		return nil
+	case "ciConfigPath":
+		return nil
+	case "members":
+		return nil
+	case "protectedBranches":
+		return nil
+	case "approvalSettings":
+		return nil
+	case "approvalRules":
+		return nil
+	case "variables":
+		return nil
+	case "deployKeys":
+		return nil
+	case "webhooks":
+		return nil
+	case "runners":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"gitlab.project\" resource")
+	}
+}
+
+// Field accessor autogenerated
+func (s *mqlGitlabProject) Field(name string) (interface{}, error) {
+	log.Trace().Str("field", name).Msg("[gitlab.project].Field")
+	switch name {
+	case "id":
+		return s.Id()
+	case "name":
+		return s.Name()
+	case "path":
+		return s.Path()
+	case "fullPath":
+		return s.FullPath()
 	case "description":
 		return s.Description()
 	case "visibility":
 		return s.Visibility()
+	case "webURL":
+		return s.WebURL()
+	case "defaultBranch":
+		return s.DefaultBranch()
+	case "archived":
+		return s.Archived()
+	case "createdAt":
+		return s.CreatedAt()
+	case "onlyAllowMergeIfPipelineSucceeds":
+		return s.OnlyAllowMergeIfPipelineSucceeds()
+	case "onlyAllowMergeIfAllDiscussionsAreResolved":
+		return s.OnlyAllowMergeIfAllDiscussionsAreResolved()
+	case "allowMergeOnSkippedPipeline":
+		return s.AllowMergeOnSkippedPipeline()
+	case "removeSourceBranchAfterMerge":
+		return s.RemoveSourceBranchAfterMerge()
+	case "mergeMethod":
+		return s.MergeMethod()
+	case "squashOption":
+		return s.SquashOption()
+	case "requestAccessEnabled":
+		return s.RequestAccessEnabled()
+	case "issuesEnabled":
+		return s.IssuesEnabled()
+	case "mergeRequestsEnabled":
+		return s.MergeRequestsEnabled()
+	case "wikiEnabled":
+		return s.WikiEnabled()
+	case "snippetsEnabled":
+		return s.SnippetsEnabled()
+	case "containerRegistryEnabled":
+		return s.ContainerRegistryEnabled()
+	case "packagesEnabled":
+		return s.PackagesEnabled()
+	case "jobsEnabled":
+		return s.JobsEnabled()
+	case "publicJobs":
+		return s.PublicJobs()
+	case "sharedRunnersEnabled":
+		return s.SharedRunnersEnabled()
+	case "autoDevopsEnabled":
+		return s.AutoDevopsEnabled()
+	case "forkingAccessLevel":
+		return s.ForkingAccessLevel()
+	case "restrictUserDefinedVariables":
+		return s.RestrictUserDefinedVariables()
+	case "ciConfigPath":
+		return s.CiConfigPath()
+	case "members":
+		return s.Members()
+	case "protectedBranches":
+		return s.ProtectedBranches()
+	case "approvalSettings":
+		return s.ApprovalSettings()
+	case "approvalRules":
+		return s.ApprovalRules()
+	case "variables":
+		return s.Variables()
+	case "deployKeys":
+		return s.DeployKeys()
+	case "webhooks":
+		return s.Webhooks()
+	case "runners":
+		return s.Runners()
 	default:
 		return nil, fmt.Errorf("Cannot find field '" + name + "' in \"gitlab.project\" resource")
 	}
@@ -538,6 +1191,22 @@ func (s *mqlGitlabProject) Path() (string, error) {
 	return tres, nil
 }
 
+// FullPath accessor autogenerated
+func (s *mqlGitlabProject) FullPath() (string, error) {
+	res, ok := s.Cache.Load("fullPath")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.project\" failed: no value provided for static field \"fullPath\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.project\" failed to cast field \"fullPath\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
 // Description accessor autogenerated
 func (s *mqlGitlabProject) Description() (string, error) {
 	res, ok := s.Cache.Load("description")
@@ -570,22 +1239,3462 @@ func (s *mqlGitlabProject) Visibility() (string, error) {
 	return tres, nil
 }
 
-// Compute accessor autogenerated
-func (s *mqlGitlabProject) Compute(name string) error {
-	log.Trace().Str("field", name).Msg("[gitlab.project].Compute")
-	switch name {
-	case "id":
-		return nil
-	case "name":
-		return nil
-	case "path":
-		return nil
-	case "description":
-		return nil
-	case "visibility":
-		return nil
-	default:
-		return errors.New("Cannot find field '" + name + "' in \"gitlab.project\" resource")
+// WebURL accessor autogenerated
+func (s *mqlGitlabProject) WebURL() (string, error) {
+	res, ok := s.Cache.Load("webURL")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.project\" failed: no value provided for static field \"webURL\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.project\" failed to cast field \"webURL\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// DefaultBranch accessor autogenerated
+func (s *mqlGitlabProject) DefaultBranch() (string, error) {
+	res, ok := s.Cache.Load("defaultBranch")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.project\" failed: no value provided for static field \"defaultBranch\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.project\" failed to cast field \"defaultBranch\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Archived accessor autogenerated
+func (s *mqlGitlabProject) Archived() (bool, error) {
+	res, ok := s.Cache.Load("archived")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project\" failed: no value provided for static field \"archived\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project\" failed to cast field \"archived\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// CreatedAt accessor autogenerated
+func (s *mqlGitlabProject) CreatedAt() (*time.Time, error) {
+	res, ok := s.Cache.Load("createdAt")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"gitlab.project\" failed: no value provided for static field \"createdAt\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(*time.Time)
+	if !ok {
+		return nil, fmt.Errorf("\"gitlab.project\" failed to cast field \"createdAt\" to the right type (*time.Time): %#v", res)
+	}
+	return tres, nil
+}
+
+// OnlyAllowMergeIfPipelineSucceeds accessor autogenerated
+func (s *mqlGitlabProject) OnlyAllowMergeIfPipelineSucceeds() (bool, error) {
+	res, ok := s.Cache.Load("onlyAllowMergeIfPipelineSucceeds")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project\" failed: no value provided for static field \"onlyAllowMergeIfPipelineSucceeds\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project\" failed to cast field \"onlyAllowMergeIfPipelineSucceeds\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// OnlyAllowMergeIfAllDiscussionsAreResolved accessor autogenerated
+func (s *mqlGitlabProject) OnlyAllowMergeIfAllDiscussionsAreResolved() (bool, error) {
+	res, ok := s.Cache.Load("onlyAllowMergeIfAllDiscussionsAreResolved")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project\" failed: no value provided for static field \"onlyAllowMergeIfAllDiscussionsAreResolved\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project\" failed to cast field \"onlyAllowMergeIfAllDiscussionsAreResolved\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// AllowMergeOnSkippedPipeline accessor autogenerated
+func (s *mqlGitlabProject) AllowMergeOnSkippedPipeline() (bool, error) {
+	res, ok := s.Cache.Load("allowMergeOnSkippedPipeline")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project\" failed: no value provided for static field \"allowMergeOnSkippedPipeline\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project\" failed to cast field \"allowMergeOnSkippedPipeline\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// RemoveSourceBranchAfterMerge accessor autogenerated
+func (s *mqlGitlabProject) RemoveSourceBranchAfterMerge() (bool, error) {
+	res, ok := s.Cache.Load("removeSourceBranchAfterMerge")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project\" failed: no value provided for static field \"removeSourceBranchAfterMerge\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project\" failed to cast field \"removeSourceBranchAfterMerge\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// MergeMethod accessor autogenerated
+func (s *mqlGitlabProject) MergeMethod() (string, error) {
+	res, ok := s.Cache.Load("mergeMethod")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.project\" failed: no value provided for static field \"mergeMethod\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.project\" failed to cast field \"mergeMethod\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// SquashOption accessor autogenerated
+func (s *mqlGitlabProject) SquashOption() (string, error) {
+	res, ok := s.Cache.Load("squashOption")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.project\" failed: no value provided for static field \"squashOption\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.project\" failed to cast field \"squashOption\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// RequestAccessEnabled accessor autogenerated
+func (s *mqlGitlabProject) RequestAccessEnabled() (bool, error) {
+	res, ok := s.Cache.Load("requestAccessEnabled")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project\" failed: no value provided for static field \"requestAccessEnabled\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project\" failed to cast field \"requestAccessEnabled\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// IssuesEnabled accessor autogenerated
+func (s *mqlGitlabProject) IssuesEnabled() (bool, error) {
+	res, ok := s.Cache.Load("issuesEnabled")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project\" failed: no value provided for static field \"issuesEnabled\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project\" failed to cast field \"issuesEnabled\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// MergeRequestsEnabled accessor autogenerated
+func (s *mqlGitlabProject) MergeRequestsEnabled() (bool, error) {
+	res, ok := s.Cache.Load("mergeRequestsEnabled")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project\" failed: no value provided for static field \"mergeRequestsEnabled\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project\" failed to cast field \"mergeRequestsEnabled\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// WikiEnabled accessor autogenerated
+func (s *mqlGitlabProject) WikiEnabled() (bool, error) {
+	res, ok := s.Cache.Load("wikiEnabled")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project\" failed: no value provided for static field \"wikiEnabled\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project\" failed to cast field \"wikiEnabled\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// SnippetsEnabled accessor autogenerated
+func (s *mqlGitlabProject) SnippetsEnabled() (bool, error) {
+	res, ok := s.Cache.Load("snippetsEnabled")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project\" failed: no value provided for static field \"snippetsEnabled\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project\" failed to cast field \"snippetsEnabled\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// ContainerRegistryEnabled accessor autogenerated
+func (s *mqlGitlabProject) ContainerRegistryEnabled() (bool, error) {
+	res, ok := s.Cache.Load("containerRegistryEnabled")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project\" failed: no value provided for static field \"containerRegistryEnabled\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project\" failed to cast field \"containerRegistryEnabled\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// PackagesEnabled accessor autogenerated
+func (s *mqlGitlabProject) PackagesEnabled() (bool, error) {
+	res, ok := s.Cache.Load("packagesEnabled")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project\" failed: no value provided for static field \"packagesEnabled\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project\" failed to cast field \"packagesEnabled\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// JobsEnabled accessor autogenerated
+func (s *mqlGitlabProject) JobsEnabled() (bool, error) {
+	res, ok := s.Cache.Load("jobsEnabled")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project\" failed: no value provided for static field \"jobsEnabled\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project\" failed to cast field \"jobsEnabled\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// PublicJobs accessor autogenerated
+func (s *mqlGitlabProject) PublicJobs() (bool, error) {
+	res, ok := s.Cache.Load("publicJobs")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project\" failed: no value provided for static field \"publicJobs\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project\" failed to cast field \"publicJobs\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// SharedRunnersEnabled accessor autogenerated
+func (s *mqlGitlabProject) SharedRunnersEnabled() (bool, error) {
+	res, ok := s.Cache.Load("sharedRunnersEnabled")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project\" failed: no value provided for static field \"sharedRunnersEnabled\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project\" failed to cast field \"sharedRunnersEnabled\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// AutoDevopsEnabled accessor autogenerated
+func (s *mqlGitlabProject) AutoDevopsEnabled() (bool, error) {
+	res, ok := s.Cache.Load("autoDevopsEnabled")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project\" failed: no value provided for static field \"autoDevopsEnabled\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project\" failed to cast field \"autoDevopsEnabled\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// ForkingAccessLevel accessor autogenerated
+func (s *mqlGitlabProject) ForkingAccessLevel() (string, error) {
+	res, ok := s.Cache.Load("forkingAccessLevel")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.project\" failed: no value provided for static field \"forkingAccessLevel\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.project\" failed to cast field \"forkingAccessLevel\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// RestrictUserDefinedVariables accessor autogenerated
+func (s *mqlGitlabProject) RestrictUserDefinedVariables() (bool, error) {
+	res, ok := s.Cache.Load("restrictUserDefinedVariables")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project\" failed: no value provided for static field \"restrictUserDefinedVariables\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project\" failed to cast field \"restrictUserDefinedVariables\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// CiConfigPath accessor autogenerated
+func (s *mqlGitlabProject) CiConfigPath() (string, error) {
+	res, ok := s.Cache.Load("ciConfigPath")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.project\" failed: no value provided for static field \"ciConfigPath\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.project\" failed to cast field \"ciConfigPath\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Members accessor autogenerated
+func (s *mqlGitlabProject) Members() ([]interface{}, error) {
+	res, ok := s.Cache.Load("members")
+	if !ok || !res.Valid {
+		if err := s.ComputeMembers(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("members")
+		if !ok {
+			return nil, errors.New("\"gitlab.project\" calculated \"members\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "members")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"gitlab.project\" failed to cast field \"members\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// ProtectedBranches accessor autogenerated
+func (s *mqlGitlabProject) ProtectedBranches() ([]interface{}, error) {
+	res, ok := s.Cache.Load("protectedBranches")
+	if !ok || !res.Valid {
+		if err := s.ComputeProtectedBranches(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("protectedBranches")
+		if !ok {
+			return nil, errors.New("\"gitlab.project\" calculated \"protectedBranches\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "protectedBranches")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"gitlab.project\" failed to cast field \"protectedBranches\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// ApprovalSettings accessor autogenerated
+func (s *mqlGitlabProject) ApprovalSettings() (GitlabProjectApprovalSetting, error) {
+	res, ok := s.Cache.Load("approvalSettings")
+	if !ok || !res.Valid {
+		if err := s.ComputeApprovalSettings(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("approvalSettings")
+		if !ok {
+			return nil, errors.New("\"gitlab.project\" calculated \"approvalSettings\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "approvalSettings")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(GitlabProjectApprovalSetting)
+	if !ok {
+		return nil, fmt.Errorf("\"gitlab.project\" failed to cast field \"approvalSettings\" to the right type (GitlabProjectApprovalSetting): %#v", res)
+	}
+	return tres, nil
+}
+
+// ApprovalRules accessor autogenerated
+func (s *mqlGitlabProject) ApprovalRules() ([]interface{}, error) {
+	res, ok := s.Cache.Load("approvalRules")
+	if !ok || !res.Valid {
+		if err := s.ComputeApprovalRules(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("approvalRules")
+		if !ok {
+			return nil, errors.New("\"gitlab.project\" calculated \"approvalRules\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "approvalRules")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"gitlab.project\" failed to cast field \"approvalRules\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Variables accessor autogenerated
+func (s *mqlGitlabProject) Variables() ([]interface{}, error) {
+	res, ok := s.Cache.Load("variables")
+	if !ok || !res.Valid {
+		if err := s.ComputeVariables(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("variables")
+		if !ok {
+			return nil, errors.New("\"gitlab.project\" calculated \"variables\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "variables")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"gitlab.project\" failed to cast field \"variables\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// DeployKeys accessor autogenerated
+func (s *mqlGitlabProject) DeployKeys() ([]interface{}, error) {
+	res, ok := s.Cache.Load("deployKeys")
+	if !ok || !res.Valid {
+		if err := s.ComputeDeployKeys(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("deployKeys")
+		if !ok {
+			return nil, errors.New("\"gitlab.project\" calculated \"deployKeys\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "deployKeys")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"gitlab.project\" failed to cast field \"deployKeys\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Webhooks accessor autogenerated
+func (s *mqlGitlabProject) Webhooks() ([]interface{}, error) {
+	res, ok := s.Cache.Load("webhooks")
+	if !ok || !res.Valid {
+		if err := s.ComputeWebhooks(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("webhooks")
+		if !ok {
+			return nil, errors.New("\"gitlab.project\" calculated \"webhooks\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "webhooks")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"gitlab.project\" failed to cast field \"webhooks\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Runners accessor autogenerated
+func (s *mqlGitlabProject) Runners() ([]interface{}, error) {
+	res, ok := s.Cache.Load("runners")
+	if !ok || !res.Valid {
+		if err := s.ComputeRunners(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("runners")
+		if !ok {
+			return nil, errors.New("\"gitlab.project\" calculated \"runners\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "runners")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"gitlab.project\" failed to cast field \"runners\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Compute accessor autogenerated
+func (s *mqlGitlabProject) Compute(name string) error {
+	log.Trace().Str("field", name).Msg("[gitlab.project].Compute")
+	switch name {
+	case "id":
+		return nil
+	case "name":
+		return nil
+	case "path":
+		return nil
+	case "fullPath":
+		return nil
+	case "description":
+		return nil
+	case "visibility":
+		return nil
+	case "webURL":
+		return nil
+	case "defaultBranch":
+		return nil
+	case "archived":
+		return nil
+	case "createdAt":
+		return nil
+	case "onlyAllowMergeIfPipelineSucceeds":
+		return nil
+	case "onlyAllowMergeIfAllDiscussionsAreResolved":
+		return nil
+	case "allowMergeOnSkippedPipeline":
+		return nil
+	case "removeSourceBranchAfterMerge":
+		return nil
+	case "mergeMethod":
+		return nil
+	case "squashOption":
+		return nil
+	case "requestAccessEnabled":
+		return nil
+	case "issuesEnabled":
+		return nil
+	case "mergeRequestsEnabled":
+		return nil
+	case "wikiEnabled":
+		return nil
+	case "snippetsEnabled":
+		return nil
+	case "containerRegistryEnabled":
+		return nil
+	case "packagesEnabled":
+		return nil
+	case "jobsEnabled":
+		return nil
+	case "publicJobs":
+		return nil
+	case "sharedRunnersEnabled":
+		return nil
+	case "autoDevopsEnabled":
+		return nil
+	case "forkingAccessLevel":
+		return nil
+	case "restrictUserDefinedVariables":
+		return nil
+	case "ciConfigPath":
+		return nil
+	case "members":
+		return s.ComputeMembers()
+	case "protectedBranches":
+		return s.ComputeProtectedBranches()
+	case "approvalSettings":
+		return s.ComputeApprovalSettings()
+	case "approvalRules":
+		return s.ComputeApprovalRules()
+	case "variables":
+		return s.ComputeVariables()
+	case "deployKeys":
+		return s.ComputeDeployKeys()
+	case "webhooks":
+		return s.ComputeWebhooks()
+	case "runners":
+		return s.ComputeRunners()
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"gitlab.project\" resource")
+	}
+}
+
+// ComputeMembers computer autogenerated
+func (s *mqlGitlabProject) ComputeMembers() error {
+	var err error
+	if _, ok := s.Cache.Load("members"); ok {
+		return nil
+	}
+	vres, err := s.GetMembers()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("members", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeProtectedBranches computer autogenerated
+func (s *mqlGitlabProject) ComputeProtectedBranches() error {
+	var err error
+	if _, ok := s.Cache.Load("protectedBranches"); ok {
+		return nil
+	}
+	vres, err := s.GetProtectedBranches()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("protectedBranches", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeApprovalSettings computer autogenerated
+func (s *mqlGitlabProject) ComputeApprovalSettings() error {
+	var err error
+	if _, ok := s.Cache.Load("approvalSettings"); ok {
+		return nil
+	}
+	vres, err := s.GetApprovalSettings()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("approvalSettings", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeApprovalRules computer autogenerated
+func (s *mqlGitlabProject) ComputeApprovalRules() error {
+	var err error
+	if _, ok := s.Cache.Load("approvalRules"); ok {
+		return nil
+	}
+	vres, err := s.GetApprovalRules()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("approvalRules", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeVariables computer autogenerated
+func (s *mqlGitlabProject) ComputeVariables() error {
+	var err error
+	if _, ok := s.Cache.Load("variables"); ok {
+		return nil
+	}
+	vres, err := s.GetVariables()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("variables", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeDeployKeys computer autogenerated
+func (s *mqlGitlabProject) ComputeDeployKeys() error {
+	var err error
+	if _, ok := s.Cache.Load("deployKeys"); ok {
+		return nil
+	}
+	vres, err := s.GetDeployKeys()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("deployKeys", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeWebhooks computer autogenerated
+func (s *mqlGitlabProject) ComputeWebhooks() error {
+	var err error
+	if _, ok := s.Cache.Load("webhooks"); ok {
+		return nil
+	}
+	vres, err := s.GetWebhooks()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("webhooks", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeRunners computer autogenerated
+func (s *mqlGitlabProject) ComputeRunners() error {
+	var err error
+	if _, ok := s.Cache.Load("runners"); ok {
+		return nil
+	}
+	vres, err := s.GetRunners()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("runners", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// GitlabMember resource interface
+type GitlabMember interface {
+	MqlResource() (*resources.Resource)
+	Compute(string) error
+	Field(string) (interface{}, error)
+	Register(string) error
+	Validate() error
+	Id() (int64, error)
+	Username() (string, error)
+	Name() (string, error)
+	State() (string, error)
+	AccessLevel() (int64, error)
+	Role() (string, error)
+	ExpiresAt() (*time.Time, error)
+	CreatedAt() (*time.Time, error)
+}
+
+// mqlGitlabMember for the gitlab.member resource
+type mqlGitlabMember struct {
+	*resources.Resource
+}
+
+// MqlResource to retrieve the underlying resource info
+func (s *mqlGitlabMember) MqlResource() *resources.Resource {
+	return s.Resource
+}
+
+// create a new instance of the gitlab.member resource
+func newGitlabMember(runtime *resources.Runtime, args *resources.Args) (interface{}, error) {
+	// User hooks
+	var err error
+	res := mqlGitlabMember{runtime.NewResource("gitlab.member")}
+	// assign all named fields
+	var id string
+
+	now := time.Now().Unix()
+	for name, val := range *args {
+		if val == nil {
+			res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+			continue
+		}
+
+		switch name {
+		case "id":
+			if _, ok := val.(int64); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.member\", its \"id\" argument has the wrong type (expected type \"int64\")")
+			}
+		case "username":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.member\", its \"username\" argument has the wrong type (expected type \"string\")")
+			}
+		case "name":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.member\", its \"name\" argument has the wrong type (expected type \"string\")")
+			}
+		case "state":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.member\", its \"state\" argument has the wrong type (expected type \"string\")")
+			}
+		case "accessLevel":
+			if _, ok := val.(int64); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.member\", its \"accessLevel\" argument has the wrong type (expected type \"int64\")")
+			}
+		case "role":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.member\", its \"role\" argument has the wrong type (expected type \"string\")")
+			}
+		case "expiresAt":
+			if _, ok := val.(*time.Time); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.member\", its \"expiresAt\" argument has the wrong type (expected type \"*time.Time\")")
+			}
+		case "createdAt":
+			if _, ok := val.(*time.Time); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.member\", its \"createdAt\" argument has the wrong type (expected type \"*time.Time\")")
+			}
+		case "__id":
+			idVal, ok := val.(string)
+			if !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.member\", its \"__id\" argument has the wrong type (expected type \"string\")")
+			}
+			id = idVal
+		default:
+			return nil, errors.New("Initialized gitlab.member with unknown argument " + name)
+		}
+		res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+	}
+
+	// Get the ID
+	if id == "" {
+		res.Resource.Id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res.Resource.Id = id
+	}
+
+	return &res, nil
+}
+
+func (s *mqlGitlabMember) Validate() error {
+	// required arguments
+	if _, ok := s.Cache.Load("id"); !ok {
+		return errors.New("Initialized \"gitlab.member\" resource without a \"id\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("username"); !ok {
+		return errors.New("Initialized \"gitlab.member\" resource without a \"username\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("name"); !ok {
+		return errors.New("Initialized \"gitlab.member\" resource without a \"name\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("state"); !ok {
+		return errors.New("Initialized \"gitlab.member\" resource without a \"state\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("accessLevel"); !ok {
+		return errors.New("Initialized \"gitlab.member\" resource without a \"accessLevel\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("role"); !ok {
+		return errors.New("Initialized \"gitlab.member\" resource without a \"role\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("expiresAt"); !ok {
+		return errors.New("Initialized \"gitlab.member\" resource without a \"expiresAt\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("createdAt"); !ok {
+		return errors.New("Initialized \"gitlab.member\" resource without a \"createdAt\". This field is required.")
+	}
+
+	return nil
+}
+
+// Register accessor autogenerated
+func (s *mqlGitlabMember) Register(name string) error {
+	log.Trace().Str("field", name).Msg("[gitlab.member].Register")
+	switch name {
+	case "id":
+		return nil
+	case "username":
+		return nil
+	case "name":
+		return nil
+	case "state":
+		return nil
+	case "accessLevel":
+		return nil
+	case "role":
+		return nil
+	case "expiresAt":
+		return nil
+	case "createdAt":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"gitlab.member\" resource")
+	}
+}
+
+// Field accessor autogenerated
+func (s *mqlGitlabMember) Field(name string) (interface{}, error) {
+	log.Trace().Str("field", name).Msg("[gitlab.member].Field")
+	switch name {
+	case "id":
+		return s.Id()
+	case "username":
+		return s.Username()
+	case "name":
+		return s.Name()
+	case "state":
+		return s.State()
+	case "accessLevel":
+		return s.AccessLevel()
+	case "role":
+		return s.Role()
+	case "expiresAt":
+		return s.ExpiresAt()
+	case "createdAt":
+		return s.CreatedAt()
+	default:
+		return nil, fmt.Errorf("Cannot find field '" + name + "' in \"gitlab.member\" resource")
+	}
+}
+
+// Id accessor autogenerated
+func (s *mqlGitlabMember) Id() (int64, error) {
+	res, ok := s.Cache.Load("id")
+	if !ok || !res.Valid {
+		return 0, errors.New("\"gitlab.member\" failed: no value provided for static field \"id\"")
+	}
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	tres, ok := res.Data.(int64)
+	if !ok {
+		return 0, fmt.Errorf("\"gitlab.member\" failed to cast field \"id\" to the right type (int64): %#v", res)
+	}
+	return tres, nil
+}
+
+// Username accessor autogenerated
+func (s *mqlGitlabMember) Username() (string, error) {
+	res, ok := s.Cache.Load("username")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.member\" failed: no value provided for static field \"username\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.member\" failed to cast field \"username\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Name accessor autogenerated
+func (s *mqlGitlabMember) Name() (string, error) {
+	res, ok := s.Cache.Load("name")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.member\" failed: no value provided for static field \"name\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.member\" failed to cast field \"name\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// State accessor autogenerated
+func (s *mqlGitlabMember) State() (string, error) {
+	res, ok := s.Cache.Load("state")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.member\" failed: no value provided for static field \"state\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.member\" failed to cast field \"state\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// AccessLevel accessor autogenerated
+func (s *mqlGitlabMember) AccessLevel() (int64, error) {
+	res, ok := s.Cache.Load("accessLevel")
+	if !ok || !res.Valid {
+		return 0, errors.New("\"gitlab.member\" failed: no value provided for static field \"accessLevel\"")
+	}
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	tres, ok := res.Data.(int64)
+	if !ok {
+		return 0, fmt.Errorf("\"gitlab.member\" failed to cast field \"accessLevel\" to the right type (int64): %#v", res)
+	}
+	return tres, nil
+}
+
+// Role accessor autogenerated
+func (s *mqlGitlabMember) Role() (string, error) {
+	res, ok := s.Cache.Load("role")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.member\" failed: no value provided for static field \"role\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.member\" failed to cast field \"role\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// ExpiresAt accessor autogenerated
+func (s *mqlGitlabMember) ExpiresAt() (*time.Time, error) {
+	res, ok := s.Cache.Load("expiresAt")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"gitlab.member\" failed: no value provided for static field \"expiresAt\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(*time.Time)
+	if !ok {
+		return nil, fmt.Errorf("\"gitlab.member\" failed to cast field \"expiresAt\" to the right type (*time.Time): %#v", res)
+	}
+	return tres, nil
+}
+
+// CreatedAt accessor autogenerated
+func (s *mqlGitlabMember) CreatedAt() (*time.Time, error) {
+	res, ok := s.Cache.Load("createdAt")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"gitlab.member\" failed: no value provided for static field \"createdAt\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(*time.Time)
+	if !ok {
+		return nil, fmt.Errorf("\"gitlab.member\" failed to cast field \"createdAt\" to the right type (*time.Time): %#v", res)
+	}
+	return tres, nil
+}
+
+// Compute accessor autogenerated
+func (s *mqlGitlabMember) Compute(name string) error {
+	log.Trace().Str("field", name).Msg("[gitlab.member].Compute")
+	switch name {
+	case "id":
+		return nil
+	case "username":
+		return nil
+	case "name":
+		return nil
+	case "state":
+		return nil
+	case "accessLevel":
+		return nil
+	case "role":
+		return nil
+	case "expiresAt":
+		return nil
+	case "createdAt":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"gitlab.member\" resource")
+	}
+}
+
+// GitlabProjectProtectedBranch resource interface
+type GitlabProjectProtectedBranch interface {
+	MqlResource() (*resources.Resource)
+	Compute(string) error
+	Field(string) (interface{}, error)
+	Register(string) error
+	Validate() error
+	Id() (int64, error)
+	Name() (string, error)
+	AllowForcePush() (bool, error)
+	CodeOwnerApprovalRequired() (bool, error)
+	PushAccessLevels() ([]interface{}, error)
+	MergeAccessLevels() ([]interface{}, error)
+	UnprotectAccessLevels() ([]interface{}, error)
+}
+
+// mqlGitlabProjectProtectedBranch for the gitlab.project.protectedBranch resource
+type mqlGitlabProjectProtectedBranch struct {
+	*resources.Resource
+}
+
+// MqlResource to retrieve the underlying resource info
+func (s *mqlGitlabProjectProtectedBranch) MqlResource() *resources.Resource {
+	return s.Resource
+}
+
+// create a new instance of the gitlab.project.protectedBranch resource
+func newGitlabProjectProtectedBranch(runtime *resources.Runtime, args *resources.Args) (interface{}, error) {
+	// User hooks
+	var err error
+	res := mqlGitlabProjectProtectedBranch{runtime.NewResource("gitlab.project.protectedBranch")}
+	// assign all named fields
+	var id string
+
+	now := time.Now().Unix()
+	for name, val := range *args {
+		if val == nil {
+			res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+			continue
+		}
+
+		switch name {
+		case "id":
+			if _, ok := val.(int64); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.protectedBranch\", its \"id\" argument has the wrong type (expected type \"int64\")")
+			}
+		case "name":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.protectedBranch\", its \"name\" argument has the wrong type (expected type \"string\")")
+			}
+		case "allowForcePush":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.protectedBranch\", its \"allowForcePush\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "codeOwnerApprovalRequired":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.protectedBranch\", its \"codeOwnerApprovalRequired\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "pushAccessLevels":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.protectedBranch\", its \"pushAccessLevels\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
+		case "mergeAccessLevels":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.protectedBranch\", its \"mergeAccessLevels\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
+		case "unprotectAccessLevels":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.protectedBranch\", its \"unprotectAccessLevels\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
+		case "__id":
+			idVal, ok := val.(string)
+			if !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.protectedBranch\", its \"__id\" argument has the wrong type (expected type \"string\")")
+			}
+			id = idVal
+		default:
+			return nil, errors.New("Initialized gitlab.project.protectedBranch with unknown argument " + name)
+		}
+		res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+	}
+
+	// Get the ID
+	if id == "" {
+		res.Resource.Id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res.Resource.Id = id
+	}
+
+	return &res, nil
+}
+
+func (s *mqlGitlabProjectProtectedBranch) Validate() error {
+	// required arguments
+	if _, ok := s.Cache.Load("id"); !ok {
+		return errors.New("Initialized \"gitlab.project.protectedBranch\" resource without a \"id\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("name"); !ok {
+		return errors.New("Initialized \"gitlab.project.protectedBranch\" resource without a \"name\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("allowForcePush"); !ok {
+		return errors.New("Initialized \"gitlab.project.protectedBranch\" resource without a \"allowForcePush\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("codeOwnerApprovalRequired"); !ok {
+		return errors.New("Initialized \"gitlab.project.protectedBranch\" resource without a \"codeOwnerApprovalRequired\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("pushAccessLevels"); !ok {
+		return errors.New("Initialized \"gitlab.project.protectedBranch\" resource without a \"pushAccessLevels\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("mergeAccessLevels"); !ok {
+		return errors.New("Initialized \"gitlab.project.protectedBranch\" resource without a \"mergeAccessLevels\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("unprotectAccessLevels"); !ok {
+		return errors.New("Initialized \"gitlab.project.protectedBranch\" resource without a \"unprotectAccessLevels\". This field is required.")
+	}
+
+	return nil
+}
+
+// Register accessor autogenerated
+func (s *mqlGitlabProjectProtectedBranch) Register(name string) error {
+	log.Trace().Str("field", name).Msg("[gitlab.project.protectedBranch].Register")
+	switch name {
+	case "id":
+		return nil
+	case "name":
+		return nil
+	case "allowForcePush":
+		return nil
+	case "codeOwnerApprovalRequired":
+		return nil
+	case "pushAccessLevels":
+		return nil
+	case "mergeAccessLevels":
+		return nil
+	case "unprotectAccessLevels":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"gitlab.project.protectedBranch\" resource")
+	}
+}
+
+// Field accessor autogenerated
+func (s *mqlGitlabProjectProtectedBranch) Field(name string) (interface{}, error) {
+	log.Trace().Str("field", name).Msg("[gitlab.project.protectedBranch].Field")
+	switch name {
+	case "id":
+		return s.Id()
+	case "name":
+		return s.Name()
+	case "allowForcePush":
+		return s.AllowForcePush()
+	case "codeOwnerApprovalRequired":
+		return s.CodeOwnerApprovalRequired()
+	case "pushAccessLevels":
+		return s.PushAccessLevels()
+	case "mergeAccessLevels":
+		return s.MergeAccessLevels()
+	case "unprotectAccessLevels":
+		return s.UnprotectAccessLevels()
+	default:
+		return nil, fmt.Errorf("Cannot find field '" + name + "' in \"gitlab.project.protectedBranch\" resource")
+	}
+}
+
+// Id accessor autogenerated
+func (s *mqlGitlabProjectProtectedBranch) Id() (int64, error) {
+	res, ok := s.Cache.Load("id")
+	if !ok || !res.Valid {
+		return 0, errors.New("\"gitlab.project.protectedBranch\" failed: no value provided for static field \"id\"")
+	}
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	tres, ok := res.Data.(int64)
+	if !ok {
+		return 0, fmt.Errorf("\"gitlab.project.protectedBranch\" failed to cast field \"id\" to the right type (int64): %#v", res)
+	}
+	return tres, nil
+}
+
+// Name accessor autogenerated
+func (s *mqlGitlabProjectProtectedBranch) Name() (string, error) {
+	res, ok := s.Cache.Load("name")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.project.protectedBranch\" failed: no value provided for static field \"name\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.project.protectedBranch\" failed to cast field \"name\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// AllowForcePush accessor autogenerated
+func (s *mqlGitlabProjectProtectedBranch) AllowForcePush() (bool, error) {
+	res, ok := s.Cache.Load("allowForcePush")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project.protectedBranch\" failed: no value provided for static field \"allowForcePush\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project.protectedBranch\" failed to cast field \"allowForcePush\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// CodeOwnerApprovalRequired accessor autogenerated
+func (s *mqlGitlabProjectProtectedBranch) CodeOwnerApprovalRequired() (bool, error) {
+	res, ok := s.Cache.Load("codeOwnerApprovalRequired")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project.protectedBranch\" failed: no value provided for static field \"codeOwnerApprovalRequired\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project.protectedBranch\" failed to cast field \"codeOwnerApprovalRequired\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// PushAccessLevels accessor autogenerated
+func (s *mqlGitlabProjectProtectedBranch) PushAccessLevels() ([]interface{}, error) {
+	res, ok := s.Cache.Load("pushAccessLevels")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"gitlab.project.protectedBranch\" failed: no value provided for static field \"pushAccessLevels\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"gitlab.project.protectedBranch\" failed to cast field \"pushAccessLevels\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// MergeAccessLevels accessor autogenerated
+func (s *mqlGitlabProjectProtectedBranch) MergeAccessLevels() ([]interface{}, error) {
+	res, ok := s.Cache.Load("mergeAccessLevels")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"gitlab.project.protectedBranch\" failed: no value provided for static field \"mergeAccessLevels\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"gitlab.project.protectedBranch\" failed to cast field \"mergeAccessLevels\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// UnprotectAccessLevels accessor autogenerated
+func (s *mqlGitlabProjectProtectedBranch) UnprotectAccessLevels() ([]interface{}, error) {
+	res, ok := s.Cache.Load("unprotectAccessLevels")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"gitlab.project.protectedBranch\" failed: no value provided for static field \"unprotectAccessLevels\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"gitlab.project.protectedBranch\" failed to cast field \"unprotectAccessLevels\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Compute accessor autogenerated
+func (s *mqlGitlabProjectProtectedBranch) Compute(name string) error {
+	log.Trace().Str("field", name).Msg("[gitlab.project.protectedBranch].Compute")
+	switch name {
+	case "id":
+		return nil
+	case "name":
+		return nil
+	case "allowForcePush":
+		return nil
+	case "codeOwnerApprovalRequired":
+		return nil
+	case "pushAccessLevels":
+		return nil
+	case "mergeAccessLevels":
+		return nil
+	case "unprotectAccessLevels":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"gitlab.project.protectedBranch\" resource")
+	}
+}
+
+// GitlabProjectApprovalSetting resource interface
+type GitlabProjectApprovalSetting interface {
+	MqlResource() (*resources.Resource)
+	Compute(string) error
+	Field(string) (interface{}, error)
+	Register(string) error
+	Validate() error
+	ApprovalsBeforeMerge() (int64, error)
+	ResetApprovalsOnPush() (bool, error)
+	DisableOverridingApproversPerMergeRequest() (bool, error)
+	MergeRequestsAuthorApproval() (bool, error)
+	MergeRequestsDisableCommittersApproval() (bool, error)
+	RequirePasswordToApprove() (bool, error)
+}
+
+// mqlGitlabProjectApprovalSetting for the gitlab.project.approvalSetting resource
+type mqlGitlabProjectApprovalSetting struct {
+	*resources.Resource
+}
+
+// MqlResource to retrieve the underlying resource info
+func (s *mqlGitlabProjectApprovalSetting) MqlResource() *resources.Resource {
+	return s.Resource
+}
+
+// create a new instance of the gitlab.project.approvalSetting resource
+func newGitlabProjectApprovalSetting(runtime *resources.Runtime, args *resources.Args) (interface{}, error) {
+	// User hooks
+	var err error
+	res := mqlGitlabProjectApprovalSetting{runtime.NewResource("gitlab.project.approvalSetting")}
+	// assign all named fields
+	var id string
+
+	now := time.Now().Unix()
+	for name, val := range *args {
+		if val == nil {
+			res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+			continue
+		}
+
+		switch name {
+		case "approvalsBeforeMerge":
+			if _, ok := val.(int64); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.approvalSetting\", its \"approvalsBeforeMerge\" argument has the wrong type (expected type \"int64\")")
+			}
+		case "resetApprovalsOnPush":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.approvalSetting\", its \"resetApprovalsOnPush\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "disableOverridingApproversPerMergeRequest":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.approvalSetting\", its \"disableOverridingApproversPerMergeRequest\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "mergeRequestsAuthorApproval":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.approvalSetting\", its \"mergeRequestsAuthorApproval\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "mergeRequestsDisableCommittersApproval":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.approvalSetting\", its \"mergeRequestsDisableCommittersApproval\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "requirePasswordToApprove":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.approvalSetting\", its \"requirePasswordToApprove\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "__id":
+			idVal, ok := val.(string)
+			if !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.approvalSetting\", its \"__id\" argument has the wrong type (expected type \"string\")")
+			}
+			id = idVal
+		default:
+			return nil, errors.New("Initialized gitlab.project.approvalSetting with unknown argument " + name)
+		}
+		res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+	}
+
+	// Get the ID
+	if id == "" {
+		res.Resource.Id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res.Resource.Id = id
+	}
+
+	return &res, nil
+}
+
+func (s *mqlGitlabProjectApprovalSetting) Validate() error {
+	// required arguments
+	if _, ok := s.Cache.Load("approvalsBeforeMerge"); !ok {
+		return errors.New("Initialized \"gitlab.project.approvalSetting\" resource without a \"approvalsBeforeMerge\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("resetApprovalsOnPush"); !ok {
+		return errors.New("Initialized \"gitlab.project.approvalSetting\" resource without a \"resetApprovalsOnPush\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("disableOverridingApproversPerMergeRequest"); !ok {
+		return errors.New("Initialized \"gitlab.project.approvalSetting\" resource without a \"disableOverridingApproversPerMergeRequest\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("mergeRequestsAuthorApproval"); !ok {
+		return errors.New("Initialized \"gitlab.project.approvalSetting\" resource without a \"mergeRequestsAuthorApproval\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("mergeRequestsDisableCommittersApproval"); !ok {
+		return errors.New("Initialized \"gitlab.project.approvalSetting\" resource without a \"mergeRequestsDisableCommittersApproval\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("requirePasswordToApprove"); !ok {
+		return errors.New("Initialized \"gitlab.project.approvalSetting\" resource without a \"requirePasswordToApprove\". This field is required.")
+	}
+
+	return nil
+}
+
+// Register accessor autogenerated
+func (s *mqlGitlabProjectApprovalSetting) Register(name string) error {
+	log.Trace().Str("field", name).Msg("[gitlab.project.approvalSetting].Register")
+	switch name {
+	case "approvalsBeforeMerge":
+		return nil
+	case "resetApprovalsOnPush":
+		return nil
+	case "disableOverridingApproversPerMergeRequest":
+		return nil
+	case "mergeRequestsAuthorApproval":
+		return nil
+	case "mergeRequestsDisableCommittersApproval":
+		return nil
+	case "requirePasswordToApprove":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"gitlab.project.approvalSetting\" resource")
+	}
+}
+
+// Field accessor autogenerated
+func (s *mqlGitlabProjectApprovalSetting) Field(name string) (interface{}, error) {
+	log.Trace().Str("field", name).Msg("[gitlab.project.approvalSetting].Field")
+	switch name {
+	case "approvalsBeforeMerge":
+		return s.ApprovalsBeforeMerge()
+	case "resetApprovalsOnPush":
+		return s.ResetApprovalsOnPush()
+	case "disableOverridingApproversPerMergeRequest":
+		return s.DisableOverridingApproversPerMergeRequest()
+	case "mergeRequestsAuthorApproval":
+		return s.MergeRequestsAuthorApproval()
+	case "mergeRequestsDisableCommittersApproval":
+		return s.MergeRequestsDisableCommittersApproval()
+	case "requirePasswordToApprove":
+		return s.RequirePasswordToApprove()
+	default:
+		return nil, fmt.Errorf("Cannot find field '" + name + "' in \"gitlab.project.approvalSetting\" resource")
+	}
+}
+
+// ApprovalsBeforeMerge accessor autogenerated
+func (s *mqlGitlabProjectApprovalSetting) ApprovalsBeforeMerge() (int64, error) {
+	res, ok := s.Cache.Load("approvalsBeforeMerge")
+	if !ok || !res.Valid {
+		return 0, errors.New("\"gitlab.project.approvalSetting\" failed: no value provided for static field \"approvalsBeforeMerge\"")
+	}
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	tres, ok := res.Data.(int64)
+	if !ok {
+		return 0, fmt.Errorf("\"gitlab.project.approvalSetting\" failed to cast field \"approvalsBeforeMerge\" to the right type (int64): %#v", res)
+	}
+	return tres, nil
+}
+
+// ResetApprovalsOnPush accessor autogenerated
+func (s *mqlGitlabProjectApprovalSetting) ResetApprovalsOnPush() (bool, error) {
+	res, ok := s.Cache.Load("resetApprovalsOnPush")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project.approvalSetting\" failed: no value provided for static field \"resetApprovalsOnPush\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project.approvalSetting\" failed to cast field \"resetApprovalsOnPush\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// DisableOverridingApproversPerMergeRequest accessor autogenerated
+func (s *mqlGitlabProjectApprovalSetting) DisableOverridingApproversPerMergeRequest() (bool, error) {
+	res, ok := s.Cache.Load("disableOverridingApproversPerMergeRequest")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project.approvalSetting\" failed: no value provided for static field \"disableOverridingApproversPerMergeRequest\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project.approvalSetting\" failed to cast field \"disableOverridingApproversPerMergeRequest\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// MergeRequestsAuthorApproval accessor autogenerated
+func (s *mqlGitlabProjectApprovalSetting) MergeRequestsAuthorApproval() (bool, error) {
+	res, ok := s.Cache.Load("mergeRequestsAuthorApproval")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project.approvalSetting\" failed: no value provided for static field \"mergeRequestsAuthorApproval\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project.approvalSetting\" failed to cast field \"mergeRequestsAuthorApproval\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// MergeRequestsDisableCommittersApproval accessor autogenerated
+func (s *mqlGitlabProjectApprovalSetting) MergeRequestsDisableCommittersApproval() (bool, error) {
+	res, ok := s.Cache.Load("mergeRequestsDisableCommittersApproval")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project.approvalSetting\" failed: no value provided for static field \"mergeRequestsDisableCommittersApproval\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project.approvalSetting\" failed to cast field \"mergeRequestsDisableCommittersApproval\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// RequirePasswordToApprove accessor autogenerated
+func (s *mqlGitlabProjectApprovalSetting) RequirePasswordToApprove() (bool, error) {
+	res, ok := s.Cache.Load("requirePasswordToApprove")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project.approvalSetting\" failed: no value provided for static field \"requirePasswordToApprove\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project.approvalSetting\" failed to cast field \"requirePasswordToApprove\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// Compute accessor autogenerated
+func (s *mqlGitlabProjectApprovalSetting) Compute(name string) error {
+	log.Trace().Str("field", name).Msg("[gitlab.project.approvalSetting].Compute")
+	switch name {
+	case "approvalsBeforeMerge":
+		return nil
+	case "resetApprovalsOnPush":
+		return nil
+	case "disableOverridingApproversPerMergeRequest":
+		return nil
+	case "mergeRequestsAuthorApproval":
+		return nil
+	case "mergeRequestsDisableCommittersApproval":
+		return nil
+	case "requirePasswordToApprove":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"gitlab.project.approvalSetting\" resource")
+	}
+}
+
+// GitlabProjectApprovalRule resource interface
+type GitlabProjectApprovalRule interface {
+	MqlResource() (*resources.Resource)
+	Compute(string) error
+	Field(string) (interface{}, error)
+	Register(string) error
+	Validate() error
+	Id() (int64, error)
+	Name() (string, error)
+	RuleType() (string, error)
+	ApprovalsRequired() (int64, error)
+	Users() ([]interface{}, error)
+	Groups() ([]interface{}, error)
+	ProtectedBranches() ([]interface{}, error)
+}
+
+// mqlGitlabProjectApprovalRule for the gitlab.project.approvalRule resource
+type mqlGitlabProjectApprovalRule struct {
+	*resources.Resource
+}
+
+// MqlResource to retrieve the underlying resource info
+func (s *mqlGitlabProjectApprovalRule) MqlResource() *resources.Resource {
+	return s.Resource
+}
+
+// create a new instance of the gitlab.project.approvalRule resource
+func newGitlabProjectApprovalRule(runtime *resources.Runtime, args *resources.Args) (interface{}, error) {
+	// User hooks
+	var err error
+	res := mqlGitlabProjectApprovalRule{runtime.NewResource("gitlab.project.approvalRule")}
+	// assign all named fields
+	var id string
+
+	now := time.Now().Unix()
+	for name, val := range *args {
+		if val == nil {
+			res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+			continue
+		}
+
+		switch name {
+		case "id":
+			if _, ok := val.(int64); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.approvalRule\", its \"id\" argument has the wrong type (expected type \"int64\")")
+			}
+		case "name":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.approvalRule\", its \"name\" argument has the wrong type (expected type \"string\")")
+			}
+		case "ruleType":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.approvalRule\", its \"ruleType\" argument has the wrong type (expected type \"string\")")
+			}
+		case "approvalsRequired":
+			if _, ok := val.(int64); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.approvalRule\", its \"approvalsRequired\" argument has the wrong type (expected type \"int64\")")
+			}
+		case "users":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.approvalRule\", its \"users\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
+		case "groups":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.approvalRule\", its \"groups\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
+		case "protectedBranches":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.approvalRule\", its \"protectedBranches\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
+		case "__id":
+			idVal, ok := val.(string)
+			if !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.approvalRule\", its \"__id\" argument has the wrong type (expected type \"string\")")
+			}
+			id = idVal
+		default:
+			return nil, errors.New("Initialized gitlab.project.approvalRule with unknown argument " + name)
+		}
+		res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+	}
+
+	// Get the ID
+	if id == "" {
+		res.Resource.Id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res.Resource.Id = id
+	}
+
+	return &res, nil
+}
+
+func (s *mqlGitlabProjectApprovalRule) Validate() error {
+	// required arguments
+	if _, ok := s.Cache.Load("id"); !ok {
+		return errors.New("Initialized \"gitlab.project.approvalRule\" resource without a \"id\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("name"); !ok {
+		return errors.New("Initialized \"gitlab.project.approvalRule\" resource without a \"name\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("ruleType"); !ok {
+		return errors.New("Initialized \"gitlab.project.approvalRule\" resource without a \"ruleType\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("approvalsRequired"); !ok {
+		return errors.New("Initialized \"gitlab.project.approvalRule\" resource without a \"approvalsRequired\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("users"); !ok {
+		return errors.New("Initialized \"gitlab.project.approvalRule\" resource without a \"users\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("groups"); !ok {
+		return errors.New("Initialized \"gitlab.project.approvalRule\" resource without a \"groups\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("protectedBranches"); !ok {
+		return errors.New("Initialized \"gitlab.project.approvalRule\" resource without a \"protectedBranches\". This field is required.")
+	}
+
+	return nil
+}
+
+// Register accessor autogenerated
+func (s *mqlGitlabProjectApprovalRule) Register(name string) error {
+	log.Trace().Str("field", name).Msg("[gitlab.project.approvalRule].Register")
+	switch name {
+	case "id":
+		return nil
+	case "name":
+		return nil
+	case "ruleType":
+		return nil
+	case "approvalsRequired":
+		return nil
+	case "users":
+		return nil
+	case "groups":
+		return nil
+	case "protectedBranches":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"gitlab.project.approvalRule\" resource")
+	}
+}
+
+// Field accessor autogenerated
+func (s *mqlGitlabProjectApprovalRule) Field(name string) (interface{}, error) {
+	log.Trace().Str("field", name).Msg("[gitlab.project.approvalRule].Field")
+	switch name {
+	case "id":
+		return s.Id()
+	case "name":
+		return s.Name()
+	case "ruleType":
+		return s.RuleType()
+	case "approvalsRequired":
+		return s.ApprovalsRequired()
+	case "users":
+		return s.Users()
+	case "groups":
+		return s.Groups()
+	case "protectedBranches":
+		return s.ProtectedBranches()
+	default:
+		return nil, fmt.Errorf("Cannot find field '" + name + "' in \"gitlab.project.approvalRule\" resource")
+	}
+}
+
+// Id accessor autogenerated
+func (s *mqlGitlabProjectApprovalRule) Id() (int64, error) {
+	res, ok := s.Cache.Load("id")
+	if !ok || !res.Valid {
+		return 0, errors.New("\"gitlab.project.approvalRule\" failed: no value provided for static field \"id\"")
+	}
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	tres, ok := res.Data.(int64)
+	if !ok {
+		return 0, fmt.Errorf("\"gitlab.project.approvalRule\" failed to cast field \"id\" to the right type (int64): %#v", res)
+	}
+	return tres, nil
+}
+
+// Name accessor autogenerated
+func (s *mqlGitlabProjectApprovalRule) Name() (string, error) {
+	res, ok := s.Cache.Load("name")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.project.approvalRule\" failed: no value provided for static field \"name\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.project.approvalRule\" failed to cast field \"name\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// RuleType accessor autogenerated
+func (s *mqlGitlabProjectApprovalRule) RuleType() (string, error) {
+	res, ok := s.Cache.Load("ruleType")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.project.approvalRule\" failed: no value provided for static field \"ruleType\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.project.approvalRule\" failed to cast field \"ruleType\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// ApprovalsRequired accessor autogenerated
+func (s *mqlGitlabProjectApprovalRule) ApprovalsRequired() (int64, error) {
+	res, ok := s.Cache.Load("approvalsRequired")
+	if !ok || !res.Valid {
+		return 0, errors.New("\"gitlab.project.approvalRule\" failed: no value provided for static field \"approvalsRequired\"")
+	}
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	tres, ok := res.Data.(int64)
+	if !ok {
+		return 0, fmt.Errorf("\"gitlab.project.approvalRule\" failed to cast field \"approvalsRequired\" to the right type (int64): %#v", res)
+	}
+	return tres, nil
+}
+
+// Users accessor autogenerated
+func (s *mqlGitlabProjectApprovalRule) Users() ([]interface{}, error) {
+	res, ok := s.Cache.Load("users")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"gitlab.project.approvalRule\" failed: no value provided for static field \"users\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"gitlab.project.approvalRule\" failed to cast field \"users\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Groups accessor autogenerated
+func (s *mqlGitlabProjectApprovalRule) Groups() ([]interface{}, error) {
+	res, ok := s.Cache.Load("groups")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"gitlab.project.approvalRule\" failed: no value provided for static field \"groups\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"gitlab.project.approvalRule\" failed to cast field \"groups\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// ProtectedBranches accessor autogenerated
+func (s *mqlGitlabProjectApprovalRule) ProtectedBranches() ([]interface{}, error) {
+	res, ok := s.Cache.Load("protectedBranches")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"gitlab.project.approvalRule\" failed: no value provided for static field \"protectedBranches\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"gitlab.project.approvalRule\" failed to cast field \"protectedBranches\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Compute accessor autogenerated
+func (s *mqlGitlabProjectApprovalRule) Compute(name string) error {
+	log.Trace().Str("field", name).Msg("[gitlab.project.approvalRule].Compute")
+	switch name {
+	case "id":
+		return nil
+	case "name":
+		return nil
+	case "ruleType":
+		return nil
+	case "approvalsRequired":
+		return nil
+	case "users":
+		return nil
+	case "groups":
+		return nil
+	case "protectedBranches":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"gitlab.project.approvalRule\" resource")
+	}
+}
+
+// GitlabVariable resource interface
+type GitlabVariable interface {
+	MqlResource() (*resources.Resource)
+	Compute(string) error
+	Field(string) (interface{}, error)
+	Register(string) error
+	Validate() error
+	Key() (string, error)
+	Type() (string, error)
+	Protected() (bool, error)
+	Masked() (bool, error)
+	EnvironmentScope() (string, error)
+}
+
+// mqlGitlabVariable for the gitlab.variable resource
+type mqlGitlabVariable struct {
+	*resources.Resource
+}
+
+// MqlResource to retrieve the underlying resource info
+func (s *mqlGitlabVariable) MqlResource() *resources.Resource {
+	return s.Resource
+}
+
+// create a new instance of the gitlab.variable resource
+func newGitlabVariable(runtime *resources.Runtime, args *resources.Args) (interface{}, error) {
+	// User hooks
+	var err error
+	res := mqlGitlabVariable{runtime.NewResource("gitlab.variable")}
+	// assign all named fields
+	var id string
+
+	now := time.Now().Unix()
+	for name, val := range *args {
+		if val == nil {
+			res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+			continue
+		}
+
+		switch name {
+		case "key":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.variable\", its \"key\" argument has the wrong type (expected type \"string\")")
+			}
+		case "type":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.variable\", its \"type\" argument has the wrong type (expected type \"string\")")
+			}
+		case "protected":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.variable\", its \"protected\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "masked":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.variable\", its \"masked\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "environmentScope":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.variable\", its \"environmentScope\" argument has the wrong type (expected type \"string\")")
+			}
+		case "__id":
+			idVal, ok := val.(string)
+			if !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.variable\", its \"__id\" argument has the wrong type (expected type \"string\")")
+			}
+			id = idVal
+		default:
+			return nil, errors.New("Initialized gitlab.variable with unknown argument " + name)
+		}
+		res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+	}
+
+	// Get the ID
+	if id == "" {
+		res.Resource.Id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res.Resource.Id = id
+	}
+
+	return &res, nil
+}
+
+func (s *mqlGitlabVariable) Validate() error {
+	// required arguments
+	if _, ok := s.Cache.Load("key"); !ok {
+		return errors.New("Initialized \"gitlab.variable\" resource without a \"key\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("type"); !ok {
+		return errors.New("Initialized \"gitlab.variable\" resource without a \"type\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("protected"); !ok {
+		return errors.New("Initialized \"gitlab.variable\" resource without a \"protected\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("masked"); !ok {
+		return errors.New("Initialized \"gitlab.variable\" resource without a \"masked\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("environmentScope"); !ok {
+		return errors.New("Initialized \"gitlab.variable\" resource without a \"environmentScope\". This field is required.")
+	}
+
+	return nil
+}
+
+// Register accessor autogenerated
+func (s *mqlGitlabVariable) Register(name string) error {
+	log.Trace().Str("field", name).Msg("[gitlab.variable].Register")
+	switch name {
+	case "key":
+		return nil
+	case "type":
+		return nil
+	case "protected":
+		return nil
+	case "masked":
+		return nil
+	case "environmentScope":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"gitlab.variable\" resource")
+	}
+}
+
+// Field accessor autogenerated
+func (s *mqlGitlabVariable) Field(name string) (interface{}, error) {
+	log.Trace().Str("field", name).Msg("[gitlab.variable].Field")
+	switch name {
+	case "key":
+		return s.Key()
+	case "type":
+		return s.Type()
+	case "protected":
+		return s.Protected()
+	case "masked":
+		return s.Masked()
+	case "environmentScope":
+		return s.EnvironmentScope()
+	default:
+		return nil, fmt.Errorf("Cannot find field '" + name + "' in \"gitlab.variable\" resource")
+	}
+}
+
+// Key accessor autogenerated
+func (s *mqlGitlabVariable) Key() (string, error) {
+	res, ok := s.Cache.Load("key")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.variable\" failed: no value provided for static field \"key\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.variable\" failed to cast field \"key\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Type accessor autogenerated
+func (s *mqlGitlabVariable) Type() (string, error) {
+	res, ok := s.Cache.Load("type")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.variable\" failed: no value provided for static field \"type\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.variable\" failed to cast field \"type\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Protected accessor autogenerated
+func (s *mqlGitlabVariable) Protected() (bool, error) {
+	res, ok := s.Cache.Load("protected")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.variable\" failed: no value provided for static field \"protected\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.variable\" failed to cast field \"protected\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// Masked accessor autogenerated
+func (s *mqlGitlabVariable) Masked() (bool, error) {
+	res, ok := s.Cache.Load("masked")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.variable\" failed: no value provided for static field \"masked\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.variable\" failed to cast field \"masked\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// EnvironmentScope accessor autogenerated
+func (s *mqlGitlabVariable) EnvironmentScope() (string, error) {
+	res, ok := s.Cache.Load("environmentScope")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.variable\" failed: no value provided for static field \"environmentScope\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.variable\" failed to cast field \"environmentScope\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Compute accessor autogenerated
+func (s *mqlGitlabVariable) Compute(name string) error {
+	log.Trace().Str("field", name).Msg("[gitlab.variable].Compute")
+	switch name {
+	case "key":
+		return nil
+	case "type":
+		return nil
+	case "protected":
+		return nil
+	case "masked":
+		return nil
+	case "environmentScope":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"gitlab.variable\" resource")
+	}
+}
+
+// GitlabProjectDeployKey resource interface
+type GitlabProjectDeployKey interface {
+	MqlResource() (*resources.Resource)
+	Compute(string) error
+	Field(string) (interface{}, error)
+	Register(string) error
+	Validate() error
+	Id() (int64, error)
+	Title() (string, error)
+	Key() (string, error)
+	CanPush() (bool, error)
+	CreatedAt() (*time.Time, error)
+}
+
+// mqlGitlabProjectDeployKey for the gitlab.project.deployKey resource
+type mqlGitlabProjectDeployKey struct {
+	*resources.Resource
+}
+
+// MqlResource to retrieve the underlying resource info
+func (s *mqlGitlabProjectDeployKey) MqlResource() *resources.Resource {
+	return s.Resource
+}
+
+// create a new instance of the gitlab.project.deployKey resource
+func newGitlabProjectDeployKey(runtime *resources.Runtime, args *resources.Args) (interface{}, error) {
+	// User hooks
+	var err error
+	res := mqlGitlabProjectDeployKey{runtime.NewResource("gitlab.project.deployKey")}
+	// assign all named fields
+	var id string
+
+	now := time.Now().Unix()
+	for name, val := range *args {
+		if val == nil {
+			res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+			continue
+		}
+
+		switch name {
+		case "id":
+			if _, ok := val.(int64); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.deployKey\", its \"id\" argument has the wrong type (expected type \"int64\")")
+			}
+		case "title":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.deployKey\", its \"title\" argument has the wrong type (expected type \"string\")")
+			}
+		case "key":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.deployKey\", its \"key\" argument has the wrong type (expected type \"string\")")
+			}
+		case "canPush":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.deployKey\", its \"canPush\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "createdAt":
+			if _, ok := val.(*time.Time); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.deployKey\", its \"createdAt\" argument has the wrong type (expected type \"*time.Time\")")
+			}
+		case "__id":
+			idVal, ok := val.(string)
+			if !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.deployKey\", its \"__id\" argument has the wrong type (expected type \"string\")")
+			}
+			id = idVal
+		default:
+			return nil, errors.New("Initialized gitlab.project.deployKey with unknown argument " + name)
+		}
+		res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+	}
+
+	// Get the ID
+	if id == "" {
+		res.Resource.Id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res.Resource.Id = id
+	}
+
+	return &res, nil
+}
+
+func (s *mqlGitlabProjectDeployKey) Validate() error {
+	// required arguments
+	if _, ok := s.Cache.Load("id"); !ok {
+		return errors.New("Initialized \"gitlab.project.deployKey\" resource without a \"id\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("title"); !ok {
+		return errors.New("Initialized \"gitlab.project.deployKey\" resource without a \"title\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("key"); !ok {
+		return errors.New("Initialized \"gitlab.project.deployKey\" resource without a \"key\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("canPush"); !ok {
+		return errors.New("Initialized \"gitlab.project.deployKey\" resource without a \"canPush\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("createdAt"); !ok {
+		return errors.New("Initialized \"gitlab.project.deployKey\" resource without a \"createdAt\". This field is required.")
+	}
+
+	return nil
+}
+
+// Register accessor autogenerated
+func (s *mqlGitlabProjectDeployKey) Register(name string) error {
+	log.Trace().Str("field", name).Msg("[gitlab.project.deployKey].Register")
+	switch name {
+	case "id":
+		return nil
+	case "title":
+		return nil
+	case "key":
+		return nil
+	case "canPush":
+		return nil
+	case "createdAt":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"gitlab.project.deployKey\" resource")
+	}
+}
+
+// Field accessor autogenerated
+func (s *mqlGitlabProjectDeployKey) Field(name string) (interface{}, error) {
+	log.Trace().Str("field", name).Msg("[gitlab.project.deployKey].Field")
+	switch name {
+	case "id":
+		return s.Id()
+	case "title":
+		return s.Title()
+	case "key":
+		return s.Key()
+	case "canPush":
+		return s.CanPush()
+	case "createdAt":
+		return s.CreatedAt()
+	default:
+		return nil, fmt.Errorf("Cannot find field '" + name + "' in \"gitlab.project.deployKey\" resource")
+	}
+}
+
+// Id accessor autogenerated
+func (s *mqlGitlabProjectDeployKey) Id() (int64, error) {
+	res, ok := s.Cache.Load("id")
+	if !ok || !res.Valid {
+		return 0, errors.New("\"gitlab.project.deployKey\" failed: no value provided for static field \"id\"")
+	}
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	tres, ok := res.Data.(int64)
+	if !ok {
+		return 0, fmt.Errorf("\"gitlab.project.deployKey\" failed to cast field \"id\" to the right type (int64): %#v", res)
+	}
+	return tres, nil
+}
+
+// Title accessor autogenerated
+func (s *mqlGitlabProjectDeployKey) Title() (string, error) {
+	res, ok := s.Cache.Load("title")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.project.deployKey\" failed: no value provided for static field \"title\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.project.deployKey\" failed to cast field \"title\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Key accessor autogenerated
+func (s *mqlGitlabProjectDeployKey) Key() (string, error) {
+	res, ok := s.Cache.Load("key")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.project.deployKey\" failed: no value provided for static field \"key\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.project.deployKey\" failed to cast field \"key\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// CanPush accessor autogenerated
+func (s *mqlGitlabProjectDeployKey) CanPush() (bool, error) {
+	res, ok := s.Cache.Load("canPush")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project.deployKey\" failed: no value provided for static field \"canPush\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project.deployKey\" failed to cast field \"canPush\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// CreatedAt accessor autogenerated
+func (s *mqlGitlabProjectDeployKey) CreatedAt() (*time.Time, error) {
+	res, ok := s.Cache.Load("createdAt")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"gitlab.project.deployKey\" failed: no value provided for static field \"createdAt\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(*time.Time)
+	if !ok {
+		return nil, fmt.Errorf("\"gitlab.project.deployKey\" failed to cast field \"createdAt\" to the right type (*time.Time): %#v", res)
+	}
+	return tres, nil
+}
+
+// Compute accessor autogenerated
+func (s *mqlGitlabProjectDeployKey) Compute(name string) error {
+	log.Trace().Str("field", name).Msg("[gitlab.project.deployKey].Compute")
+	switch name {
+	case "id":
+		return nil
+	case "title":
+		return nil
+	case "key":
+		return nil
+	case "canPush":
+		return nil
+	case "createdAt":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"gitlab.project.deployKey\" resource")
+	}
+}
+
+// GitlabProjectWebhook resource interface
+type GitlabProjectWebhook interface {
+	MqlResource() (*resources.Resource)
+	Compute(string) error
+	Field(string) (interface{}, error)
+	Register(string) error
+	Validate() error
+	Id() (int64, error)
+	Url() (string, error)
+	PushEvents() (bool, error)
+	PushEventsBranchFilter() (string, error)
+	TagPushEvents() (bool, error)
+	MergeRequestsEvents() (bool, error)
+	IssuesEvents() (bool, error)
+	ConfidentialIssuesEvents() (bool, error)
+	NoteEvents() (bool, error)
+	ConfidentialNoteEvents() (bool, error)
+	JobEvents() (bool, error)
+	PipelineEvents() (bool, error)
+	WikiPageEvents() (bool, error)
+	DeploymentEvents() (bool, error)
+	ReleasesEvents() (bool, error)
+	EnableSslVerification() (bool, error)
+	CreatedAt() (*time.Time, error)
+}
+
+// mqlGitlabProjectWebhook for the gitlab.project.webhook resource
+type mqlGitlabProjectWebhook struct {
+	*resources.Resource
+}
+
+// MqlResource to retrieve the underlying resource info
+func (s *mqlGitlabProjectWebhook) MqlResource() *resources.Resource {
+	return s.Resource
+}
+
+// create a new instance of the gitlab.project.webhook resource
+func newGitlabProjectWebhook(runtime *resources.Runtime, args *resources.Args) (interface{}, error) {
+	// User hooks
+	var err error
+	res := mqlGitlabProjectWebhook{runtime.NewResource("gitlab.project.webhook")}
+	// assign all named fields
+	var id string
+
+	now := time.Now().Unix()
+	for name, val := range *args {
+		if val == nil {
+			res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+			continue
+		}
+
+		switch name {
+		case "id":
+			if _, ok := val.(int64); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.webhook\", its \"id\" argument has the wrong type (expected type \"int64\")")
+			}
+		case "url":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.webhook\", its \"url\" argument has the wrong type (expected type \"string\")")
+			}
+		case "pushEvents":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.webhook\", its \"pushEvents\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "pushEventsBranchFilter":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.webhook\", its \"pushEventsBranchFilter\" argument has the wrong type (expected type \"string\")")
+			}
+		case "tagPushEvents":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.webhook\", its \"tagPushEvents\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "mergeRequestsEvents":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.webhook\", its \"mergeRequestsEvents\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "issuesEvents":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.webhook\", its \"issuesEvents\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "confidentialIssuesEvents":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.webhook\", its \"confidentialIssuesEvents\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "noteEvents":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.webhook\", its \"noteEvents\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "confidentialNoteEvents":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.webhook\", its \"confidentialNoteEvents\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "jobEvents":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.webhook\", its \"jobEvents\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "pipelineEvents":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.webhook\", its \"pipelineEvents\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "wikiPageEvents":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.webhook\", its \"wikiPageEvents\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "deploymentEvents":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.webhook\", its \"deploymentEvents\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "releasesEvents":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.webhook\", its \"releasesEvents\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "enableSslVerification":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.webhook\", its \"enableSslVerification\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "createdAt":
+			if _, ok := val.(*time.Time); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.webhook\", its \"createdAt\" argument has the wrong type (expected type \"*time.Time\")")
+			}
+		case "__id":
+			idVal, ok := val.(string)
+			if !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.project.webhook\", its \"__id\" argument has the wrong type (expected type \"string\")")
+			}
+			id = idVal
+		default:
+			return nil, errors.New("Initialized gitlab.project.webhook with unknown argument " + name)
+		}
+		res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+	}
+
+	// Get the ID
+	if id == "" {
+		res.Resource.Id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res.Resource.Id = id
+	}
+
+	return &res, nil
+}
+
+func (s *mqlGitlabProjectWebhook) Validate() error {
+	// required arguments
+	if _, ok := s.Cache.Load("id"); !ok {
+		return errors.New("Initialized \"gitlab.project.webhook\" resource without a \"id\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("url"); !ok {
+		return errors.New("Initialized \"gitlab.project.webhook\" resource without a \"url\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("pushEvents"); !ok {
+		return errors.New("Initialized \"gitlab.project.webhook\" resource without a \"pushEvents\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("pushEventsBranchFilter"); !ok {
+		return errors.New("Initialized \"gitlab.project.webhook\" resource without a \"pushEventsBranchFilter\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("tagPushEvents"); !ok {
+		return errors.New("Initialized \"gitlab.project.webhook\" resource without a \"tagPushEvents\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("mergeRequestsEvents"); !ok {
+		return errors.New("Initialized \"gitlab.project.webhook\" resource without a \"mergeRequestsEvents\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("issuesEvents"); !ok {
+		return errors.New("Initialized \"gitlab.project.webhook\" resource without a \"issuesEvents\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("confidentialIssuesEvents"); !ok {
+		return errors.New("Initialized \"gitlab.project.webhook\" resource without a \"confidentialIssuesEvents\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("noteEvents"); !ok {
+		return errors.New("Initialized \"gitlab.project.webhook\" resource without a \"noteEvents\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("confidentialNoteEvents"); !ok {
+		return errors.New("Initialized \"gitlab.project.webhook\" resource without a \"confidentialNoteEvents\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("jobEvents"); !ok {
+		return errors.New("Initialized \"gitlab.project.webhook\" resource without a \"jobEvents\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("pipelineEvents"); !ok {
+		return errors.New("Initialized \"gitlab.project.webhook\" resource without a \"pipelineEvents\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("wikiPageEvents"); !ok {
+		return errors.New("Initialized \"gitlab.project.webhook\" resource without a \"wikiPageEvents\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("deploymentEvents"); !ok {
+		return errors.New("Initialized \"gitlab.project.webhook\" resource without a \"deploymentEvents\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("releasesEvents"); !ok {
+		return errors.New("Initialized \"gitlab.project.webhook\" resource without a \"releasesEvents\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("enableSslVerification"); !ok {
+		return errors.New("Initialized \"gitlab.project.webhook\" resource without a \"enableSslVerification\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("createdAt"); !ok {
+		return errors.New("Initialized \"gitlab.project.webhook\" resource without a \"createdAt\". This field is required.")
+	}
+
+	return nil
+}
+
+// Register accessor autogenerated
+func (s *mqlGitlabProjectWebhook) Register(name string) error {
+	log.Trace().Str("field", name).Msg("[gitlab.project.webhook].Register")
+	switch name {
+	case "id":
+		return nil
+	case "url":
+		return nil
+	case "pushEvents":
+		return nil
+	case "pushEventsBranchFilter":
+		return nil
+	case "tagPushEvents":
+		return nil
+	case "mergeRequestsEvents":
+		return nil
+	case "issuesEvents":
+		return nil
+	case "confidentialIssuesEvents":
+		return nil
+	case "noteEvents":
+		return nil
+	case "confidentialNoteEvents":
+		return nil
+	case "jobEvents":
+		return nil
+	case "pipelineEvents":
+		return nil
+	case "wikiPageEvents":
+		return nil
+	case "deploymentEvents":
+		return nil
+	case "releasesEvents":
+		return nil
+	case "enableSslVerification":
+		return nil
+	case "createdAt":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"gitlab.project.webhook\" resource")
+	}
+}
+
+// Field accessor autogenerated
+func (s *mqlGitlabProjectWebhook) Field(name string) (interface{}, error) {
+	log.Trace().Str("field", name).Msg("[gitlab.project.webhook].Field")
+	switch name {
+	case "id":
+		return s.Id()
+	case "url":
+		return s.Url()
+	case "pushEvents":
+		return s.PushEvents()
+	case "pushEventsBranchFilter":
+		return s.PushEventsBranchFilter()
+	case "tagPushEvents":
+		return s.TagPushEvents()
+	case "mergeRequestsEvents":
+		return s.MergeRequestsEvents()
+	case "issuesEvents":
+		return s.IssuesEvents()
+	case "confidentialIssuesEvents":
+		return s.ConfidentialIssuesEvents()
+	case "noteEvents":
+		return s.NoteEvents()
+	case "confidentialNoteEvents":
+		return s.ConfidentialNoteEvents()
+	case "jobEvents":
+		return s.JobEvents()
+	case "pipelineEvents":
+		return s.PipelineEvents()
+	case "wikiPageEvents":
+		return s.WikiPageEvents()
+	case "deploymentEvents":
+		return s.DeploymentEvents()
+	case "releasesEvents":
+		return s.ReleasesEvents()
+	case "enableSslVerification":
+		return s.EnableSslVerification()
+	case "createdAt":
+		return s.CreatedAt()
+	default:
+		return nil, fmt.Errorf("Cannot find field '" + name + "' in \"gitlab.project.webhook\" resource")
+	}
+}
+
+// Id accessor autogenerated
+func (s *mqlGitlabProjectWebhook) Id() (int64, error) {
+	res, ok := s.Cache.Load("id")
+	if !ok || !res.Valid {
+		return 0, errors.New("\"gitlab.project.webhook\" failed: no value provided for static field \"id\"")
+	}
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	tres, ok := res.Data.(int64)
+	if !ok {
+		return 0, fmt.Errorf("\"gitlab.project.webhook\" failed to cast field \"id\" to the right type (int64): %#v", res)
+	}
+	return tres, nil
+}
+
+// Url accessor autogenerated
+func (s *mqlGitlabProjectWebhook) Url() (string, error) {
+	res, ok := s.Cache.Load("url")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.project.webhook\" failed: no value provided for static field \"url\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.project.webhook\" failed to cast field \"url\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// PushEvents accessor autogenerated
+func (s *mqlGitlabProjectWebhook) PushEvents() (bool, error) {
+	res, ok := s.Cache.Load("pushEvents")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project.webhook\" failed: no value provided for static field \"pushEvents\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project.webhook\" failed to cast field \"pushEvents\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// PushEventsBranchFilter accessor autogenerated
+func (s *mqlGitlabProjectWebhook) PushEventsBranchFilter() (string, error) {
+	res, ok := s.Cache.Load("pushEventsBranchFilter")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.project.webhook\" failed: no value provided for static field \"pushEventsBranchFilter\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.project.webhook\" failed to cast field \"pushEventsBranchFilter\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// TagPushEvents accessor autogenerated
+func (s *mqlGitlabProjectWebhook) TagPushEvents() (bool, error) {
+	res, ok := s.Cache.Load("tagPushEvents")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project.webhook\" failed: no value provided for static field \"tagPushEvents\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project.webhook\" failed to cast field \"tagPushEvents\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// MergeRequestsEvents accessor autogenerated
+func (s *mqlGitlabProjectWebhook) MergeRequestsEvents() (bool, error) {
+	res, ok := s.Cache.Load("mergeRequestsEvents")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project.webhook\" failed: no value provided for static field \"mergeRequestsEvents\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project.webhook\" failed to cast field \"mergeRequestsEvents\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// IssuesEvents accessor autogenerated
+func (s *mqlGitlabProjectWebhook) IssuesEvents() (bool, error) {
+	res, ok := s.Cache.Load("issuesEvents")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project.webhook\" failed: no value provided for static field \"issuesEvents\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project.webhook\" failed to cast field \"issuesEvents\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// ConfidentialIssuesEvents accessor autogenerated
+func (s *mqlGitlabProjectWebhook) ConfidentialIssuesEvents() (bool, error) {
+	res, ok := s.Cache.Load("confidentialIssuesEvents")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project.webhook\" failed: no value provided for static field \"confidentialIssuesEvents\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project.webhook\" failed to cast field \"confidentialIssuesEvents\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// NoteEvents accessor autogenerated
+func (s *mqlGitlabProjectWebhook) NoteEvents() (bool, error) {
+	res, ok := s.Cache.Load("noteEvents")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project.webhook\" failed: no value provided for static field \"noteEvents\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project.webhook\" failed to cast field \"noteEvents\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// ConfidentialNoteEvents accessor autogenerated
+func (s *mqlGitlabProjectWebhook) ConfidentialNoteEvents() (bool, error) {
+	res, ok := s.Cache.Load("confidentialNoteEvents")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project.webhook\" failed: no value provided for static field \"confidentialNoteEvents\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project.webhook\" failed to cast field \"confidentialNoteEvents\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// JobEvents accessor autogenerated
+func (s *mqlGitlabProjectWebhook) JobEvents() (bool, error) {
+	res, ok := s.Cache.Load("jobEvents")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project.webhook\" failed: no value provided for static field \"jobEvents\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project.webhook\" failed to cast field \"jobEvents\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// PipelineEvents accessor autogenerated
+func (s *mqlGitlabProjectWebhook) PipelineEvents() (bool, error) {
+	res, ok := s.Cache.Load("pipelineEvents")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project.webhook\" failed: no value provided for static field \"pipelineEvents\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project.webhook\" failed to cast field \"pipelineEvents\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// WikiPageEvents accessor autogenerated
+func (s *mqlGitlabProjectWebhook) WikiPageEvents() (bool, error) {
+	res, ok := s.Cache.Load("wikiPageEvents")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project.webhook\" failed: no value provided for static field \"wikiPageEvents\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project.webhook\" failed to cast field \"wikiPageEvents\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// DeploymentEvents accessor autogenerated
+func (s *mqlGitlabProjectWebhook) DeploymentEvents() (bool, error) {
+	res, ok := s.Cache.Load("deploymentEvents")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project.webhook\" failed: no value provided for static field \"deploymentEvents\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project.webhook\" failed to cast field \"deploymentEvents\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// ReleasesEvents accessor autogenerated
+func (s *mqlGitlabProjectWebhook) ReleasesEvents() (bool, error) {
+	res, ok := s.Cache.Load("releasesEvents")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project.webhook\" failed: no value provided for static field \"releasesEvents\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project.webhook\" failed to cast field \"releasesEvents\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// EnableSslVerification accessor autogenerated
+func (s *mqlGitlabProjectWebhook) EnableSslVerification() (bool, error) {
+	res, ok := s.Cache.Load("enableSslVerification")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.project.webhook\" failed: no value provided for static field \"enableSslVerification\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.project.webhook\" failed to cast field \"enableSslVerification\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// CreatedAt accessor autogenerated
+func (s *mqlGitlabProjectWebhook) CreatedAt() (*time.Time, error) {
+	res, ok := s.Cache.Load("createdAt")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"gitlab.project.webhook\" failed: no value provided for static field \"createdAt\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(*time.Time)
+	if !ok {
+		return nil, fmt.Errorf("\"gitlab.project.webhook\" failed to cast field \"createdAt\" to the right type (*time.Time): %#v", res)
+	}
+	return tres, nil
+}
+
+// Compute accessor autogenerated
+func (s *mqlGitlabProjectWebhook) Compute(name string) error {
+	log.Trace().Str("field", name).Msg("[gitlab.project.webhook].Compute")
+	switch name {
+	case "id":
+		return nil
+	case "url":
+		return nil
+	case "pushEvents":
+		return nil
+	case "pushEventsBranchFilter":
+		return nil
+	case "tagPushEvents":
+		return nil
+	case "mergeRequestsEvents":
+		return nil
+	case "issuesEvents":
+		return nil
+	case "confidentialIssuesEvents":
+		return nil
+	case "noteEvents":
+		return nil
+	case "confidentialNoteEvents":
+		return nil
+	case "jobEvents":
+		return nil
+	case "pipelineEvents":
+		return nil
+	case "wikiPageEvents":
+		return nil
+	case "deploymentEvents":
+		return nil
+	case "releasesEvents":
+		return nil
+	case "enableSslVerification":
+		return nil
+	case "createdAt":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"gitlab.project.webhook\" resource")
+	}
+}
+
+// GitlabRunner resource interface
+type GitlabRunner interface {
+	MqlResource() (*resources.Resource)
+	Compute(string) error
+	Field(string) (interface{}, error)
+	Register(string) error
+	Validate() error
+	Id() (int64, error)
+	Name() (string, error)
+	Description() (string, error)
+	RunnerType() (string, error)
+	IsShared() (bool, error)
+	Active() (bool, error)
+	Paused() (bool, error)
+	Online() (bool, error)
+	Status() (string, error)
+	IpAddress() (string, error)
+}
+
+// mqlGitlabRunner for the gitlab.runner resource
+type mqlGitlabRunner struct {
+	*resources.Resource
+}
+
+// MqlResource to retrieve the underlying resource info
+func (s *mqlGitlabRunner) MqlResource() *resources.Resource {
+	return s.Resource
+}
+
+// create a new instance of the gitlab.runner resource
+func newGitlabRunner(runtime *resources.Runtime, args *resources.Args) (interface{}, error) {
+	// User hooks
+	var err error
+	res := mqlGitlabRunner{runtime.NewResource("gitlab.runner")}
+	// assign all named fields
+	var id string
+
+	now := time.Now().Unix()
+	for name, val := range *args {
+		if val == nil {
+			res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+			continue
+		}
+
+		switch name {
+		case "id":
+			if _, ok := val.(int64); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.runner\", its \"id\" argument has the wrong type (expected type \"int64\")")
+			}
+		case "name":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.runner\", its \"name\" argument has the wrong type (expected type \"string\")")
+			}
+		case "description":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.runner\", its \"description\" argument has the wrong type (expected type \"string\")")
+			}
+		case "runnerType":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.runner\", its \"runnerType\" argument has the wrong type (expected type \"string\")")
+			}
+		case "isShared":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.runner\", its \"isShared\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "active":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.runner\", its \"active\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "paused":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.runner\", its \"paused\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "online":
+			if _, ok := val.(bool); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.runner\", its \"online\" argument has the wrong type (expected type \"bool\")")
+			}
+		case "status":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.runner\", its \"status\" argument has the wrong type (expected type \"string\")")
+			}
+		case "ipAddress":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.runner\", its \"ipAddress\" argument has the wrong type (expected type \"string\")")
+			}
+		case "__id":
+			idVal, ok := val.(string)
+			if !ok {
+				return nil, errors.New("Failed to initialize \"gitlab.runner\", its \"__id\" argument has the wrong type (expected type \"string\")")
+			}
+			id = idVal
+		default:
+			return nil, errors.New("Initialized gitlab.runner with unknown argument " + name)
+		}
+		res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+	}
+
+	// Get the ID
+	if id == "" {
+		res.Resource.Id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res.Resource.Id = id
+	}
+
+	return &res, nil
+}
+
+func (s *mqlGitlabRunner) Validate() error {
+	// required arguments
+	if _, ok := s.Cache.Load("id"); !ok {
+		return errors.New("Initialized \"gitlab.runner\" resource without a \"id\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("name"); !ok {
+		return errors.New("Initialized \"gitlab.runner\" resource without a \"name\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("description"); !ok {
+		return errors.New("Initialized \"gitlab.runner\" resource without a \"description\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("runnerType"); !ok {
+		return errors.New("Initialized \"gitlab.runner\" resource without a \"runnerType\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("isShared"); !ok {
+		return errors.New("Initialized \"gitlab.runner\" resource without a \"isShared\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("active"); !ok {
+		return errors.New("Initialized \"gitlab.runner\" resource without a \"active\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("paused"); !ok {
+		return errors.New("Initialized \"gitlab.runner\" resource without a \"paused\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("online"); !ok {
+		return errors.New("Initialized \"gitlab.runner\" resource without a \"online\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("status"); !ok {
+		return errors.New("Initialized \"gitlab.runner\" resource without a \"status\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("ipAddress"); !ok {
+		return errors.New("Initialized \"gitlab.runner\" resource without a \"ipAddress\". This field is required.")
+	}
+
+	return nil
+}
+
+// Register accessor autogenerated
+func (s *mqlGitlabRunner) Register(name string) error {
+	log.Trace().Str("field", name).Msg("[gitlab.runner].Register")
+	switch name {
+	case "id":
+		return nil
+	case "name":
+		return nil
+	case "description":
+		return nil
+	case "runnerType":
+		return nil
+	case "isShared":
+		return nil
+	case "active":
+		return nil
+	case "paused":
+		return nil
+	case "online":
+		return nil
+	case "status":
+		return nil
+	case "ipAddress":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"gitlab.runner\" resource")
+	}
+}
+
+// Field accessor autogenerated
+func (s *mqlGitlabRunner) Field(name string) (interface{}, error) {
+	log.Trace().Str("field", name).Msg("[gitlab.runner].Field")
+	switch name {
+	case "id":
+		return s.Id()
+	case "name":
+		return s.Name()
+	case "description":
+		return s.Description()
+	case "runnerType":
+		return s.RunnerType()
+	case "isShared":
+		return s.IsShared()
+	case "active":
+		return s.Active()
+	case "paused":
+		return s.Paused()
+	case "online":
+		return s.Online()
+	case "status":
+		return s.Status()
+	case "ipAddress":
+		return s.IpAddress()
+	default:
+		return nil, fmt.Errorf("Cannot find field '" + name + "' in \"gitlab.runner\" resource")
+	}
+}
+
+// Id accessor autogenerated
+func (s *mqlGitlabRunner) Id() (int64, error) {
+	res, ok := s.Cache.Load("id")
+	if !ok || !res.Valid {
+		return 0, errors.New("\"gitlab.runner\" failed: no value provided for static field \"id\"")
+	}
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	tres, ok := res.Data.(int64)
+	if !ok {
+		return 0, fmt.Errorf("\"gitlab.runner\" failed to cast field \"id\" to the right type (int64): %#v", res)
+	}
+	return tres, nil
+}
+
+// Name accessor autogenerated
+func (s *mqlGitlabRunner) Name() (string, error) {
+	res, ok := s.Cache.Load("name")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.runner\" failed: no value provided for static field \"name\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.runner\" failed to cast field \"name\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Description accessor autogenerated
+func (s *mqlGitlabRunner) Description() (string, error) {
+	res, ok := s.Cache.Load("description")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.runner\" failed: no value provided for static field \"description\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.runner\" failed to cast field \"description\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// RunnerType accessor autogenerated
+func (s *mqlGitlabRunner) RunnerType() (string, error) {
+	res, ok := s.Cache.Load("runnerType")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.runner\" failed: no value provided for static field \"runnerType\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.runner\" failed to cast field \"runnerType\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// IsShared accessor autogenerated
+func (s *mqlGitlabRunner) IsShared() (bool, error) {
+	res, ok := s.Cache.Load("isShared")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.runner\" failed: no value provided for static field \"isShared\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.runner\" failed to cast field \"isShared\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// Active accessor autogenerated
+func (s *mqlGitlabRunner) Active() (bool, error) {
+	res, ok := s.Cache.Load("active")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.runner\" failed: no value provided for static field \"active\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.runner\" failed to cast field \"active\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// Paused accessor autogenerated
+func (s *mqlGitlabRunner) Paused() (bool, error) {
+	res, ok := s.Cache.Load("paused")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.runner\" failed: no value provided for static field \"paused\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.runner\" failed to cast field \"paused\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// Online accessor autogenerated
+func (s *mqlGitlabRunner) Online() (bool, error) {
+	res, ok := s.Cache.Load("online")
+	if !ok || !res.Valid {
+		return false, errors.New("\"gitlab.runner\" failed: no value provided for static field \"online\"")
+	}
+	if res.Error != nil {
+		return false, res.Error
+	}
+	tres, ok := res.Data.(bool)
+	if !ok {
+		return false, fmt.Errorf("\"gitlab.runner\" failed to cast field \"online\" to the right type (bool): %#v", res)
+	}
+	return tres, nil
+}
+
+// Status accessor autogenerated
+func (s *mqlGitlabRunner) Status() (string, error) {
+	res, ok := s.Cache.Load("status")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.runner\" failed: no value provided for static field \"status\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.runner\" failed to cast field \"status\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// IpAddress accessor autogenerated
+func (s *mqlGitlabRunner) IpAddress() (string, error) {
+	res, ok := s.Cache.Load("ipAddress")
+	if !ok || !res.Valid {
+		return "", errors.New("\"gitlab.runner\" failed: no value provided for static field \"ipAddress\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"gitlab.runner\" failed to cast field \"ipAddress\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Compute accessor autogenerated
+func (s *mqlGitlabRunner) Compute(name string) error {
+	log.Trace().Str("field", name).Msg("[gitlab.runner].Compute")
+	switch name {
+	case "id":
+		return nil
+	case "name":
+		return nil
+	case "description":
+		return nil
+	case "runnerType":
+		return nil
+	case "isShared":
+		return nil
+	case "active":
+		return nil
+	case "paused":
+		return nil
+	case "online":
+		return nil
+	case "status":
+		return nil
+	case "ipAddress":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"gitlab.runner\" resource")
 	}
 }
 
@@ -0,0 +1,202 @@
+package gitlab_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/motor"
+	"go.mondoo.com/cnquery/motor/providers"
+	provider "go.mondoo.com/cnquery/motor/providers/gitlab"
+	"go.mondoo.com/cnquery/resources/packs/gitlab"
+	"go.mondoo.com/cnquery/resources/packs/testutils"
+)
+
+// fixtureServer serves recorded GitLab API responses from testdata/api, e.g.
+// GET /api/v4/projects/42/hooks is answered with testdata/api/projects/42/hooks.json
+func fixtureServer(t *testing.T) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, "/api/v4/")
+		data, err := os.ReadFile(filepath.Join("testdata", "api", filepath.FromSlash(name)+".json"))
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func testGitlabQuery(t *testing.T, options map[string]string, query string) []*llx.RawResult {
+	srv := fixtureServer(t)
+
+	opts := map[string]string{
+		"token": "test",
+		"group": "mondoo",
+		"url":   srv.URL + "/api/v4",
+	}
+	for k, v := range options {
+		opts[k] = v
+	}
+
+	p, err := provider.New(&providers.Config{
+		Backend: providers.ProviderType_GITLAB,
+		Options: opts,
+	})
+	require.NoError(t, err)
+
+	m, err := motor.New(p)
+	require.NoError(t, err)
+
+	x := testutils.InitTester(m, gitlab.Registry)
+	return x.TestQuery(t, query)
+}
+
+func TestGitlabGroup(t *testing.T) {
+	t.Run("group", func(t *testing.T) {
+		res := testGitlabQuery(t, nil, "gitlab.group.fullPath")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, "mondoo", res[0].Data.Value)
+
+		res = testGitlabQuery(t, nil, "gitlab.group.requireTwoFactorAuthentication && gitlab.group.preventForkingOutsideGroup")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, true, res[0].Data.Value)
+	})
+
+	t.Run("projects", func(t *testing.T) {
+		res := testGitlabQuery(t, nil, "gitlab.group.projects.map(fullPath)")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, []interface{}{"mondoo/webapp", "mondoo/team/docs"}, res[0].Data.Value)
+
+		res = testGitlabQuery(t, nil, "gitlab.group.projects.where(onlyAllowMergeIfPipelineSucceeds == false).map(name)")
+		require.NotEmpty(t, res)
+		assert.Equal(t, []interface{}{"docs"}, res[0].Data.Value)
+	})
+
+	t.Run("members", func(t *testing.T) {
+		res := testGitlabQuery(t, nil, "gitlab.group.members.where(role == 'owner').map(username)")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, []interface{}{"alice"}, res[0].Data.Value)
+
+		res = testGitlabQuery(t, nil, "gitlab.group.members.where(username == 'bob').first.accessLevel")
+		require.NotEmpty(t, res)
+		assert.Equal(t, int64(30), res[0].Data.Value)
+	})
+
+	t.Run("variables", func(t *testing.T) {
+		res := testGitlabQuery(t, nil, "gitlab.group.variables.where(masked && protected).map(key)")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, []interface{}{"REGISTRY_PASSWORD"}, res[0].Data.Value)
+	})
+
+	t.Run("runners", func(t *testing.T) {
+		res := testGitlabQuery(t, nil, "gitlab.group.runners.map(runnerType)")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, []interface{}{"group_type"}, res[0].Data.Value)
+	})
+}
+
+func TestGitlabProject(t *testing.T) {
+	project := map[string]string{"project": "webapp"}
+
+	t.Run("settings", func(t *testing.T) {
+		res := testGitlabQuery(t, project, "gitlab.project.fullPath")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, "mondoo/webapp", res[0].Data.Value)
+
+		res = testGitlabQuery(t, project, "gitlab.project.onlyAllowMergeIfPipelineSucceeds && gitlab.project.restrictUserDefinedVariables")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, true, res[0].Data.Value)
+
+		res = testGitlabQuery(t, project, "gitlab.project.forkingAccessLevel")
+		require.NotEmpty(t, res)
+		assert.Equal(t, "disabled", res[0].Data.Value)
+	})
+
+	t.Run("members", func(t *testing.T) {
+		res := testGitlabQuery(t, project, "gitlab.project.members.where(state == 'blocked').map(role)")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, []interface{}{"maintainer"}, res[0].Data.Value)
+	})
+
+	t.Run("protected branches", func(t *testing.T) {
+		res := testGitlabQuery(t, project, "gitlab.project.protectedBranches.where(allowForcePush).map(name)")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, []interface{}{"release/*"}, res[0].Data.Value)
+
+		res = testGitlabQuery(t, project, "gitlab.project.protectedBranches.where(name == 'main').first.pushAccessLevels[0]['access_level']")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, float64(40), res[0].Data.Value)
+	})
+
+	t.Run("approvals", func(t *testing.T) {
+		res := testGitlabQuery(t, project, "gitlab.project.approvalSettings.approvalsBeforeMerge")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, int64(2), res[0].Data.Value)
+
+		res = testGitlabQuery(t, project, "gitlab.project.approvalSettings.mergeRequestsAuthorApproval")
+		require.NotEmpty(t, res)
+		assert.Equal(t, false, res[0].Data.Value)
+
+		res = testGitlabQuery(t, project, "gitlab.project.approvalRules.where(name == 'Security').first.groups")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, []interface{}{"mondoo/security"}, res[0].Data.Value)
+
+		res = testGitlabQuery(t, project, "gitlab.project.approvalRules.where(protectedBranches.contains('main')).map(users)")
+		require.NotEmpty(t, res)
+		assert.Equal(t, []interface{}{[]interface{}{"alice"}}, res[0].Data.Value)
+	})
+
+	t.Run("variables", func(t *testing.T) {
+		res := testGitlabQuery(t, project, "gitlab.project.variables.where(masked == false).map(key)")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, []interface{}{"KUBECONFIG"}, res[0].Data.Value)
+
+		res = testGitlabQuery(t, project, "gitlab.project.variables.where(key == 'AWS_SECRET_ACCESS_KEY').first.environmentScope")
+		require.NotEmpty(t, res)
+		assert.Equal(t, "production", res[0].Data.Value)
+	})
+
+	t.Run("deploy keys", func(t *testing.T) {
+		res := testGitlabQuery(t, project, "gitlab.project.deployKeys.where(canPush).map(title)")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, []interface{}{"ci"}, res[0].Data.Value)
+	})
+
+	t.Run("webhooks", func(t *testing.T) {
+		res := testGitlabQuery(t, project, "gitlab.project.webhooks.where(enableSslVerification == false).map(url)")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, []interface{}{"http://ci.example.com/hook"}, res[0].Data.Value)
+	})
+
+	t.Run("runners", func(t *testing.T) {
+		res := testGitlabQuery(t, project, "gitlab.project.runners.where(isShared).map(status)")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, []interface{}{"offline"}, res[0].Data.Value)
+	})
+}
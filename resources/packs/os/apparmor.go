@@ -0,0 +1,71 @@
+package os
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/resources/packs/os/apparmor"
+)
+
+func (a *mqlApparmor) id() (string, error) {
+	return "apparmor", nil
+}
+
+func (a *mqlApparmor) GetEnabled() (bool, error) {
+	osProvider, err := osProvider(a.MotorRuntime.Motor)
+	if err != nil {
+		return false, err
+	}
+
+	data, err := afero.ReadFile(osProvider.FS(), apparmor.EnabledPath)
+	if errors.Is(err, afero.ErrFileNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return strings.TrimSpace(string(data)) == "Y", nil
+}
+
+func (a *mqlApparmor) GetProfiles() ([]interface{}, error) {
+	enabled, err := a.Enabled()
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return []interface{}{}, nil
+	}
+
+	osProvider, err := osProvider(a.MotorRuntime.Motor)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := osProvider.FS().Open(apparmor.ProfilesPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	profiles, err := apparmor.ParseProfiles(f)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(profiles))
+	for i := range profiles {
+		mqlProfile, err := a.MotorRuntime.CreateResource("apparmor.profile",
+			"name", profiles[i].Name,
+			"mode", profiles[i].Mode,
+		)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = mqlProfile
+	}
+	return res, nil
+}
+
+func (a *mqlApparmorProfile) id() (string, error) {
+	return a.Name()
+}
@@ -0,0 +1,45 @@
+package apparmor
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+const (
+	// EnabledPath reports if the apparmor module is enabled in the kernel
+	EnabledPath = "/sys/module/apparmor/parameters/enabled"
+	// ProfilesPath lists the profiles loaded into the kernel, it is only readable by root
+	ProfilesPath = "/sys/kernel/security/apparmor/profiles"
+)
+
+type Profile struct {
+	Name string
+	// Mode is enforce, complain, kill or unconfined
+	Mode string
+}
+
+// ParseProfiles parses the loaded profiles of securityfs, every line has the
+// profile name followed by the mode, e.g. "/usr/sbin/cupsd (enforce)"
+func ParseProfiles(r io.Reader) ([]Profile, error) {
+	profiles := []Profile{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		// profile names may contain spaces, the mode is always last
+		i := strings.LastIndex(line, " (")
+		if i < 0 || !strings.HasSuffix(line, ")") {
+			profiles = append(profiles, Profile{Name: line})
+			continue
+		}
+		profiles = append(profiles, Profile{
+			Name: line[:i],
+			Mode: line[i+2 : len(line)-1],
+		})
+	}
+	return profiles, scanner.Err()
+}
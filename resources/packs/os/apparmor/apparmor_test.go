@@ -0,0 +1,26 @@
+package apparmor_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/resources/packs/os/apparmor"
+)
+
+func TestParseProfiles(t *testing.T) {
+	content := `/usr/sbin/cupsd (enforce)
+/usr/lib/cups/backend/cups-pdf (complain)
+lsb_release (enforce)
+snap.firefox.hook.configure (enforce)
+unprivileged userns (kill)
+docker-default (enforce)
+`
+	profiles, err := apparmor.ParseProfiles(strings.NewReader(content))
+	require.NoError(t, err)
+	require.Len(t, profiles, 6)
+	assert.Equal(t, apparmor.Profile{Name: "/usr/sbin/cupsd", Mode: "enforce"}, profiles[0])
+	assert.Equal(t, apparmor.Profile{Name: "/usr/lib/cups/backend/cups-pdf", Mode: "complain"}, profiles[1])
+	assert.Equal(t, apparmor.Profile{Name: "unprivileged userns", Mode: "kill"}, profiles[4])
+}
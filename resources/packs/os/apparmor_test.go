@@ -0,0 +1,24 @@
+package os_test
+
+import (
+	"testing"
+
+	"go.mondoo.com/cnquery/resources/packs/testutils"
+)
+
+func TestResource_Apparmor(t *testing.T) {
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			"apparmor.enabled",
+			0, true,
+		},
+		{
+			"apparmor.profiles.length",
+			0, int64(2),
+		},
+		{
+			"apparmor.profiles.where(mode == 'complain')[0].name",
+			0, "/usr/bin/man",
+		},
+	})
+}
@@ -0,0 +1,66 @@
+package os
+
+import (
+	"errors"
+
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/resources"
+	"go.mondoo.com/cnquery/resources/packs/core/php"
+	"go.mondoo.com/cnquery/upstream/mvd"
+)
+
+var composerPackages = languagePackages{
+	resource: "composer.package",
+	purlType: "composer",
+	manifests: []packageManifest{
+		{regex: `.*/composer\.lock$`, parse: parseComposerLock},
+		{regex: `.*/vendor/composer/installed\.json$`, parse: parseComposerInstalled},
+	},
+}
+
+func parseComposerLock(path string, f afero.File) ([]*mvd.Package, error) {
+	return php.ParseComposerLock(f)
+}
+
+func parseComposerInstalled(path string, f afero.File) ([]*mvd.Package, error) {
+	return php.ParseComposerInstalled(f)
+}
+
+func (s *mqlComposerPackages) init(args *resources.Args) (*resources.Args, ComposerPackages, error) {
+	if err := initLanguagePackages(args); err != nil {
+		return nil, nil, errors.New("cannot initialize composer.packages: " + err.Error())
+	}
+	return args, nil, nil
+}
+
+func (s *mqlComposerPackages) id() (string, error) {
+	return s.Path()
+}
+
+func (s *mqlComposerPackages) GetFiles() ([]interface{}, error) {
+	path, err := s.Path()
+	if err != nil {
+		return nil, err
+	}
+	return composerPackages.files(s.MotorRuntime, path)
+}
+
+func (s *mqlComposerPackages) GetList(files []interface{}) ([]interface{}, error) {
+	return composerPackages.list(s.MotorRuntime, files)
+}
+
+func (s *mqlComposerPackage) id() (string, error) {
+	path, err := s.Path()
+	if err != nil {
+		return "", err
+	}
+	name, err := s.Name()
+	if err != nil {
+		return "", err
+	}
+	version, err := s.Version()
+	if err != nil {
+		return "", err
+	}
+	return languagePackageId(path, name, version), nil
+}
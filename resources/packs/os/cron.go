@@ -0,0 +1,55 @@
+package os
+
+import (
+	"strconv"
+
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/resources/packs/os/cron"
+)
+
+func (c *mqlCron) id() (string, error) {
+	return "cron", nil
+}
+
+func (c *mqlCron) GetList() ([]interface{}, error) {
+	osProvider, err := osProvider(c.MotorRuntime.Motor)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := cron.List(osProvider.FS())
+	if err != nil {
+		return nil, err
+	}
+	log.Debug().Int("entries", len(entries)).Msg("mql[cron]> scheduled jobs")
+
+	res := make([]interface{}, len(entries))
+	for i := range entries {
+		entry := entries[i]
+		mqlEntry, err := c.MotorRuntime.CreateResource("cron.entry",
+			"type", entry.Type,
+			"schedule", entry.Schedule,
+			"user", entry.User,
+			"command", entry.Command,
+			"source", entry.Source,
+			"line", int64(entry.Line),
+		)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = mqlEntry.(CronEntry)
+	}
+	return res, nil
+}
+
+func (c *mqlCronEntry) id() (string, error) {
+	source, err := c.Source()
+	if err != nil {
+		return "", err
+	}
+	line, err := c.Line()
+	if err != nil {
+		return "", err
+	}
+	return source + ":" + strconv.FormatInt(line, 10), nil
+}
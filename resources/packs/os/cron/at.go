@@ -0,0 +1,85 @@
+package cron
+
+import (
+	"bufio"
+	"io"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// at job files encode the queue, the job number and the execution time in
+	// minutes since the epoch, e.g. a0000301a2b3c4, running jobs use = as queue
+	atJobRegex = regexp.MustCompile(`^([a-zA-Z=])([0-9a-f]{5})([0-9a-f]{8})$`)
+	atUidRegex = regexp.MustCompile(`^#\s*atrun uid=(\d+)`)
+	// the job is executed as here document, e.g. ${SHELL:-/bin/sh} << 'marcinDELIMITER2d7e3d5b'
+	atHeredocRegex = regexp.MustCompile(`<<\s*'?(marcinDELIMITER[0-9a-f]+)'?\s*$`)
+)
+
+// IsAtJob checks if the file name of the at spool is a job
+func IsAtJob(name string) bool {
+	return atJobRegex.MatchString(name)
+}
+
+// ParseAtJob parses a job of the at spool, the job is a shell script that
+// restores the environment of the user and runs the command afterwards
+func ParseAtJob(r io.Reader, source string) (Entry, error) {
+	entry := Entry{
+		Type:   TypeAt,
+		Source: source,
+	}
+
+	if m := atJobRegex.FindStringSubmatch(path.Base(source)); m != nil {
+		minutes, err := strconv.ParseInt(m[3], 16, 64)
+		if err == nil {
+			entry.Schedule = time.Unix(minutes*60, 0).UTC().Format(time.RFC3339)
+		}
+	}
+
+	var uid string
+	var delimiter string
+	var command []string
+	afterCd := false
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case delimiter != "":
+			if line == delimiter {
+				delimiter = ""
+				continue
+			}
+			command = append(command, line)
+		case strings.HasPrefix(line, "# mail"):
+			// # mail     chris 0
+			fields := strings.Fields(line)
+			if len(fields) >= 3 {
+				entry.User = fields[2]
+			}
+		case atUidRegex.MatchString(line):
+			uid = atUidRegex.FindStringSubmatch(line)[1]
+		case atHeredocRegex.MatchString(line):
+			delimiter = atHeredocRegex.FindStringSubmatch(line)[1]
+			command = nil
+		case afterCd:
+			// older versions of at append the command after the cd block
+			if strings.TrimSpace(line) != "" {
+				command = append(command, line)
+			}
+		case line == "}":
+			afterCd = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return entry, err
+	}
+
+	if entry.User == "" {
+		entry.User = uid
+	}
+	entry.Command = strings.TrimSpace(strings.Join(command, "\n"))
+	return entry, nil
+}
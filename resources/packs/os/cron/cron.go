@@ -0,0 +1,129 @@
+package cron
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+)
+
+const (
+	TypeCron    = "cron"
+	TypeAnacron = "anacron"
+	TypeAt      = "at"
+)
+
+// Entry is a scheduled job
+type Entry struct {
+	// Type is cron, anacron or at
+	Type string
+	// Schedule is the cron expression, e.g. */5 * * * * or @daily, the period
+	// of anacron jobs in days or the execution time of at jobs
+	Schedule string
+	User     string
+	Command  string
+	// Source is the file that defines the job
+	Source string
+	// Line is the line of the entry in the source, starting with 1
+	Line int
+}
+
+// environment assignments like SHELL=/bin/sh or MAILTO="" are not jobs
+var envRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*\s*=`)
+
+// ParseCrontab parses a crontab, system crontabs like /etc/crontab and
+// /etc/cron.d/* have a user column, per-user crontabs are run as the given user
+func ParseCrontab(r io.Reader, source string, user string) ([]Entry, error) {
+	entries := []Entry{}
+	systemCrontab := user == ""
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || envRegex.MatchString(text) {
+			continue
+		}
+
+		// special schedules like @reboot or @daily replace the five time fields
+		scheduleFields := 5
+		if strings.HasPrefix(text, "@") {
+			scheduleFields = 1
+		}
+		columns := scheduleFields + 1
+		if systemCrontab {
+			columns++
+		}
+
+		fields := splitFields(text, columns)
+		if len(fields) < columns {
+			continue
+		}
+
+		entry := Entry{
+			Type:     TypeCron,
+			Schedule: strings.Join(fields[:scheduleFields], " "),
+			User:     user,
+			Command:  fields[columns-1],
+			Source:   source,
+			Line:     line,
+		}
+		if systemCrontab {
+			entry.User = fields[scheduleFields]
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
+
+// ParseAnacrontab parses /etc/anacrontab, every job has a period in days or
+// a named period like @monthly, a delay in minutes, an identifier and the
+// command. All jobs run as root.
+func ParseAnacrontab(r io.Reader, source string) ([]Entry, error) {
+	entries := []Entry{}
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || envRegex.MatchString(text) {
+			continue
+		}
+
+		fields := splitFields(text, 4)
+		if len(fields) < 4 {
+			continue
+		}
+		entries = append(entries, Entry{
+			Type:     TypeAnacron,
+			Schedule: fields[0],
+			User:     "root",
+			Command:  fields[3],
+			Source:   source,
+			Line:     line,
+		})
+	}
+	return entries, scanner.Err()
+}
+
+// splitFields splits the line into n whitespace separated fields, the last
+// field contains the remaining line
+func splitFields(line string, n int) []string {
+	fields := []string{}
+	for len(fields) < n-1 {
+		line = strings.TrimLeft(line, " \t")
+		i := strings.IndexAny(line, " \t")
+		if i < 0 {
+			break
+		}
+		fields = append(fields, line[:i])
+		line = line[i:]
+	}
+	line = strings.TrimSpace(line)
+	if line != "" {
+		fields = append(fields, line)
+	}
+	return fields
+}
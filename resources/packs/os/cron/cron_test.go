@@ -0,0 +1,110 @@
+package cron
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/motor/providers/fs"
+)
+
+func TestParseCrontab(t *testing.T) {
+	content := `SHELL=/bin/bash
+MAILTO = root
+# m h dom mon dow user command
+0 4 * * 1-5 backup /usr/local/bin/backup --target s3://bucket  --verbose
+@reboot root /usr/bin/cleanup
+
+invalid line
+`
+	entries, err := ParseCrontab(strings.NewReader(content), "/etc/cron.d/backup", "")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, Entry{
+		Type:     TypeCron,
+		Schedule: "0 4 * * 1-5",
+		User:     "backup",
+		Command:  "/usr/local/bin/backup --target s3://bucket  --verbose",
+		Source:   "/etc/cron.d/backup",
+		Line:     4,
+	}, entries[0])
+	assert.Equal(t, "@reboot", entries[1].Schedule)
+	assert.Equal(t, "root", entries[1].User)
+	assert.Equal(t, "/usr/bin/cleanup", entries[1].Command)
+
+	// user crontabs have no user column
+	entries, err = ParseCrontab(strings.NewReader("*/10 * * * *\t/usr/bin/php artisan schedule:run\n"), "/var/spool/cron/www-data", "www-data")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "*/10 * * * *", entries[0].Schedule)
+	assert.Equal(t, "www-data", entries[0].User)
+	assert.Equal(t, "/usr/bin/php artisan schedule:run", entries[0].Command)
+}
+
+func TestList(t *testing.T) {
+	entries, err := List(fs.NewMountedFs("testdata/debian"))
+	require.NoError(t, err)
+
+	bySource := map[string][]Entry{}
+	for _, e := range entries {
+		bySource[e.Source] = append(bySource[e.Source], e)
+	}
+
+	require.Len(t, bySource["/etc/crontab"], 4)
+	assert.Equal(t, "17 * * * *", bySource["/etc/crontab"][0].Schedule)
+	assert.Equal(t, "cd / && run-parts --report /etc/cron.hourly", bySource["/etc/crontab"][0].Command)
+	assert.Equal(t, 18, bySource["/etc/crontab"][0].Line)
+
+	require.Len(t, bySource["/etc/cron.d/e2scrub_all"], 2)
+	require.Len(t, bySource["/etc/cron.d/php"], 2)
+	assert.Equal(t, "www-data", bySource["/etc/cron.d/php"][1].User)
+	// cron ignores files with dots
+	assert.NotContains(t, bySource, "/etc/cron.d/php.dpkg-old")
+
+	userEntries := bySource["/var/spool/cron/crontabs/chris"]
+	require.Len(t, userEntries, 2)
+	assert.Equal(t, "chris", userEntries[1].User)
+	assert.Equal(t, "@daily", userEntries[1].Schedule)
+	assert.Equal(t, "curl -fsSL https://example.com/update.sh | sh", userEntries[1].Command)
+
+	anacron := bySource["/etc/anacrontab"]
+	require.Len(t, anacron, 3)
+	assert.Equal(t, Entry{
+		Type:     TypeAnacron,
+		Schedule: "@monthly",
+		User:     "root",
+		Command:  "run-parts --report /etc/cron.monthly",
+		Source:   "/etc/anacrontab",
+		Line:     12,
+	}, anacron[2])
+
+	atJobs := bySource["/var/spool/cron/atjobs/a0000301b0c1e4"]
+	require.Len(t, atJobs, 1)
+	assert.Equal(t, Entry{
+		Type:     TypeAt,
+		Schedule: "2023-12-04T06:28:00Z",
+		User:     "chris",
+		Command:  "/usr/bin/backup --full\nrm -f /tmp/backup.lock",
+		Source:   "/var/spool/cron/atjobs/a0000301b0c1e4",
+	}, atJobs[0])
+
+	assert.Len(t, entries, 14)
+}
+
+func TestParseAtJob_Legacy(t *testing.T) {
+	content := `#!/bin/sh
+# atrun uid=0 gid=0
+umask 22
+cd /root || {
+	 echo 'Execution directory inaccessible' >&2
+	 exit 1
+}
+/usr/sbin/reboot
+`
+	entry, err := ParseAtJob(strings.NewReader(content), "/var/spool/at/=0000a01b0c1e4")
+	require.NoError(t, err)
+	assert.Equal(t, "0", entry.User)
+	assert.Equal(t, "/usr/sbin/reboot", entry.Command)
+	assert.Equal(t, "2023-12-04T06:28:00Z", entry.Schedule)
+}
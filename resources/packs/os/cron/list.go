@@ -0,0 +1,147 @@
+package cron
+
+import (
+	"os"
+	"path"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+)
+
+const (
+	CrontabPath    = "/etc/crontab"
+	CronDir        = "/etc/cron.d"
+	AnacrontabPath = "/etc/anacrontab"
+)
+
+var (
+	// SpoolDirs hold the per-user crontabs, the files are named after the user
+	SpoolDirs = []string{
+		"/var/spool/cron/crontabs", // debian, ubuntu
+		"/var/spool/cron/tabs",     // suse
+		"/var/spool/cron",          // redhat, alpine
+		"/var/cron/tabs",           // freebsd
+		"/var/at/tabs",             // macos
+	}
+	// AtSpoolDirs hold the jobs scheduled with at
+	AtSpoolDirs = []string{
+		"/var/spool/cron/atjobs", // debian, ubuntu
+		"/var/spool/at",          // redhat
+		"/var/at/jobs",           // freebsd, macos
+	}
+)
+
+// List collects the jobs of cron, anacron and at. Sources that do not exist
+// are skipped, the spool directories are only readable by root.
+func List(fs afero.Fs) ([]Entry, error) {
+	entries := []Entry{}
+
+	crontabs := []string{CrontabPath}
+	files, err := listFiles(fs, CronDir)
+	if err != nil {
+		return nil, err
+	}
+	for i := range files {
+		// cron ignores files with dots, e.g. backups of package managers
+		if strings.Contains(path.Base(files[i]), ".") {
+			continue
+		}
+		crontabs = append(crontabs, files[i])
+	}
+
+	for i := range crontabs {
+		res, err := parseFile(fs, crontabs[i], func(f afero.File) ([]Entry, error) {
+			return ParseCrontab(f, crontabs[i], "")
+		})
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, res...)
+	}
+
+	for _, dir := range SpoolDirs {
+		files, err := listFiles(fs, dir)
+		if err != nil {
+			log.Warn().Err(err).Str("dir", dir).Msg("cron> could not read user crontabs")
+			continue
+		}
+		for i := range files {
+			user := path.Base(files[i])
+			if strings.HasPrefix(user, ".") {
+				continue
+			}
+			res, err := parseFile(fs, files[i], func(f afero.File) ([]Entry, error) {
+				return ParseCrontab(f, files[i], user)
+			})
+			if err != nil {
+				log.Warn().Err(err).Str("file", files[i]).Msg("cron> could not read user crontab")
+				continue
+			}
+			entries = append(entries, res...)
+		}
+	}
+
+	res, err := parseFile(fs, AnacrontabPath, func(f afero.File) ([]Entry, error) {
+		return ParseAnacrontab(f, AnacrontabPath)
+	})
+	if err != nil {
+		return nil, err
+	}
+	entries = append(entries, res...)
+
+	for _, dir := range AtSpoolDirs {
+		files, err := listFiles(fs, dir)
+		if err != nil {
+			log.Warn().Err(err).Str("dir", dir).Msg("cron> could not read at jobs")
+			continue
+		}
+		for i := range files {
+			if !IsAtJob(path.Base(files[i])) {
+				continue
+			}
+			res, err := parseFile(fs, files[i], func(f afero.File) ([]Entry, error) {
+				entry, err := ParseAtJob(f, files[i])
+				return []Entry{entry}, err
+			})
+			if err != nil {
+				log.Warn().Err(err).Str("file", files[i]).Msg("cron> could not read at job")
+				continue
+			}
+			entries = append(entries, res...)
+		}
+	}
+
+	return entries, nil
+}
+
+// parseFile opens and parses the file, files that do not exist have no entries
+func parseFile(fs afero.Fs, path string, parse func(f afero.File) ([]Entry, error)) ([]Entry, error) {
+	f, err := fs.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parse(f)
+}
+
+// listFiles returns the files of the directory, a missing directory has no files
+func listFiles(fs afero.Fs, dir string) ([]string, error) {
+	infos, err := afero.ReadDir(fs, dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	files := []string{}
+	for i := range infos {
+		if infos[i].IsDir() {
+			continue
+		}
+		files = append(files, path.Join(dir, infos[i].Name()))
+	}
+	return files, nil
+}
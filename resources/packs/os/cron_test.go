@@ -0,0 +1,32 @@
+package os_test
+
+import (
+	"testing"
+
+	"go.mondoo.com/cnquery/resources/packs/testutils"
+)
+
+func TestResource_Cron(t *testing.T) {
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			"cron.list.length",
+			0, int64(2),
+		},
+		{
+			"cron.list[0].schedule",
+			0, "17 * * * *",
+		},
+		{
+			"cron.list[0].command",
+			0, "cd / && run-parts --report /etc/cron.hourly",
+		},
+		{
+			"cron.list[0].line",
+			0, int64(3),
+		},
+		{
+			"cron.where(source == '/etc/cron.d/backup').list[0].user",
+			0, "backup",
+		},
+	})
+}
@@ -0,0 +1,263 @@
+package os
+
+import (
+	"errors"
+	"strconv"
+
+	"go.mondoo.com/cnquery/motor/providers/dockerfile"
+	"go.mondoo.com/cnquery/resources"
+	"go.mondoo.com/cnquery/resources/packs/core"
+)
+
+func (d *mqlDockerFile) init(args *resources.Args) (*resources.Args, DockerFile, error) {
+	if x, ok := (*args)["path"]; ok {
+		if _, ok := x.(string); !ok {
+			return nil, nil, errors.New("Wrong type for 'path' in docker.file initialization, it must be a string")
+		}
+		return args, nil, nil
+	}
+
+	// without a path we use the Dockerfile of the docker-file provider
+	p, ok := d.MotorRuntime.Motor.Provider.(*dockerfile.Provider)
+	if !ok {
+		return nil, nil, errors.New("docker.file requires a path on this transport")
+	}
+	(*args)["path"] = p.Path()
+	return args, nil, nil
+}
+
+func (d *mqlDockerFile) id() (string, error) {
+	path, err := d.Path()
+	if err != nil {
+		return "", err
+	}
+	return "docker.file/" + path, nil
+}
+
+// dockerfile parses the Dockerfile, the docker-file provider has already
+// parsed its Dockerfile while all others read it from the file system
+func (d *mqlDockerFile) dockerfile() (*dockerfile.Dockerfile, error) {
+	if c, ok := d.Cache.Load("_dockerfile"); ok {
+		return c.Data.(*dockerfile.Dockerfile), nil
+	}
+
+	path, err := d.Path()
+	if err != nil {
+		return nil, err
+	}
+
+	var res *dockerfile.Dockerfile
+	if p, ok := d.MotorRuntime.Motor.Provider.(*dockerfile.Provider); ok && p.Path() == path {
+		res = p.Dockerfile()
+	} else {
+		osProvider, err := osProvider(d.MotorRuntime.Motor)
+		if err != nil {
+			return nil, err
+		}
+		f, err := osProvider.FS().Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		res, err = dockerfile.Parse(f)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	d.Cache.Store("_dockerfile", &resources.CacheEntry{Data: res})
+	return res, nil
+}
+
+func (d *mqlDockerFile) GetDirectives() (map[string]interface{}, error) {
+	df, err := d.dockerfile()
+	if err != nil {
+		return nil, err
+	}
+	return core.StrMapToInterface(df.Directives), nil
+}
+
+func (d *mqlDockerFile) GetArgs() (map[string]interface{}, error) {
+	df, err := d.dockerfile()
+	if err != nil {
+		return nil, err
+	}
+	return core.StrMapToInterface(df.Args), nil
+}
+
+func (d *mqlDockerFile) GetStages() ([]interface{}, error) {
+	df, err := d.dockerfile()
+	if err != nil {
+		return nil, err
+	}
+	path, err := d.Path()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(df.Stages))
+	for i := range df.Stages {
+		stage := df.Stages[i]
+
+		copies := make([]interface{}, len(stage.Copies))
+		for j := range stage.Copies {
+			c := stage.Copies[j]
+			start, err := dockerFilePosition(d.MotorRuntime, path, c.Start)
+			if err != nil {
+				return nil, err
+			}
+			mqlCopy, err := d.MotorRuntime.CreateResource("docker.file.copy",
+				"keyword", c.Keyword,
+				"sources", core.StrSliceToInterface(c.Sources),
+				"destination", c.Destination,
+				"from", c.From,
+				"chown", c.Chown,
+				"chmod", c.Chmod,
+				"start", start,
+			)
+			if err != nil {
+				return nil, err
+			}
+			copies[j] = mqlCopy
+		}
+
+		instructions, err := dockerFileInstructions(d.MotorRuntime, path, stage.Instructions)
+		if err != nil {
+			return nil, err
+		}
+
+		start, err := dockerFilePosition(d.MotorRuntime, path, stage.Start)
+		if err != nil {
+			return nil, err
+		}
+		end, err := dockerFilePosition(d.MotorRuntime, path, stage.End)
+		if err != nil {
+			return nil, err
+		}
+
+		mqlStage, err := d.MotorRuntime.CreateResource("docker.file.stage",
+			"index", int64(stage.Index),
+			"name", stage.Name,
+			"from", stage.From,
+			"platform", stage.Platform,
+			"image", stage.Image,
+			"tag", stage.Tag,
+			"digest", stage.Digest,
+			"baseStage", stage.BaseStage,
+			"user", stage.User,
+			"workdir", stage.Workdir,
+			"env", core.StrMapToInterface(stage.Env),
+			"labels", core.StrMapToInterface(stage.Labels),
+			"expose", core.StrSliceToInterface(stage.Expose),
+			"entrypoint", core.StrSliceToInterface(stage.Entrypoint),
+			"cmd", core.StrSliceToInterface(stage.Cmd),
+			"run", core.StrSliceToInterface(stage.Run),
+			"copy", copies,
+			"healthcheck", healthcheckToDict(stage.Healthcheck),
+			"instructions", instructions,
+			"start", start,
+			"end", end,
+		)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = mqlStage
+	}
+	return res, nil
+}
+
+func (d *mqlDockerFile) GetInstructions() ([]interface{}, error) {
+	df, err := d.dockerfile()
+	if err != nil {
+		return nil, err
+	}
+	path, err := d.Path()
+	if err != nil {
+		return nil, err
+	}
+	return dockerFileInstructions(d.MotorRuntime, path, df.Instructions)
+}
+
+func healthcheckToDict(h *dockerfile.Healthcheck) map[string]interface{} {
+	if h == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"test":          core.StrSliceToInterface(h.Test),
+		"interval":      h.Interval,
+		"timeout":       h.Timeout,
+		"startPeriod":   h.StartPeriod,
+		"startInterval": h.StartInterval,
+		"retries":       int64(h.Retries),
+	}
+}
+
+func dockerFileInstructions(runtime *resources.Runtime, path string, instructions []dockerfile.Instruction) ([]interface{}, error) {
+	res := make([]interface{}, len(instructions))
+	for i := range instructions {
+		instruction := instructions[i]
+		start, err := dockerFilePosition(runtime, path, instruction.Start)
+		if err != nil {
+			return nil, err
+		}
+		end, err := dockerFilePosition(runtime, path, instruction.End)
+		if err != nil {
+			return nil, err
+		}
+
+		mqlInstruction, err := runtime.CreateResource("docker.file.instruction",
+			"keyword", instruction.Keyword,
+			"flags", core.StrMapToInterface(instruction.Flags),
+			"arguments", core.StrSliceToInterface(instruction.Arguments),
+			"json", instruction.JSON,
+			"original", instruction.Original,
+			"start", start,
+			"end", end,
+		)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = mqlInstruction
+	}
+	return res, nil
+}
+
+func dockerFilePosition(runtime *resources.Runtime, path string, pos dockerfile.Position) (resources.ResourceType, error) {
+	return runtime.CreateResource("docker.file.position",
+		"path", path,
+		"line", int64(pos.Line),
+		"column", int64(pos.Column),
+	)
+}
+
+func (d *mqlDockerFileStage) id() (string, error) {
+	start, err := d.Start()
+	if err != nil {
+		return "", err
+	}
+	return start.MqlResource().Id, nil
+}
+
+func (d *mqlDockerFileInstruction) id() (string, error) {
+	start, err := d.Start()
+	if err != nil {
+		return "", err
+	}
+	return start.MqlResource().Id, nil
+}
+
+func (d *mqlDockerFileCopy) id() (string, error) {
+	start, err := d.Start()
+	if err != nil {
+		return "", err
+	}
+	return start.MqlResource().Id, nil
+}
+
+func (d *mqlDockerFilePosition) id() (string, error) {
+	path, _ := d.Path()
+	line, _ := d.Line()
+	column, _ := d.Column()
+	return "docker.file.position/" + path + "/" + strconv.FormatInt(line, 10) + "/" + strconv.FormatInt(column, 10), nil
+}
@@ -0,0 +1,60 @@
+package os_test
+
+import (
+	"testing"
+
+	"go.mondoo.com/cnquery/resources/packs/testutils"
+)
+
+func TestResource_DockerFile(t *testing.T) {
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			"docker.file('/Dockerfile').stages.length",
+			0, int64(2),
+		},
+		{
+			"docker.file('/Dockerfile').instructions.length",
+			0, int64(7),
+		},
+		{
+			"docker.file('/Dockerfile').stages[0].image",
+			0, "alpine",
+		},
+		{
+			"docker.file('/Dockerfile').stages[0].tag",
+			0, "3.16",
+		},
+		{
+			"docker.file('/Dockerfile').stages[0].run[0]",
+			0, "apk add --no-cache     make",
+		},
+		{
+			"docker.file('/Dockerfile').stages[0].copy[0].chown",
+			0, "build",
+		},
+		{
+			"docker.file('/Dockerfile').stages[1].baseStage",
+			0, "build",
+		},
+		{
+			"docker.file('/Dockerfile').stages[1].user",
+			0, "nobody",
+		},
+		{
+			"docker.file('/Dockerfile').stages[1].expose",
+			0, []interface{}{"80/tcp"},
+		},
+		{
+			"docker.file('/Dockerfile').stages[1].healthcheck['test'][0]",
+			0, "CMD-SHELL",
+		},
+		{
+			"docker.file('/Dockerfile').instructions[1].start.line",
+			0, int64(2),
+		},
+		{
+			"docker.file('/Dockerfile').instructions[1].end.line",
+			0, int64(3),
+		},
+	})
+}
@@ -0,0 +1,61 @@
+package os_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mondoo.com/cnquery/motor/asset"
+	"go.mondoo.com/cnquery/resources"
+	"go.mondoo.com/cnquery/resources/packs/os"
+	"go.mondoo.com/cnquery/resources/packs/testutils"
+)
+
+type memoryFieldCache struct {
+	entries sync.Map
+}
+
+func (c *memoryFieldCache) Load(key string) ([]byte, bool) {
+	v, ok := c.entries.Load(key)
+	if !ok {
+		return nil, false
+	}
+	return v.([]byte), true
+}
+
+func (c *memoryFieldCache) Store(key string, data []byte) {
+	c.entries.Store(key, data)
+}
+
+func fieldCacheRuntime(path string, cache resources.FieldCache) *resources.Runtime {
+	m := testutils.Mock(path)
+	m.SetAsset(&asset.Asset{PlatformIds: []string{"//platformid.api.mondoo.app/runtime/test"}})
+	runtime := resources.NewRuntime(os.Registry, m)
+	runtime.FieldCache = cache
+	return runtime
+}
+
+func TestFieldCache(t *testing.T) {
+	cache := &memoryFieldCache{}
+	query := "file(\"/etc/passwd\").exists\nusers.list.map(name)"
+
+	x := testutils.InitRuntimeTester(fieldCacheRuntime("../testdata/arch.toml", cache))
+	res := x.TestQuery(t, query)
+	assert.NoError(t, res[0].Data.Error)
+	assert.NoError(t, res[1].Data.Error)
+	exists := res[0].Data.Value
+	assert.Equal(t, true, exists)
+	names := res[1].Data.Value
+
+	n := 0
+	cache.entries.Range(func(key, value interface{}) bool { n++; return true })
+	assert.NotZero(t, n)
+
+	// the same asset served from another backend only sees cached values
+	x = testutils.InitRuntimeTester(fieldCacheRuntime("../testdata/windows.toml", cache))
+	res = x.TestQuery(t, query)
+	assert.NoError(t, res[0].Data.Error)
+	assert.NoError(t, res[1].Data.Error)
+	assert.Equal(t, exists, res[0].Data.Value)
+	assert.Equal(t, names, res[1].Data.Value)
+}
@@ -0,0 +1,73 @@
+package os
+
+import (
+	"errors"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/resources"
+	"go.mondoo.com/cnquery/resources/packs/core/gem"
+	"go.mondoo.com/cnquery/upstream/mvd"
+)
+
+var gemPackages = languagePackages{
+	resource: "gem.package",
+	purlType: "gem",
+	manifests: []packageManifest{
+		{regex: `.*/Gemfile\.lock$`, parse: parseGemfileLock},
+		{regex: `.*/specifications/[^/]*\.gemspec$`, parse: parseGemspec},
+	},
+}
+
+func parseGemfileLock(path string, f afero.File) ([]*mvd.Package, error) {
+	return gem.ParseGemfileLock(f)
+}
+
+// parseGemspec detects installed gems, their specification file is named
+// after the gem and its version
+func parseGemspec(path string, f afero.File) ([]*mvd.Package, error) {
+	pkg, err := gem.ParseGemspecFilename(filepath.Base(path))
+	if err != nil {
+		return nil, err
+	}
+	return []*mvd.Package{pkg}, nil
+}
+
+func (s *mqlGemPackages) init(args *resources.Args) (*resources.Args, GemPackages, error) {
+	if err := initLanguagePackages(args); err != nil {
+		return nil, nil, errors.New("cannot initialize gem.packages: " + err.Error())
+	}
+	return args, nil, nil
+}
+
+func (s *mqlGemPackages) id() (string, error) {
+	return s.Path()
+}
+
+func (s *mqlGemPackages) GetFiles() ([]interface{}, error) {
+	path, err := s.Path()
+	if err != nil {
+		return nil, err
+	}
+	return gemPackages.files(s.MotorRuntime, path)
+}
+
+func (s *mqlGemPackages) GetList(files []interface{}) ([]interface{}, error) {
+	return gemPackages.list(s.MotorRuntime, files)
+}
+
+func (s *mqlGemPackage) id() (string, error) {
+	path, err := s.Path()
+	if err != nil {
+		return "", err
+	}
+	name, err := s.Name()
+	if err != nil {
+		return "", err
+	}
+	version, err := s.Version()
+	if err != nil {
+		return "", err
+	}
+	return languagePackageId(path, name, version), nil
+}
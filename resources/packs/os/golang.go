@@ -0,0 +1,68 @@
+package os
+
+import (
+	"errors"
+
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/resources"
+	"go.mondoo.com/cnquery/resources/packs/os/golang"
+	"go.mondoo.com/cnquery/upstream/mvd"
+)
+
+var golangPackages = languagePackages{
+	resource: "go.module",
+	purlType: "golang",
+	manifests: []packageManifest{
+		{regex: `.*/go\.sum$`, parse: parseGoSum},
+		// go binaries are only searched in bin directories, since every
+		// file has to be read to detect them
+		{regex: `.*/s?bin/[^/]*$`, parse: parseGoBinary},
+	},
+}
+
+func parseGoSum(path string, f afero.File) ([]*mvd.Package, error) {
+	return golang.ParseGoSum(f)
+}
+
+func parseGoBinary(path string, f afero.File) ([]*mvd.Package, error) {
+	return golang.ParseBuildInfo(f)
+}
+
+func (s *mqlGoModules) init(args *resources.Args) (*resources.Args, GoModules, error) {
+	if err := initLanguagePackages(args); err != nil {
+		return nil, nil, errors.New("cannot initialize go.modules: " + err.Error())
+	}
+	return args, nil, nil
+}
+
+func (s *mqlGoModules) id() (string, error) {
+	return s.Path()
+}
+
+func (s *mqlGoModules) GetFiles() ([]interface{}, error) {
+	path, err := s.Path()
+	if err != nil {
+		return nil, err
+	}
+	return golangPackages.files(s.MotorRuntime, path)
+}
+
+func (s *mqlGoModules) GetList(files []interface{}) ([]interface{}, error) {
+	return golangPackages.list(s.MotorRuntime, files)
+}
+
+func (s *mqlGoModule) id() (string, error) {
+	path, err := s.Path()
+	if err != nil {
+		return "", err
+	}
+	name, err := s.Name()
+	if err != nil {
+		return "", err
+	}
+	version, err := s.Version()
+	if err != nil {
+		return "", err
+	}
+	return languagePackageId(path, name, version), nil
+}
@@ -0,0 +1,71 @@
+package golang
+
+import (
+	"bufio"
+	"debug/buildinfo"
+	"io"
+	"strings"
+
+	"go.mondoo.com/cnquery/upstream/mvd"
+)
+
+func newModule(path string, version string) *mvd.Package {
+	return &mvd.Package{
+		Name:      path,
+		Version:   version,
+		Format:    "go",
+		Namespace: "go",
+	}
+}
+
+// ParseGoSum parses the modules of a go.sum file. Modules of which only
+// the go.mod was needed are not part of the build and are skipped.
+func ParseGoSum(r io.Reader) ([]*mvd.Package, error) {
+	entries := []*mvd.Package{}
+	seen := map[string]struct{}{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 3 || strings.HasSuffix(fields[1], "/go.mod") {
+			continue
+		}
+
+		key := fields[0] + "@" + fields[1]
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		entries = append(entries, newModule(fields[0], fields[1]))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// ParseBuildInfo reads the modules that are embedded into a Go binary.
+// It returns an error for files that are not Go binaries.
+func ParseBuildInfo(r io.ReaderAt) ([]*mvd.Package, error) {
+	info, err := buildinfo.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []*mvd.Package{}
+	// local builds have no version for the main module
+	if info.Main.Path != "" && info.Main.Version != "(devel)" {
+		entries = append(entries, newModule(info.Main.Path, info.Main.Version))
+	}
+
+	for _, dep := range info.Deps {
+		// replaced modules are the ones that were actually compiled in
+		if dep.Replace != nil {
+			dep = dep.Replace
+		}
+		entries = append(entries, newModule(dep.Path, dep.Version))
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,53 @@
+package golang_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/resources/packs/os/golang"
+	"go.mondoo.com/cnquery/upstream/mvd"
+)
+
+func TestGoSumParser(t *testing.T) {
+	data, err := os.Open("./testdata/go.sum")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pkgs, err := golang.ParseGoSum(data)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(pkgs))
+
+	assert.Contains(t, pkgs, &mvd.Package{
+		Name:      "github.com/rs/zerolog",
+		Version:   "v1.28.0",
+		Format:    "go",
+		Namespace: "go",
+	})
+}
+
+func TestBuildInfoParser(t *testing.T) {
+	// the test binary itself is a go binary with embedded modules
+	exe, err := os.Executable()
+	require.NoError(t, err)
+	f, err := os.Open(exe)
+	require.NoError(t, err)
+	defer f.Close()
+
+	pkgs, err := golang.ParseBuildInfo(f)
+	require.NoError(t, err)
+
+	found := false
+	for i := range pkgs {
+		if pkgs[i].Name == "github.com/stretchr/testify" {
+			found = strings.HasPrefix(pkgs[i].Version, "v")
+		}
+	}
+	assert.True(t, found, "testify is embedded into the test binary")
+
+	_, err = golang.ParseBuildInfo(strings.NewReader("not a binary"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,179 @@
+package os
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/resources"
+	"go.mondoo.com/cnquery/resources/packs/core"
+	"go.mondoo.com/cnquery/upstream/mvd"
+)
+
+// packageManifest describes a file that declares language packages
+type packageManifest struct {
+	// regex matches the full path of the file, it is used for the find
+	// command as well as the file search of the provider
+	regex string
+	parse func(path string, f afero.File) ([]*mvd.Package, error)
+}
+
+// languagePackages is shared by all language package resources, which
+// differ in their manifests and package type
+type languagePackages struct {
+	resource  string
+	purlType  string
+	manifests []packageManifest
+}
+
+func initLanguagePackages(args *resources.Args) error {
+	x, ok := (*args)["path"]
+	if !ok {
+		(*args)["path"] = "/"
+		return nil
+	}
+	if _, ok := x.(string); !ok {
+		return errors.New("wrong type for 'path', it must be a string")
+	}
+	return nil
+}
+
+// files searches for all manifests below the path
+func (l languagePackages) files(runtime *resources.Runtime, path string) ([]interface{}, error) {
+	res := []interface{}{}
+	seen := map[string]struct{}{}
+
+	for i := range l.manifests {
+		raw, err := runtime.CreateResource("files.find", "from", path, "regex", l.manifests[i].regex, "type", "file")
+		if err != nil {
+			return nil, err
+		}
+		files, err := raw.(FilesFind).List()
+		if err != nil {
+			return nil, err
+		}
+
+		for j := range files {
+			p, err := files[j].(core.File).Path()
+			if err != nil {
+				return nil, err
+			}
+			// find returns an empty line if nothing was found
+			if _, ok := seen[p]; ok || p == "" {
+				continue
+			}
+			seen[p] = struct{}{}
+			res = append(res, files[j])
+		}
+	}
+
+	return res, nil
+}
+
+// list parses all manifest files and creates a resource for every package
+func (l languagePackages) list(runtime *resources.Runtime, files []interface{}) ([]interface{}, error) {
+	osProvider, err := osProvider(runtime.Motor)
+	if err != nil {
+		return nil, err
+	}
+
+	regexes := make([]*regexp.Regexp, len(l.manifests))
+	for i := range l.manifests {
+		regexes[i] = regexp.MustCompile(l.manifests[i].regex)
+	}
+
+	res := []interface{}{}
+	seen := map[string]struct{}{}
+	for i := range files {
+		path, err := files[i].(core.File).Path()
+		if err != nil {
+			return nil, err
+		}
+
+		var manifest *packageManifest
+		for j := range regexes {
+			if regexes[j].MatchString(path) {
+				manifest = &l.manifests[j]
+				break
+			}
+		}
+		if manifest == nil {
+			continue
+		}
+
+		pkgs, err := parseManifest(osProvider.FS(), path, manifest)
+		if err != nil {
+			// inventories are best effort, a single broken file must not hide all other packages
+			log.Debug().Err(err).Str("path", path).Msg(l.resource + "> cannot parse file")
+			continue
+		}
+
+		// parsers often iterate over maps, sort them to get stable results
+		sort.Slice(pkgs, func(i, j int) bool {
+			if pkgs[i].Name == pkgs[j].Name {
+				return pkgs[i].Version < pkgs[j].Version
+			}
+			return pkgs[i].Name < pkgs[j].Name
+		})
+
+		for _, pkg := range pkgs {
+			if pkg.Name == "" {
+				continue
+			}
+			key := pkg.Name + "@" + pkg.Version + "@" + path
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+
+			r, err := runtime.CreateResource(l.resource,
+				"name", pkg.Name,
+				"version", pkg.Version,
+				"purl", newPurl(l.purlType, pkg.Name, pkg.Version),
+				"path", path,
+			)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, r)
+		}
+	}
+
+	return res, nil
+}
+
+func parseManifest(fs afero.Fs, path string, manifest *packageManifest) ([]*mvd.Package, error) {
+	f, err := fs.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return manifest.parse(path, f)
+}
+
+func languagePackageId(path string, name string, version string) string {
+	return path + ":" + name + "@" + version
+}
+
+// newPurl creates a package URL, see https://github.com/package-url/purl-spec
+// The name may contain the namespace, e.g. @babel/core or github.com/rs/zerolog
+func newPurl(typ string, name string, version string) string {
+	segments := strings.Split(name, "/")
+	for i := range segments {
+		segments[i] = purlEscape(segments[i])
+	}
+
+	res := "pkg:" + typ + "/" + strings.Join(segments, "/")
+	if version != "" {
+		res += "@" + purlEscape(version)
+	}
+	return res
+}
+
+func purlEscape(s string) string {
+	return strings.ReplaceAll(url.PathEscape(s), "@", "%40")
+}
@@ -0,0 +1,59 @@
+package os_test
+
+import (
+	"testing"
+
+	"go.mondoo.com/cnquery/resources/packs/os"
+	"go.mondoo.com/cnquery/resources/packs/testutils"
+)
+
+func TestLanguagePackages(t *testing.T) {
+	x := testutils.InitTester(testutils.Mock("../testdata/languages.toml"), os.Registry)
+
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			Code:        "npm.packages.map(purl)",
+			ResultIndex: 0,
+			Expectation: []interface{}{
+				"pkg:npm/%40babel/code-frame@7.18.6",
+				"pkg:npm/express@4.18.2",
+				"pkg:npm/webapp@1.0.0",
+				"pkg:npm/webapp@1.0.0",
+				"pkg:npm/express@4.18.2",
+			},
+		},
+		{
+			Code:        "npm.packages.where(name == 'express').map(path)",
+			ResultIndex: 0,
+			Expectation: []interface{}{
+				"/app/package-lock.json",
+				"/app/node_modules/express/package.json",
+			},
+		},
+		{
+			Code:        "gem.packages.map(purl)",
+			ResultIndex: 0,
+			Expectation: []interface{}{"pkg:gem/rack@2.2.4", "pkg:gem/bundler@2.3.7"},
+		},
+		{
+			Code:        "composer.packages.map(purl)",
+			ResultIndex: 0,
+			Expectation: []interface{}{"pkg:composer/monolog/monolog@2.8.0"},
+		},
+		{
+			Code:        "python.packages.map(purl)",
+			ResultIndex: 0,
+			Expectation: []interface{}{
+				"pkg:pypi/pyyaml@5.4.1",
+				"pkg:pypi/six@1.16.0",
+				"pkg:pypi/django@4.1.2",
+				"pkg:pypi/typing-extensions@4.4.0",
+			},
+		},
+		{
+			Code:        "go.modules.map(purl)",
+			ResultIndex: 0,
+			Expectation: []interface{}{"pkg:golang/github.com/rs/zerolog@v1.28.0"},
+		},
+	})
+}
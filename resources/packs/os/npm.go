@@ -0,0 +1,82 @@
+package os
+
+import (
+	"errors"
+
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/resources"
+	"go.mondoo.com/cnquery/resources/packs/os/npm"
+	"go.mondoo.com/cnquery/upstream/mvd"
+)
+
+var npmPackages = languagePackages{
+	resource: "npm.package",
+	purlType: "npm",
+	manifests: []packageManifest{
+		{regex: `.*/package-lock\.json$`, parse: parseNpmLock},
+		{regex: `.*/yarn\.lock$`, parse: parseYarnLock},
+		{regex: `.*/package\.json$`, parse: parsePackageJson},
+	},
+}
+
+func parseNpmLock(path string, f afero.File) ([]*mvd.Package, error) {
+	return npm.ParsePackageJsonLock(f)
+}
+
+func parseYarnLock(path string, f afero.File) ([]*mvd.Package, error) {
+	return npm.ParseYarnLock(f)
+}
+
+// parsePackageJson only reports the package itself. Its dependencies are
+// version ranges, installed dependencies have their own package.json in
+// node_modules.
+func parsePackageJson(path string, f afero.File) ([]*mvd.Package, error) {
+	pkgs, err := npm.ParsePackageJson(f)
+	if err != nil || len(pkgs) == 0 {
+		return nil, err
+	}
+	// package.json files of tests and fixtures often have no version
+	if pkgs[0].Version == "" {
+		return nil, nil
+	}
+	return pkgs[:1], nil
+}
+
+func (s *mqlNpmPackages) init(args *resources.Args) (*resources.Args, NpmPackages, error) {
+	if err := initLanguagePackages(args); err != nil {
+		return nil, nil, errors.New("cannot initialize npm.packages: " + err.Error())
+	}
+	return args, nil, nil
+}
+
+func (s *mqlNpmPackages) id() (string, error) {
+	return s.Path()
+}
+
+func (s *mqlNpmPackages) GetFiles() ([]interface{}, error) {
+	path, err := s.Path()
+	if err != nil {
+		return nil, err
+	}
+	return npmPackages.files(s.MotorRuntime, path)
+}
+
+func (s *mqlNpmPackages) GetList(files []interface{}) ([]interface{}, error) {
+	return npmPackages.list(s.MotorRuntime, files)
+}
+
+func (s *mqlNpmPackage) id() (string, error) {
+	path, err := s.Path()
+	if err != nil {
+		return "", err
+	}
+	name, err := s.Name()
+	if err != nil {
+		return "", err
+	}
+	version, err := s.Version()
+	if err != nil {
+		return "", err
+	}
+	return languagePackageId(path, name, version), nil
+}
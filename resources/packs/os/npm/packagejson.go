@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"io"
 	"io/ioutil"
+	"strings"
 
 	"go.mondoo.com/cnquery/upstream/mvd"
 )
@@ -28,6 +29,9 @@ type PackageJsonLock struct {
 	Name         string                          `json:"name"`
 	Version      string                          `json:"version"`
 	Dependencies map[string]PackageJsonLockEntry `jsonn:"dependencies"`
+	// Packages is used by lockfileVersion 2 and 3, keyed by their install
+	// path, e.g. node_modules/@babel/core
+	Packages map[string]PackageJsonLockEntry `json:"packages"`
 }
 
 func ParsePackageJson(r io.Reader) ([]*mvd.Package, error) {
@@ -98,5 +102,24 @@ func ParsePackageJsonLock(r io.Reader) ([]*mvd.Package, error) {
 		})
 	}
 
+	// newer lockfiles only list packages, older ones list both
+	if len(packageJsonLock.Dependencies) == 0 {
+		for k, v := range packageJsonLock.Packages {
+			idx := strings.LastIndex(k, nodeModules)
+			// the root package has an empty key and links have no version
+			if idx == -1 || v.Version == "" {
+				continue
+			}
+			entries = append(entries, &mvd.Package{
+				Name:      k[idx+len(nodeModules):],
+				Version:   v.Version,
+				Format:    "npm",
+				Namespace: "nodejs",
+			})
+		}
+	}
+
 	return entries, nil
 }
+
+const nodeModules = "node_modules/"
@@ -60,3 +60,29 @@ func TestPackageJsonLockParser(t *testing.T) {
 		Namespace: "nodejs",
 	})
 }
+
+func TestPackageJsonLockV3Parser(t *testing.T) {
+	data, err := os.Open("./testdata/v3-package-lock.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pkgs, err := npm.ParsePackageJsonLock(data)
+	assert.Nil(t, err)
+	// own package and three installed packages, links are skipped
+	assert.Equal(t, 4, len(pkgs))
+
+	assert.Contains(t, pkgs, &mvd.Package{
+		Name:      "@babel/code-frame",
+		Version:   "7.18.6",
+		Format:    "npm",
+		Namespace: "nodejs",
+	})
+
+	assert.Contains(t, pkgs, &mvd.Package{
+		Name:      "ms",
+		Version:   "2.0.0",
+		Format:    "npm",
+		Namespace: "nodejs",
+	})
+}
@@ -0,0 +1,132 @@
+package os
+
+import (
+	"go.mondoo.com/cnquery/resources/packs/core"
+	"go.mondoo.com/cnquery/resources/packs/os/polkit"
+)
+
+func (p *mqlPolkit) id() (string, error) {
+	return "polkit", nil
+}
+
+func (p *mqlPolkit) GetRules() ([]interface{}, error) {
+	osProvider, err := osProvider(p.MotorRuntime.Motor)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := polkit.ListRules(osProvider.FS())
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(rules))
+	for i := range rules {
+		mqlRule, err := p.MotorRuntime.CreateResource("polkit.rule",
+			"name", rules[i].Name,
+			"path", rules[i].Path,
+			"actions", core.StrSliceToInterface(rules[i].Actions),
+		)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = mqlRule
+	}
+	return res, nil
+}
+
+func (p *mqlPolkit) GetActions() ([]interface{}, error) {
+	osProvider, err := osProvider(p.MotorRuntime.Motor)
+	if err != nil {
+		return nil, err
+	}
+
+	actions, err := polkit.ListActions(osProvider.FS())
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(actions))
+	for i := range actions {
+		a := actions[i]
+		mqlAction, err := p.MotorRuntime.CreateResource("polkit.action",
+			"source", a.Source,
+			"id", a.ID,
+			"description", a.Description,
+			"message", a.Message,
+			"vendor", a.Vendor,
+			"allowAny", a.AllowAny,
+			"allowInactive", a.AllowInactive,
+			"allowActive", a.AllowActive,
+			"annotations", core.StrMapToInterface(a.Annotations),
+		)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = mqlAction
+	}
+	return res, nil
+}
+
+func (p *mqlPolkit) GetLocalAuthorizations() ([]interface{}, error) {
+	osProvider, err := osProvider(p.MotorRuntime.Motor)
+	if err != nil {
+		return nil, err
+	}
+
+	authorizations, err := polkit.ListLocalAuthorizations(osProvider.FS())
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(authorizations))
+	for i := range authorizations {
+		a := authorizations[i]
+		mqlAuthorization, err := p.MotorRuntime.CreateResource("polkit.localAuthorization",
+			"source", a.Source,
+			"name", a.Name,
+			"identities", core.StrSliceToInterface(a.Identities),
+			"actions", core.StrSliceToInterface(a.Actions),
+			"resultAny", a.ResultAny,
+			"resultInactive", a.ResultInactive,
+			"resultActive", a.ResultActive,
+		)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = mqlAuthorization
+	}
+	return res, nil
+}
+
+func (p *mqlPolkitRule) id() (string, error) {
+	return p.Path()
+}
+
+func (p *mqlPolkitRule) GetFile() (core.File, error) {
+	path, err := p.Path()
+	if err != nil {
+		return nil, err
+	}
+	f, err := p.MotorRuntime.CreateResource("file", "path", path)
+	if err != nil {
+		return nil, err
+	}
+	return f.(core.File), nil
+}
+
+func (p *mqlPolkitAction) id() (string, error) {
+	return p.Id()
+}
+
+func (p *mqlPolkitLocalAuthorization) id() (string, error) {
+	source, err := p.Source()
+	if err != nil {
+		return "", err
+	}
+	name, err := p.Name()
+	if err != nil {
+		return "", err
+	}
+	return source + "/" + name, nil
+}
@@ -0,0 +1,331 @@
+package polkit
+
+import (
+	"bufio"
+	"encoding/xml"
+	"io"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+var (
+	// RulesDirs are the directories of JavaScript rules, rules in /etc
+	// replace rules with the same name in /usr/share
+	RulesDirs = []string{"/etc/polkit-1/rules.d", "/usr/share/polkit-1/rules.d"}
+	// ActionsDir holds the action definitions of applications
+	ActionsDir = "/usr/share/polkit-1/actions"
+	// LocalAuthorityDirs are the directories of pkla files used by polkit
+	// before version 0.106
+	LocalAuthorityDirs = []string{"/etc/polkit-1/localauthority", "/var/lib/polkit-1/localauthority"}
+)
+
+// Rule is a JavaScript rules file
+type Rule struct {
+	Name string
+	Path string
+	// Actions are the action ids the rule compares against
+	Actions []string
+}
+
+// Action is an action that applications register, e.g. org.freedesktop.login1.reboot
+type Action struct {
+	Source      string
+	ID          string
+	Description string
+	Message     string
+	Vendor      string
+	// AllowAny, AllowInactive and AllowActive are the implicit authorizations
+	// for clients in any, inactive and active sessions, e.g. no, yes,
+	// auth_self or auth_admin_keep
+	AllowAny      string
+	AllowInactive string
+	AllowActive   string
+	Annotations   map[string]string
+}
+
+// LocalAuthorization is a section of a pkla file
+type LocalAuthorization struct {
+	Source string
+	Name   string
+	// Identities are users and groups, e.g. unix-user:alice or unix-group:admin
+	Identities     []string
+	Actions        []string
+	ResultAny      string
+	ResultInactive string
+	ResultActive   string
+}
+
+var actionIDRegex = regexp.MustCompile(`action\.id\s*={2,3}\s*["']([^"']+)["']`)
+
+// ListRules returns the rules files in the order polkit evaluates them
+func ListRules(fs afero.Fs) ([]Rule, error) {
+	rules := map[string]string{}
+	for i := len(RulesDirs) - 1; i >= 0; i-- {
+		files, err := listFiles(fs, RulesDirs[i], ".rules")
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			rules[path.Base(file)] = file
+		}
+	}
+
+	names := make([]string, 0, len(rules))
+	for name := range rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	res := make([]Rule, len(names))
+	for i, name := range names {
+		data, err := afero.ReadFile(fs, rules[name])
+		if err != nil {
+			return nil, err
+		}
+		res[i] = Rule{Name: name, Path: rules[name], Actions: ParseRuleActions(string(data))}
+	}
+	return res, nil
+}
+
+// ParseRuleActions extracts the action ids that are compared in the rule,
+// e.g. action.id == "org.freedesktop.systemd1.manage-units"
+func ParseRuleActions(content string) []string {
+	res := []string{}
+	seen := map[string]struct{}{}
+	for _, m := range actionIDRegex.FindAllStringSubmatch(content, -1) {
+		if _, ok := seen[m[1]]; ok {
+			continue
+		}
+		seen[m[1]] = struct{}{}
+		res = append(res, m[1])
+	}
+	return res
+}
+
+type policyConfig struct {
+	Vendor  string         `xml:"vendor"`
+	Actions []policyAction `xml:"action"`
+}
+
+type policyAction struct {
+	ID           string             `xml:"id,attr"`
+	Descriptions []localizedText    `xml:"description"`
+	Messages     []localizedText    `xml:"message"`
+	Vendor       string             `xml:"vendor"`
+	Defaults     policyDefaults     `xml:"defaults"`
+	Annotations  []policyAnnotation `xml:"annotate"`
+}
+
+type localizedText struct {
+	Lang string `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+	Text string `xml:",chardata"`
+}
+
+type policyDefaults struct {
+	AllowAny      string `xml:"allow_any"`
+	AllowInactive string `xml:"allow_inactive"`
+	AllowActive   string `xml:"allow_active"`
+}
+
+type policyAnnotation struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// untranslated returns the text without language, translations are ignored
+func untranslated(texts []localizedText) string {
+	for i := range texts {
+		if texts[i].Lang == "" {
+			return strings.TrimSpace(texts[i].Text)
+		}
+	}
+	return ""
+}
+
+// ParseActions parses a .policy file
+func ParseActions(r io.Reader, source string) ([]Action, error) {
+	var cfg policyConfig
+	decoder := xml.NewDecoder(r)
+	decoder.Strict = false
+	if err := decoder.Decode(&cfg); err != nil {
+		return nil, err
+	}
+
+	res := make([]Action, len(cfg.Actions))
+	for i := range cfg.Actions {
+		a := cfg.Actions[i]
+		vendor := strings.TrimSpace(a.Vendor)
+		if vendor == "" {
+			vendor = strings.TrimSpace(cfg.Vendor)
+		}
+		annotations := map[string]string{}
+		for _, annotation := range a.Annotations {
+			annotations[annotation.Key] = strings.TrimSpace(annotation.Value)
+		}
+		res[i] = Action{
+			Source:        source,
+			ID:            a.ID,
+			Description:   untranslated(a.Descriptions),
+			Message:       untranslated(a.Messages),
+			Vendor:        vendor,
+			AllowAny:      strings.TrimSpace(a.Defaults.AllowAny),
+			AllowInactive: strings.TrimSpace(a.Defaults.AllowInactive),
+			AllowActive:   strings.TrimSpace(a.Defaults.AllowActive),
+			Annotations:   annotations,
+		}
+	}
+	return res, nil
+}
+
+// ListActions returns the actions of all .policy files
+func ListActions(fs afero.Fs) ([]Action, error) {
+	files, err := listFiles(fs, ActionsDir, ".policy")
+	if err != nil {
+		return nil, err
+	}
+
+	res := []Action{}
+	for _, file := range files {
+		f, err := fs.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		actions, err := ParseActions(f, file)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, actions...)
+	}
+	return res, nil
+}
+
+// ParseLocalAuthorizations parses a pkla file
+func ParseLocalAuthorizations(r io.Reader, source string) ([]LocalAuthorization, error) {
+	res := []LocalAuthorization{}
+	var current *LocalAuthorization
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			res = append(res, LocalAuthorization{
+				Source:     source,
+				Name:       line[1 : len(line)-1],
+				Identities: []string{},
+				Actions:    []string{},
+			})
+			current = &res[len(res)-1]
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok || current == nil {
+			continue
+		}
+		switch strings.TrimSpace(key) {
+		case "Identity":
+			current.Identities = splitList(value)
+		case "Action":
+			current.Actions = splitList(value)
+		case "ResultAny":
+			current.ResultAny = strings.TrimSpace(value)
+		case "ResultInactive":
+			current.ResultInactive = strings.TrimSpace(value)
+		case "ResultActive":
+			current.ResultActive = strings.TrimSpace(value)
+		}
+	}
+	return res, scanner.Err()
+}
+
+// ListLocalAuthorizations returns the authorizations of all pkla files, they
+// are read in the order of their directory names and file names, later
+// authorizations override earlier ones
+func ListLocalAuthorizations(fs afero.Fs) ([]LocalAuthorization, error) {
+	type pkla struct {
+		dir  string
+		file string
+	}
+	files := []pkla{}
+	for _, root := range LocalAuthorityDirs {
+		entries, err := afero.ReadDir(fs, root)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+			dirFiles, err := listFiles(fs, path.Join(root, entry.Name()), ".pkla")
+			if err != nil {
+				return nil, err
+			}
+			for _, file := range dirFiles {
+				files = append(files, pkla{dir: entry.Name(), file: file})
+			}
+		}
+	}
+	sort.SliceStable(files, func(i, j int) bool {
+		if files[i].dir != files[j].dir {
+			return files[i].dir < files[j].dir
+		}
+		return path.Base(files[i].file) < path.Base(files[j].file)
+	})
+
+	res := []LocalAuthorization{}
+	for _, file := range files {
+		f, err := fs.Open(file.file)
+		if err != nil {
+			return nil, err
+		}
+		authorizations, err := ParseLocalAuthorizations(f, file.file)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, authorizations...)
+	}
+	return res, nil
+}
+
+func splitList(value string) []string {
+	res := []string{}
+	for _, item := range strings.Split(value, ";") {
+		if item = strings.TrimSpace(item); item != "" {
+			res = append(res, item)
+		}
+	}
+	return res
+}
+
+// listFiles returns the sorted files of a directory with the extension, a
+// missing directory has no files
+func listFiles(fs afero.Fs, dir string, ext string) ([]string, error) {
+	entries, err := afero.ReadDir(fs, dir)
+	if os.IsNotExist(err) {
+		return []string{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	res := []string{}
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ext) {
+			res = append(res, path.Join(dir, entry.Name()))
+		}
+	}
+	sort.Strings(res)
+	return res, nil
+}
@@ -0,0 +1,86 @@
+package polkit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/motor/providers/fs"
+)
+
+func TestListRules(t *testing.T) {
+	rules, err := ListRules(fs.NewMountedFs("testdata/debian"))
+	require.NoError(t, err)
+
+	assert.Equal(t, []Rule{
+		{
+			Name:    "10-systemd.rules",
+			Path:    "/etc/polkit-1/rules.d/10-systemd.rules",
+			Actions: []string{"org.freedesktop.systemd1.manage-units"},
+		},
+		{
+			Name:    "50-default.rules",
+			Path:    "/usr/share/polkit-1/rules.d/50-default.rules",
+			Actions: []string{},
+		},
+		{
+			Name: "60-network.rules",
+			Path: "/etc/polkit-1/rules.d/60-network.rules",
+			Actions: []string{
+				"org.freedesktop.NetworkManager.settings.modify.system",
+				"org.freedesktop.NetworkManager.network-control",
+			},
+		},
+	}, rules)
+}
+
+func TestListActions(t *testing.T) {
+	actions, err := ListActions(fs.NewMountedFs("testdata/debian"))
+	require.NoError(t, err)
+	require.Len(t, actions, 3)
+
+	assert.Equal(t, Action{
+		Source:        "/usr/share/polkit-1/actions/org.freedesktop.login1.policy",
+		ID:            "org.freedesktop.login1.reboot",
+		Description:   "Reboot the system",
+		Message:       "Authentication is required to reboot the system.",
+		Vendor:        "The systemd Project",
+		AllowAny:      "auth_admin_keep",
+		AllowInactive: "auth_admin_keep",
+		AllowActive:   "yes",
+		Annotations: map[string]string{
+			"org.freedesktop.policykit.imply": "org.freedesktop.login1.set-wall-message",
+		},
+	}, actions[0])
+	assert.Equal(t, "org.freedesktop.policykit.exec", actions[2].ID)
+	assert.Equal(t, "Debian", actions[2].Vendor)
+	assert.Equal(t, "auth_admin", actions[2].AllowActive)
+
+	_, err = ParseActions(strings.NewReader("<policyconfig><action"), "broken.policy")
+	assert.Error(t, err)
+}
+
+func TestListLocalAuthorizations(t *testing.T) {
+	authorizations, err := ListLocalAuthorizations(fs.NewMountedFs("testdata/debian"))
+	require.NoError(t, err)
+
+	assert.Equal(t, []LocalAuthorization{
+		{
+			Source:         "/var/lib/polkit-1/localauthority/10-vendor.d/org.freedesktop.NetworkManager.pkla",
+			Name:           "nm-applet",
+			Identities:     []string{"unix-group:netdev", "unix-group:sudo"},
+			Actions:        []string{"org.freedesktop.NetworkManager.*"},
+			ResultAny:      "no",
+			ResultInactive: "no",
+			ResultActive:   "yes",
+		},
+		{
+			Source:       "/etc/polkit-1/localauthority/50-local.d/disable-suspend.pkla",
+			Name:         "Disable suspend",
+			Identities:   []string{"unix-user:*"},
+			Actions:      []string{"org.freedesktop.login1.suspend", "org.freedesktop.login1.suspend-multiple-sessions"},
+			ResultActive: "no",
+		},
+	}, authorizations)
+}
@@ -0,0 +1,28 @@
+package os_test
+
+import (
+	"testing"
+
+	"go.mondoo.com/cnquery/resources/packs/testutils"
+)
+
+func TestResource_Polkit(t *testing.T) {
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			"polkit.rules[0].name",
+			0, "50-default.rules",
+		},
+		{
+			"polkit.actions[0].id",
+			0, "org.freedesktop.policykit.exec",
+		},
+		{
+			"polkit.actions[0].allowActive",
+			0, "auth_admin",
+		},
+		{
+			"polkit.localAuthorizations.length",
+			0, int64(0),
+		},
+	})
+}
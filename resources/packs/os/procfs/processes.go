@@ -25,8 +25,17 @@ type LinuxProcessStatus struct {
 	PPid       int64  `json:"ppid"`       // process id of the parent process
 	Executable string `json:"executable"` // filename of the executable
 	State      string `json:"state"`
-	Tgid       int64  `json:"tgid"` // thread group ID
-	Ngid       int64  `json:"ngid"` // NUMA group ID (0 if none)
+	Tgid       int64  `json:"tgid"`       // thread group ID
+	Ngid       int64  `json:"ngid"`       // NUMA group ID (0 if none)
+	Seccomp    string `json:"seccomp"`    // seccomp mode: disabled, strict or filter
+	NoNewPrivs bool   `json:"noNewPrivs"` // no_new_privs bit, prevents privilege gains via execve
+}
+
+// seccomp modes as reported in /proc/<pid>/status
+var seccompModes = map[string]string{
+	"0": "disabled",
+	"1": "strict",
+	"2": "filter",
 }
 
 var LINUX_PROCES_STATUS_REGEX = regexp.MustCompile(`^(.*):\s*(.*)$`)
@@ -58,7 +67,7 @@ func ParseProcessStatus(input io.Reader) (*LinuxProcessStatus, error) {
 				continue
 			}
 		case "PPid":
-			if lps.Pid, err = strconv.ParseInt(value, 10, 64); err != nil {
+			if lps.PPid, err = strconv.ParseInt(value, 10, 64); err != nil {
 				log.Warn().Err(err).Str("key", key).Msg("process> could not parse value")
 				continue
 			}
@@ -72,6 +81,15 @@ func ParseProcessStatus(input io.Reader) (*LinuxProcessStatus, error) {
 				log.Warn().Err(err).Str("key", key).Msg("process> could not parse value")
 				continue
 			}
+		case "Seccomp":
+			mode, ok := seccompModes[value]
+			if !ok {
+				log.Warn().Str("key", key).Str("value", value).Msg("process> unknown seccomp mode")
+				continue
+			}
+			lps.Seccomp = mode
+		case "NoNewPrivs":
+			lps.NoNewPrivs = value == "1"
 		case "Uid": // Real, effective, saved set, and  file system UIDs
 
 		case "Gid": // Real, effective, saved set, and  file system GIDs
@@ -79,7 +97,7 @@ func ParseProcessStatus(input io.Reader) (*LinuxProcessStatus, error) {
 		case "Umask", "TracerPid", "FDSize", "Groups", "VmPeak", "VmSize", "VmLck", "VmPin",
 			"VmHWM", "VmRSS", "RssAnon", "RssFile", "RssShmem", "VmData", "VmStk", "VmExe", "VmLib",
 			"VmPTE", "VmSwap", "Threads", "SigQ", "SigPnd", "ShdPnd", "SigBlk", "SigIgn", "SigCgt",
-			"CapInh", "CapPrm", "CapEff", "CapBnd", "CapAmb", "Seccomp", "Cpus_allowed", "Cpus_allowed_list",
+			"CapInh", "CapPrm", "CapEff", "CapBnd", "CapAmb", "Cpus_allowed", "Cpus_allowed_list",
 			"Mems_allowed", "Mems_allowed_list", "voluntary_ctxt_switches", "nonvoluntary_ctxt_switches":
 			// known, nothing to do yet
 		default:
@@ -21,6 +21,10 @@ func TestParseProcessStatus(t *testing.T) {
 
 	assert.NotNil(t, processStatus, "process is not nil")
 	assert.Equal(t, "bash", processStatus.Executable, "detected process name")
+	assert.Equal(t, int64(1), processStatus.Pid)
+	assert.Equal(t, int64(0), processStatus.PPid)
+	assert.Equal(t, "filter", processStatus.Seccomp)
+	assert.True(t, processStatus.NoNewPrivs)
 }
 
 func TestParseProcessCmdline(t *testing.T) {
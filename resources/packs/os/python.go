@@ -0,0 +1,96 @@
+package os
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/resources"
+	"go.mondoo.com/cnquery/resources/packs/os/python"
+	"go.mondoo.com/cnquery/upstream/mvd"
+)
+
+var pythonPackages = languagePackages{
+	resource: "python.package",
+	purlType: "pypi",
+	manifests: []packageManifest{
+		// installed packages in site-packages or dist-packages (debian)
+		{regex: `.*-packages/[^/]*\.dist-info/METADATA$`, parse: parsePythonMetadata},
+		{regex: `.*-packages/[^/]*\.egg-info/PKG-INFO$`, parse: parsePythonMetadata},
+		{regex: `.*-packages/[^/]*\.egg-info$`, parse: parsePythonMetadata},
+		{regex: `.*/requirements\.txt$`, parse: parseRequirements},
+		{regex: `.*/poetry\.lock$`, parse: parsePoetryLock},
+	},
+}
+
+func parsePythonMetadata(path string, f afero.File) ([]*mvd.Package, error) {
+	pkg, err := python.ParseMetadata(f)
+	if err != nil || pkg == nil {
+		return nil, err
+	}
+	return []*mvd.Package{normalizePythonPackage(pkg)}, nil
+}
+
+func parseRequirements(path string, f afero.File) ([]*mvd.Package, error) {
+	pkgs, err := python.ParseRequirements(f)
+	for i := range pkgs {
+		normalizePythonPackage(pkgs[i])
+	}
+	return pkgs, err
+}
+
+func parsePoetryLock(path string, f afero.File) ([]*mvd.Package, error) {
+	pkgs, err := python.ParsePoetryLock(f)
+	for i := range pkgs {
+		normalizePythonPackage(pkgs[i])
+	}
+	return pkgs, err
+}
+
+// normalizePythonPackage normalizes the name as pip does, so that the same
+// package is reported identically by all files, see PEP 503
+func normalizePythonPackage(pkg *mvd.Package) *mvd.Package {
+	pkg.Name = strings.ToLower(pythonNameSeparators.Replace(pkg.Name))
+	return pkg
+}
+
+var pythonNameSeparators = strings.NewReplacer("_", "-", ".", "-")
+
+func (s *mqlPythonPackages) init(args *resources.Args) (*resources.Args, PythonPackages, error) {
+	if err := initLanguagePackages(args); err != nil {
+		return nil, nil, errors.New("cannot initialize python.packages: " + err.Error())
+	}
+	return args, nil, nil
+}
+
+func (s *mqlPythonPackages) id() (string, error) {
+	return s.Path()
+}
+
+func (s *mqlPythonPackages) GetFiles() ([]interface{}, error) {
+	path, err := s.Path()
+	if err != nil {
+		return nil, err
+	}
+	return pythonPackages.files(s.MotorRuntime, path)
+}
+
+func (s *mqlPythonPackages) GetList(files []interface{}) ([]interface{}, error) {
+	return pythonPackages.list(s.MotorRuntime, files)
+}
+
+func (s *mqlPythonPackage) id() (string, error) {
+	path, err := s.Path()
+	if err != nil {
+		return "", err
+	}
+	name, err := s.Name()
+	if err != nil {
+		return "", err
+	}
+	version, err := s.Version()
+	if err != nil {
+		return "", err
+	}
+	return languagePackageId(path, name, version), nil
+}
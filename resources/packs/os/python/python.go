@@ -0,0 +1,110 @@
+package python
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"go.mondoo.com/cnquery/upstream/mvd"
+)
+
+func newPackage(name string, version string) *mvd.Package {
+	return &mvd.Package{
+		Name:      name,
+		Version:   version,
+		Format:    "python",
+		Namespace: "python",
+	}
+}
+
+// ParseMetadata parses the METADATA file of a dist-info directory or the
+// PKG-INFO of an egg-info. Both use the same email header format.
+func ParseMetadata(r io.Reader) (*mvd.Package, error) {
+	var name, version string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		// the headers end with the first empty line, the description follows
+		if line == "" {
+			break
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "Name":
+			name = strings.TrimSpace(value)
+		case "Version":
+			version = strings.TrimSpace(value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if name == "" {
+		return nil, nil
+	}
+	return newPackage(name, version), nil
+}
+
+// requirement matches the name and an optional pinned version,
+// e.g. requests[security]==2.28.1 ; python_version > "3.6"
+var requirement = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9._-]*)\s*(\[[^\]]*\])?\s*(===?\s*([^\s;,]+))?`)
+
+// ParseRequirements parses a requirements.txt file. Only pinned versions
+// are reported, all other requirements have an empty version.
+func ParseRequirements(r io.Reader) ([]*mvd.Package, error) {
+	entries := []*mvd.Package{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if idx := strings.Index(line, "#"); idx != -1 {
+			line = line[:idx]
+		}
+		line = strings.TrimSpace(line)
+
+		// skip options like -r other.txt or -e git+https://...
+		if line == "" || strings.HasPrefix(line, "-") {
+			continue
+		}
+
+		m := requirement.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		entries = append(entries, newPackage(m[1], m[4]))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+type poetryLock struct {
+	Packages []struct {
+		Name    string `toml:"name"`
+		Version string `toml:"version"`
+	} `toml:"package"`
+}
+
+// ParsePoetryLock parses the packages of a poetry.lock file
+func ParsePoetryLock(r io.Reader) ([]*mvd.Package, error) {
+	var lock poetryLock
+	if _, err := toml.NewDecoder(r).Decode(&lock); err != nil {
+		return nil, err
+	}
+
+	entries := []*mvd.Package{}
+	for i := range lock.Packages {
+		entries = append(entries, newPackage(lock.Packages[i].Name, lock.Packages[i].Version))
+	}
+	return entries, nil
+}
@@ -0,0 +1,74 @@
+package python_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mondoo.com/cnquery/resources/packs/os/python"
+	"go.mondoo.com/cnquery/upstream/mvd"
+)
+
+func TestMetadataParser(t *testing.T) {
+	data, err := os.Open("./testdata/METADATA")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pkg, err := python.ParseMetadata(data)
+	assert.Nil(t, err)
+	assert.Equal(t, &mvd.Package{
+		Name:      "requests",
+		Version:   "2.28.1",
+		Format:    "python",
+		Namespace: "python",
+	}, pkg)
+}
+
+func TestRequirementsParser(t *testing.T) {
+	data, err := os.Open("./testdata/requirements.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pkgs, err := python.ParseRequirements(data)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(pkgs))
+
+	assert.Contains(t, pkgs, &mvd.Package{
+		Name:      "Django",
+		Version:   "4.1.2",
+		Format:    "python",
+		Namespace: "python",
+	})
+	assert.Contains(t, pkgs, &mvd.Package{
+		Name:      "requests",
+		Version:   "2.28.1",
+		Format:    "python",
+		Namespace: "python",
+	})
+	assert.Contains(t, pkgs, &mvd.Package{
+		Name:      "gunicorn",
+		Version:   "",
+		Format:    "python",
+		Namespace: "python",
+	})
+}
+
+func TestPoetryLockParser(t *testing.T) {
+	data, err := os.Open("./testdata/poetry.lock")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pkgs, err := python.ParsePoetryLock(data)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(pkgs))
+
+	assert.Contains(t, pkgs, &mvd.Package{
+		Name:      "urllib3",
+		Version:   "1.26.12",
+		Format:    "python",
+		Namespace: "python",
+	})
+}
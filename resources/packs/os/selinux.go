@@ -0,0 +1,239 @@
+package os
+
+import (
+	"errors"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/motor/providers"
+	os_provider "go.mondoo.com/cnquery/motor/providers/os"
+	"go.mondoo.com/cnquery/motor/providers/os/statutil"
+	"go.mondoo.com/cnquery/resources/packs/os/selinux"
+)
+
+func (s *mqlSelinux) id() (string, error) {
+	return "selinux", nil
+}
+
+func (s *mqlSelinux) GetEnabled() (bool, error) {
+	osProvider, err := osProvider(s.MotorRuntime.Motor)
+	if err != nil {
+		return false, err
+	}
+
+	afs := &afero.Afero{Fs: osProvider.FS()}
+	return afs.Exists(selinux.EnforcePath)
+}
+
+func (s *mqlSelinux) GetMode() (string, error) {
+	enabled, err := s.Enabled()
+	if err != nil {
+		return "", err
+	}
+	if !enabled {
+		return "disabled", nil
+	}
+
+	osProvider, err := osProvider(s.MotorRuntime.Motor)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := afero.ReadFile(osProvider.FS(), selinux.EnforcePath)
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(string(data)) == "1" {
+		return "enforcing", nil
+	}
+	return "permissive", nil
+}
+
+func (s *mqlSelinux) GetConfiguredMode() (string, error) {
+	cfg, err := s.config()
+	if err != nil {
+		return "", err
+	}
+	return cfg.Mode, nil
+}
+
+func (s *mqlSelinux) GetPolicyType() (string, error) {
+	cfg, err := s.config()
+	if err != nil {
+		return "", err
+	}
+	return cfg.PolicyType, nil
+}
+
+// config parses /etc/selinux/config, an empty config is returned if the
+// system has no selinux configuration
+func (s *mqlSelinux) config() (*selinux.Config, error) {
+	osProvider, err := osProvider(s.MotorRuntime.Motor)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := osProvider.FS().Open(selinux.ConfigPath)
+	if errors.Is(err, afero.ErrFileNotFound) {
+		return &selinux.Config{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return selinux.ParseConfig(f)
+}
+
+func (s *mqlSelinux) GetPolicyVersion() (int64, error) {
+	enabled, err := s.Enabled()
+	if err != nil || !enabled {
+		return 0, err
+	}
+
+	osProvider, err := osProvider(s.MotorRuntime.Motor)
+	if err != nil {
+		return 0, err
+	}
+
+	data, err := afero.ReadFile(osProvider.FS(), selinux.PolicyVersionPath)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+}
+
+func (s *mqlSelinux) GetBooleans() ([]interface{}, error) {
+	enabled, err := s.Enabled()
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return []interface{}{}, nil
+	}
+
+	osProvider, err := osProvider(s.MotorRuntime.Motor)
+	if err != nil {
+		return nil, err
+	}
+	fs := osProvider.FS()
+
+	dir, err := fs.Open(selinux.BooleansPath)
+	if err != nil {
+		return nil, err
+	}
+	names, err := dir.Readdirnames(-1)
+	dir.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	res := []interface{}{}
+	for i := range names {
+		data, err := afero.ReadFile(fs, path.Join(selinux.BooleansPath, names[i]))
+		if err != nil {
+			return nil, err
+		}
+		value, pending, err := selinux.ParseBoolean(string(data))
+		if err != nil {
+			return nil, err
+		}
+
+		mqlBoolean, err := s.MotorRuntime.CreateResource("selinux.boolean",
+			"name", names[i],
+			"value", value,
+			"pendingValue", pending,
+		)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, mqlBoolean)
+	}
+	return res, nil
+}
+
+func (s *mqlSelinuxBoolean) id() (string, error) {
+	return s.Name()
+}
+
+func (s *mqlSelinuxFileContext) id() (string, error) {
+	return s.Path()
+}
+
+func (s *mqlSelinuxFileContext) GetContext() (string, error) {
+	path, err := s.Path()
+	if err != nil {
+		return "", err
+	}
+
+	osProvider, err := osProvider(s.MotorRuntime.Motor)
+	if err != nil {
+		return "", err
+	}
+
+	// read the extended attribute if the provider has access to it, e.g. in tar
+	// files, otherwise we need to ask the system
+	if xattrs, ok := osProvider.(os_provider.FileXattrs); ok {
+		value, err := xattrs.Xattr(path, selinux.Xattr)
+		if errors.Is(err, os_provider.ErrXattrNotFound) {
+			return "", nil
+		} else if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(strings.TrimRight(string(value), "\x00")), nil
+	}
+
+	if !s.MotorRuntime.Motor.Provider.Capabilities().HasCapability(providers.Capability_RunCommand) {
+		return "", errors.New("selinux file contexts are not supported on this provider")
+	}
+
+	cmd, err := osProvider.RunCommand("stat -c %C " + statutil.ShellEscape(path))
+	if err != nil {
+		return "", err
+	}
+	data, err := io.ReadAll(cmd.Stdout)
+	if err != nil {
+		return "", err
+	}
+	if cmd.ExitStatus != 0 {
+		stderr, _ := io.ReadAll(cmd.Stderr)
+		return "", errors.New("could not read selinux context: " + strings.TrimSpace(string(stderr)))
+	}
+
+	// stat prints a question mark if the file has no context
+	context := strings.TrimSpace(string(data))
+	if context == "?" {
+		return "", nil
+	}
+	return context, nil
+}
+
+func (s *mqlSelinuxFileContext) GetUser(context string) (string, error) {
+	ctx, err := parseSelinuxContext(context)
+	return ctx.User, err
+}
+
+func (s *mqlSelinuxFileContext) GetRole(context string) (string, error) {
+	ctx, err := parseSelinuxContext(context)
+	return ctx.Role, err
+}
+
+func (s *mqlSelinuxFileContext) GetType(context string) (string, error) {
+	ctx, err := parseSelinuxContext(context)
+	return ctx.Type, err
+}
+
+func (s *mqlSelinuxFileContext) GetLevel(context string) (string, error) {
+	ctx, err := parseSelinuxContext(context)
+	return ctx.Level, err
+}
+
+// parseSelinuxContext returns an empty context for files without a context
+func parseSelinuxContext(context string) (selinux.Context, error) {
+	if context == "" {
+		return selinux.Context{}, nil
+	}
+	return selinux.ParseContext(context)
+}
@@ -0,0 +1,94 @@
+package selinux
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+)
+
+const (
+	// ConfigPath is the SELinux configuration that is applied on boot
+	ConfigPath = "/etc/selinux/config"
+	// EnforcePath exists if selinuxfs is mounted, it holds the current mode
+	EnforcePath = "/sys/fs/selinux/enforce"
+	// PolicyVersionPath holds the version of the loaded policy
+	PolicyVersionPath = "/sys/fs/selinux/policyvers"
+	// BooleansPath holds one file per policy boolean
+	BooleansPath = "/sys/fs/selinux/booleans"
+	// Xattr is the extended attribute with the security context of a file
+	Xattr = "security.selinux"
+)
+
+type Config struct {
+	// Mode is enforcing, permissive or disabled
+	Mode string
+	// PolicyType is the name of the policy, e.g. targeted or mls
+	PolicyType string
+}
+
+// ParseConfig parses /etc/selinux/config, it is a shell-like file with
+// SELINUX and SELINUXTYPE assignments
+func ParseConfig(r io.Reader) (*Config, error) {
+	cfg := &Config{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+		switch strings.TrimSpace(key) {
+		case "SELINUX":
+			cfg.Mode = strings.ToLower(value)
+		case "SELINUXTYPE":
+			cfg.PolicyType = value
+		}
+	}
+	return cfg, scanner.Err()
+}
+
+// Context is a security context, e.g. system_u:object_r:shadow_t:s0
+type Context struct {
+	User  string
+	Role  string
+	Type  string
+	Level string
+}
+
+// ParseContext splits the security context into its parts, the level is
+// optional and may contain colons itself, e.g. s0-s0:c0.c1023
+func ParseContext(s string) (Context, error) {
+	// the xattr value is null-terminated
+	s = strings.TrimSpace(strings.TrimRight(s, "\x00"))
+
+	parts := strings.SplitN(s, ":", 4)
+	if len(parts) < 3 {
+		return Context{}, errors.New("invalid selinux context: " + s)
+	}
+
+	ctx := Context{
+		User: parts[0],
+		Role: parts[1],
+		Type: parts[2],
+	}
+	if len(parts) == 4 {
+		ctx.Level = parts[3]
+	}
+	return ctx, nil
+}
+
+// ParseBoolean parses a boolean file of selinuxfs, it contains the current and
+// the pending value, e.g. "1 0"
+func ParseBoolean(s string) (bool, bool, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return false, false, errors.New("invalid selinux boolean: " + s)
+	}
+	return fields[0] == "1", fields[1] == "1", nil
+}
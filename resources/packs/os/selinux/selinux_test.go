@@ -0,0 +1,58 @@
+package selinux_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/resources/packs/os/selinux"
+)
+
+func TestParseConfig(t *testing.T) {
+	config := `# This file controls the state of SELinux on the system.
+# SELINUX= can take one of these three values:
+#     enforcing - SELinux security policy is enforced.
+#     permissive - SELinux prints warnings instead of enforcing.
+#     disabled - No SELinux policy is loaded.
+SELINUX=Enforcing
+# SELINUXTYPE= can take one of these three values:
+#     targeted - Targeted processes are protected,
+#     minimum - Modification of targeted policy. Only selected processes are protected.
+#     mls - Multi Level Security protection.
+SELINUXTYPE="targeted"
+`
+	cfg, err := selinux.ParseConfig(strings.NewReader(config))
+	require.NoError(t, err)
+	assert.Equal(t, "enforcing", cfg.Mode)
+	assert.Equal(t, "targeted", cfg.PolicyType)
+}
+
+func TestParseContext(t *testing.T) {
+	ctx, err := selinux.ParseContext("system_u:object_r:shadow_t:s0\x00")
+	require.NoError(t, err)
+	assert.Equal(t, selinux.Context{User: "system_u", Role: "object_r", Type: "shadow_t", Level: "s0"}, ctx)
+
+	ctx, err = selinux.ParseContext("unconfined_u:unconfined_r:unconfined_t:s0-s0:c0.c1023\n")
+	require.NoError(t, err)
+	assert.Equal(t, "unconfined_t", ctx.Type)
+	assert.Equal(t, "s0-s0:c0.c1023", ctx.Level)
+
+	// the level is only available with mls support
+	ctx, err = selinux.ParseContext("system_u:object_r:etc_t")
+	require.NoError(t, err)
+	assert.Equal(t, "", ctx.Level)
+
+	_, err = selinux.ParseContext("?")
+	assert.EqualError(t, err, "invalid selinux context: ?")
+}
+
+func TestParseBoolean(t *testing.T) {
+	value, pending, err := selinux.ParseBoolean("1 0")
+	require.NoError(t, err)
+	assert.True(t, value)
+	assert.False(t, pending)
+
+	_, _, err = selinux.ParseBoolean("1")
+	assert.Error(t, err)
+}
@@ -0,0 +1,52 @@
+package os_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mondoo.com/cnquery/resources/packs/testutils"
+)
+
+func TestResource_Selinux(t *testing.T) {
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			"selinux.enabled",
+			0, true,
+		},
+		{
+			"selinux.mode",
+			0, "enforcing",
+		},
+		{
+			"selinux.configuredMode",
+			0, "enforcing",
+		},
+		{
+			"selinux.policyType",
+			0, "targeted",
+		},
+		{
+			"selinux.policyVersion",
+			0, int64(33),
+		},
+		{
+			"selinux.booleans.where(name == 'httpd_can_network_connect')[0].pendingValue",
+			0, true,
+		},
+		{
+			"selinux.fileContext('/etc/shadow').type",
+			0, "shadow_t",
+		},
+		{
+			"selinux.fileContext('/etc/shadow').level",
+			0, "s0",
+		},
+	})
+
+	t.Run("selinux booleans", func(t *testing.T) {
+		res := x.TestQuery(t, "selinux.booleans.length")
+		assert.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, int64(2), res[0].Data.Value)
+	})
+}
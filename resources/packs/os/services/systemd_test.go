@@ -128,3 +128,36 @@ func TestSystemdFS(t *testing.T) {
 		Masked:      false,
 	}, servicesMap["sshd"])
 }
+
+func TestSystemdTimers(t *testing.T) {
+	timers, err := ListSystemdTimers(fs.NewMountedFs("testdata/systemd"))
+	require.NoError(t, err)
+	require.Len(t, timers, 4)
+
+	assert.Equal(t, "apt-daily.timer", timers[0].Name)
+	assert.True(t, timers[0].Masked)
+	assert.False(t, timers[0].Enabled)
+
+	// drop-ins reset and override settings
+	assert.Equal(t, "fstrim.timer", timers[1].Name)
+	assert.Equal(t, []string{"Sun *-*-* 03:00:00"}, timers[1].OnCalendar)
+	assert.False(t, timers[1].Persistent)
+	assert.False(t, timers[1].Enabled)
+
+	assert.Equal(t, &Timer{
+		Name:        "logrotate.timer",
+		Path:        "/usr/lib/systemd/system/logrotate.timer",
+		Description: "Daily rotation of log files",
+		Unit:        "logrotate.service",
+		OnCalendar:  []string{"daily"},
+		Monotonic:   map[string]string{},
+		Persistent:  true,
+		Enabled:     true,
+	}, timers[2])
+
+	assert.Equal(t, "update-helper.timer", timers[3].Name)
+	assert.Equal(t, "/etc/systemd/system/update-helper.timer", timers[3].Path)
+	assert.Equal(t, "update-helper.service", timers[3].Unit)
+	assert.Equal(t, map[string]string{"OnBootSec": "5min", "OnUnitActiveSec": "1h"}, timers[3].Monotonic)
+	assert.True(t, timers[3].Enabled)
+}
@@ -0,0 +1,226 @@
+package services
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/coreos/go-systemd/unit"
+	"github.com/spf13/afero"
+)
+
+// Timer is a systemd timer unit, it activates another unit on calendar or
+// monotonic events
+type Timer struct {
+	// Name is the unit name including the type, e.g. logrotate.timer
+	Name        string
+	Path        string
+	Description string
+	// Unit is the activated unit, by default the service with the same name
+	Unit string
+	// OnCalendar holds the calendar events, e.g. daily or Mon *-*-* 00:00:00
+	OnCalendar []string
+	// Monotonic holds the timers relative to events, e.g. OnBootSec=5min
+	Monotonic  map[string]string
+	Persistent bool
+	// Enabled is set if the timer is linked in a .wants or .requires directory
+	Enabled bool
+	Masked  bool
+}
+
+var timerMonotonicKeys = map[string]struct{}{
+	"OnActiveSec":       {},
+	"OnBootSec":         {},
+	"OnStartupSec":      {},
+	"OnUnitActiveSec":   {},
+	"OnUnitInactiveSec": {},
+}
+
+// ListSystemdTimers reads all timer units of the unit search path. Drop-in
+// files in name.timer.d directories are applied to the timers.
+func ListSystemdTimers(fsys afero.Fs) ([]*Timer, error) {
+	timers := map[string]*Timer{}
+	enabled := map[string]bool{}
+
+	for _, searchPath := range systemdUnitSearchPath {
+		entries, err := afero.ReadDir(fsys, searchPath)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			name := entry.Name()
+			if entry.IsDir() {
+				if strings.HasSuffix(name, ".wants") || strings.HasSuffix(name, ".requires") {
+					if err := findLinkedTimers(fsys, path.Join(searchPath, name), enabled); err != nil {
+						return nil, err
+					}
+				}
+				continue
+			}
+
+			// units of earlier search paths take precedence
+			if !strings.HasSuffix(name, ".timer") || timers[name] != nil {
+				continue
+			}
+			timer, err := readTimer(fsys, path.Join(searchPath, name))
+			if err != nil {
+				return nil, err
+			}
+			timers[name] = timer
+		}
+	}
+
+	res := make([]*Timer, 0, len(timers))
+	for name, timer := range timers {
+		timer.Enabled = enabled[name] && !timer.Masked
+		res = append(res, timer)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+	return res, nil
+}
+
+// findLinkedTimers collects the timers that are linked in a .wants or .requires directory
+func findLinkedTimers(fsys afero.Fs, dir string, enabled map[string]bool) error {
+	entries, err := afero.ReadDir(fsys, dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if strings.HasSuffix(entry.Name(), ".timer") {
+			enabled[entry.Name()] = true
+		}
+	}
+	return nil
+}
+
+func readTimer(fsys afero.Fs, unitPath string) (*Timer, error) {
+	name := path.Base(unitPath)
+	timer := &Timer{
+		Name:       name,
+		Path:       unitPath,
+		Unit:       strings.TrimSuffix(name, ".timer") + ".service",
+		OnCalendar: []string{},
+		Monotonic:  map[string]string{},
+	}
+
+	// timers linked to /dev/null are masked
+	if lstater, ok := fsys.(afero.Lstater); ok {
+		fi, _, err := lstater.LstatIfPossible(unitPath)
+		if err != nil {
+			return nil, err
+		}
+		if fi.Mode()&fs.ModeSymlink != 0 {
+			if lr, ok := fsys.(afero.LinkReader); ok {
+				linkPath, err := lr.ReadlinkIfPossible(unitPath)
+				if err != nil {
+					return nil, err
+				}
+				if linkPath == "/dev/null" {
+					timer.Masked = true
+					return timer, nil
+				}
+				if !path.IsAbs(linkPath) {
+					linkPath = path.Join(path.Dir(unitPath), linkPath)
+				}
+				unitPath = linkPath
+			}
+		}
+	}
+
+	files := append([]string{unitPath}, timerDropIns(fsys, name)...)
+	for _, file := range files {
+		if err := applyTimerFile(fsys, file, timer); err != nil {
+			return nil, err
+		}
+	}
+	return timer, nil
+}
+
+// timerDropIns returns the drop-in configuration files of the timer, they are
+// applied in the order of their file name
+func timerDropIns(fsys afero.Fs, name string) []string {
+	dropIns := map[string]string{}
+	for i := len(systemdUnitSearchPath) - 1; i >= 0; i-- {
+		dir := path.Join(systemdUnitSearchPath[i], name+".d")
+		entries, err := afero.ReadDir(fsys, dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			// files of earlier search paths override files with the same name
+			if strings.HasSuffix(entry.Name(), ".conf") {
+				dropIns[entry.Name()] = path.Join(dir, entry.Name())
+			}
+		}
+	}
+
+	names := make([]string, 0, len(dropIns))
+	for k := range dropIns {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	files := make([]string, len(names))
+	for i := range names {
+		files[i] = dropIns[names[i]]
+	}
+	return files
+}
+
+func applyTimerFile(fsys afero.Fs, file string, timer *Timer) error {
+	f, err := fsys.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	opts, err := unit.Deserialize(f)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range opts {
+		switch {
+		case o.Section == "Unit" && o.Name == "Description":
+			timer.Description = o.Value
+		case o.Section != "Timer":
+			continue
+		case o.Name == "Unit":
+			timer.Unit = o.Value
+		case o.Name == "Persistent":
+			timer.Persistent = parseSystemdBool(o.Value)
+		case o.Name == "OnCalendar":
+			// an empty assignment resets the list
+			if o.Value == "" {
+				timer.OnCalendar = []string{}
+				continue
+			}
+			timer.OnCalendar = append(timer.OnCalendar, o.Value)
+		default:
+			if _, ok := timerMonotonicKeys[o.Name]; !ok {
+				continue
+			}
+			if o.Value == "" {
+				delete(timer.Monotonic, o.Name)
+				continue
+			}
+			timer.Monotonic[o.Name] = o.Value
+		}
+	}
+	return nil
+}
+
+func parseSystemdBool(value string) bool {
+	switch strings.ToLower(value) {
+	case "1", "yes", "y", "true", "t", "on":
+		return true
+	}
+	return false
+}
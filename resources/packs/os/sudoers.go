@@ -0,0 +1,253 @@
+package os
+
+import (
+	"errors"
+	"os"
+	"strconv"
+
+	"go.mondoo.com/cnquery/checksums"
+	"go.mondoo.com/cnquery/resources"
+	"go.mondoo.com/cnquery/resources/packs/core"
+	"go.mondoo.com/cnquery/resources/packs/os/sudoers"
+)
+
+const (
+	defaultSudoersConfig = "/etc/sudoers"
+	// sudo from ports and packages on BSD systems
+	localSudoersConfig = "/usr/local/etc/sudoers"
+)
+
+func (s *mqlSudoers) init(args *resources.Args) (*resources.Args, Sudoers, error) {
+	if x, ok := (*args)["path"]; ok {
+		path, ok := x.(string)
+		if !ok {
+			return nil, nil, errors.New("Wrong type for 'path' in sudoers initialization, it must be a string")
+		}
+
+		f, err := s.MotorRuntime.CreateResource("file", "path", path)
+		if err != nil {
+			return nil, nil, err
+		}
+		(*args)["file"] = f
+		delete(*args, "path")
+	}
+
+	return args, nil, nil
+}
+
+func (s *mqlSudoers) id() (string, error) {
+	r, err := s.File()
+	if err != nil {
+		return "", err
+	}
+	return r.Path()
+}
+
+func (s *mqlSudoers) GetFile() (core.File, error) {
+	osProvider, err := osProvider(s.MotorRuntime.Motor)
+	if err != nil {
+		return nil, err
+	}
+
+	path := defaultSudoersConfig
+	if _, err := osProvider.FS().Stat(defaultSudoersConfig); os.IsNotExist(err) {
+		if _, err := osProvider.FS().Stat(localSudoersConfig); err == nil {
+			path = localSudoersConfig
+		}
+	}
+
+	f, err := s.MotorRuntime.CreateResource("file", "path", path)
+	if err != nil {
+		return nil, err
+	}
+	return f.(core.File), nil
+}
+
+// config loads the configuration with all included files, systems without
+// sudo have an empty configuration
+func (s *mqlSudoers) config(file core.File) (*sudoers.Config, error) {
+	if c, ok := s.Cache.Load("_config"); ok {
+		return c.Data.(*sudoers.Config), nil
+	}
+
+	path, err := file.Path()
+	if err != nil {
+		return nil, err
+	}
+	osProvider, err := osProvider(s.MotorRuntime.Motor)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := sudoers.Load(osProvider.FS(), path)
+	if os.IsNotExist(err) {
+		cfg = &sudoers.Config{
+			Files:    []string{},
+			Defaults: []sudoers.Default{},
+			Aliases:  []sudoers.Alias{},
+			Rules:    []sudoers.Rule{},
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	s.Cache.Store("_config", &resources.CacheEntry{Data: cfg})
+	return cfg, nil
+}
+
+func (s *mqlSudoers) GetFiles(file core.File) ([]interface{}, error) {
+	cfg, err := s.config(file)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(cfg.Files))
+	for i := range cfg.Files {
+		f, err := s.MotorRuntime.CreateResource("file", "path", cfg.Files[i])
+		if err != nil {
+			return nil, err
+		}
+		res[i] = f.(core.File)
+	}
+	return res, nil
+}
+
+func (s *mqlSudoers) GetDefaults(file core.File) ([]interface{}, error) {
+	cfg, err := s.config(file)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(cfg.Defaults))
+	for i := range cfg.Defaults {
+		d := cfg.Defaults[i]
+		mqlDefault, err := s.MotorRuntime.CreateResource("sudoers.default",
+			"source", d.Source,
+			"line", int64(d.Line),
+			"scope", d.Scope,
+			"targets", core.StrSliceToInterface(d.Targets),
+			"name", d.Name,
+			"operator", d.Operator,
+			"value", d.Value,
+			"negated", d.Negated,
+		)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = mqlDefault
+	}
+	return res, nil
+}
+
+func (s *mqlSudoers) GetAliases(file core.File) ([]interface{}, error) {
+	cfg, err := s.config(file)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(cfg.Aliases))
+	for i := range cfg.Aliases {
+		a := cfg.Aliases[i]
+		mqlAlias, err := s.MotorRuntime.CreateResource("sudoers.alias",
+			"source", a.Source,
+			"line", int64(a.Line),
+			"type", a.Type,
+			"name", a.Name,
+			"members", core.StrSliceToInterface(a.Members),
+		)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = mqlAlias
+	}
+	return res, nil
+}
+
+func (s *mqlSudoers) GetRules(file core.File) ([]interface{}, error) {
+	cfg, err := s.config(file)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(cfg.Rules))
+	for i := range cfg.Rules {
+		r := cfg.Rules[i]
+		mqlRule, err := s.MotorRuntime.CreateResource("sudoers.rule",
+			"source", r.Source,
+			"line", int64(r.Line),
+			"users", core.StrSliceToInterface(r.Users),
+			"hosts", core.StrSliceToInterface(r.Hosts),
+			"runAsUsers", core.StrSliceToInterface(r.RunAsUsers),
+			"runAsGroups", core.StrSliceToInterface(r.RunAsGroups),
+			"options", core.StrMapToInterface(r.Options),
+			"tags", core.StrSliceToInterface(r.Tags),
+			"commands", core.StrSliceToInterface(r.Commands),
+			"noPasswd", r.NoPasswd(),
+			"setenv", r.Setenv(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = mqlRule
+	}
+	return res, nil
+}
+
+func (s *mqlSudoersDefault) id() (string, error) {
+	source, err := s.Source()
+	if err != nil {
+		return "", err
+	}
+	line, err := s.Line()
+	if err != nil {
+		return "", err
+	}
+	name, err := s.Name()
+	if err != nil {
+		return "", err
+	}
+	return source + ":" + strconv.FormatInt(line, 10) + "/" + name, nil
+}
+
+func (s *mqlSudoersAlias) id() (string, error) {
+	source, err := s.Source()
+	if err != nil {
+		return "", err
+	}
+	line, err := s.Line()
+	if err != nil {
+		return "", err
+	}
+	name, err := s.Name()
+	if err != nil {
+		return "", err
+	}
+	return source + ":" + strconv.FormatInt(line, 10) + "/" + name, nil
+}
+
+// id of the rule, a user specification may be split into multiple rules
+// with different hosts, runas users, tags or commands
+func (s *mqlSudoersRule) id() (string, error) {
+	source, err := s.Source()
+	if err != nil {
+		return "", err
+	}
+	line, err := s.Line()
+	if err != nil {
+		return "", err
+	}
+
+	checksum := checksums.New
+	for _, field := range []func() ([]interface{}, error){s.Hosts, s.RunAsUsers, s.RunAsGroups, s.Tags, s.Commands} {
+		values, err := field()
+		if err != nil {
+			return "", err
+		}
+		for i := range values {
+			checksum = checksum.Add(values[i].(string))
+		}
+		checksum = checksum.Add("|")
+	}
+
+	return source + ":" + strconv.FormatInt(line, 10) + "/" + checksum.String(), nil
+}
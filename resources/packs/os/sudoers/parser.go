@@ -0,0 +1,417 @@
+package sudoers
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var aliasTypes = map[string]string{
+	"User_Alias":  AliasUser,
+	"Runas_Alias": AliasRunas,
+	"Host_Alias":  AliasHost,
+	"Cmnd_Alias":  AliasCommand,
+	"Cmd_Alias":   AliasCommand,
+}
+
+var defaultScopes = map[byte]string{
+	'@': ScopeHost,
+	':': ScopeUser,
+	'>': ScopeRunas,
+	'!': ScopeCommand,
+}
+
+// options that may precede a command, e.g. CWD=/tmp
+var commandOptions = map[string]struct{}{
+	"ROLE":             {},
+	"TYPE":             {},
+	"APPARMOR_PROFILE": {},
+	"CWD":              {},
+	"CHROOT":           {},
+	"NOTBEFORE":        {},
+	"NOTAFTER":         {},
+	"TIMEOUT":          {},
+	"PRIVS":            {},
+	"LIMITPRIVS":       {},
+}
+
+var commandTags = map[string]struct{}{
+	"NOPASSWD":     {},
+	"PASSWD":       {},
+	"NOEXEC":       {},
+	"EXEC":         {},
+	"NOSETENV":     {},
+	"SETENV":       {},
+	"NOFOLLOW":     {},
+	"FOLLOW":       {},
+	"NOLOG_INPUT":  {},
+	"LOG_INPUT":    {},
+	"NOLOG_OUTPUT": {},
+	"LOG_OUTPUT":   {},
+	"NOMAIL":       {},
+	"MAIL":         {},
+	"NOINTERCEPT":  {},
+	"INTERCEPT":    {},
+}
+
+// lexer reads the words of a sudoers line, special characters in words are
+// escaped with a backslash
+type lexer struct {
+	text string
+	pos  int
+}
+
+func (l *lexer) skipSpace() {
+	for l.pos < len(l.text) && (l.text[l.pos] == ' ' || l.text[l.pos] == '\t') {
+		l.pos++
+	}
+}
+
+func (l *lexer) done() bool {
+	l.skipSpace()
+	return l.pos >= len(l.text)
+}
+
+func (l *lexer) peek() byte {
+	l.skipSpace()
+	if l.pos >= len(l.text) {
+		return 0
+	}
+	return l.text[l.pos]
+}
+
+func (l *lexer) expect(c byte) error {
+	if l.peek() != c {
+		return errors.New("expected '" + string(c) + "'")
+	}
+	l.pos++
+	return nil
+}
+
+// word reads until whitespace or one of the stop characters
+func (l *lexer) word(stop string) string {
+	l.skipSpace()
+	var b strings.Builder
+	quoted := false
+	for l.pos < len(l.text) {
+		c := l.text[l.pos]
+		if c == '\\' && l.pos+1 < len(l.text) {
+			b.WriteByte(l.text[l.pos+1])
+			l.pos += 2
+			continue
+		}
+		if c == '"' {
+			quoted = !quoted
+			l.pos++
+			continue
+		}
+		if !quoted && (c == ' ' || c == '\t' || strings.IndexByte(stop, c) >= 0) {
+			// %:group refers to a non-unix group
+			if !(c == ':' && b.String() == "%") {
+				break
+			}
+		}
+		b.WriteByte(c)
+		l.pos++
+	}
+	return b.String()
+}
+
+// list reads comma separated words
+func (l *lexer) list(stop string) ([]string, error) {
+	res := []string{}
+	for {
+		w := l.word(stop + ",")
+		if w == "" {
+			return nil, errors.New("expected a name at position " + strconv.Itoa(l.pos+1))
+		}
+		res = append(res, w)
+		if l.peek() != ',' {
+			return res, nil
+		}
+		l.pos++
+	}
+}
+
+// command reads a command with its arguments until an unescaped , or :
+func (l *lexer) command() string {
+	l.skipSpace()
+	start := l.pos
+	// digests precede the command, e.g. sha256:b5d3...
+	if idx := strings.IndexByte(l.text[start:], ':'); idx > 0 && isDigest(l.text[start:start+idx]) {
+		l.pos += idx + 1
+	}
+
+	var b strings.Builder
+	b.WriteString(l.text[start:l.pos])
+	for l.pos < len(l.text) {
+		c := l.text[l.pos]
+		if c == '\\' && l.pos+1 < len(l.text) {
+			next := l.text[l.pos+1]
+			// only special characters are unescaped, sudo keeps the others
+			// for the argument matching
+			if strings.IndexByte(",:=\\", next) < 0 {
+				b.WriteByte(c)
+			}
+			b.WriteByte(next)
+			l.pos += 2
+			continue
+		}
+		if c == ',' || c == ':' {
+			break
+		}
+		b.WriteByte(c)
+		l.pos++
+	}
+	return strings.TrimSpace(b.String())
+}
+
+func isDigest(s string) bool {
+	switch s {
+	case "sha224", "sha256", "sha384", "sha512":
+		return true
+	}
+	return false
+}
+
+// parseDefaults parses Defaults lines, e.g. Defaults:%wheel !authenticate
+func parseDefaults(text string) ([]Default, error) {
+	l := &lexer{text: text, pos: len("Defaults")}
+
+	scope := ""
+	targets := []string{}
+	if l.pos < len(text) {
+		if s, ok := defaultScopes[text[l.pos]]; ok {
+			scope = s
+			l.pos++
+			var err error
+			if targets, err = l.list(""); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	res := []Default{}
+	for !l.done() {
+		d := Default{Scope: scope, Targets: targets}
+		for l.peek() == '!' {
+			d.Negated = !d.Negated
+			l.pos++
+		}
+		d.Name = l.word("=+-,")
+		if d.Name == "" {
+			return nil, errors.New("expected a setting at position " + strconv.Itoa(l.pos+1))
+		}
+
+		l.skipSpace()
+		switch {
+		case strings.HasPrefix(l.text[l.pos:], "+="), strings.HasPrefix(l.text[l.pos:], "-="):
+			d.Operator = l.text[l.pos : l.pos+2]
+			l.pos += 2
+		case l.peek() == '=':
+			d.Operator = "="
+			l.pos++
+		}
+		if d.Operator != "" {
+			d.Value = l.word(",")
+		}
+		res = append(res, d)
+
+		if l.done() {
+			break
+		}
+		if err := l.expect(','); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// parseAliases parses the definitions of an alias line, e.g.
+// ADMINS = alice, bob : OPS = carol
+func parseAliases(typ string, text string) ([]Alias, error) {
+	l := &lexer{text: text}
+	res := []Alias{}
+	for {
+		alias := Alias{Type: typ, Name: l.word("=:")}
+		if alias.Name == "" {
+			return nil, errors.New("expected an alias name")
+		}
+		if err := l.expect('='); err != nil {
+			return nil, err
+		}
+
+		if typ == AliasCommand {
+			for {
+				cmd := l.command()
+				if cmd == "" {
+					return nil, errors.New("expected a command")
+				}
+				alias.Members = append(alias.Members, cmd)
+				if l.peek() != ',' {
+					break
+				}
+				l.pos++
+			}
+		} else {
+			members, err := l.list(":")
+			if err != nil {
+				return nil, err
+			}
+			alias.Members = members
+		}
+		res = append(res, alias)
+
+		if l.done() {
+			return res, nil
+		}
+		if err := l.expect(':'); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// parseUserSpec parses a user specification, e.g.
+// %admin ALL = (ALL:ALL) NOPASSWD: /usr/bin/apt, /usr/bin/systemctl
+func parseUserSpec(text string) ([]Rule, error) {
+	l := &lexer{text: text}
+	users, err := l.list("=")
+	if err != nil {
+		return nil, err
+	}
+
+	res := []Rule{}
+	for {
+		hosts, err := l.list("=:")
+		if err != nil {
+			return nil, err
+		}
+		if err := l.expect('='); err != nil {
+			return nil, err
+		}
+
+		// the runas list, options and tags apply to all following commands
+		// of the host group until they are changed
+		var runasUsers, runasGroups []string
+		options := map[string]string{}
+		tags := []string{}
+		var rule *Rule
+		for {
+			changed := false
+			if l.peek() == '(' {
+				l.pos++
+				runasUsers, runasGroups = []string{}, []string{}
+				if l.peek() != ':' && l.peek() != ')' {
+					if runasUsers, err = l.list(":)"); err != nil {
+						return nil, err
+					}
+				}
+				if l.peek() == ':' {
+					l.pos++
+					if l.peek() != ')' {
+						if runasGroups, err = l.list(")"); err != nil {
+							return nil, err
+						}
+					}
+				}
+				if err := l.expect(')'); err != nil {
+					return nil, err
+				}
+				changed = true
+			}
+
+			for {
+				l.skipSpace()
+				rest := l.text[l.pos:]
+				key, _, _ := strings.Cut(rest, "=")
+				if _, ok := commandOptions[key]; ok {
+					options = copyOptions(options)
+					l.pos += len(key) + 1
+					options[key] = l.word(",:")
+					changed = true
+					continue
+				}
+				tag, _, found := strings.Cut(rest, ":")
+				if _, ok := commandTags[tag]; ok && found {
+					tags = addTag(tags, tag)
+					l.pos += len(tag) + 1
+					changed = true
+					continue
+				}
+				break
+			}
+
+			cmd := l.command()
+			if cmd == "" {
+				return nil, errors.New("expected a command at position " + strconv.Itoa(l.pos+1))
+			}
+			if rule == nil || changed {
+				res = append(res, Rule{
+					Users:       users,
+					Hosts:       hosts,
+					RunAsUsers:  runasUsers,
+					RunAsGroups: runasGroups,
+					Options:     options,
+					Tags:        tags,
+					Commands:    []string{},
+				})
+				rule = &res[len(res)-1]
+			}
+			rule.Commands = append(rule.Commands, cmd)
+
+			if l.peek() != ',' {
+				break
+			}
+			l.pos++
+		}
+
+		if l.done() {
+			break
+		}
+		if err := l.expect(':'); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range res {
+		if res[i].RunAsGroups == nil {
+			res[i].RunAsGroups = []string{}
+		}
+	}
+	return res, nil
+}
+
+// addTag adds the tag and removes its opposite, e.g. PASSWD for NOPASSWD
+func addTag(tags []string, tag string) []string {
+	opposite := "NO" + tag
+	if strings.HasPrefix(tag, "NO") {
+		opposite = strings.TrimPrefix(tag, "NO")
+	}
+
+	res := []string{}
+	for i := range tags {
+		if tags[i] != tag && tags[i] != opposite {
+			res = append(res, tags[i])
+		}
+	}
+	return append(res, tag)
+}
+
+func copyOptions(options map[string]string) map[string]string {
+	res := make(map[string]string, len(options))
+	for k, v := range options {
+		res[k] = v
+	}
+	return res
+}
+
+func unescape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,434 @@
+package sudoers
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+const (
+	ScopeHost    = "host"
+	ScopeUser    = "user"
+	ScopeRunas   = "runas"
+	ScopeCommand = "command"
+
+	AliasUser    = "user"
+	AliasRunas   = "runas"
+	AliasHost    = "host"
+	AliasCommand = "command"
+)
+
+// maxIncludeDepth matches the nesting limit of sudo
+const maxIncludeDepth = 128
+
+// Default is a setting of a Defaults line, e.g. Defaults:alice !requiretty
+type Default struct {
+	Source string
+	Line   int
+	// Scope is empty for global defaults, otherwise host, user, runas or command
+	Scope   string
+	Targets []string
+	Name    string
+	// Operator is =, += or -= for settings with a value
+	Operator string
+	Value    string
+	Negated  bool
+}
+
+// Alias is a named list of users, runas users, hosts or commands
+type Alias struct {
+	Source  string
+	Line    int
+	Type    string
+	Name    string
+	Members []string
+}
+
+// Rule grants users to run commands on hosts as other users. Commands of a
+// user specification that share the runas list, options and tags are
+// grouped into one rule.
+type Rule struct {
+	Source string
+	Line   int
+	// Users may contain user names, %groups, #uids, aliases and ALL
+	Users []string
+	Hosts []string
+	// RunAsUsers is the runas_default user, usually root, if the rule has no
+	// runas specification
+	RunAsUsers  []string
+	RunAsGroups []string
+	// Options are the SELinux, AppArmor, CWD, CHROOT and time options
+	Options map[string]string
+	// Tags are the effective tags of the commands, e.g. NOPASSWD or SETENV
+	Tags     []string
+	Commands []string
+	// NoAuthenticate is set if Defaults disable authenticate for the users, hosts
+	// or runas users of the rule
+	NoAuthenticate bool
+}
+
+// HasTag checks if the tag is effective for the commands of the rule
+func (r Rule) HasTag(tag string) bool {
+	for i := range r.Tags {
+		if r.Tags[i] == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// NoPasswd is set if the commands can be run without authentication, the
+// PASSWD and NOPASSWD tags take precedence over the authenticate Defaults
+func (r Rule) NoPasswd() bool {
+	if r.HasTag("NOPASSWD") {
+		return true
+	}
+	return r.NoAuthenticate && !r.HasTag("PASSWD")
+}
+
+// Setenv is set if the user may override the environment, this is implied
+// for the ALL command
+func (r Rule) Setenv() bool {
+	if r.HasTag("NOSETENV") {
+		return false
+	}
+	if r.HasTag("SETENV") {
+		return true
+	}
+	for i := range r.Commands {
+		if r.Commands[i] == "ALL" {
+			return true
+		}
+	}
+	return false
+}
+
+// Config is the sudo configuration, including all included files
+type Config struct {
+	// Files are all files of the configuration in the order they are read
+	Files    []string
+	Defaults []Default
+	Aliases  []Alias
+	Rules    []Rule
+}
+
+// Load reads the sudoers file and all files included via @include,
+// @includedir and their legacy #include variants
+func Load(fs afero.Fs, filename string) (*Config, error) {
+	cfg := &Config{
+		Files:    []string{},
+		Defaults: []Default{},
+		Aliases:  []Alias{},
+		Rules:    []Rule{},
+	}
+
+	f, err := fs.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Files = append(cfg.Files, filename)
+	err = parse(fs, f, filename, cfg, 0)
+	f.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	applyRunasDefault(cfg)
+	applyAuthenticateDefaults(cfg)
+	return cfg, nil
+}
+
+// Parse reads a single sudoers file, include directives are ignored
+func Parse(r io.Reader, source string) (*Config, error) {
+	cfg := &Config{
+		Files:    []string{source},
+		Defaults: []Default{},
+		Aliases:  []Alias{},
+		Rules:    []Rule{},
+	}
+	if err := parse(nil, r, source, cfg, 0); err != nil {
+		return nil, err
+	}
+	applyRunasDefault(cfg)
+	applyAuthenticateDefaults(cfg)
+	return cfg, nil
+}
+
+// applyRunasDefault sets the runas_default user for rules without runas
+// specification
+func applyRunasDefault(cfg *Config) {
+	runasDefault := "root"
+	for i := range cfg.Defaults {
+		d := cfg.Defaults[i]
+		if d.Scope == "" && d.Name == "runas_default" && d.Value != "" {
+			runasDefault = d.Value
+		}
+	}
+	for i := range cfg.Rules {
+		if cfg.Rules[i].RunAsUsers == nil {
+			cfg.Rules[i].RunAsUsers = []string{runasDefault}
+		}
+	}
+}
+
+// applyAuthenticateDefaults determines if the authenticate flag is disabled for
+// the rules. Like sudo, global Defaults are applied first, followed by the host,
+// user and runas Defaults. Scoped Defaults apply to a rule if their targets
+// overlap with the rule, since group memberships are not known here.
+func applyAuthenticateDefaults(cfg *Config) {
+	aliases := map[string]map[string][]string{}
+	for i := range cfg.Aliases {
+		a := cfg.Aliases[i]
+		if aliases[a.Type] == nil {
+			aliases[a.Type] = map[string][]string{}
+		}
+		aliases[a.Type][a.Name] = a.Members
+	}
+
+	for i := range cfg.Rules {
+		rule := &cfg.Rules[i]
+		entries := map[string][]string{
+			ScopeHost:  rule.Hosts,
+			ScopeUser:  rule.Users,
+			ScopeRunas: rule.RunAsUsers,
+		}
+
+		authenticate := true
+		for _, scope := range []string{"", ScopeHost, ScopeUser, ScopeRunas} {
+			for _, d := range cfg.Defaults {
+				if d.Name != "authenticate" || d.Scope != scope {
+					continue
+				}
+				if scope != "" && !overlaps(aliases[scope], d.Targets, entries[scope]) {
+					continue
+				}
+				authenticate = !d.Negated
+			}
+		}
+		rule.NoAuthenticate = !authenticate
+	}
+}
+
+// overlaps checks if two lists of users, hosts or runas users share an entry
+// after aliases are expanded, ALL overlaps with every entry
+func overlaps(aliases map[string][]string, a []string, b []string) bool {
+	expandedA := expandAliases(aliases, a, map[string]bool{})
+	expandedB := expandAliases(aliases, b, map[string]bool{})
+	if expandedA["ALL"] || expandedB["ALL"] {
+		return len(expandedA) > 0 && len(expandedB) > 0
+	}
+	for entry := range expandedA {
+		if expandedB[entry] {
+			return true
+		}
+	}
+	return false
+}
+
+// expandAliases returns the members of the list with resolved aliases, negated
+// members are skipped
+func expandAliases(aliases map[string][]string, list []string, seen map[string]bool) map[string]bool {
+	res := map[string]bool{}
+	for _, entry := range list {
+		if strings.HasPrefix(entry, "!") {
+			continue
+		}
+		members, ok := aliases[entry]
+		if !ok {
+			res[entry] = true
+			continue
+		}
+		if seen[entry] {
+			continue
+		}
+		seen[entry] = true
+		for member := range expandAliases(aliases, members, seen) {
+			res[member] = true
+		}
+	}
+	return res
+}
+
+func parse(fs afero.Fs, r io.Reader, source string, cfg *Config, depth int) error {
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		start := lineNo
+		text := scanner.Text()
+
+		// a trailing backslash continues the line
+		for strings.HasSuffix(text, "\\") && scanner.Scan() {
+			lineNo++
+			text = strings.TrimSuffix(text, "\\") + scanner.Text()
+		}
+
+		text = strings.TrimSpace(text)
+		if include, isDir, ok := parseInclude(text); ok {
+			if fs == nil {
+				continue
+			}
+			if !path.IsAbs(include) {
+				include = path.Join(path.Dir(source), include)
+			}
+			if err := loadInclude(fs, include, isDir, cfg, depth+1); err != nil {
+				return err
+			}
+			continue
+		}
+
+		text = stripComment(text)
+		if text == "" {
+			continue
+		}
+
+		keyword := text
+		if idx := strings.IndexAny(text, " \t"); idx >= 0 {
+			keyword = text[:idx]
+		}
+
+		switch {
+		case isDefaults(keyword):
+			defaults, err := parseDefaults(text)
+			if err != nil {
+				return lineError(source, start, err)
+			}
+			for i := range defaults {
+				defaults[i].Source = source
+				defaults[i].Line = start
+			}
+			cfg.Defaults = append(cfg.Defaults, defaults...)
+		case aliasTypes[keyword] != "":
+			aliases, err := parseAliases(aliasTypes[keyword], text[len(keyword):])
+			if err != nil {
+				return lineError(source, start, err)
+			}
+			for i := range aliases {
+				aliases[i].Source = source
+				aliases[i].Line = start
+			}
+			cfg.Aliases = append(cfg.Aliases, aliases...)
+		default:
+			rules, err := parseUserSpec(text)
+			if err != nil {
+				return lineError(source, start, err)
+			}
+			for i := range rules {
+				rules[i].Source = source
+				rules[i].Line = start
+			}
+			cfg.Rules = append(cfg.Rules, rules...)
+		}
+	}
+	return scanner.Err()
+}
+
+// isDefaults checks for Defaults and its scoped variants like Defaults:alice
+func isDefaults(keyword string) bool {
+	if !strings.HasPrefix(keyword, "Defaults") {
+		return false
+	}
+	if len(keyword) == len("Defaults") {
+		return true
+	}
+	_, ok := defaultScopes[keyword[len("Defaults")]]
+	return ok
+}
+
+func lineError(source string, line int, err error) error {
+	return fmt.Errorf("could not parse %s:%d: %v", source, line, err)
+}
+
+func loadInclude(fs afero.Fs, include string, isDir bool, cfg *Config, depth int) error {
+	if depth > maxIncludeDepth {
+		return errors.New("too many levels of includes in " + include)
+	}
+
+	files := []string{include}
+	if isDir {
+		entries, err := afero.ReadDir(fs, include)
+		if os.IsNotExist(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		// sudo skips files that end with ~ or contain a dot, e.g. editor
+		// backups and package manager leftovers
+		files = []string{}
+		for _, entry := range entries {
+			name := entry.Name()
+			if entry.IsDir() || strings.HasSuffix(name, "~") || strings.Contains(name, ".") {
+				continue
+			}
+			files = append(files, path.Join(include, name))
+		}
+		sort.Strings(files)
+	}
+
+	for _, file := range files {
+		f, err := fs.Open(file)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		cfg.Files = append(cfg.Files, file)
+		err = parse(fs, f, file, cfg, depth)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseInclude detects @include, @includedir, #include and #includedir lines
+func parseInclude(text string) (string, bool, bool) {
+	if !strings.HasPrefix(text, "@include") && !strings.HasPrefix(text, "#include") {
+		return "", false, false
+	}
+	directive, arg, ok := cut(text[1:])
+	if !ok || (directive != "include" && directive != "includedir") {
+		return "", false, false
+	}
+	arg = strings.TrimSpace(arg)
+	if len(arg) > 1 && arg[0] == '"' && arg[len(arg)-1] == '"' {
+		arg = unescape(arg[1 : len(arg)-1])
+	}
+	return arg, directive == "includedir", true
+}
+
+// stripComment removes comments, a # followed by a digit is a uid
+func stripComment(text string) string {
+	quoted := false
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '\\':
+			i++
+		case '"':
+			quoted = !quoted
+		case '#':
+			if quoted || (i+1 < len(text) && text[i+1] >= '0' && text[i+1] <= '9') {
+				continue
+			}
+			return strings.TrimSpace(text[:i])
+		}
+	}
+	return text
+}
+
+func cut(text string) (string, string, bool) {
+	idx := strings.IndexAny(text, " \t")
+	if idx < 0 {
+		return text, "", false
+	}
+	return text[:idx], text[idx+1:], true
+}
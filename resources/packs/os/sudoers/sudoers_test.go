@@ -0,0 +1,181 @@
+package sudoers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/motor/providers/fs"
+)
+
+func TestLoad(t *testing.T) {
+	cfg, err := Load(fs.NewMountedFs("testdata/debian"), "/etc/sudoers")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"/etc/sudoers",
+		"/etc/sudoers.local",
+		"/etc/sudoers.d/90-cloud-init-users",
+		"/etc/sudoers.d/README",
+	}, cfg.Files)
+
+	t.Run("defaults", func(t *testing.T) {
+		require.Len(t, cfg.Defaults, 8)
+		assert.Equal(t, Default{
+			Source:   "/etc/sudoers",
+			Line:     6,
+			Targets:  []string{},
+			Name:     "secure_path",
+			Operator: "=",
+			Value:    "/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin",
+		}, cfg.Defaults[2])
+		assert.Equal(t, "+=", cfg.Defaults[3].Operator)
+		assert.Equal(t, "LANG LC_*", cfg.Defaults[3].Value)
+
+		assert.Equal(t, ScopeUser, cfg.Defaults[4].Scope)
+		assert.Equal(t, []string{"%wheel", "backup"}, cfg.Defaults[4].Targets)
+		assert.True(t, cfg.Defaults[4].Negated)
+		assert.Equal(t, "requiretty", cfg.Defaults[4].Name)
+		assert.Equal(t, "timestamp_timeout", cfg.Defaults[5].Name)
+		assert.Equal(t, "0", cfg.Defaults[5].Value)
+
+		assert.Equal(t, ScopeCommand, cfg.Defaults[6].Scope)
+		assert.Equal(t, []string{"/usr/bin/sudoreplay"}, cfg.Defaults[6].Targets)
+
+		assert.Equal(t, "/etc/sudoers.local", cfg.Defaults[7].Source)
+		assert.Equal(t, "authenticate", cfg.Defaults[7].Name)
+	})
+
+	t.Run("aliases", func(t *testing.T) {
+		require.Len(t, cfg.Aliases, 5)
+		assert.Equal(t, Alias{Source: "/etc/sudoers", Line: 12, Type: AliasHost, Name: "DBSERVERS", Members: []string{"10.0.0.0/24"}}, cfg.Aliases[1])
+		assert.Equal(t, []string{"alice", "%sre"}, cfg.Aliases[2].Members)
+		assert.Equal(t, Alias{
+			Source:  "/etc/sudoers",
+			Line:    18,
+			Type:    AliasCommand,
+			Name:    "SERVICES",
+			Members: []string{"/usr/bin/systemctl start *", "/usr/bin/systemctl stop *"},
+		}, cfg.Aliases[3])
+	})
+
+	t.Run("rules", func(t *testing.T) {
+		require.Len(t, cfg.Rules, 9)
+		assert.Equal(t, Rule{
+			Source:      "/etc/sudoers",
+			Line:        26,
+			Users:       []string{"%sudo"},
+			Hosts:       []string{"ALL"},
+			RunAsUsers:  []string{"ALL"},
+			RunAsGroups: []string{"ALL"},
+			Options:     map[string]string{},
+			Tags:        []string{},
+			Commands:    []string{"ALL"},
+		}, cfg.Rules[1])
+		assert.True(t, cfg.Rules[1].Setenv())
+		assert.False(t, cfg.Rules[1].NoPasswd())
+
+		// tags change within a host group, the runas list is inherited
+		admins := cfg.Rules[2:5]
+		assert.Equal(t, []string{"ADMINS"}, admins[0].Users)
+		assert.Equal(t, []string{"WEBSERVERS"}, admins[0].Hosts)
+		assert.Equal(t, []string{"SERVICES"}, admins[0].Commands)
+		assert.True(t, admins[0].NoPasswd())
+		assert.Equal(t, []string{"root"}, admins[1].RunAsUsers)
+		assert.Equal(t, []string{"PASSWD"}, admins[1].Tags)
+		assert.Equal(t, []string{"!SHELLS"}, admins[1].Commands)
+		assert.Equal(t, []string{"DBSERVERS"}, admins[2].Hosts)
+		assert.Equal(t, []string{"postgres"}, admins[2].RunAsUsers)
+		assert.Equal(t, []string{}, admins[2].Tags)
+
+		// uids are no comments, rules without runas use runas_default
+		assert.Equal(t, []string{"#1001"}, cfg.Rules[5].Users)
+		assert.Equal(t, []string{"root"}, cfg.Rules[5].RunAsUsers)
+		assert.True(t, strings.HasPrefix(cfg.Rules[5].Commands[0], "sha256:0f1e"))
+		assert.True(t, strings.HasSuffix(cfg.Rules[5].Commands[0], " /usr/local/bin/backup"))
+
+		assert.Equal(t, Rule{
+			Source:      "/etc/sudoers",
+			Line:        30,
+			Users:       []string{"deploy"},
+			Hosts:       []string{"ALL"},
+			RunAsUsers:  []string{"www-data"},
+			RunAsGroups: []string{"www-data"},
+			Options:     map[string]string{"CWD": "/srv/app"},
+			Tags:        []string{"NOPASSWD", "SETENV"},
+			Commands:    []string{"/usr/bin/rsync", "/usr/bin/chown www-data:www-data /srv/app"},
+		}, cfg.Rules[6])
+
+		assert.Equal(t, "/etc/sudoers.local", cfg.Rules[7].Source)
+		assert.Equal(t, []string{"/usr/bin/docker"}, cfg.Rules[7].Commands)
+		assert.True(t, cfg.Rules[7].NoPasswd())
+		assert.False(t, cfg.Rules[6].NoAuthenticate)
+
+		assert.Equal(t, "/etc/sudoers.d/90-cloud-init-users", cfg.Rules[8].Source)
+		assert.Equal(t, []string{"ubuntu"}, cfg.Rules[8].Users)
+		assert.True(t, cfg.Rules[8].NoPasswd())
+	})
+}
+
+func TestParse(t *testing.T) {
+	cfg, err := Parse(strings.NewReader(`Defaults runas_default=operator
+Defaults>root   !set_logname
+bob, %ops host1,host2 = (:wheel) /usr/bin/id
+@include /etc/sudoers.local
+`), "/etc/sudoers")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/etc/sudoers"}, cfg.Files)
+
+	require.Len(t, cfg.Defaults, 2)
+	assert.Equal(t, ScopeRunas, cfg.Defaults[1].Scope)
+	assert.Equal(t, []string{"root"}, cfg.Defaults[1].Targets)
+
+	require.Len(t, cfg.Rules, 1)
+	assert.Equal(t, []string{"bob", "%ops"}, cfg.Rules[0].Users)
+	assert.Equal(t, []string{"host1", "host2"}, cfg.Rules[0].Hosts)
+	assert.Equal(t, []string{}, cfg.Rules[0].RunAsUsers)
+	assert.Equal(t, []string{"wheel"}, cfg.Rules[0].RunAsGroups)
+
+	_, err = Parse(strings.NewReader("alice ALL = (root\n"), "/etc/sudoers")
+	assert.EqualError(t, err, "could not parse /etc/sudoers:1: expected ')'")
+}
+
+func TestAuthenticateDefaults(t *testing.T) {
+	cfg, err := Parse(strings.NewReader(`User_Alias CI = jenkins, gitlab
+Host_Alias BUILD = build1, build2
+Defaults:CI !authenticate
+Defaults@build2 !authenticate
+Defaults>backup !authenticate
+Defaults:gitlab authenticate
+alice ALL = ALL
+jenkins ALL = /usr/bin/docker
+CI ALL = PASSWD: /usr/bin/podman
+gitlab ALL = /usr/bin/docker
+bob BUILD = /usr/bin/make
+carol ALL = (backup) /usr/bin/rsync
+`), "/etc/sudoers")
+	require.NoError(t, err)
+	require.Len(t, cfg.Rules, 6)
+
+	// user defaults via alias, the PASSWD tag takes precedence
+	assert.True(t, cfg.Rules[1].NoPasswd())
+	assert.False(t, cfg.Rules[2].NoPasswd())
+	// later defaults of the same scope override earlier ones
+	assert.False(t, cfg.Rules[3].NoPasswd())
+	// host and runas defaults
+	assert.True(t, cfg.Rules[4].NoPasswd())
+	assert.True(t, cfg.Rules[5].NoPasswd())
+
+	// host defaults apply to rules for ALL hosts as well
+	assert.True(t, cfg.Rules[0].NoPasswd())
+
+	cfg, err = Parse(strings.NewReader(`Defaults !authenticate
+Defaults:alice authenticate
+alice ALL = ALL
+bob ALL = ALL
+`), "/etc/sudoers")
+	require.NoError(t, err)
+	assert.False(t, cfg.Rules[0].NoPasswd())
+	assert.True(t, cfg.Rules[1].NoPasswd())
+}
@@ -0,0 +1,44 @@
+package os_test
+
+import (
+	"testing"
+
+	"go.mondoo.com/cnquery/resources/packs/testutils"
+)
+
+func TestResource_Sudoers(t *testing.T) {
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			"sudoers.files.length",
+			0, int64(2),
+		},
+		{
+			"sudoers.defaults[1].scope",
+			0, "user",
+		},
+		{
+			"sudoers.defaults[1].negated",
+			0, true,
+		},
+		{
+			"sudoers.aliases[0].members[0]",
+			0, "/usr/bin/systemctl restart *",
+		},
+		{
+			"sudoers.rules.length",
+			0, int64(3),
+		},
+		{
+			"sudoers.rules.where(noPasswd)[0].users[0]",
+			0, "deploy",
+		},
+		{
+			"sudoers.rules.where(noPasswd)[0].runAsUsers[0]",
+			0, "root",
+		},
+		{
+			"sudoers('/etc/sudoers.d/deploy').rules[0].source",
+			0, "/etc/sudoers.d/deploy",
+		},
+	})
+}
@@ -0,0 +1,71 @@
+package os
+
+import (
+	"strings"
+
+	"go.mondoo.com/cnquery/resources/packs/core"
+	"go.mondoo.com/cnquery/resources/packs/os/services"
+)
+
+func (s *mqlSystemd) id() (string, error) {
+	return "systemd", nil
+}
+
+func (s *mqlSystemdTimers) id() (string, error) {
+	return "systemd.timers", nil
+}
+
+func (s *mqlSystemdTimers) GetList() ([]interface{}, error) {
+	osProvider, err := osProvider(s.MotorRuntime.Motor)
+	if err != nil {
+		return nil, err
+	}
+
+	timers, err := services.ListSystemdTimers(osProvider.FS())
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(timers))
+	for i := range timers {
+		timer := timers[i]
+		mqlTimer, err := s.MotorRuntime.CreateResource("systemd.timer",
+			"name", timer.Name,
+			"path", timer.Path,
+			"description", timer.Description,
+			"unit", timer.Unit,
+			"onCalendar", core.StrSliceToInterface(timer.OnCalendar),
+			"monotonic", core.StrMapToInterface(timer.Monotonic),
+			"persistent", timer.Persistent,
+			"enabled", timer.Enabled,
+			"masked", timer.Masked,
+		)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = mqlTimer.(SystemdTimer)
+	}
+	return res, nil
+}
+
+func (s *mqlSystemdTimer) id() (string, error) {
+	return s.Name()
+}
+
+// GetService returns the service that is activated by the timer, timers may
+// also activate other unit types, e.g. targets
+func (s *mqlSystemdTimer) GetService() (Service, error) {
+	unit, err := s.Unit()
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(unit, ".service") {
+		return nil, nil
+	}
+
+	mqlService, err := s.MotorRuntime.CreateResource("service", "name", strings.TrimSuffix(unit, ".service"))
+	if err != nil {
+		return nil, err
+	}
+	return mqlService.(Service), nil
+}
@@ -0,0 +1,28 @@
+package os_test
+
+import (
+	"testing"
+
+	"go.mondoo.com/cnquery/resources/packs/testutils"
+)
+
+func TestResource_SystemdTimers(t *testing.T) {
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			"systemd.timers.list.length",
+			0, int64(1),
+		},
+		{
+			"systemd.timers.list[0].onCalendar[0]",
+			0, "daily",
+		},
+		{
+			"systemd.timers.list[0].enabled",
+			0, true,
+		},
+		{
+			"systemd.timers.list[0].service.name",
+			0, "logrotate",
+		},
+	})
+}
@@ -0,0 +1,220 @@
+package terraform
+
+import (
+	"github.com/zclconf/go-cty/cty"
+	"go.mondoo.com/cnquery/motor/providers/terraform"
+	"go.mondoo.com/cnquery/resources"
+)
+
+func (t *mqlTerraformEvaluated) id() (string, error) {
+	return "terraform.evaluated", nil
+}
+
+func (t *mqlTerraformEvaluated) rootModule() (*terraform.EvaluatedModule, error) {
+	p, err := terraformProvider(t.MotorRuntime.Motor.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return p.Evaluated()
+}
+
+func (t *mqlTerraformEvaluated) GetVariables() (interface{}, error) {
+	root, err := t.rootModule()
+	if err != nil {
+		return nil, err
+	}
+	return ctyMapToDict(root.Variables), nil
+}
+
+func (t *mqlTerraformEvaluated) GetLocals() (interface{}, error) {
+	root, err := t.rootModule()
+	if err != nil {
+		return nil, err
+	}
+	return ctyMapToDict(root.Locals), nil
+}
+
+func (t *mqlTerraformEvaluated) GetOutputs() (interface{}, error) {
+	root, err := t.rootModule()
+	if err != nil {
+		return nil, err
+	}
+	return ctyMapToDict(root.Outputs), nil
+}
+
+func (t *mqlTerraformEvaluated) GetResources() ([]interface{}, error) {
+	root, err := t.rootModule()
+	if err != nil {
+		return nil, err
+	}
+
+	var res []interface{}
+	var walk func(mod *terraform.EvaluatedModule) error
+	walk = func(mod *terraform.EvaluatedModule) error {
+		mqlResources, err := newMqlEvaluatedResources(t.MotorRuntime, mod.Resources)
+		if err != nil {
+			return err
+		}
+		res = append(res, mqlResources...)
+		for i := range mod.Modules {
+			if err := walk(mod.Modules[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(root); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (t *mqlTerraformEvaluated) GetModules() ([]interface{}, error) {
+	root, err := t.rootModule()
+	if err != nil {
+		return nil, err
+	}
+
+	var res []interface{}
+	var walk func(mod *terraform.EvaluatedModule) error
+	walk = func(mod *terraform.EvaluatedModule) error {
+		for i := range mod.Modules {
+			child := mod.Modules[i]
+			mqlModule, err := newMqlEvaluatedModule(t.MotorRuntime, child)
+			if err != nil {
+				return err
+			}
+			res = append(res, mqlModule)
+			if err := walk(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(root); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func newMqlEvaluatedModule(runtime *resources.Runtime, mod *terraform.EvaluatedModule) (resources.ResourceType, error) {
+	block, err := newMqlHclBlock(runtime, mod.Block, mod.File)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := runtime.CreateResource("terraform.evaluated.module",
+		"address", mod.Address,
+		"source", mod.Source,
+		"dir", mod.Dir,
+		"key", ctyToDict(mod.Key),
+		"variables", ctyMapToDict(mod.Variables),
+		"locals", ctyMapToDict(mod.Locals),
+		"outputs", ctyMapToDict(mod.Outputs),
+		"block", block,
+	)
+	if err == nil {
+		r.MqlResource().Cache.Store("_module", &resources.CacheEntry{
+			Data: mod,
+		})
+	}
+	return r, err
+}
+
+func (t *mqlTerraformEvaluatedModule) id() (string, error) {
+	address, err := t.Address()
+	if err != nil {
+		return "", err
+	}
+	return "terraform.evaluated.module/" + address, nil
+}
+
+func (t *mqlTerraformEvaluatedModule) GetResources() ([]interface{}, error) {
+	ce, ok := t.MqlResource().Cache.Load("_module")
+	if !ok {
+		return nil, nil
+	}
+	mod := ce.Data.(*terraform.EvaluatedModule)
+	return newMqlEvaluatedResources(t.MotorRuntime, mod.Resources)
+}
+
+func newMqlEvaluatedResources(runtime *resources.Runtime, evaluated []*terraform.EvaluatedResource) ([]interface{}, error) {
+	res := make([]interface{}, len(evaluated))
+	for i := range evaluated {
+		r := evaluated[i]
+		block, err := newMqlHclBlock(runtime, r.Block, r.File)
+		if err != nil {
+			return nil, err
+		}
+
+		mqlResource, err := runtime.CreateResource("terraform.evaluated.resource",
+			"address", r.Address,
+			"module", r.Module,
+			"mode", r.Mode,
+			"type", r.Type,
+			"name", r.Name,
+			"key", ctyToDict(r.Key),
+			"arguments", ctyMapToDict(r.Arguments),
+			"block", block,
+		)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = mqlResource
+	}
+	return res, nil
+}
+
+func (t *mqlTerraformEvaluatedResource) id() (string, error) {
+	address, err := t.Address()
+	if err != nil {
+		return "", err
+	}
+	return "terraform.evaluated.resource/" + address, nil
+}
+
+func ctyMapToDict(values map[string]cty.Value) map[string]interface{} {
+	res := make(map[string]interface{}, len(values))
+	for k := range values {
+		res[k] = ctyToDict(values[k])
+	}
+	return res
+}
+
+// ctyToDict converts evaluated values, unknown values are null. Numbers are
+// float64 like the values of terraform.block.arguments.
+func ctyToDict(v cty.Value) interface{} {
+	if v == cty.NilVal || !v.IsKnown() || v.IsNull() {
+		return nil
+	}
+	v, _ = v.UnmarkDeep()
+
+	ty := v.Type()
+	switch {
+	case ty == cty.String:
+		return v.AsString()
+	case ty == cty.Bool:
+		return v.True()
+	case ty == cty.Number:
+		f, _ := v.AsBigFloat().Float64()
+		return f
+	case ty.IsListType() || ty.IsSetType() || ty.IsTupleType():
+		res := []interface{}{}
+		it := v.ElementIterator()
+		for it.Next() {
+			_, elem := it.Element()
+			res = append(res, ctyToDict(elem))
+		}
+		return res
+	case ty.IsMapType() || ty.IsObjectType():
+		res := map[string]interface{}{}
+		it := v.ElementIterator()
+		for it.Next() {
+			key, elem := it.Element()
+			res[key.AsString()] = ctyToDict(elem)
+		}
+		return res
+	default:
+		return nil
+	}
+}
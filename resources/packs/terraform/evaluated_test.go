@@ -0,0 +1,89 @@
+package terraform_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/motor"
+	"go.mondoo.com/cnquery/motor/providers"
+	provider "go.mondoo.com/cnquery/motor/providers/terraform"
+	"go.mondoo.com/cnquery/resources/packs/terraform"
+	"go.mondoo.com/cnquery/resources/packs/testutils"
+)
+
+func TestResource_TerraformEvaluated(t *testing.T) {
+	t.Run("variables from tfvars", func(t *testing.T) {
+		res := testTerraformHclQuery(t, "terraform.evaluated.variables['image_id']")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, "ami-abc123", res[0].Data.Value)
+	})
+
+	t.Run("locals", func(t *testing.T) {
+		res := testTerraformHclQuery(t, "terraform.evaluated.locals['log_bucket']")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, "production-logs", res[0].Data.Value)
+	})
+
+	t.Run("for_each instances", func(t *testing.T) {
+		res := testTerraformHclQuery(t, "terraform.evaluated.resources.where(type == 'aws_s3_bucket' && module == '').map(arguments['bucket'])")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, []interface{}{"production-assets", "production-backups"}, res[0].Data.Value)
+	})
+
+	t.Run("nested blocks", func(t *testing.T) {
+		res := testTerraformHclQuery(t, "terraform.evaluated.resources.where(type == 'aws_s3_bucket_server_side_encryption_configuration')[0].arguments['rule'][0]['apply_server_side_encryption_by_default'][0]['sse_algorithm']")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, "aws:kms", res[0].Data.Value)
+	})
+
+	t.Run("local modules", func(t *testing.T) {
+		res := testTerraformHclQuery(t, "terraform.evaluated.resources.where(module == 'module.logs').map(address)")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, []interface{}{"module.logs.aws_s3_bucket.this", "module.logs.aws_s3_bucket_versioning.this[0]"}, res[0].Data.Value)
+
+		res = testTerraformHclQuery(t, "terraform.evaluated.modules.where(address == 'module.logs')[0].outputs['bucket']")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, "production-logs", res[0].Data.Value)
+	})
+
+	t.Run("remote modules without manifest", func(t *testing.T) {
+		res := testTerraformHclQuery(t, "terraform.evaluated.modules.where(address == 'module.consul')[0].dir")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, "", res[0].Data.Value)
+	})
+
+	t.Run("raw block", func(t *testing.T) {
+		res := testTerraformHclQuery(t, "terraform.evaluated.resources.where(address == 'aws_instance.example')[0].block.arguments['ami']")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, "ami-a1b2c3d4", res[0].Data.Value)
+	})
+
+	t.Run("variable overrides", func(t *testing.T) {
+		p, err := provider.New(&providers.Config{
+			Backend: providers.ProviderType_TERRAFORM,
+			Options: map[string]string{
+				"path":               "./testdata/terraform",
+				"var.kms_encryption": "false",
+			},
+		})
+		require.NoError(t, err)
+
+		m, err := motor.New(p)
+		require.NoError(t, err)
+
+		x := testutils.InitTester(m, terraform.Registry)
+		res := x.TestQuery(t, "terraform.evaluated.resources.where(type == 'aws_s3_bucket_server_side_encryption_configuration')[0].arguments['rule'][0]['apply_server_side_encryption_by_default'][0]['sse_algorithm']")
+		require.NotEmpty(t, res)
+		assert.Empty(t, res[0].Result().Error)
+		assert.Equal(t, "AES256", res[0].Data.Value)
+	})
+}
@@ -18,6 +18,9 @@ func Init(registry *resources.Registry) {
 	registry.AddFactory("terraform.block", newTerraformBlock)
 	registry.AddFactory("terraform.module", newTerraformModule)
 	registry.AddFactory("terraform.settings", newTerraformSettings)
+	registry.AddFactory("terraform.evaluated", newTerraformEvaluated)
+	registry.AddFactory("terraform.evaluated.module", newTerraformEvaluatedModule)
+	registry.AddFactory("terraform.evaluated.resource", newTerraformEvaluatedResource)
 	registry.AddFactory("terraform.state", newTerraformState)
 	registry.AddFactory("terraform.state.output", newTerraformStateOutput)
 	registry.AddFactory("terraform.state.module", newTerraformStateModule)
@@ -1747,6 +1750,1054 @@ func (s *mqlTerraformSettings) Compute(name string) error {
 	}
 }
 
+// TerraformEvaluated resource interface
+type TerraformEvaluated interface {
+	MqlResource() (*resources.Resource)
+	Compute(string) error
+	Field(string) (interface{}, error)
+	Register(string) error
+	Validate() error
+	Variables() (interface{}, error)
+	Locals() (interface{}, error)
+	Outputs() (interface{}, error)
+	Resources() ([]interface{}, error)
+	Modules() ([]interface{}, error)
+}
+
+// mqlTerraformEvaluated for the terraform.evaluated resource
+type mqlTerraformEvaluated struct {
+	*resources.Resource
+}
+
+// MqlResource to retrieve the underlying resource info
+func (s *mqlTerraformEvaluated) MqlResource() *resources.Resource {
+	return s.Resource
+}
+
+// create a new instance of the terraform.evaluated resource
+func newTerraformEvaluated(runtime *resources.Runtime, args *resources.Args) (interface{}, error) {
+	// User hooks
+	var err error
+	res := mqlTerraformEvaluated{runtime.NewResource("terraform.evaluated")}
+	// assign all named fields
+	var id string
+
+	now := time.Now().Unix()
+	for name, val := range *args {
+		if val == nil {
+			res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+			continue
+		}
+
+		switch name {
+		case "variables":
+			if _, ok := val.(interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated\", its \"variables\" argument has the wrong type (expected type \"interface{}\")")
+			}
+		case "locals":
+			if _, ok := val.(interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated\", its \"locals\" argument has the wrong type (expected type \"interface{}\")")
+			}
+		case "outputs":
+			if _, ok := val.(interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated\", its \"outputs\" argument has the wrong type (expected type \"interface{}\")")
+			}
+		case "resources":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated\", its \"resources\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
+		case "modules":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated\", its \"modules\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
+		case "__id":
+			idVal, ok := val.(string)
+			if !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated\", its \"__id\" argument has the wrong type (expected type \"string\")")
+			}
+			id = idVal
+		default:
+			return nil, errors.New("Initialized terraform.evaluated with unknown argument " + name)
+		}
+		res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+	}
+
+	// Get the ID
+	if id == "" {
+		res.Resource.Id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res.Resource.Id = id
+	}
+
+	return &res, nil
+}
+
+func (s *mqlTerraformEvaluated) Validate() error {
+	// required arguments
+	// no required fields found
+
+	return nil
+}
+
+// Register accessor autogenerated
+func (s *mqlTerraformEvaluated) Register(name string) error {
+	log.Trace().Str("field", name).Msg("[terraform.evaluated].Register")
+	switch name {
+	case "variables":
+		return nil
+	case "locals":
+		return nil
+	case "outputs":
+		return nil
+	case "resources":
+		return nil
+	case "modules":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"terraform.evaluated\" resource")
+	}
+}
+
+// Field accessor autogenerated
+func (s *mqlTerraformEvaluated) Field(name string) (interface{}, error) {
+	log.Trace().Str("field", name).Msg("[terraform.evaluated].Field")
+	switch name {
+	case "variables":
+		return s.Variables()
+	case "locals":
+		return s.Locals()
+	case "outputs":
+		return s.Outputs()
+	case "resources":
+		return s.Resources()
+	case "modules":
+		return s.Modules()
+	default:
+		return nil, fmt.Errorf("Cannot find field '" + name + "' in \"terraform.evaluated\" resource")
+	}
+}
+
+// Variables accessor autogenerated
+func (s *mqlTerraformEvaluated) Variables() (interface{}, error) {
+	res, ok := s.Cache.Load("variables")
+	if !ok || !res.Valid {
+		if err := s.ComputeVariables(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("variables")
+		if !ok {
+			return nil, errors.New("\"terraform.evaluated\" calculated \"variables\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "variables")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"terraform.evaluated\" failed to cast field \"variables\" to the right type (interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Locals accessor autogenerated
+func (s *mqlTerraformEvaluated) Locals() (interface{}, error) {
+	res, ok := s.Cache.Load("locals")
+	if !ok || !res.Valid {
+		if err := s.ComputeLocals(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("locals")
+		if !ok {
+			return nil, errors.New("\"terraform.evaluated\" calculated \"locals\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "locals")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"terraform.evaluated\" failed to cast field \"locals\" to the right type (interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Outputs accessor autogenerated
+func (s *mqlTerraformEvaluated) Outputs() (interface{}, error) {
+	res, ok := s.Cache.Load("outputs")
+	if !ok || !res.Valid {
+		if err := s.ComputeOutputs(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("outputs")
+		if !ok {
+			return nil, errors.New("\"terraform.evaluated\" calculated \"outputs\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "outputs")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"terraform.evaluated\" failed to cast field \"outputs\" to the right type (interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Resources accessor autogenerated
+func (s *mqlTerraformEvaluated) Resources() ([]interface{}, error) {
+	res, ok := s.Cache.Load("resources")
+	if !ok || !res.Valid {
+		if err := s.ComputeResources(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("resources")
+		if !ok {
+			return nil, errors.New("\"terraform.evaluated\" calculated \"resources\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "resources")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"terraform.evaluated\" failed to cast field \"resources\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Modules accessor autogenerated
+func (s *mqlTerraformEvaluated) Modules() ([]interface{}, error) {
+	res, ok := s.Cache.Load("modules")
+	if !ok || !res.Valid {
+		if err := s.ComputeModules(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("modules")
+		if !ok {
+			return nil, errors.New("\"terraform.evaluated\" calculated \"modules\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "modules")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"terraform.evaluated\" failed to cast field \"modules\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Compute accessor autogenerated
+func (s *mqlTerraformEvaluated) Compute(name string) error {
+	log.Trace().Str("field", name).Msg("[terraform.evaluated].Compute")
+	switch name {
+	case "variables":
+		return s.ComputeVariables()
+	case "locals":
+		return s.ComputeLocals()
+	case "outputs":
+		return s.ComputeOutputs()
+	case "resources":
+		return s.ComputeResources()
+	case "modules":
+		return s.ComputeModules()
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"terraform.evaluated\" resource")
+	}
+}
+
+// ComputeVariables computer autogenerated
+func (s *mqlTerraformEvaluated) ComputeVariables() error {
+	var err error
+	if _, ok := s.Cache.Load("variables"); ok {
+		return nil
+	}
+	vres, err := s.GetVariables()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("variables", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeLocals computer autogenerated
+func (s *mqlTerraformEvaluated) ComputeLocals() error {
+	var err error
+	if _, ok := s.Cache.Load("locals"); ok {
+		return nil
+	}
+	vres, err := s.GetLocals()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("locals", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeOutputs computer autogenerated
+func (s *mqlTerraformEvaluated) ComputeOutputs() error {
+	var err error
+	if _, ok := s.Cache.Load("outputs"); ok {
+		return nil
+	}
+	vres, err := s.GetOutputs()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("outputs", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeResources computer autogenerated
+func (s *mqlTerraformEvaluated) ComputeResources() error {
+	var err error
+	if _, ok := s.Cache.Load("resources"); ok {
+		return nil
+	}
+	vres, err := s.GetResources()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("resources", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// ComputeModules computer autogenerated
+func (s *mqlTerraformEvaluated) ComputeModules() error {
+	var err error
+	if _, ok := s.Cache.Load("modules"); ok {
+		return nil
+	}
+	vres, err := s.GetModules()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("modules", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// TerraformEvaluatedModule resource interface
+type TerraformEvaluatedModule interface {
+	MqlResource() (*resources.Resource)
+	Compute(string) error
+	Field(string) (interface{}, error)
+	Register(string) error
+	Validate() error
+	Address() (string, error)
+	Source() (string, error)
+	Dir() (string, error)
+	Key() (interface{}, error)
+	Variables() (interface{}, error)
+	Locals() (interface{}, error)
+	Outputs() (interface{}, error)
+	Block() (TerraformBlock, error)
+	Resources() ([]interface{}, error)
+}
+
+// mqlTerraformEvaluatedModule for the terraform.evaluated.module resource
+type mqlTerraformEvaluatedModule struct {
+	*resources.Resource
+}
+
+// MqlResource to retrieve the underlying resource info
+func (s *mqlTerraformEvaluatedModule) MqlResource() *resources.Resource {
+	return s.Resource
+}
+
+// create a new instance of the terraform.evaluated.module resource
+func newTerraformEvaluatedModule(runtime *resources.Runtime, args *resources.Args) (interface{}, error) {
+	// User hooks
+	var err error
+	res := mqlTerraformEvaluatedModule{runtime.NewResource("terraform.evaluated.module")}
+	// assign all named fields
+	var id string
+
+	now := time.Now().Unix()
+	for name, val := range *args {
+		if val == nil {
+			res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+			continue
+		}
+
+		switch name {
+		case "address":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated.module\", its \"address\" argument has the wrong type (expected type \"string\")")
+			}
+		case "source":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated.module\", its \"source\" argument has the wrong type (expected type \"string\")")
+			}
+		case "dir":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated.module\", its \"dir\" argument has the wrong type (expected type \"string\")")
+			}
+		case "key":
+			if _, ok := val.(interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated.module\", its \"key\" argument has the wrong type (expected type \"interface{}\")")
+			}
+		case "variables":
+			if _, ok := val.(interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated.module\", its \"variables\" argument has the wrong type (expected type \"interface{}\")")
+			}
+		case "locals":
+			if _, ok := val.(interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated.module\", its \"locals\" argument has the wrong type (expected type \"interface{}\")")
+			}
+		case "outputs":
+			if _, ok := val.(interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated.module\", its \"outputs\" argument has the wrong type (expected type \"interface{}\")")
+			}
+		case "block":
+			if _, ok := val.(TerraformBlock); !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated.module\", its \"block\" argument has the wrong type (expected type \"TerraformBlock\")")
+			}
+		case "resources":
+			if _, ok := val.([]interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated.module\", its \"resources\" argument has the wrong type (expected type \"[]interface{}\")")
+			}
+		case "__id":
+			idVal, ok := val.(string)
+			if !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated.module\", its \"__id\" argument has the wrong type (expected type \"string\")")
+			}
+			id = idVal
+		default:
+			return nil, errors.New("Initialized terraform.evaluated.module with unknown argument " + name)
+		}
+		res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+	}
+
+	// Get the ID
+	if id == "" {
+		res.Resource.Id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res.Resource.Id = id
+	}
+
+	return &res, nil
+}
+
+func (s *mqlTerraformEvaluatedModule) Validate() error {
+	// required arguments
+	if _, ok := s.Cache.Load("address"); !ok {
+		return errors.New("Initialized \"terraform.evaluated.module\" resource without a \"address\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("source"); !ok {
+		return errors.New("Initialized \"terraform.evaluated.module\" resource without a \"source\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("dir"); !ok {
+		return errors.New("Initialized \"terraform.evaluated.module\" resource without a \"dir\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("key"); !ok {
+		return errors.New("Initialized \"terraform.evaluated.module\" resource without a \"key\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("variables"); !ok {
+		return errors.New("Initialized \"terraform.evaluated.module\" resource without a \"variables\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("locals"); !ok {
+		return errors.New("Initialized \"terraform.evaluated.module\" resource without a \"locals\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("outputs"); !ok {
+		return errors.New("Initialized \"terraform.evaluated.module\" resource without a \"outputs\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("block"); !ok {
+		return errors.New("Initialized \"terraform.evaluated.module\" resource without a \"block\". This field is required.")
+	}
+
+	return nil
+}
+
+// Register accessor autogenerated
+func (s *mqlTerraformEvaluatedModule) Register(name string) error {
+	log.Trace().Str("field", name).Msg("[terraform.evaluated.module].Register")
+	switch name {
+	case "address":
+		return nil
+	case "source":
+		return nil
+	case "dir":
+		return nil
+	case "key":
+		return nil
+	case "variables":
+		return nil
+	case "locals":
+		return nil
+	case "outputs":
+		return nil
+	case "block":
+		return nil
+	case "resources":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"terraform.evaluated.module\" resource")
+	}
+}
+
+// Field accessor autogenerated
+func (s *mqlTerraformEvaluatedModule) Field(name string) (interface{}, error) {
+	log.Trace().Str("field", name).Msg("[terraform.evaluated.module].Field")
+	switch name {
+	case "address":
+		return s.Address()
+	case "source":
+		return s.Source()
+	case "dir":
+		return s.Dir()
+	case "key":
+		return s.Key()
+	case "variables":
+		return s.Variables()
+	case "locals":
+		return s.Locals()
+	case "outputs":
+		return s.Outputs()
+	case "block":
+		return s.Block()
+	case "resources":
+		return s.Resources()
+	default:
+		return nil, fmt.Errorf("Cannot find field '" + name + "' in \"terraform.evaluated.module\" resource")
+	}
+}
+
+// Address accessor autogenerated
+func (s *mqlTerraformEvaluatedModule) Address() (string, error) {
+	res, ok := s.Cache.Load("address")
+	if !ok || !res.Valid {
+		return "", errors.New("\"terraform.evaluated.module\" failed: no value provided for static field \"address\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"terraform.evaluated.module\" failed to cast field \"address\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Source accessor autogenerated
+func (s *mqlTerraformEvaluatedModule) Source() (string, error) {
+	res, ok := s.Cache.Load("source")
+	if !ok || !res.Valid {
+		return "", errors.New("\"terraform.evaluated.module\" failed: no value provided for static field \"source\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"terraform.evaluated.module\" failed to cast field \"source\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Dir accessor autogenerated
+func (s *mqlTerraformEvaluatedModule) Dir() (string, error) {
+	res, ok := s.Cache.Load("dir")
+	if !ok || !res.Valid {
+		return "", errors.New("\"terraform.evaluated.module\" failed: no value provided for static field \"dir\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"terraform.evaluated.module\" failed to cast field \"dir\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Key accessor autogenerated
+func (s *mqlTerraformEvaluatedModule) Key() (interface{}, error) {
+	res, ok := s.Cache.Load("key")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"terraform.evaluated.module\" failed: no value provided for static field \"key\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"terraform.evaluated.module\" failed to cast field \"key\" to the right type (interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Variables accessor autogenerated
+func (s *mqlTerraformEvaluatedModule) Variables() (interface{}, error) {
+	res, ok := s.Cache.Load("variables")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"terraform.evaluated.module\" failed: no value provided for static field \"variables\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"terraform.evaluated.module\" failed to cast field \"variables\" to the right type (interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Locals accessor autogenerated
+func (s *mqlTerraformEvaluatedModule) Locals() (interface{}, error) {
+	res, ok := s.Cache.Load("locals")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"terraform.evaluated.module\" failed: no value provided for static field \"locals\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"terraform.evaluated.module\" failed to cast field \"locals\" to the right type (interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Outputs accessor autogenerated
+func (s *mqlTerraformEvaluatedModule) Outputs() (interface{}, error) {
+	res, ok := s.Cache.Load("outputs")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"terraform.evaluated.module\" failed: no value provided for static field \"outputs\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"terraform.evaluated.module\" failed to cast field \"outputs\" to the right type (interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Block accessor autogenerated
+func (s *mqlTerraformEvaluatedModule) Block() (TerraformBlock, error) {
+	res, ok := s.Cache.Load("block")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"terraform.evaluated.module\" failed: no value provided for static field \"block\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(TerraformBlock)
+	if !ok {
+		return nil, fmt.Errorf("\"terraform.evaluated.module\" failed to cast field \"block\" to the right type (TerraformBlock): %#v", res)
+	}
+	return tres, nil
+}
+
+// Resources accessor autogenerated
+func (s *mqlTerraformEvaluatedModule) Resources() ([]interface{}, error) {
+	res, ok := s.Cache.Load("resources")
+	if !ok || !res.Valid {
+		if err := s.ComputeResources(); err != nil {
+			return nil, err
+		}
+		res, ok = s.Cache.Load("resources")
+		if !ok {
+			return nil, errors.New("\"terraform.evaluated.module\" calculated \"resources\" but didn't find its value in cache.")
+		}
+		s.MotorRuntime.Trigger(s, "resources")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"terraform.evaluated.module\" failed to cast field \"resources\" to the right type ([]interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Compute accessor autogenerated
+func (s *mqlTerraformEvaluatedModule) Compute(name string) error {
+	log.Trace().Str("field", name).Msg("[terraform.evaluated.module].Compute")
+	switch name {
+	case "address":
+		return nil
+	case "source":
+		return nil
+	case "dir":
+		return nil
+	case "key":
+		return nil
+	case "variables":
+		return nil
+	case "locals":
+		return nil
+	case "outputs":
+		return nil
+	case "block":
+		return nil
+	case "resources":
+		return s.ComputeResources()
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"terraform.evaluated.module\" resource")
+	}
+}
+
+// ComputeResources computer autogenerated
+func (s *mqlTerraformEvaluatedModule) ComputeResources() error {
+	var err error
+	if _, ok := s.Cache.Load("resources"); ok {
+		return nil
+	}
+	vres, err := s.GetResources()
+	if _, ok := err.(resources.NotReadyError); ok {
+		return err
+	}
+	s.Cache.Store("resources", &resources.CacheEntry{Data: vres, Valid: true, Error: err, Timestamp: time.Now().Unix()})
+	return nil
+}
+
+// TerraformEvaluatedResource resource interface
+type TerraformEvaluatedResource interface {
+	MqlResource() (*resources.Resource)
+	Compute(string) error
+	Field(string) (interface{}, error)
+	Register(string) error
+	Validate() error
+	Address() (string, error)
+	Module() (string, error)
+	Mode() (string, error)
+	Type() (string, error)
+	Name() (string, error)
+	Key() (interface{}, error)
+	Arguments() (interface{}, error)
+	Block() (TerraformBlock, error)
+}
+
+// mqlTerraformEvaluatedResource for the terraform.evaluated.resource resource
+type mqlTerraformEvaluatedResource struct {
+	*resources.Resource
+}
+
+// MqlResource to retrieve the underlying resource info
+func (s *mqlTerraformEvaluatedResource) MqlResource() *resources.Resource {
+	return s.Resource
+}
+
+// create a new instance of the terraform.evaluated.resource resource
+func newTerraformEvaluatedResource(runtime *resources.Runtime, args *resources.Args) (interface{}, error) {
+	// User hooks
+	var err error
+	res := mqlTerraformEvaluatedResource{runtime.NewResource("terraform.evaluated.resource")}
+	// assign all named fields
+	var id string
+
+	now := time.Now().Unix()
+	for name, val := range *args {
+		if val == nil {
+			res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+			continue
+		}
+
+		switch name {
+		case "address":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated.resource\", its \"address\" argument has the wrong type (expected type \"string\")")
+			}
+		case "module":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated.resource\", its \"module\" argument has the wrong type (expected type \"string\")")
+			}
+		case "mode":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated.resource\", its \"mode\" argument has the wrong type (expected type \"string\")")
+			}
+		case "type":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated.resource\", its \"type\" argument has the wrong type (expected type \"string\")")
+			}
+		case "name":
+			if _, ok := val.(string); !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated.resource\", its \"name\" argument has the wrong type (expected type \"string\")")
+			}
+		case "key":
+			if _, ok := val.(interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated.resource\", its \"key\" argument has the wrong type (expected type \"interface{}\")")
+			}
+		case "arguments":
+			if _, ok := val.(interface{}); !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated.resource\", its \"arguments\" argument has the wrong type (expected type \"interface{}\")")
+			}
+		case "block":
+			if _, ok := val.(TerraformBlock); !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated.resource\", its \"block\" argument has the wrong type (expected type \"TerraformBlock\")")
+			}
+		case "__id":
+			idVal, ok := val.(string)
+			if !ok {
+				return nil, errors.New("Failed to initialize \"terraform.evaluated.resource\", its \"__id\" argument has the wrong type (expected type \"string\")")
+			}
+			id = idVal
+		default:
+			return nil, errors.New("Initialized terraform.evaluated.resource with unknown argument " + name)
+		}
+		res.Cache.Store(name, &resources.CacheEntry{Data: val, Valid: true, Timestamp: now})
+	}
+
+	// Get the ID
+	if id == "" {
+		res.Resource.Id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res.Resource.Id = id
+	}
+
+	return &res, nil
+}
+
+func (s *mqlTerraformEvaluatedResource) Validate() error {
+	// required arguments
+	if _, ok := s.Cache.Load("address"); !ok {
+		return errors.New("Initialized \"terraform.evaluated.resource\" resource without a \"address\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("module"); !ok {
+		return errors.New("Initialized \"terraform.evaluated.resource\" resource without a \"module\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("mode"); !ok {
+		return errors.New("Initialized \"terraform.evaluated.resource\" resource without a \"mode\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("type"); !ok {
+		return errors.New("Initialized \"terraform.evaluated.resource\" resource without a \"type\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("name"); !ok {
+		return errors.New("Initialized \"terraform.evaluated.resource\" resource without a \"name\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("key"); !ok {
+		return errors.New("Initialized \"terraform.evaluated.resource\" resource without a \"key\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("arguments"); !ok {
+		return errors.New("Initialized \"terraform.evaluated.resource\" resource without a \"arguments\". This field is required.")
+	}
+	if _, ok := s.Cache.Load("block"); !ok {
+		return errors.New("Initialized \"terraform.evaluated.resource\" resource without a \"block\". This field is required.")
+	}
+
+	return nil
+}
+
+// Register accessor autogenerated
+func (s *mqlTerraformEvaluatedResource) Register(name string) error {
+	log.Trace().Str("field", name).Msg("[terraform.evaluated.resource].Register")
+	switch name {
+	case "address":
+		return nil
+	case "module":
+		return nil
+	case "mode":
+		return nil
+	case "type":
+		return nil
+	case "name":
+		return nil
+	case "key":
+		return nil
+	case "arguments":
+		return nil
+	case "block":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"terraform.evaluated.resource\" resource")
+	}
+}
+
+// Field accessor autogenerated
+func (s *mqlTerraformEvaluatedResource) Field(name string) (interface{}, error) {
+	log.Trace().Str("field", name).Msg("[terraform.evaluated.resource].Field")
+	switch name {
+	case "address":
+		return s.Address()
+	case "module":
+		return s.Module()
+	case "mode":
+		return s.Mode()
+	case "type":
+		return s.Type()
+	case "name":
+		return s.Name()
+	case "key":
+		return s.Key()
+	case "arguments":
+		return s.Arguments()
+	case "block":
+		return s.Block()
+	default:
+		return nil, fmt.Errorf("Cannot find field '" + name + "' in \"terraform.evaluated.resource\" resource")
+	}
+}
+
+// Address accessor autogenerated
+func (s *mqlTerraformEvaluatedResource) Address() (string, error) {
+	res, ok := s.Cache.Load("address")
+	if !ok || !res.Valid {
+		return "", errors.New("\"terraform.evaluated.resource\" failed: no value provided for static field \"address\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"terraform.evaluated.resource\" failed to cast field \"address\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Module accessor autogenerated
+func (s *mqlTerraformEvaluatedResource) Module() (string, error) {
+	res, ok := s.Cache.Load("module")
+	if !ok || !res.Valid {
+		return "", errors.New("\"terraform.evaluated.resource\" failed: no value provided for static field \"module\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"terraform.evaluated.resource\" failed to cast field \"module\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Mode accessor autogenerated
+func (s *mqlTerraformEvaluatedResource) Mode() (string, error) {
+	res, ok := s.Cache.Load("mode")
+	if !ok || !res.Valid {
+		return "", errors.New("\"terraform.evaluated.resource\" failed: no value provided for static field \"mode\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"terraform.evaluated.resource\" failed to cast field \"mode\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Type accessor autogenerated
+func (s *mqlTerraformEvaluatedResource) Type() (string, error) {
+	res, ok := s.Cache.Load("type")
+	if !ok || !res.Valid {
+		return "", errors.New("\"terraform.evaluated.resource\" failed: no value provided for static field \"type\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"terraform.evaluated.resource\" failed to cast field \"type\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Name accessor autogenerated
+func (s *mqlTerraformEvaluatedResource) Name() (string, error) {
+	res, ok := s.Cache.Load("name")
+	if !ok || !res.Valid {
+		return "", errors.New("\"terraform.evaluated.resource\" failed: no value provided for static field \"name\"")
+	}
+	if res.Error != nil {
+		return "", res.Error
+	}
+	tres, ok := res.Data.(string)
+	if !ok {
+		return "", fmt.Errorf("\"terraform.evaluated.resource\" failed to cast field \"name\" to the right type (string): %#v", res)
+	}
+	return tres, nil
+}
+
+// Key accessor autogenerated
+func (s *mqlTerraformEvaluatedResource) Key() (interface{}, error) {
+	res, ok := s.Cache.Load("key")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"terraform.evaluated.resource\" failed: no value provided for static field \"key\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"terraform.evaluated.resource\" failed to cast field \"key\" to the right type (interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Arguments accessor autogenerated
+func (s *mqlTerraformEvaluatedResource) Arguments() (interface{}, error) {
+	res, ok := s.Cache.Load("arguments")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"terraform.evaluated.resource\" failed: no value provided for static field \"arguments\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(interface{})
+	if !ok {
+		return nil, fmt.Errorf("\"terraform.evaluated.resource\" failed to cast field \"arguments\" to the right type (interface{}): %#v", res)
+	}
+	return tres, nil
+}
+
+// Block accessor autogenerated
+func (s *mqlTerraformEvaluatedResource) Block() (TerraformBlock, error) {
+	res, ok := s.Cache.Load("block")
+	if !ok || !res.Valid {
+		return nil, errors.New("\"terraform.evaluated.resource\" failed: no value provided for static field \"block\"")
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	tres, ok := res.Data.(TerraformBlock)
+	if !ok {
+		return nil, fmt.Errorf("\"terraform.evaluated.resource\" failed to cast field \"block\" to the right type (TerraformBlock): %#v", res)
+	}
+	return tres, nil
+}
+
+// Compute accessor autogenerated
+func (s *mqlTerraformEvaluatedResource) Compute(name string) error {
+	log.Trace().Str("field", name).Msg("[terraform.evaluated.resource].Compute")
+	switch name {
+	case "address":
+		return nil
+	case "module":
+		return nil
+	case "mode":
+		return nil
+	case "type":
+		return nil
+	case "name":
+		return nil
+	case "key":
+		return nil
+	case "arguments":
+		return nil
+	case "block":
+		return nil
+	default:
+		return errors.New("Cannot find field '" + name + "' in \"terraform.evaluated.resource\" resource")
+	}
+}
+
 // TerraformState resource interface
 type TerraformState interface {
 	MqlResource() (*resources.Resource)
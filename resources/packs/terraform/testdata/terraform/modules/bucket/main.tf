@@ -0,0 +1,25 @@
+variable "name" {
+  type = string
+}
+
+variable "versioning" {
+  type    = bool
+  default = true
+}
+
+resource "aws_s3_bucket" "this" {
+  bucket = var.name
+}
+
+resource "aws_s3_bucket_versioning" "this" {
+  count  = var.versioning ? 1 : 0
+  bucket = aws_s3_bucket.this.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+output "bucket" {
+  value = var.name
+}
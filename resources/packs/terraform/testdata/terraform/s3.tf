@@ -0,0 +1,30 @@
+variable "kms_encryption" {
+  type    = bool
+  default = true
+}
+
+locals {
+  environment = "production"
+  log_bucket  = "${local.environment}-logs"
+}
+
+resource "aws_s3_bucket" "data" {
+  for_each = toset(["assets", "backups"])
+  bucket   = "${local.environment}-${each.key}"
+}
+
+resource "aws_s3_bucket_server_side_encryption_configuration" "data" {
+  for_each = aws_s3_bucket.data
+  bucket   = each.value.id
+
+  rule {
+    apply_server_side_encryption_by_default {
+      sse_algorithm = var.kms_encryption ? "aws:kms" : "AES256"
+    }
+  }
+}
+
+module "logs" {
+  source = "./modules/bucket"
+  name   = local.log_bucket
+}
@@ -67,6 +67,11 @@ func InitTester(motor *motor.Motor, registry *resources.Registry) *tester {
 	}
 }
 
+// InitRuntimeTester runs queries on an existing runtime
+func InitRuntimeTester(runtime *resources.Runtime) *tester {
+	return &tester{runtime: runtime}
+}
+
 func (ctx *tester) Compile(query string) (*llx.CodeBundle, error) {
 	return mqlc.Compile(query, nil, mqlc.NewConfig(ctx.runtime.Registry.Schema(), Features))
 }
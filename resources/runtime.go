@@ -78,6 +78,7 @@ type Runtime struct {
 	cache          *Cache
 	Observers      *Observers
 	UpstreamConfig *UpstreamConfig
+	FieldCache     FieldCache
 	children       []*Runtime
 }
 
@@ -129,11 +130,12 @@ func (ctx *Runtime) cloneWithMotor(motor *motor.Motor) *Runtime {
 	}
 
 	return &Runtime{
-		Registry:  ctx.Registry,
-		Observers: ctx.Observers,
-		Motor:     motor,
-		cache:     &Cache{},
-		children:  []*Runtime{},
+		Registry:   ctx.Registry,
+		Observers:  ctx.Observers,
+		Motor:      motor,
+		FieldCache: ctx.FieldCache,
+		cache:      &Cache{},
+		children:   []*Runtime{},
 	}
 }
 
@@ -338,11 +340,17 @@ func (ctx *Runtime) WatchAndUpdate(r ResourceType, field string, watcherUID stri
 	// if the field wasnt registered in the chain of watchers yet,
 	// pull all its dependencies in
 	if isInitial {
+		// cached fields don't need their dependencies
+		if ctx.loadFromFieldCache(resource, field) {
+			processResult()
+			return nil
+		}
+
 		if err = r.Register(field); err != nil {
 			return err
 		}
 
-		err = r.Compute(field)
+		err = ctx.compute(r, field)
 		// normal case most often: we called compute but it depends on something
 		// that is not ready
 		if _, ok := err.(NotReadyError); ok {
@@ -378,7 +386,7 @@ func (ctx *Runtime) WatchAndCompute(src ResourceType, sfield string, dst Resourc
 
 	isInitial, exists, err := ctx.Observers.Watch(sid, fid, func() {
 		// once the source field changes, we recalculate the destination field
-		ierr := dst.Compute(dfield)
+		ierr := ctx.compute(dst, dfield)
 		// if the field isnt ready, finish this execution
 		if _, ok := ierr.(NotReadyError); ok {
 			return
@@ -409,12 +417,16 @@ func (ctx *Runtime) WatchAndCompute(src ResourceType, sfield string, dst Resourc
 	// if the field wasn't registered in the chain of watchers yet,
 	// pull all its dependencies in
 	if isInitial {
+		if ctx.loadFromFieldCache(src.MqlResource(), sfield) {
+			return nil
+		}
+
 		if err = src.Register(sfield); err != nil {
 			log.Error().Err(err).Msg("w+c> initial register failed")
 			return err
 		}
 
-		err = src.Compute(sfield)
+		err = ctx.compute(src, sfield)
 		if err != nil {
 			if _, ok := err.(NotReadyError); !ok {
 				log.Trace().Err(err).Msg("w+c> initial compute failed")
@@ -454,7 +466,10 @@ func (ctx *Runtime) Trigger(r ResourceType, field string) error {
 		return NotReadyError{}
 	}
 
-	return r.Compute(field)
+	if ctx.loadFromFieldCache(resource, field) {
+		return ctx.Observers.Trigger(resource.FieldUID(field))
+	}
+	return ctx.compute(r, field)
 }
 
 func (r *Runtime) Close() {
@@ -1,17 +1,27 @@
 package service
 
-//go:generate protoc --proto_path=../../resources:. --go_out=. --go_opt=paths=source_relative --rangerrpc_out=. service.proto
+//go:generate protoc --proto_path=../../resources:../../llx:. --go_out=. --go_opt=paths=source_relative --rangerrpc_out=. service.proto
 
 import (
 	context "context"
 	json "encoding/json"
+	"errors"
+	"sync"
 
+	"go.mondoo.com/cnquery"
+	"go.mondoo.com/cnquery/llx"
+	"go.mondoo.com/cnquery/mql"
+	"go.mondoo.com/cnquery/mqlc"
 	"go.mondoo.com/cnquery/resources"
 )
 
 type Server struct {
 	Registry *resources.Registry
 	Runtime  *resources.Runtime
+	Features cnquery.Features
+
+	// queries are executed one at a time against the runtime
+	runLock sync.Mutex
 }
 
 // List out all resources
@@ -71,3 +81,35 @@ func (server *Server) GetField(ctx context.Context, q *FieldArguments) (*FieldRe
 	}
 	return &FieldReturn{Data: bytes}, nil
 }
+
+// Run compiles the query and executes it against the runtime. It returns the
+// results of all entrypoints of the query.
+func (server *Server) Run(ctx context.Context, q *RunRequest) (*RunResponse, error) {
+	schema := server.Registry.Schema()
+	bundle, err := mqlc.Compile(q.Query, q.Props, mqlc.NewConfig(schema, server.Features))
+	if err != nil {
+		return nil, errors.New("failed to compile: " + err.Error())
+	}
+
+	server.runLock.Lock()
+	raw, err := mql.ExecuteCode(schema, server.Runtime, bundle, q.Props, server.Features)
+	server.runLock.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	results := llx.ReturnValuesV2(bundle, func(checksum string) (*llx.RawResult, bool) {
+		res, ok := raw[checksum]
+		return res, ok
+	})
+
+	res := &RunResponse{Results: make([]*llx.Result, len(results))}
+	for i := range results {
+		data, err := results[i].Data.Dereference(results[i].CodeID, bundle)
+		if err != nil {
+			return nil, err
+		}
+		res.Results[i] = (&llx.RawResult{CodeID: results[i].CodeID, Data: data}).Result()
+	}
+	return res, nil
+}
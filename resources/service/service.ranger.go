@@ -25,6 +25,7 @@ type MQL interface {
 	ListFields(context.Context, *FieldsQuery) (*Fields, error)
 	CreateResource(context.Context, *ResourceArguments) (*resources.ResourceID, error)
 	GetField(context.Context, *FieldArguments) (*FieldReturn, error)
+	Run(context.Context, *RunRequest) (*RunResponse, error)
 }
 
 // client implementation
@@ -78,6 +79,11 @@ func (c *MQLClient) GetField(ctx context.Context, in *FieldArguments) (*FieldRet
 	err := c.DoClientRequest(ctx, c.httpclient, strings.Join([]string{c.prefix, "/GetField"}, ""), in, out)
 	return out, err
 }
+func (c *MQLClient) Run(ctx context.Context, in *RunRequest) (*RunResponse, error) {
+	out := new(RunResponse)
+	err := c.DoClientRequest(ctx, c.httpclient, strings.Join([]string{c.prefix, "/Run"}, ""), in, out)
+	return out, err
+}
 
 // server implementation
 
@@ -106,6 +112,7 @@ func NewMQLServer(handler MQL, opts ...MQLServerOption) http.Handler {
 			"ListFields":     srv.ListFields,
 			"CreateResource": srv.CreateResource,
 			"GetField":       srv.GetField,
+			"Run":            srv.Run,
 		},
 	}
 	return ranger.NewRPCServer(&service)
@@ -236,3 +243,27 @@ func (p *MQLServer) GetField(ctx context.Context, reqBytes *[]byte) (pb.Message,
 	}
 	return p.handler.GetField(ctx, &req)
 }
+func (p *MQLServer) Run(ctx context.Context, reqBytes *[]byte) (pb.Message, error) {
+	var req RunRequest
+	var err error
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, errors.New("could not access header")
+	}
+
+	switch md.First("Content-Type") {
+	case "application/protobuf", "application/octet-stream", "application/grpc+proto":
+		err = pb.Unmarshal(*reqBytes, &req)
+	default:
+		// handle case of empty object
+		if len(*reqBytes) > 0 {
+			err = jsonpb.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(*reqBytes, &req)
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return p.handler.Run(ctx, &req)
+}
@@ -281,3 +281,60 @@ var Equal = map[Type]func(interface{}, interface{}) bool{
 		return left.(int32) == right.(int32)
 	},
 }
+
+// Less provides a set of functions for a range of types to test if the left
+// value is smaller than the right value of that type
+var Less = map[Type]func(interface{}, interface{}) bool{
+	Bool: func(left, right interface{}) bool {
+		return !left.(bool) && right.(bool)
+	},
+	Int: func(left, right interface{}) bool {
+		return left.(int64) < right.(int64)
+	},
+	Float: func(left, right interface{}) bool {
+		return left.(float64) < right.(float64)
+	},
+	String: func(left, right interface{}) bool {
+		return left.(string) < right.(string)
+	},
+	Time: func(left, right interface{}) bool {
+		l := left.(*time.Time)
+		r := right.(*time.Time)
+		if l == nil || r == nil {
+			return l == nil && r != nil
+		}
+		return l.Before(*r)
+	},
+	// dicts can hold any value; numbers are sorted before strings
+	Dict: func(left, right interface{}) bool {
+		lrank, lnum, lstr := dictSortKey(left)
+		rrank, rnum, rstr := dictSortKey(right)
+		if lrank != rrank {
+			return lrank < rrank
+		}
+		if lrank == 1 {
+			return lnum < rnum
+		}
+		return lstr < rstr
+	},
+}
+
+func dictSortKey(v interface{}) (int, float64, string) {
+	switch x := v.(type) {
+	case nil:
+		return 0, 0, ""
+	case bool:
+		if x {
+			return 1, 1, ""
+		}
+		return 1, 0, ""
+	case int64:
+		return 1, float64(x), ""
+	case float64:
+		return 1, x, ""
+	case string:
+		return 2, 0, x
+	default:
+		return 3, 0, ""
+	}
+}